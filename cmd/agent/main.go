@@ -9,16 +9,28 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/kserve/kserve/pkg/agent"
 	"github.com/kserve/kserve/pkg/agent/storage"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/auth"
 	"github.com/kserve/kserve/pkg/batcher"
+	"github.com/kserve/kserve/pkg/cache"
+	"github.com/kserve/kserve/pkg/constants"
 	kfslogger "github.com/kserve/kserve/pkg/logger"
+	"github.com/kserve/kserve/pkg/metrics"
+	"github.com/kserve/kserve/pkg/openai"
+	"github.com/kserve/kserve/pkg/outlier"
+	"github.com/kserve/kserve/pkg/requestqueue"
+	"github.com/kserve/kserve/pkg/tracing"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	flag "github.com/spf13/pflag"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	network "knative.dev/networking/pkg"
 	pkglogging "knative.dev/pkg/logging"
@@ -42,14 +54,52 @@ var (
 	workers          = flag.Int("workers", 5, "Number of workers")
 	sourceUri        = flag.String("source-uri", "", "The source URI to use when publishing cloudevents")
 	logMode          = flag.String("log-mode", string(v1beta1.LogAll), "Whether to log 'request', 'response' or 'all'")
-	inferenceService = flag.String("inference-service", "", "The InferenceService name to add as header to log events")
-	namespace        = flag.String("namespace", "", "The namespace to add as header to log events")
+	inferenceService = flag.String("inference-service", "", "The InferenceService name to add as header to log events and as label to metrics")
+	namespace        = flag.String("namespace", "", "The namespace to add as header to log events and as label to metrics")
 	endpoint         = flag.String("endpoint", "", "The endpoint name to add as header to log events")
-	component        = flag.String("component", "", "The component name (predictor, explainer, transformer) to add as header to log events")
+	component        = flag.String("component", "", "The component name (predictor, explainer, transformer) to add as header to log events and as label to metrics")
+	samplingPercent  = flag.Int("log-sampling-percent", 100, "The percentage of the requests to log")
+	kafkaBrokers     = flag.String("log-kafka-brokers", "", "The comma separated Kafka brokers to send request/response logs to instead of the log-url")
+	kafkaTopic       = flag.String("log-kafka-topic", "", "The Kafka topic to send request/response logs to")
+	kafkaSecretDir   = flag.String("log-kafka-secret-dir", "", "The directory of the TLS and SASL configuration of the Kafka brokers")
+	storageUri       = flag.String("log-storage-uri", "", "The s3:// or gs:// URI to write request/response logs to instead of the log-url")
+	storageBatchSize = flag.Int("log-storage-batch-size", 1000, "The max number of request/response logs written to a storage object")
+	storageFlush     = flag.Duration("log-storage-flush-interval", time.Minute, "The max duration request/response logs are buffered before they are written to storage")
+	redactPaths      = flag.String("log-redact", "", "The comma separated JSON paths of the payload fields to mask before logging")
 	// batcher flags
 	enableBatcher = flag.Bool("enable-batcher", false, "Enable request batcher")
 	maxBatchSize  = flag.String("max-batchsize", "32", "Max Batch Size")
 	maxLatency    = flag.String("max-latency", "5000", "Max Latency in milliseconds")
+	// outlier detector flags
+	outlierDetectorUrl = flag.String("outlier-detector-url", "", "The URL of the outlier detector to score requests with before responding")
+	// request queue flags
+	enableRequestQueue = flag.Bool("enable-request-queue", false, "Enable request queue")
+	maxInFlight        = flag.Int("max-in-flight", 1, "Max number of requests forwarded to the component concurrently")
+	maxQueueDepth      = flag.Int("max-queue-depth", 0, "Max number of requests waiting for the component")
+	retryAfter         = flag.Int("retry-after", requestqueue.DefaultRetryAfterSeconds, "Retry-After seconds of the rejected requests")
+	metricsPort        = flag.String("metrics-port", "9089", "Agent metrics port")
+	// openai flags
+	enableOpenAI     = flag.Bool("enable-openai", false, "Enable the OpenAI API")
+	openAIModelName  = flag.String("openai-model-name", "", "The name of the model the OpenAI requests are sent to")
+	openAIInputName  = flag.String("openai-input-name", openai.DefaultInputName, "The input tensor of the prompts")
+	openAIOutputName = flag.String("openai-output-name", "", "The output tensor of the generated texts or embeddings")
+	// response cache flags
+	enableResponseCache    = flag.Bool("enable-response-cache", false, "Enable response cache")
+	responseCacheTTL       = flag.Int("response-cache-ttl", cache.DefaultTTLSeconds, "Seconds the responses are cached")
+	responseCacheEntries   = flag.Int("response-cache-max-entries", cache.DefaultMaxEntries, "Max number of responses cached in memory")
+	responseCacheRedis     = flag.String("response-cache-redis-address", "", "The host:port of the Redis server to cache the responses in instead of memory")
+	responseCacheKeyPrefix = flag.String("response-cache-key-prefix", "", "The prefix of the cache keys of the responses")
+	// metrics flags
+	revision                = flag.String("revision", "", "The revision name to add as label to metrics")
+	enableMetricAggregation = flag.Bool("enable-metric-aggregation", false, "Serve the component metrics together with the agent metrics")
+	componentMetricsPort    = flag.String("component-metrics-port", "8080", "The port the component serves its metrics on")
+	componentMetricsPath    = flag.String("component-metrics-path", "/metrics", "The path the component serves its metrics on")
+
+	tracingEndpoint        = flag.String("tracing-endpoint", "", "The OTLP/HTTP endpoint of the collector to export the request spans to")
+	tracingSamplingPercent = flag.Int("tracing-sampling-percent", 100, "The percentage of the traces started by the agent to sample")
+
+	authenticationAudiences = flag.String("authentication-audiences", "", "Comma separated audiences, the requests without a bearer token issued for one of them are rejected")
+	authenticationIssuer    = flag.String("authentication-issuer", "", "The issuer URL of the OIDC provider, the service account tokens of the cluster are verified when not set")
 	// probing flags
 	readinessProbeTimeout = flag.Duration("probe-period", -1, "run readiness probe with given timeout")
 	// This creates an abstract socket instead of an actual file.
@@ -83,7 +133,10 @@ type config struct {
 
 type loggerArgs struct {
 	loggerType       v1beta1.LoggerType
+	samplingPercent  int
+	redactor         *kfslogger.Redactor
 	logUrl           *url.URL
+	sink             kfslogger.Sink
 	sourceUrl        *url.URL
 	inferenceService string
 	namespace        string
@@ -91,11 +144,42 @@ type loggerArgs struct {
 	component        string
 }
 
+type outlierArgs struct {
+	detectorUrl *url.URL
+	sourceUrl   *url.URL
+}
+
 type batcherArgs struct {
 	maxBatchSize int
 	maxLatency   int
 }
 
+type requestQueueArgs struct {
+	maxInFlight   int
+	maxQueueDepth int
+	retryAfter    int
+}
+
+type responseCacheArgs struct {
+	store     cache.Store
+	ttl       time.Duration
+	keyPrefix string
+}
+
+type tracingArgs struct {
+	provider *sdktrace.TracerProvider
+}
+
+type authArgs struct {
+	verifier auth.TokenVerifier
+}
+
+type openAIArgs struct {
+	modelName  string
+	inputName  string
+	outputName string
+}
+
 func main() {
 	flag.Parse()
 	// Parse the environment.
@@ -118,7 +202,7 @@ func main() {
 	}
 
 	var loggerArgs *loggerArgs
-	if *logUrl != "" {
+	if *logUrl != "" || *kafkaBrokers != "" || *storageUri != "" {
 		logger.Info("Starting logger")
 		loggerArgs = startLogger(*workers, logger)
 	}
@@ -128,11 +212,53 @@ func main() {
 		logger.Info("Starting batcher")
 		batcherArgs = startBatcher(logger)
 	}
+
+	var outlierArgs *outlierArgs
+	if *outlierDetectorUrl != "" {
+		logger.Info("Starting outlier detection")
+		outlierArgs = startOutlierDetection(logger)
+	}
+	var requestQueueArgs *requestQueueArgs
+	if *enableRequestQueue {
+		logger.Info("Starting request queue")
+		requestQueueArgs = startRequestQueue(logger)
+	}
+	var openAIArgs *openAIArgs
+	if *enableOpenAI {
+		logger.Info("Starting OpenAI API")
+		openAIArgs = startOpenAI(logger)
+	}
+	var responseCacheArgs *responseCacheArgs
+	if *enableResponseCache {
+		logger.Info("Starting response cache")
+		responseCacheArgs = startResponseCache(logger)
+	}
+	var tracingArgs *tracingArgs
+	if *tracingEndpoint != "" {
+		logger.Info("Starting tracing")
+		tracingArgs = startTracing(logger)
+	}
+	var authArgs *authArgs
+	if *authenticationAudiences != "" {
+		logger.Info("Starting authentication")
+		authArgs = startAuthentication(logger)
+	}
+	registerMetrics(logger)
 	logger.Info("Starting agent http server...")
 	ctx := signals.NewContext()
-	mainServer, drain := buildServer(ctx, *port, *componentPort, loggerArgs, batcherArgs, probe, logger)
+	mainServer, drain := buildServer(ctx, *port, *componentPort, loggerArgs, batcherArgs, outlierArgs, requestQueueArgs,
+		openAIArgs, responseCacheArgs, tracingArgs, authArgs, probe, logger)
+	// The metrics are served in the OpenMetrics format when accepted by the scraper, so that the exemplars are served
+	var metricsHandler http.Handler = promhttp.HandlerFor(prometheus.DefaultGatherer,
+		promhttp.HandlerOpts{EnableOpenMetrics: true})
+	if *enableMetricAggregation {
+		logger.Info("Starting metric aggregation")
+		componentMetricsUrl := fmt.Sprintf("http://localhost:%s%s", *componentMetricsPort, *componentMetricsPath)
+		metricsHandler = metrics.NewAggregator(prometheus.DefaultGatherer, componentMetricsUrl, logger)
+	}
 	servers := map[string]*http.Server{
-		"main": mainServer,
+		"main":    mainServer,
+		"metrics": pkgnet.NewServer(":"+*metricsPort, metricsHandler),
 	}
 	errCh := make(chan error)
 	listenCh := make(chan struct{})
@@ -197,10 +323,38 @@ func main() {
 				logger.Errorw("Failed to shutdown server", zap.String("server", serverName), zap.Error(err))
 			}
 		}
+		// Write the request/response logs still buffered for storage
+		if loggerArgs != nil {
+			if storageSink, ok := loggerArgs.sink.(*kfslogger.StorageSink); ok {
+				if err := storageSink.Flush(context.Background()); err != nil {
+					logger.Errorw("Failed to flush request/response logs to storage", zap.Error(err))
+				}
+			}
+		}
+		// Export the spans of the requests served while draining
+		if tracingArgs != nil {
+			if err := tracingArgs.provider.Shutdown(context.Background()); err != nil {
+				logger.Errorw("Failed to export spans", zap.Error(err))
+			}
+		}
 		logger.Info("Shutdown complete, exiting...")
 	}
 }
 
+// registerMetrics registers the agent metrics labelled with the InferenceService component served by the agent
+func registerMetrics(logger *zap.SugaredLogger) {
+	registerer := prometheus.WrapRegistererWith(metrics.ServiceLabels(*inferenceService, *namespace, *component,
+		*revision), prometheus.DefaultRegisterer)
+	if err := metrics.RegisterRequestMetrics(registerer); err != nil {
+		logger.Errorw("Failed to register the request metrics", zap.Error(err))
+		os.Exit(1)
+	}
+	if err := requestqueue.RegisterMetrics(registerer); err != nil {
+		logger.Errorw("Failed to register the request queue metrics", zap.Error(err))
+		os.Exit(1)
+	}
+}
+
 func startBatcher(logger *zap.SugaredLogger) *batcherArgs {
 	maxBatchSizeInt, err := strconv.Atoi(*maxBatchSize)
 	if err != nil || maxBatchSizeInt <= 0 {
@@ -220,6 +374,126 @@ func startBatcher(logger *zap.SugaredLogger) *batcherArgs {
 	}
 }
 
+func startRequestQueue(logger *zap.SugaredLogger) *requestQueueArgs {
+	if *maxInFlight <= 0 {
+		logger.Errorf("Invalid max in flight %d", *maxInFlight)
+		os.Exit(1)
+	}
+	if *maxQueueDepth < 0 {
+		logger.Errorf("Invalid max queue depth %d", *maxQueueDepth)
+		os.Exit(1)
+	}
+	if *retryAfter <= 0 {
+		logger.Errorf("Invalid retry after %d", *retryAfter)
+		os.Exit(1)
+	}
+	return &requestQueueArgs{
+		maxInFlight:   *maxInFlight,
+		maxQueueDepth: *maxQueueDepth,
+		retryAfter:    *retryAfter,
+	}
+}
+
+func startOpenAI(logger *zap.SugaredLogger) *openAIArgs {
+	if *openAIModelName == "" {
+		logger.Errorf("The openai-model-name must be set")
+		os.Exit(1)
+	}
+	return &openAIArgs{
+		modelName:  *openAIModelName,
+		inputName:  *openAIInputName,
+		outputName: *openAIOutputName,
+	}
+}
+
+func startResponseCache(logger *zap.SugaredLogger) *responseCacheArgs {
+	if *responseCacheTTL <= 0 {
+		logger.Errorf("Invalid response cache ttl %d", *responseCacheTTL)
+		os.Exit(1)
+	}
+	var store cache.Store
+	if *responseCacheRedis != "" {
+		store = cache.NewRedisStore(*responseCacheRedis, os.Getenv(constants.ResponseCacheRedisPasswordEnvVar))
+	} else {
+		if *responseCacheEntries <= 0 {
+			logger.Errorf("Invalid response cache max entries %d", *responseCacheEntries)
+			os.Exit(1)
+		}
+		store = cache.NewMemoryStore(*responseCacheEntries)
+	}
+	return &responseCacheArgs{
+		store:     store,
+		ttl:       time.Duration(*responseCacheTTL) * time.Second,
+		keyPrefix: *responseCacheKeyPrefix,
+	}
+}
+
+func startTracing(logger *zap.SugaredLogger) *tracingArgs {
+	if !strings.HasPrefix(*tracingEndpoint, "http://") && !strings.HasPrefix(*tracingEndpoint, "https://") {
+		logger.Errorf("Malformed tracing-endpoint %s", *tracingEndpoint)
+		os.Exit(1)
+	}
+	if *tracingSamplingPercent < 0 || *tracingSamplingPercent > 100 {
+		logger.Errorf("Malformed tracing-sampling-percent %d", *tracingSamplingPercent)
+		os.Exit(1)
+	}
+	// The spans are attributed to the InferenceService component, e.g. sklearn-predictor
+	serviceName := "kserve-agent"
+	if *inferenceService != "" {
+		serviceName = *inferenceService
+		if *component != "" {
+			serviceName = *inferenceService + "-" + *component
+		}
+	}
+	resource := map[string]string{"service.name": serviceName}
+	for key, value := range map[string]string{
+		"k8s.namespace.name":      *namespace,
+		"kserve.inferenceservice": *inferenceService,
+		"kserve.component":        *component,
+		"kserve.revision":         *revision,
+	} {
+		if value != "" {
+			resource[key] = value
+		}
+	}
+	provider, err := tracing.NewTracerProvider(*tracingEndpoint, *tracingSamplingPercent, resource)
+	if err != nil {
+		logger.Errorw("Failed to create the span exporter", zap.Error(err))
+		os.Exit(1)
+	}
+	return &tracingArgs{provider: provider}
+}
+
+func startAuthentication(logger *zap.SugaredLogger) *authArgs {
+	var audiences []string
+	for _, audience := range strings.Split(*authenticationAudiences, ",") {
+		if audience = strings.TrimSpace(audience); audience != "" {
+			audiences = append(audiences, audience)
+		}
+	}
+	if len(audiences) == 0 {
+		logger.Errorf("Malformed authentication-audiences %s", *authenticationAudiences)
+		os.Exit(1)
+	}
+	var verifier *auth.Verifier
+	if *authenticationIssuer != "" {
+		if !strings.HasPrefix(*authenticationIssuer, "https://") {
+			logger.Errorf("Malformed authentication-issuer %s", *authenticationIssuer)
+			os.Exit(1)
+		}
+		verifier = auth.NewOIDCVerifier(*authenticationIssuer, audiences)
+	} else {
+		var err error
+		if verifier, err = auth.NewKubernetesVerifier(audiences); err != nil {
+			logger.Errorw("Failed to create the service account token verifier", "error", err)
+			os.Exit(1)
+		}
+	}
+	// The requests are rejected with 503 until the signing keys are fetched, the agent starts while the issuer is down
+	go verifier.Run(context.Background(), logger)
+	return &authArgs{verifier: verifier}
+}
+
 func startLogger(workers int, logger *zap.SugaredLogger) *loggerArgs {
 	loggingMode := v1beta1.LoggerType(*logMode)
 	switch loggingMode {
@@ -229,6 +503,11 @@ func startLogger(workers int, logger *zap.SugaredLogger) *loggerArgs {
 		os.Exit(-1)
 	}
 
+	if *samplingPercent < 0 || *samplingPercent > 100 {
+		logger.Errorf("Malformed log-sampling-percent %d", *samplingPercent)
+		os.Exit(-1)
+	}
+
 	logUrlParsed, err := url.Parse(*logUrl)
 	if err != nil {
 		logger.Errorf("Malformed log-url %s", *logUrl)
@@ -244,11 +523,45 @@ func startLogger(workers int, logger *zap.SugaredLogger) *loggerArgs {
 		logger.Errorf("Malformed source_uri %s", *sourceUri)
 		os.Exit(-1)
 	}
+	var sink kfslogger.Sink
+	if *kafkaBrokers != "" {
+		kafkaSink, err := kfslogger.NewKafkaSink(strings.Split(*kafkaBrokers, ","), *kafkaTopic, *kafkaSecretDir)
+		if err != nil {
+			logger.Errorf("Failed to connect to the log-kafka-brokers %s: %v", *kafkaBrokers, err)
+			os.Exit(-1)
+		}
+		sink = kafkaSink
+	}
+	if *storageUri != "" {
+		if *storageBatchSize <= 0 || *storageFlush <= 0 {
+			logger.Errorf("Malformed log-storage-batch-size %d or log-storage-flush-interval %v", *storageBatchSize, *storageFlush)
+			os.Exit(-1)
+		}
+		storageSink, err := kfslogger.NewStorageSink(*storageUri, *storageBatchSize)
+		if err != nil {
+			logger.Errorf("Failed to create the storage sink of the log-storage-uri %s: %v", *storageUri, err)
+			os.Exit(-1)
+		}
+		go storageSink.Run(context.Background(), *storageFlush, logger)
+		sink = storageSink
+	}
+	var redactor *kfslogger.Redactor
+	if *redactPaths != "" {
+		redactor, err = kfslogger.NewRedactor(strings.Split(*redactPaths, ","))
+		if err != nil {
+			logger.Errorf("Malformed log-redact %s: %v", *redactPaths, err)
+			os.Exit(-1)
+		}
+	}
+
 	logger.Info("Starting the log dispatcher")
 	kfslogger.StartDispatcher(workers, logger)
 	return &loggerArgs{
 		loggerType:       loggingMode,
+		samplingPercent:  *samplingPercent,
+		redactor:         redactor,
 		logUrl:           logUrlParsed,
+		sink:             sink,
 		sourceUrl:        sourceUriParsed,
 		inferenceService: *inferenceService,
 		endpoint:         *endpoint,
@@ -257,6 +570,28 @@ func startLogger(workers int, logger *zap.SugaredLogger) *loggerArgs {
 	}
 }
 
+func startOutlierDetection(logger *zap.SugaredLogger) *outlierArgs {
+	detectorUrlParsed, err := url.Parse(*outlierDetectorUrl)
+	if err != nil {
+		logger.Errorf("Malformed outlier-detector-url %s", *outlierDetectorUrl)
+		os.Exit(-1)
+	}
+
+	if *sourceUri == "" {
+		*sourceUri = fmt.Sprintf("http://localhost:%s/", *port)
+	}
+
+	sourceUriParsed, err := url.Parse(*sourceUri)
+	if err != nil {
+		logger.Errorf("Malformed source_uri %s", *sourceUri)
+		os.Exit(-1)
+	}
+	return &outlierArgs{
+		detectorUrl: detectorUrlParsed,
+		sourceUrl:   sourceUriParsed,
+	}
+}
+
 func startModelPuller(logger *zap.SugaredLogger) {
 	downloader := agent.Downloader{
 		ModelDir:  *modelDir,
@@ -283,7 +618,8 @@ func buildProbe(logger *zap.SugaredLogger, probeJSON string) *readiness.Probe {
 }
 
 func buildServer(ctx context.Context, port string, userPort string, loggerArgs *loggerArgs, batcherArgs *batcherArgs,
-	probeContainer func() bool, logging *zap.SugaredLogger) (server *http.Server, drain func()) {
+	outlierArgs *outlierArgs, requestQueueArgs *requestQueueArgs, openAIArgs *openAIArgs,
+	responseCacheArgs *responseCacheArgs, tracingArgs *tracingArgs, authArgs *authArgs, probeContainer func() bool, logging *zap.SugaredLogger) (server *http.Server, drain func()) {
 
 	logging.Infof("Building server user port %s port %s", userPort, port)
 	target := &url.URL{
@@ -306,9 +642,39 @@ func buildServer(ctx context.Context, port string, userPort string, loggerArgs *
 	if batcherArgs != nil {
 		composedHandler = batcher.New(batcherArgs.maxBatchSize, batcherArgs.maxLatency, composedHandler, logging)
 	}
+	// The cached responses are logged and scored like the responses of the model server
+	if responseCacheArgs != nil {
+		composedHandler = cache.New(responseCacheArgs.store, responseCacheArgs.ttl, responseCacheArgs.keyPrefix,
+			composedHandler, logging)
+	}
 	if loggerArgs != nil {
-		composedHandler = kfslogger.New(loggerArgs.logUrl, loggerArgs.sourceUrl, loggerArgs.loggerType,
-			loggerArgs.inferenceService, loggerArgs.namespace, loggerArgs.endpoint, loggerArgs.component, composedHandler)
+		composedHandler = kfslogger.New(loggerArgs.logUrl, loggerArgs.sink, loggerArgs.sourceUrl, loggerArgs.loggerType,
+			loggerArgs.samplingPercent, loggerArgs.redactor, loggerArgs.inferenceService, loggerArgs.namespace, loggerArgs.endpoint, loggerArgs.component, composedHandler)
+	}
+	if outlierArgs != nil {
+		composedHandler = outlier.New(outlierArgs.detectorUrl, outlierArgs.sourceUrl, composedHandler, logging)
+	}
+	// The OpenAI requests are translated before the inner handlers, so they log and score inference requests
+	if openAIArgs != nil {
+		composedHandler = openai.New(openAIArgs.modelName, openAIArgs.inputName, openAIArgs.outputName, composedHandler,
+			logging)
+	}
+	// The request queue is the outermost handler so the rejected requests are neither logged nor scored
+	if requestQueueArgs != nil {
+		composedHandler = requestqueue.New(requestQueueArgs.maxInFlight, requestQueueArgs.maxQueueDepth,
+			requestQueueArgs.retryAfter, composedHandler, logging)
+	}
+	// The unauthenticated requests are rejected before they are queued, logged or served from the cache
+	if authArgs != nil {
+		composedHandler = auth.New(authArgs.verifier, composedHandler, logging)
+	}
+
+	// The latency is measured around the request queue and the authentication so that the time spent queued and the
+	// rejections are recorded
+	composedHandler = metrics.NewRequestMetricsHandler(composedHandler)
+	// The span is started before the metrics are recorded so that the exemplars hold the trace id of the agent span
+	if tracingArgs != nil {
+		composedHandler = tracing.New(tracingArgs.provider, composedHandler)
 	}
 
 	composedHandler = queue.ForwardedShimHandler(composedHandler)
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kserve/kserve/pkg/agent/storage"
+	"github.com/kserve/kserve/pkg/batchworker"
+	flag "github.com/spf13/pflag"
+	pkglogging "knative.dev/pkg/logging"
+	"knative.dev/pkg/signals"
+)
+
+// The directory the records are downloaded to
+const inputName = "input"
+
+var (
+	inputUri      = flag.String("input-uri", "", "The storage URI of the records")
+	outputUri     = flag.String("output-uri", "", "The s3 or gs prefix the responses are written to")
+	predictUrl    = flag.String("predict-url", "", "The URL the records are posted to")
+	shards        = flag.Int("shards", 1, "The number of workers")
+	workDir       = flag.String("work-dir", "/mnt/batch", "The directory the records and the responses are written to")
+	timeout       = flag.Duration("timeout", 5*time.Minute, "The timeout of the request of a record")
+	retries       = flag.Int("retries", 5, "The number of retries of a record when the InferenceService is unavailable")
+	retryInterval = flag.Duration("retry-interval", time.Second, "The interval before the first retry of a record")
+	// The index of an indexed job completion is set in the environment of its pod
+	shard = flag.Int("shard", intEnv("JOB_COMPLETION_INDEX"), "The index of the worker")
+)
+
+func intEnv(key string) int {
+	value, _ := strconv.Atoi(os.Getenv(key))
+	return value
+}
+
+func main() {
+	flag.Parse()
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	if *inputUri == "" || *outputUri == "" || *predictUrl == "" {
+		logger.Errorf("The input-uri, output-uri and predict-url must be set")
+		os.Exit(1)
+	}
+	if *shards < 1 || *shard < 0 || *shard >= *shards {
+		logger.Errorf("Invalid shard %d of %d shards", *shard, *shards)
+		os.Exit(1)
+	}
+	if _, ok := batchworker.OutputProtocol(*outputUri); !ok {
+		logger.Errorf("Unsupported output-uri %s", *outputUri)
+		os.Exit(1)
+	}
+	ctx := signals.NewContext()
+
+	var protocol storage.Protocol
+	for _, supported := range storage.SupportedProtocols {
+		if strings.HasPrefix(*inputUri, string(supported)) {
+			protocol = supported
+		}
+	}
+	providers := map[storage.Protocol]storage.Provider{}
+	provider, err := storage.GetProvider(providers, protocol)
+	if err == nil && provider == nil {
+		err = fmt.Errorf("supported protocols are %v", storage.GetAllProtocol())
+	}
+	if err != nil {
+		logger.Errorf("Unsupported input-uri %s: %v", *inputUri, err)
+		os.Exit(1)
+	}
+	logger.Infow("Downloading records", "uri", *inputUri)
+	if err := provider.DownloadModel(*workDir, inputName, *inputUri); err != nil {
+		logger.Errorf("Failed to download records: %v", err)
+		os.Exit(1)
+	}
+
+	outputFile := filepath.Join(*workDir, "output.jsonl")
+	out, err := os.Create(outputFile)
+	if err != nil {
+		logger.Errorf("Failed to create output file: %v", err)
+		os.Exit(1)
+	}
+	worker := &batchworker.Worker{
+		PredictURL:    *predictUrl,
+		InputPath:     filepath.Join(*workDir, inputName),
+		Shard:         *shard,
+		Shards:        *shards,
+		Retries:       *retries,
+		RetryInterval: *retryInterval,
+		Client:        &http.Client{Timeout: *timeout},
+		Log:           logger,
+	}
+	scored, err := worker.Run(ctx, out)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		logger.Errorf("Failed after scoring %d records: %v", scored, err)
+		os.Exit(1)
+	}
+
+	object := batchworker.OutputObject(*outputUri, *shard)
+	logger.Infow("Uploading responses", "records", scored, "uri", object)
+	if err := batchworker.Upload(ctx, providers, outputFile, object); err != nil {
+		logger.Errorf("Failed to upload responses: %v", err)
+		os.Exit(1)
+	}
+}
@@ -22,11 +22,14 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
+	batchinferencejobcontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/batchinferencejob"
 	graphcontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/inferencegraph"
+	localmodelcachecontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/localmodelcache"
 	trainedmodelcontroller "github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel"
 	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/reconcilers/modelconfig"
 	v1beta1controller "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice"
 	"github.com/kserve/kserve/pkg/webhook/admission/pod"
+	"github.com/kserve/kserve/pkg/webhook/dryrun"
 	istio_networking "istio.io/api/networking/v1alpha3"
 	"istio.io/client-go/pkg/apis/networking/v1alpha3"
 	v1 "k8s.io/api/core/v1"
@@ -135,6 +138,14 @@ func main() {
 				os.Exit(1)
 			}
 		}
+	} else if _, err := mgr.GetRESTMapper().RESTMapping(knservingv1.Kind("Service"),
+		knservingv1.SchemeGroupVersion.Version); err == nil {
+		// The components overriding the raw deployment mode are served by Knative when it is installed
+		log.Info("Setting up Knative scheme for the serverless components")
+		if err := knservingv1.AddToScheme(mgr.GetScheme()); err != nil {
+			log.Error(err, "unable to add Knative APIs to scheme")
+			os.Exit(1)
+		}
 	}
 
 	log.Info("Setting up core scheme")
@@ -192,11 +203,36 @@ func main() {
 		os.Exit(1)
 	}
 
+	//Setup LocalModelCache controller
+	setupLog.Info("Setting up LocalModelCache controller")
+	if err = (&localmodelcachecontroller.LocalModelCacheReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("v1alpha1Controllers").WithName("LocalModelCache"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: eventBroadcaster.NewRecorder(mgr.GetScheme(), v1.EventSource{Component: "LocalModelCacheController"}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "v1alpha1Controllers", "LocalModelCache")
+		os.Exit(1)
+	}
+
+	//Setup BatchInferenceJob controller
+	setupLog.Info("Setting up BatchInferenceJob controller")
+	if err = (&batchinferencejobcontroller.BatchInferenceJobReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("v1alpha1Controllers").WithName("BatchInferenceJob"),
+		Scheme:   mgr.GetScheme(),
+		Recorder: eventBroadcaster.NewRecorder(mgr.GetScheme(), v1.EventSource{Component: "BatchInferenceJobController"}),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "v1alpha1Controllers", "BatchInferenceJob")
+		os.Exit(1)
+	}
+
 	log.Info("setting up webhook server")
 	hookServer := mgr.GetWebhookServer()
 
 	log.Info("registering webhooks to the webhook server")
 	hookServer.Register("/mutate-pods", &webhook.Admission{Handler: &pod.Mutator{}})
+	hookServer.Register(dryrun.Path, &dryrun.Handler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()})
 
 	if err = ctrl.NewWebhookManagedBy(mgr).
 		For(&v1alpha1.TrainedModel{}).
@@ -212,6 +248,7 @@ func main() {
 		os.Exit(1)
 	}
 
+	v1beta1.SetValidatorClient(mgr.GetClient())
 	if err = ctrl.NewWebhookManagedBy(mgr).
 		For(&v1beta1.InferenceService{}).
 		Complete(); err != nil {
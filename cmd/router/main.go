@@ -18,12 +18,21 @@ package main
 
 import (
 	"bytes"
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/tidwall/gjson"
@@ -38,18 +47,178 @@ import (
 
 var log = logf.Log.WithName("InferenceGraphRouter")
 
-func callService(serviceUrl string, input []byte) ([]byte, error) {
-	resp, err := http.Post(serviceUrl, "application/json", bytes.NewBuffer(input))
+// defaultBackoff is the wait before the first retry when the retry policy does not set one
+const defaultBackoff = 100 * time.Millisecond
+
+// idempotentVerbRegex matches the paths of the inference verbs which have no side effects, the calls to any other
+// path are never retried as the service may have acted on the request before failing
+var idempotentVerbRegex = regexp.MustCompile(`(:(predict|explain|adversarial)|/infer)$`)
+
+// retryPolicy returns the retry policy of the node, the fields which are not set on the node are taken from the graph
+func retryPolicy(node v1alpha1.InferenceRouter, graph v1alpha1.InferenceGraphSpec) v1alpha1.RetryPolicy {
+	policy := v1alpha1.RetryPolicy{}
+	for _, override := range []*v1alpha1.RetryPolicy{graph.RetryPolicy, node.RetryPolicy} {
+		if override == nil {
+			continue
+		}
+		if override.TimeoutSeconds != nil {
+			policy.TimeoutSeconds = override.TimeoutSeconds
+		}
+		if override.Retries != nil {
+			policy.Retries = override.Retries
+		}
+		if override.BackoffMilliseconds != nil {
+			policy.BackoffMilliseconds = override.BackoffMilliseconds
+		}
+	}
+	return policy
+}
+
+func isIdempotent(serviceUrl string) bool {
+	u, err := url.Parse(serviceUrl)
+	return err == nil && idempotentVerbRegex.MatchString(u.Path)
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
+
+// eventStreamContentType is the content type of the server-sent events, the responses of this type are streamed
+const eventStreamContentType = "text/event-stream"
+
+// streamKey is the context key of the responseStream
+type streamKey struct{}
+
+// responseStream is the client response the final step of the graph streams server-sent events to, the steps whose
+// response is further processed by the graph are called without it
+type responseStream struct {
+	w        http.ResponseWriter
+	streamed bool
+}
+
+func withStream(ctx context.Context, w http.ResponseWriter) (context.Context, *responseStream) {
+	stream := &responseStream{w: w}
+	return context.WithValue(ctx, streamKey{}, stream), stream
+}
+
+func withoutStream(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamKey{}, (*responseStream)(nil))
+}
+
+func streamFrom(ctx context.Context) *responseStream {
+	stream, _ := ctx.Value(streamKey{}).(*responseStream)
+	return stream
+}
+
+// authorizationKey is the context key of the Authorization header of the client request
+type authorizationKey struct{}
+
+// withAuthorization forwards the credentials of the client to the services of the graph, so that the services which
+// authenticate the requests accept the calls of the router. An empty authorization stops the forwarding.
+func withAuthorization(ctx context.Context, authorization string) context.Context {
+	return context.WithValue(ctx, authorizationKey{}, authorization)
+}
+
+func authorizationFrom(ctx context.Context) string {
+	authorization, _ := ctx.Value(authorizationKey{}).(string)
+	return authorization
+}
+
+// streamResponse copies the event stream to the client, every chunk is flushed as soon as it is read
+func streamResponse(stream *responseStream, resp *http.Response) error {
+	stream.streamed = true
+	stream.w.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+	stream.w.Header().Set("Cache-Control", "no-cache")
+	stream.w.WriteHeader(resp.StatusCode)
+	flusher, _ := stream.w.(http.Flusher)
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			if _, werr := stream.w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// callService posts the input to the service, calls to idempotent verbs which time out, fail to connect or get a
+// 502, 503 or 504 response are retried with exponential backoff according to the retry policy
+func callService(ctx context.Context, serviceUrl string, input []byte, policy v1alpha1.RetryPolicy) ([]byte, error) {
+	retries := 0
+	if policy.Retries != nil && isIdempotent(serviceUrl) {
+		retries = int(*policy.Retries)
+	}
+	backoff := defaultBackoff
+	if policy.BackoffMilliseconds != nil {
+		backoff = time.Duration(*policy.BackoffMilliseconds) * time.Millisecond
+	}
+	for attempt := 0; ; attempt++ {
+		body, status, err := postService(ctx, serviceUrl, input, policy.TimeoutSeconds)
+		// the graph deadline has passed or the client went away, there is no point in retrying
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// a response which was streamed to the client cannot be retried
+		if stream := streamFrom(ctx); stream != nil && stream.streamed {
+			return nil, err
+		}
+		if attempt >= retries || (err == nil && !isRetryableStatus(status)) {
+			return body, err
+		}
+		log.Info("retrying service call", "service", serviceUrl, "attempt", attempt+1, "status", status, "error", err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// postService makes a single call to the service, bounded by the timeout when it is set
+func postService(ctx context.Context, serviceUrl string, input []byte, timeoutSeconds *int64) ([]byte, int, error) {
+	if timeoutSeconds != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(*timeoutSeconds)*time.Second)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, serviceUrl, bytes.NewBuffer(input))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authorization := authorizationFrom(ctx); authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		log.Error(err, "An error has occurred from service", "service", serviceUrl)
-		return nil, err
+		return nil, 0, err
 	}
 	defer resp.Body.Close()
+	if stream := streamFrom(ctx); stream != nil && resp.StatusCode == http.StatusOK &&
+		strings.HasPrefix(resp.Header.Get("Content-Type"), eventStreamContentType) {
+		if err := streamResponse(stream, resp); err != nil {
+			log.Error(err, "error while streaming the response", "service", serviceUrl)
+			return nil, resp.StatusCode, err
+		}
+		return nil, resp.StatusCode, nil
+	}
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		log.Error(err, "error while reading the response")
 	}
-	return body, err
+	return body, resp.StatusCode, err
 }
 
 func pickupRoute(routes []v1alpha1.InferenceStep) *v1alpha1.InferenceStep {
@@ -66,6 +235,62 @@ func pickupRoute(routes []v1alpha1.InferenceStep) *v1alpha1.InferenceStep {
 	return nil
 }
 
+// pickupRouteBySession picks the route for the session key so that a client always gets the same route
+func pickupRouteBySession(routes []v1alpha1.InferenceStep, key string) *v1alpha1.InferenceStep {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	point := int(hash.Sum32() % 100)
+	end := 0
+	for _, route := range routes {
+		end += int(*route.Weight)
+		if point < end {
+			return &route
+		}
+	}
+	return nil
+}
+
+// sessionKey returns the key identifying the client of the request for the session affinity
+func sessionKey(affinity *v1alpha1.SessionAffinity, headers http.Header) string {
+	if affinity.Header != "" {
+		return headers.Get(affinity.Header)
+	}
+	request := http.Request{Header: headers}
+	if cookie, err := request.Cookie(sessionCookieName(affinity)); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+func sessionCookieName(affinity *v1alpha1.SessionAffinity) string {
+	if affinity.CookieName != "" {
+		return affinity.CookieName
+	}
+	return v1alpha1.DefaultSessionCookieName
+}
+
+// setSessionCookies sets a new session cookie for the splitter nodes which identify the client with a cookie
+// when the request does not carry it yet, the cookie is also added to the request to route it accordingly
+func setSessionCookies(w http.ResponseWriter, req *http.Request, graph v1alpha1.InferenceGraphSpec) {
+	for _, node := range graph.Nodes {
+		if node.SessionAffinity == nil || node.SessionAffinity.Header != "" {
+			continue
+		}
+		name := sessionCookieName(node.SessionAffinity)
+		if _, err := req.Cookie(name); err == nil {
+			continue
+		}
+		id := make([]byte, 16)
+		if _, err := crand.Read(id); err != nil {
+			log.Error(err, "failed to generate session id")
+			continue
+		}
+		cookie := &http.Cookie{Name: name, Value: hex.EncodeToString(id), Path: "/", HttpOnly: true}
+		http.SetCookie(w, cookie)
+		req.AddCookie(cookie)
+	}
+}
+
 func pickupRouteByCondition(input []byte, routes []v1alpha1.InferenceStep) *v1alpha1.InferenceStep {
 	if !gjson.ValidBytes(input) {
 		return nil
@@ -83,30 +308,36 @@ func timeTrack(start time.Time, name string) {
 	log.Info("elapsed time", "node", name, "time", elapsed)
 }
 
-func routeStep(nodeName string, graph v1alpha1.InferenceGraphSpec, input []byte) ([]byte, error) {
+func routeStep(ctx context.Context, nodeName string, graph v1alpha1.InferenceGraphSpec, input []byte, headers http.Header) ([]byte, error) {
 	log.Info("current step", "nodeName", nodeName)
 	defer timeTrack(time.Now(), nodeName)
 	currentNode := graph.Nodes[nodeName]
+	policy := retryPolicy(currentNode, graph)
 
 	if currentNode.RouterType == v1alpha1.Splitter {
-		return executeStep(pickupRoute(currentNode.Steps), graph, input)
+		if currentNode.SessionAffinity != nil {
+			if key := sessionKey(currentNode.SessionAffinity, headers); key != "" {
+				return executeStep(ctx, pickupRouteBySession(currentNode.Steps, key), graph, input, headers, policy)
+			}
+		}
+		return executeStep(ctx, pickupRoute(currentNode.Steps), graph, input, headers, policy)
 	}
 	if currentNode.RouterType == v1alpha1.Switch {
 		route := pickupRouteByCondition(input, currentNode.Steps)
 		if route == nil {
 			return input, nil //TODO maybe should fail in this case?
 		}
-		return executeStep(route, graph, input)
+		return executeStep(ctx, route, graph, input, headers, policy)
 	}
 	if currentNode.RouterType == v1alpha1.Ensemble {
 		ensembleRes := make([]chan map[string]interface{}, len(currentNode.Steps))
-		errChan := make(chan error)
+		errChan := make(chan error, len(currentNode.Steps))
 		for i := range currentNode.Steps {
 			step := &currentNode.Steps[i]
-			resultChan := make(chan map[string]interface{})
+			resultChan := make(chan map[string]interface{}, 1)
 			ensembleRes[i] = resultChan
 			go func() {
-				output, err := executeStep(step, graph, input)
+				output, err := executeStep(withoutStream(ctx), step, graph, input, headers, policy)
 				if err == nil {
 					var res map[string]interface{}
 					if err = json.Unmarshal(output, &res); err == nil {
@@ -119,17 +350,22 @@ func routeStep(nodeName string, graph v1alpha1.InferenceGraphSpec, input []byte)
 		}
 		// merge responses from parallel steps
 		response := map[string]interface{}{}
+		results := make([]map[string]interface{}, len(ensembleRes))
 		for i, resultChan := range ensembleRes {
 			key := currentNode.Steps[i].StepName
 			if key == "" {
 				key = strconv.Itoa(i) // Use index if no step name
 			}
 			select {
-			case response[key] = <-resultChan:
+			case results[i] = <-resultChan:
+				response[key] = results[i]
 			case err := <-errChan:
 				return nil, err
 			}
 		}
+		if currentNode.Aggregation != nil {
+			return aggregateEnsemble(ctx, currentNode, graph, results, response, headers)
+		}
 		return json.Marshal(response)
 	}
 	if currentNode.RouterType == v1alpha1.Sequence {
@@ -151,7 +387,11 @@ func routeStep(nodeName string, graph v1alpha1.InferenceGraphSpec, input []byte)
 					return responseBytes, nil
 				}
 			}
-			if responseBytes, err = executeStep(step, graph, request); err != nil {
+			stepCtx := ctx
+			if i < len(currentNode.Steps)-1 {
+				stepCtx = withoutStream(ctx)
+			}
+			if responseBytes, err = executeStep(stepCtx, step, graph, request, headers, policy); err != nil {
 				return nil, err
 			}
 		}
@@ -161,21 +401,170 @@ func routeStep(nodeName string, graph v1alpha1.InferenceGraphSpec, input []byte)
 	return nil, fmt.Errorf("invalid route type: %v", currentNode.RouterType)
 }
 
-func executeStep(step *v1alpha1.InferenceStep, graph v1alpha1.InferenceGraphSpec, input []byte) ([]byte, error) {
+// aggregateEnsemble combines the step responses of an ensemble node. The custom aggregation target receives the
+// merged responses keyed by step name, the built-in strategies combine the predictions of the steps per instance.
+func aggregateEnsemble(ctx context.Context, node v1alpha1.InferenceRouter, graph v1alpha1.InferenceGraphSpec,
+	results []map[string]interface{}, merged map[string]interface{}, headers http.Header) ([]byte, error) {
+	strategy := node.Aggregation.Strategy
+	if strategy == v1alpha1.CustomAggregation {
+		input, err := json.Marshal(merged)
+		if err != nil {
+			return nil, err
+		}
+		return executeStep(ctx, &v1alpha1.InferenceStep{InferenceTarget: node.Aggregation.InferenceTarget}, graph, input,
+			headers, retryPolicy(node, graph))
+	}
+
+	var weights []float64
+	if strategy == v1alpha1.WeightedAverage {
+		weights = make([]float64, len(node.Steps))
+		for i, step := range node.Steps {
+			if step.Weight == nil {
+				return nil, fmt.Errorf("step %d has no weight for the weighted average", i)
+			}
+			weights[i] = float64(*step.Weight)
+		}
+	}
+	predictions := make([][]interface{}, len(results))
+	for i, result := range results {
+		stepPredictions, ok := result["predictions"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("response of step %d has no predictions", i)
+		}
+		if i > 0 && len(stepPredictions) != len(predictions[0]) {
+			return nil, fmt.Errorf("response of step %d has %d predictions, expected %d", i, len(stepPredictions), len(predictions[0]))
+		}
+		predictions[i] = stepPredictions
+	}
+
+	aggregated := make([]interface{}, 0)
+	if len(predictions) > 0 {
+		aggregated = make([]interface{}, len(predictions[0]))
+	}
+	for j := range aggregated {
+		values := make([]interface{}, len(predictions))
+		for i := range predictions {
+			values[i] = predictions[i][j]
+		}
+		var err error
+		switch strategy {
+		case v1alpha1.MajorityVote:
+			aggregated[j] = majorityVote(values)
+		case v1alpha1.Mean, v1alpha1.WeightedAverage:
+			aggregated[j], err = weightedMean(values, weights)
+		default:
+			err = fmt.Errorf("invalid aggregation strategy: %v", strategy)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(map[string]interface{}{"predictions": aggregated})
+}
+
+// majorityVote returns the most common value, ties go to the value of the earliest step
+func majorityVote(values []interface{}) interface{} {
+	keys := make([]string, len(values))
+	counts := map[string]int{}
+	for i, value := range values {
+		key, _ := json.Marshal(value)
+		keys[i] = string(key)
+		counts[keys[i]]++
+	}
+	winner := 0
+	for i := range values {
+		if counts[keys[i]] > counts[keys[winner]] {
+			winner = i
+		}
+	}
+	return values[winner]
+}
+
+// weightedMean averages numbers or equally shaped arrays of numbers element wise, all the values weigh the same
+// when no weights are given
+func weightedMean(values []interface{}, weights []float64) (interface{}, error) {
+	weight := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+	switch first := values[0].(type) {
+	case float64:
+		sum, total := 0.0, 0.0
+		for i, value := range values {
+			number, ok := value.(float64)
+			if !ok {
+				return nil, fmt.Errorf("can not average prediction %v", value)
+			}
+			sum += number * weight(i)
+			total += weight(i)
+		}
+		if total == 0 {
+			return nil, fmt.Errorf("the weights of the steps sum to 0")
+		}
+		return sum / total, nil
+	case []interface{}:
+		mean := make([]interface{}, len(first))
+		for j := range first {
+			column := make([]interface{}, len(values))
+			for i, value := range values {
+				array, ok := value.([]interface{})
+				if !ok || len(array) != len(first) {
+					return nil, fmt.Errorf("predictions of the steps have different shapes")
+				}
+				column[i] = array[j]
+			}
+			var err error
+			if mean[j], err = weightedMean(column, weights); err != nil {
+				return nil, err
+			}
+		}
+		return mean, nil
+	}
+	return nil, fmt.Errorf("can not average prediction %v", values[0])
+}
+
+func executeStep(ctx context.Context, step *v1alpha1.InferenceStep, graph v1alpha1.InferenceGraphSpec, input []byte,
+	headers http.Header, policy v1alpha1.RetryPolicy) ([]byte, error) {
 	if step.NodeName != "" {
 		// when nodeName is specified make a recursive call for routing to next step
-		return routeStep(step.NodeName, graph, input)
+		return routeStep(ctx, step.NodeName, graph, input, headers)
+	}
+	// The credentials of the client are only forwarded to the InferenceServices of the graph, the serviceUrl of a
+	// step may point outside the cluster
+	if step.ServiceName == "" {
+		ctx = withAuthorization(ctx, "")
 	}
-	return callService(step.ServiceURL, input)
+	return callService(ctx, step.ServiceURL, input, policy)
 }
 
 var inferenceGraph *v1alpha1.InferenceGraphSpec
 
 func graphHandler(w http.ResponseWriter, req *http.Request) {
 	inputBytes, _ := ioutil.ReadAll(req.Body)
-	if response, err := routeStep(v1alpha1.GraphRootNodeName, *inferenceGraph, inputBytes); err != nil {
+	setSessionCookies(w, req, *inferenceGraph)
+	ctx, stream := withStream(withAuthorization(req.Context(), req.Header.Get("Authorization")), w)
+	if inferenceGraph.TimeoutSeconds != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(*inferenceGraph.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+	response, err := routeStep(ctx, v1alpha1.GraphRootNodeName, *inferenceGraph, inputBytes, req.Header)
+	if stream.streamed {
+		// the status has been sent along with the beginning of the stream
+		if err != nil {
+			log.Error(err, "failed to stream response")
+		}
+		return
+	}
+	if err != nil {
 		log.Error(err, "failed to process request")
-		w.WriteHeader(500) //TODO status code tbd
+		if errors.Is(err, context.DeadlineExceeded) {
+			w.WriteHeader(http.StatusGatewayTimeout)
+		} else {
+			w.WriteHeader(500) //TODO status code tbd
+		}
 		w.Write([]byte(fmt.Sprintf("Failed to process request: %v", err)))
 	} else {
 		w.Write(response)
@@ -1,15 +1,20 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/golang/protobuf/proto"
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"knative.dev/pkg/apis"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestSimpleModelChainer(t *testing.T) {
@@ -72,7 +77,7 @@ func TestSimpleModelChainer(t *testing.T) {
 		},
 	}
 	jsonBytes, _ := json.Marshal(input)
-	res, err := routeStep("root", graphSpec, jsonBytes)
+	res, err := routeStep(context.Background(), "root", graphSpec, jsonBytes, http.Header{})
 	var response map[string]interface{}
 	err = json.Unmarshal(res, &response)
 	expectedResponse := map[string]interface{}{
@@ -141,7 +146,7 @@ func TestSimpleModelEnsemble(t *testing.T) {
 		},
 	}
 	jsonBytes, _ := json.Marshal(input)
-	res, err := routeStep("root", graphSpec, jsonBytes)
+	res, err := routeStep(context.Background(), "root", graphSpec, jsonBytes, http.Header{})
 	var response map[string]interface{}
 	err = json.Unmarshal(res, &response)
 	expectedResponse := map[string]interface{}{
@@ -317,7 +322,7 @@ func TestInferenceGraphWithCondition(t *testing.T) {
 		},
 	}
 	jsonBytes, _ := json.Marshal(input)
-	res, err := routeStep("root", graphSpec, jsonBytes)
+	res, err := routeStep(context.Background(), "root", graphSpec, jsonBytes, http.Header{})
 	var response map[string]interface{}
 	err = json.Unmarshal(res, &response)
 	expectedModel3Response := map[string]interface{}{
@@ -345,3 +350,385 @@ func TestInferenceGraphWithCondition(t *testing.T) {
 	assert.Equal(t, expectedModel3Response, response["model3"])
 	assert.Equal(t, expectedModel4Response, response["model4"])
 }
+
+func TestSplitterSessionAffinity(t *testing.T) {
+	newModel := func(prediction string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			response := map[string]interface{}{"predictions": prediction}
+			responseBytes, _ := json.Marshal(response)
+			_, _ = rw.Write(responseBytes)
+		}))
+	}
+	model1 := newModel("1")
+	defer model1.Close()
+	model2 := newModel("2")
+	defer model2.Close()
+
+	graphSpec := v1alpha1.InferenceGraphSpec{
+		Nodes: map[string]v1alpha1.InferenceRouter{
+			"root": {
+				RouterType:      v1alpha1.Splitter,
+				SessionAffinity: &v1alpha1.SessionAffinity{Header: "x-user-id"},
+				Steps: []v1alpha1.InferenceStep{
+					{
+						StepName: "model1",
+						InferenceTarget: v1alpha1.InferenceTarget{
+							ServiceURL: model1.URL,
+						},
+						Weight: proto.Int64(50),
+					},
+					{
+						StepName: "model2",
+						InferenceTarget: v1alpha1.InferenceTarget{
+							ServiceURL: model2.URL,
+						},
+						Weight: proto.Int64(50),
+					},
+				},
+			},
+		},
+	}
+	jsonBytes, _ := json.Marshal(map[string]interface{}{"instances": []string{"test"}})
+	predictions := map[string]bool{}
+	for i := 0; i < 20; i++ {
+		headers := http.Header{}
+		headers.Set("x-user-id", fmt.Sprintf("user-%d", i))
+		first, err := routeStep(context.Background(), "root", graphSpec, jsonBytes, headers)
+		assert.NoError(t, err)
+		for j := 0; j < 5; j++ {
+			res, err := routeStep(context.Background(), "root", graphSpec, jsonBytes, headers)
+			assert.NoError(t, err)
+			assert.Equal(t, string(first), string(res))
+		}
+		var response map[string]interface{}
+		_ = json.Unmarshal(first, &response)
+		predictions[response["predictions"].(string)] = true
+	}
+	// the users are spread over both models
+	assert.Equal(t, map[string]bool{"1": true, "2": true}, predictions)
+}
+
+func TestSetSessionCookies(t *testing.T) {
+	graphSpec := v1alpha1.InferenceGraphSpec{
+		Nodes: map[string]v1alpha1.InferenceRouter{
+			"root": {
+				RouterType:      v1alpha1.Splitter,
+				SessionAffinity: &v1alpha1.SessionAffinity{},
+			},
+		},
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	setSessionCookies(rec, req, graphSpec)
+	cookie, err := req.Cookie(v1alpha1.DefaultSessionCookieName)
+	assert.NoError(t, err)
+	assert.Equal(t, cookie.Value, rec.Result().Cookies()[0].Value)
+	assert.Equal(t, cookie.Value, sessionKey(graphSpec.Nodes["root"].SessionAffinity, req.Header))
+
+	// an existing session is kept
+	rec = httptest.NewRecorder()
+	setSessionCookies(rec, req, graphSpec)
+	assert.Empty(t, rec.Result().Cookies())
+}
+
+func TestEnsembleAggregation(t *testing.T) {
+	newModel := func(predictions interface{}) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			responseBytes, _ := json.Marshal(map[string]interface{}{"predictions": predictions})
+			rw.Write(responseBytes)
+		}))
+	}
+	newSteps := func(urls ...string) []v1alpha1.InferenceStep {
+		steps := []v1alpha1.InferenceStep{}
+		for i, url := range urls {
+			steps = append(steps, v1alpha1.InferenceStep{
+				StepName:        fmt.Sprintf("model%d", i+1),
+				Weight:          proto.Int64(int64(len(urls) - i)),
+				InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: url},
+			})
+		}
+		return steps
+	}
+	labels1 := newModel([]string{"cat", "cat"})
+	defer labels1.Close()
+	labels2 := newModel([]string{"dog", "cat"})
+	defer labels2.Close()
+	labels3 := newModel([]string{"dog", "bird"})
+	defer labels3.Close()
+	scores1 := newModel([][]float64{{1, 4}})
+	defer scores1.Close()
+	scores2 := newModel([][]float64{{4, 1}})
+	defer scores2.Close()
+	aggregator := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		var merged map[string]interface{}
+		json.Unmarshal(body, &merged)
+		responseBytes, _ := json.Marshal(map[string]interface{}{"steps": len(merged)})
+		rw.Write(responseBytes)
+	}))
+	defer aggregator.Close()
+
+	scenarios := map[string]struct {
+		aggregation *v1alpha1.EnsembleAggregation
+		steps       []v1alpha1.InferenceStep
+		expected    map[string]interface{}
+	}{
+		"majority vote": {
+			aggregation: &v1alpha1.EnsembleAggregation{Strategy: v1alpha1.MajorityVote},
+			steps:       newSteps(labels1.URL, labels2.URL, labels3.URL),
+			expected: map[string]interface{}{
+				"predictions": []interface{}{"dog", "cat"},
+			},
+		},
+		"mean": {
+			aggregation: &v1alpha1.EnsembleAggregation{Strategy: v1alpha1.Mean},
+			steps:       newSteps(scores1.URL, scores2.URL),
+			expected: map[string]interface{}{
+				"predictions": []interface{}{[]interface{}{2.5, 2.5}},
+			},
+		},
+		"weighted average": {
+			aggregation: &v1alpha1.EnsembleAggregation{Strategy: v1alpha1.WeightedAverage},
+			steps:       newSteps(scores1.URL, scores2.URL),
+			expected: map[string]interface{}{
+				"predictions": []interface{}{[]interface{}{2.0, 3.0}},
+			},
+		},
+		"custom": {
+			aggregation: &v1alpha1.EnsembleAggregation{
+				Strategy:        v1alpha1.CustomAggregation,
+				InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: aggregator.URL},
+			},
+			steps: newSteps(scores1.URL, scores2.URL),
+			expected: map[string]interface{}{
+				"steps": float64(2),
+			},
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			graphSpec := v1alpha1.InferenceGraphSpec{
+				Nodes: map[string]v1alpha1.InferenceRouter{
+					"root": {
+						RouterType:  v1alpha1.Ensemble,
+						Aggregation: scenario.aggregation,
+						Steps:       scenario.steps,
+					},
+				},
+			}
+			res, err := routeStep(context.Background(), "root", graphSpec, []byte(`{"instances":[1,2]}`), http.Header{})
+			assert.NoError(t, err)
+			var response map[string]interface{}
+			assert.NoError(t, json.Unmarshal(res, &response))
+			assert.Equal(t, fmt.Sprint(scenario.expected), fmt.Sprint(response))
+		})
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	mean, err := weightedMean([]interface{}{
+		[]interface{}{0.2, 0.8},
+		[]interface{}{0.6, 0.4},
+	}, []float64{3, 1})
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []interface{}{0.3, 0.7}, mean, 1e-9)
+
+	mean, err = weightedMean([]interface{}{1.0, 2.0, 6.0}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, mean)
+
+	_, err = weightedMean([]interface{}{[]interface{}{0.2, 0.8}, []interface{}{0.6}}, nil)
+	assert.Error(t, err)
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var calls int32
+	flakyModel := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			rw.Write([]byte("unavailable"))
+			return
+		}
+		rw.Write([]byte(`{"predictions":[1]}`))
+	}))
+	defer flakyModel.Close()
+
+	scenarios := map[string]struct {
+		path             string
+		expectedResponse string
+		expectedCalls    int32
+	}{
+		"predict is retried": {
+			path:             "/v1/models/flaky:predict",
+			expectedResponse: `{"predictions":[1]}`,
+			expectedCalls:    3,
+		},
+		"v2 infer is retried": {
+			path:             "/v2/models/flaky/infer",
+			expectedResponse: `{"predictions":[1]}`,
+			expectedCalls:    3,
+		},
+		"other paths are not retried": {
+			path:             "/v1/models/flaky:load",
+			expectedResponse: "unavailable",
+			expectedCalls:    1,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			atomic.StoreInt32(&calls, 0)
+			graphSpec := v1alpha1.InferenceGraphSpec{
+				RetryPolicy: &v1alpha1.RetryPolicy{Retries: proto.Int32(1)},
+				Nodes: map[string]v1alpha1.InferenceRouter{
+					"root": {
+						RouterType: v1alpha1.Sequence,
+						RetryPolicy: &v1alpha1.RetryPolicy{
+							Retries:             proto.Int32(3),
+							BackoffMilliseconds: proto.Int64(1),
+						},
+						Steps: []v1alpha1.InferenceStep{
+							{
+								InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: flakyModel.URL + scenario.path},
+							},
+						},
+					},
+				},
+			}
+			res, err := routeStep(context.Background(), "root", graphSpec, []byte(`{"instances":[1]}`), http.Header{})
+			assert.NoError(t, err)
+			assert.Equal(t, scenario.expectedResponse, string(res))
+			assert.Equal(t, scenario.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestGraphDeadline(t *testing.T) {
+	var calls int32
+	slowModel := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		ioutil.ReadAll(req.Body)
+		select {
+		case <-req.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer slowModel.Close()
+
+	graphSpec := v1alpha1.InferenceGraphSpec{
+		Nodes: map[string]v1alpha1.InferenceRouter{
+			"root": {
+				RouterType:  v1alpha1.Sequence,
+				RetryPolicy: &v1alpha1.RetryPolicy{Retries: proto.Int32(5)},
+				Steps: []v1alpha1.InferenceStep{
+					{
+						InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: slowModel.URL + "/v1/models/slow:predict"},
+					},
+				},
+			},
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := routeStep(ctx, "root", graphSpec, []byte(`{"instances":[1]}`), http.Header{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestMergeRetryPolicy(t *testing.T) {
+	graph := v1alpha1.InferenceGraphSpec{
+		RetryPolicy: &v1alpha1.RetryPolicy{
+			TimeoutSeconds: proto.Int64(10),
+			Retries:        proto.Int32(2),
+		},
+	}
+	node := v1alpha1.InferenceRouter{
+		RetryPolicy: &v1alpha1.RetryPolicy{
+			Retries:             proto.Int32(0),
+			BackoffMilliseconds: proto.Int64(500),
+		},
+	}
+	assert.Equal(t, v1alpha1.RetryPolicy{
+		TimeoutSeconds:      proto.Int64(10),
+		Retries:             proto.Int32(0),
+		BackoffMilliseconds: proto.Int64(500),
+	}, retryPolicy(node, graph))
+	assert.Equal(t, *graph.RetryPolicy, retryPolicy(v1alpha1.InferenceRouter{}, graph))
+}
+
+func TestStreamingResponse(t *testing.T) {
+	events := "data: {\"token\":\"hel\"}\n\ndata: {\"token\":\"lo\"}\n\n"
+	prompt := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		ioutil.ReadAll(req.Body)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"prompt":"hello"}`))
+	}))
+	defer prompt.Close()
+	var received []byte
+	llm := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received, _ = ioutil.ReadAll(req.Body)
+		rw.Header().Set("Content-Type", "text/event-stream")
+		for _, event := range strings.SplitAfter(events, "\n\n")[:2] {
+			rw.Write([]byte(event))
+			rw.(http.Flusher).Flush()
+		}
+	}))
+	defer llm.Close()
+
+	inferenceGraph = &v1alpha1.InferenceGraphSpec{
+		Nodes: map[string]v1alpha1.InferenceRouter{
+			"root": {
+				RouterType: v1alpha1.Sequence,
+				Steps: []v1alpha1.InferenceStep{
+					{InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: prompt.URL}},
+					{InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: llm.URL}, Data: "$response"},
+				},
+			},
+		},
+	}
+	defer func() { inferenceGraph = nil }()
+	w := httptest.NewRecorder()
+	graphHandler(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"instances":["hi"]}`)))
+
+	// The response of the last step is streamed to the client as it is generated
+	assert.Equal(t, `{"prompt":"hello"}`, string(received))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.True(t, w.Flushed)
+	assert.Equal(t, events, w.Body.String())
+}
+
+func TestForwardAuthorization(t *testing.T) {
+	var authorizations []string
+	model := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		ioutil.ReadAll(req.Body)
+		authorizations = append(authorizations, req.Header.Get("Authorization"))
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Write([]byte(`{"predictions":[1]}`))
+	}))
+	defer model.Close()
+
+	// the controller resolves the url of the steps which reference an InferenceService
+	inferenceGraph = &v1alpha1.InferenceGraphSpec{
+		Nodes: map[string]v1alpha1.InferenceRouter{
+			"root": {
+				RouterType: v1alpha1.Sequence,
+				Steps: []v1alpha1.InferenceStep{
+					{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model1", ServiceURL: model.URL}},
+					{InferenceTarget: v1alpha1.InferenceTarget{ServiceURL: model.URL}, Data: "$response"},
+					{InferenceTarget: v1alpha1.InferenceTarget{ServiceName: "model2", ServiceURL: model.URL}, Data: "$response"},
+				},
+			},
+		},
+	}
+	defer func() { inferenceGraph = nil }()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"instances":[1]}`))
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+	graphHandler(w, req)
+
+	// The InferenceServices are called with the credentials of the client, the external url is called without them
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"Bearer token", "", "Bearer token"}, authorizations)
+}
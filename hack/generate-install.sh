@@ -64,3 +64,7 @@ cp config/crd/serving.kserve.io_clusterservingruntimes.yaml charts/kserve/crds/s
 cp config/crd/serving.kserve.io_inferenceservices.yaml charts/kserve/crds/serving.kserve.io_inferenceservices.yaml
 cp config/crd/serving.kserve.io_trainedmodels.yaml charts/kserve/crds/serving.kserve.io_trainedmodels.yaml
 cp config/crd/serving.kserve.io_inferencegraphs.yaml charts/kserve/crds/serving.kserve.io_inferencegraphs.yaml
+cp config/crd/serving.kserve.io_localmodelcaches.yaml charts/kserve/crds/serving.kserve.io_localmodelcaches.yaml
+cp config/crd/serving.kserve.io_batchinferencejobs.yaml charts/kserve/crds/serving.kserve.io_batchinferencejobs.yaml
+cp config/crd/serving.kserve.io_clusterstoragecontainers.yaml charts/kserve/crds/serving.kserve.io_clusterstoragecontainers.yaml
+cp config/crd/serving.kserve.io_clusterservingpolicies.yaml charts/kserve/crds/serving.kserve.io_clusterservingpolicies.yaml
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -166,3 +167,39 @@ func GetProvider(providers map[Protocol]Provider, protocol Protocol) (Provider,
 
 	return providers[protocol], nil
 }
+
+// Upload writes the body to the object of the s3:// or gs:// URI
+func Upload(ctx context.Context, providers map[Protocol]Provider, uri string, body io.Reader) error {
+	var protocol Protocol
+	switch {
+	case strings.HasPrefix(uri, string(S3)):
+		protocol = S3
+	case strings.HasPrefix(uri, string(GCS)):
+		protocol = GCS
+	default:
+		return fmt.Errorf("unsupported upload URI %s, supported protocols are [%s %s]", uri, S3, GCS)
+	}
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(uri, string(protocol)), "/")
+	provider, err := GetProvider(providers, protocol)
+	if err != nil {
+		return err
+	}
+
+	switch p := provider.(type) {
+	case *S3Provider:
+		_, err = s3manager.NewUploaderWithClient(p.Client).UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			Body:   body,
+		})
+		return err
+	case *GCSProvider:
+		writer := p.Client.Bucket(bucket).Object(key).NewWriter(ctx)
+		if _, err := io.Copy(writer, body); err != nil {
+			writer.Close()
+			return err
+		}
+		return writer.Close()
+	}
+	return fmt.Errorf("unsupported upload URI %s", uri)
+}
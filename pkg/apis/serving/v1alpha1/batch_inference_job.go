@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// BatchInferenceJob is the Schema for the BatchInferenceJob API, it scores the records of a storage location offline
+// with an InferenceService and writes the responses back to object storage
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="InferenceService",type="string",JSONPath=".spec.inferenceService"
+// +kubebuilder:printcolumn:name="Succeeded",type="integer",JSONPath=".status.succeededWorkers"
+// +kubebuilder:printcolumn:name="Parallelism",type="integer",JSONPath=".spec.parallelism"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[?(@.type=='Succeeded')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:path=batchinferencejobs,shortName=bij,singular=batchinferencejob
+type BatchInferenceJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              BatchInferenceJobSpec   `json:"spec,omitempty"`
+	Status            BatchInferenceJobStatus `json:"status,omitempty"`
+}
+
+// BatchInferenceJobSpec defines the records to score, the InferenceService to score them with and where to write
+// the responses
+// +k8s:openapi-gen=true
+type BatchInferenceJobSpec struct {
+	// InputUri is the storage URI of the records, a file or a prefix of files with one JSON request body per line.
+	// The files are read in the lexical order of their paths.
+	InputUri string `json:"inputUri"`
+	// OutputUri is the s3 or gs prefix the responses are written to, every worker writes the responses to its
+	// records to a part-NNNNN.jsonl file, one line per record in the order of the records
+	OutputUri string `json:"outputUri"`
+	// InferenceService is the name of the InferenceService in the namespace of the job which scores the records
+	InferenceService string `json:"inferenceService"`
+	// Parallelism is the number of workers, the records are split into contiguous ranges of one worker each.
+	// Defaults to 1.
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+	// ServiceAccountName is the service account whose secrets are used to read the records and to write the responses
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// BatchInferenceJobStatus defines the progress of the workers of a BatchInferenceJob
+// +k8s:openapi-gen=true
+type BatchInferenceJobStatus struct {
+	// Conditions for BatchInferenceJob, the Succeeded condition is true once all the workers completed
+	duckv1.Status `json:",inline"`
+	// ActiveWorkers is the number of running workers
+	// +optional
+	ActiveWorkers int32 `json:"activeWorkers,omitempty"`
+	// SucceededWorkers is the number of workers which wrote the responses to their records
+	// +optional
+	SucceededWorkers int32 `json:"succeededWorkers,omitempty"`
+	// FailedWorkers is the number of failed worker pods, the failed workers are retried
+	// +optional
+	FailedWorkers int32 `json:"failedWorkers,omitempty"`
+	// StartTime is the time the workers were started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time all the workers completed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// BatchInferenceJobList contains a list of BatchInferenceJob
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+type BatchInferenceJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=set
+	Items []BatchInferenceJob `json:"items"`
+}
+
+// GetParallelism returns the number of workers of the job
+func (j *BatchInferenceJob) GetParallelism() int32 {
+	if j.Spec.Parallelism == nil {
+		return 1
+	}
+	return *j.Spec.Parallelism
+}
+
+func init() {
+	SchemeBuilder.Register(&BatchInferenceJob{}, &BatchInferenceJobList{})
+}
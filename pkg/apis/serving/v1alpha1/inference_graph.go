@@ -45,6 +45,16 @@ type InferenceGraphSpec struct {
 	// Map of InferenceGraph router nodes
 	// Each node defines the router which can be different routing types
 	Nodes map[string]InferenceRouter `json:"nodes"`
+
+	// TimeoutSeconds specifies the number of seconds to wait before timing out a request to the graph, which includes
+	// the calls to all the steps and their retries
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int64 `json:"timeout,omitempty"`
+
+	// RetryPolicy is the default timeout and retry policy of the calls to the services of the steps in all the nodes
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // InferenceRouterType constant for inference routing types
@@ -70,6 +80,8 @@ const (
 const (
 	// GraphRootNodeName is the root node name.
 	GraphRootNodeName string = "root"
+	// DefaultSessionCookieName is the name of the session cookie set by the router for session affinity.
+	DefaultSessionCookieName string = "kserve-session"
 )
 
 // +k8s:openapi-gen=true
@@ -208,6 +220,93 @@ type InferenceRouter struct {
 	// Steps defines destinations for the current router node
 	// +optional
 	Steps []InferenceStep `json:"steps,omitempty"`
+
+	// SessionAffinity routes all the requests of a client to the same step, only used for Splitter Router
+	// +optional
+	SessionAffinity *SessionAffinity `json:"sessionAffinity,omitempty"`
+
+	// Aggregation combines the responses of the steps into a single response, only used for Ensemble Router.
+	// When it is not set the Ensemble Router returns the responses of all the steps keyed by step name.
+	// +optional
+	Aggregation *EnsembleAggregation `json:"aggregation,omitempty"`
+
+	// RetryPolicy overrides the fields of the graph retry policy for the calls made by the steps of this node
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// EnsembleStrategy defines how the responses of the Ensemble Router steps are combined
+// +kubebuilder:validation:Enum=MajorityVote;Mean;WeightedAverage;Custom
+type EnsembleStrategy string
+
+// EnsembleStrategy Enum
+const (
+	// MajorityVote returns the prediction returned by most steps for every instance
+	MajorityVote EnsembleStrategy = "MajorityVote"
+	// Mean averages the numeric predictions of the steps for every instance
+	Mean EnsembleStrategy = "Mean"
+	// WeightedAverage averages the numeric predictions of the steps using the step weights
+	WeightedAverage EnsembleStrategy = "WeightedAverage"
+	// CustomAggregation sends the responses of all the steps keyed by step name to the aggregation target
+	CustomAggregation EnsembleStrategy = "Custom"
+)
+
+// EnsembleAggregation combines the predictions of the Ensemble Router steps. The built-in strategies operate on the
+// `predictions` of the step responses and require every step to return the same number of predictions.
+// +k8s:openapi-gen=true
+type EnsembleAggregation struct {
+	// Strategy
+	//
+	// - `MajorityVote:` picks the most common prediction, ties go to the earliest step
+	//
+	// - `Mean:` averages the predictions, which can be numbers or arrays of numbers such as class probabilities
+	//
+	// - `WeightedAverage:` averages the predictions weighted by the weight of each step
+	//
+	// - `Custom:` the node or service set on the aggregation combines the responses of the steps
+	//
+	Strategy EnsembleStrategy `json:"strategy"`
+
+	// Node or service which combines the responses for the Custom strategy, e.g. an InferenceService running a
+	// custom container
+	// +optional
+	InferenceTarget `json:",inline"`
+}
+
+// RetryPolicy bounds the calls to the service of a step so that a slow or failing model does not hang the whole
+// graph. Only the calls to the inference verbs without side effects, i.e. `:predict`, `:explain`, `:adversarial` and
+// `/infer`, are retried, the calls to any other service URL are made once with the timeout.
+// +k8s:openapi-gen=true
+type RetryPolicy struct {
+	// TimeoutSeconds specifies the number of seconds to wait for a response to a single call
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int64 `json:"timeout,omitempty"`
+
+	// Retries is the number of times a call which times out, fails to connect or returns a 502, 503 or 504 response is
+	// retried, defaults to 0
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Retries *int32 `json:"retries,omitempty"`
+
+	// BackoffMilliseconds specifies the number of milliseconds to wait before the first retry, the wait doubles for
+	// every following retry. Defaults to 100
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffMilliseconds *int64 `json:"backoff,omitempty"`
+}
+
+// SessionAffinity identifies the client of a request so that a Splitter Router picks the same step for all of its
+// requests. When no header is set the router identifies the client with a session cookie set on the first response.
+// +k8s:openapi-gen=true
+type SessionAffinity struct {
+	// Header which identifies the client, e.g. x-user-id
+	// +optional
+	Header string `json:"header,omitempty"`
+
+	// Name of the session cookie which identifies the client when no header is set, defaults to kserve-session
+	// +optional
+	CookieName string `json:"cookieName,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -242,7 +341,8 @@ type InferenceStep struct {
 	Data string `json:"data,omitempty"`
 
 	// the weight for split of the traffic, only used for Split Router
-	// when weight is specified all the routing targets should be sum to 100
+	// when weight is specified all the routing targets should be sum to 100.
+	// For an Ensemble Router with the WeightedAverage strategy it is the weight of the step predictions
 	// +optional
 	Weight *int64 `json:"weight,omitempty"`
 
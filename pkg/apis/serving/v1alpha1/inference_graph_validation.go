@@ -42,6 +42,18 @@ const (
 	TargetNotProvidedError = "Step %d (\"%s\") in node \"%s\" of InferenceGraph \"%s\" does not specify an inference target"
 	// InvalidTargetError defines the error message for inference graph target specifies more than one of nodeName, serviceName, serviceUrl
 	InvalidTargetError = "Step %d (\"%s\") in node \"%s\" of InferenceGraph \"%s\" specifies more than one of nodeName, serviceName, serviceUrl"
+	// InvalidSessionAffinityError defines the error message for session affinity set on a node which is not a splitter
+	InvalidSessionAffinityError = "InferenceGraph[%s] Node[%s] session affinity is only supported for splitter nodes"
+	// InvalidAggregationError defines the error message for aggregation set on a node which is not an ensemble
+	InvalidAggregationError = "InferenceGraph[%s] Node[%s] aggregation is only supported for ensemble nodes"
+	// InvalidAggregationTargetError defines the error message for an aggregation target which does not match the strategy
+	InvalidAggregationTargetError = "InferenceGraph[%s] Node[%s] the Custom aggregation requires exactly one of nodeName, serviceName, serviceUrl and the other strategies accept none"
+	// InvalidGraphTimeoutError defines the error message for a graph timeout which is not positive
+	InvalidGraphTimeoutError = "InferenceGraph[%s] the timeout must be greater than 0"
+	// InvalidRetryPolicyError defines the error message for a retry policy with invalid timeout, retries or backoff
+	InvalidRetryPolicyError = "InferenceGraph[%s] %s retry policy: the timeout must be greater than 0 and the retries and backoff can not be negative"
+	// RetryTimeoutExceedsGraphTimeoutError defines the error message for a call timeout longer than the graph timeout
+	RetryTimeoutExceedsGraphTimeoutError = "InferenceGraph[%s] %s retry policy: the timeout of %d seconds exceeds the graph timeout of %d seconds"
 )
 
 const (
@@ -83,6 +95,18 @@ func (ig *InferenceGraph) ValidateCreate() error {
 	if err := validateInferenceGraphSplitterWeight(ig); err != nil {
 		return err
 	}
+
+	if err := validateInferenceGraphSessionAffinity(ig); err != nil {
+		return err
+	}
+
+	if err := validateInferenceGraphAggregation(ig); err != nil {
+		return err
+	}
+
+	if err := validateInferenceGraphRetryPolicies(ig); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -182,3 +206,68 @@ func validateInferenceGraphSplitterWeight(ig *InferenceGraph) error {
 	}
 	return nil
 }
+
+// Validation of inference graph session affinity
+func validateInferenceGraphSessionAffinity(ig *InferenceGraph) error {
+	for name, node := range ig.Spec.Nodes {
+		if node.SessionAffinity != nil && node.RouterType != Splitter {
+			return fmt.Errorf(InvalidSessionAffinityError, ig.Name, name)
+		}
+	}
+	return nil
+}
+
+// Validation of inference graph ensemble aggregation
+func validateInferenceGraphAggregation(ig *InferenceGraph) error {
+	for name, node := range ig.Spec.Nodes {
+		aggregation := node.Aggregation
+		if aggregation == nil {
+			continue
+		}
+		if node.RouterType != Ensemble {
+			return fmt.Errorf(InvalidAggregationError, ig.Name, name)
+		}
+		targets := 0
+		for _, target := range []string{aggregation.NodeName, aggregation.ServiceName, aggregation.ServiceURL} {
+			if target != "" {
+				targets += 1
+			}
+		}
+		if (aggregation.Strategy == CustomAggregation) != (targets == 1) || targets > 1 {
+			return fmt.Errorf(InvalidAggregationTargetError, ig.Name, name)
+		}
+		if aggregation.Strategy == WeightedAverage {
+			for _, route := range node.Steps {
+				if route.Weight == nil {
+					return fmt.Errorf(WeightNotProvidedError, ig.Name, name, route.ServiceName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Validation of inference graph timeout and retry policies
+func validateInferenceGraphRetryPolicies(ig *InferenceGraph) error {
+	if ig.Spec.TimeoutSeconds != nil && *ig.Spec.TimeoutSeconds <= 0 {
+		return fmt.Errorf(InvalidGraphTimeoutError, ig.Name)
+	}
+	policies := map[string]*RetryPolicy{"Graph": ig.Spec.RetryPolicy}
+	for name, node := range ig.Spec.Nodes {
+		policies[fmt.Sprintf("Node[%s]", name)] = node.RetryPolicy
+	}
+	for scope, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		if (policy.TimeoutSeconds != nil && *policy.TimeoutSeconds <= 0) ||
+			(policy.Retries != nil && *policy.Retries < 0) ||
+			(policy.BackoffMilliseconds != nil && *policy.BackoffMilliseconds < 0) {
+			return fmt.Errorf(InvalidRetryPolicyError, ig.Name, scope)
+		}
+		if policy.TimeoutSeconds != nil && ig.Spec.TimeoutSeconds != nil && *policy.TimeoutSeconds > *ig.Spec.TimeoutSeconds {
+			return fmt.Errorf(RetryTimeoutExceedsGraphTimeoutError, ig.Name, scope, *policy.TimeoutSeconds, *ig.Spec.TimeoutSeconds)
+		}
+	}
+	return nil
+}
@@ -226,6 +226,183 @@ func TestInferenceGraph_ValidateCreate(t *testing.T) {
 			},
 			matcher: gomega.MatchError(fmt.Errorf(DuplicateStepNameError, GraphRootNodeName, "foo-bar", "step1")),
 		},
+		"session affinity on splitter": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RouterType:      "Splitter",
+					SessionAffinity: &SessionAffinity{Header: "x-user-id"},
+					Steps: []InferenceStep{
+						{
+							StepName: "step1",
+							Weight:   proto.Int64(80),
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service1",
+							},
+						},
+						{
+							StepName: "step2",
+							Weight:   proto.Int64(20),
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service2",
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.MatchError(nil),
+		},
+		"session affinity on sequence": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RouterType:      "Sequence",
+					SessionAffinity: &SessionAffinity{},
+					Steps: []InferenceStep{
+						{
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service1",
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.MatchError(fmt.Errorf(InvalidSessionAffinityError, "foo-bar", GraphRootNodeName)),
+		},
+		"weighted average aggregation on ensemble": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RouterType:  "Ensemble",
+					Aggregation: &EnsembleAggregation{Strategy: WeightedAverage},
+					Steps: []InferenceStep{
+						{
+							Weight: proto.Int64(2),
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service1",
+							},
+						},
+						{
+							Weight: proto.Int64(1),
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service2",
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.MatchError(nil),
+		},
+		"weighted average aggregation without weights": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RouterType:  "Ensemble",
+					Aggregation: &EnsembleAggregation{Strategy: WeightedAverage},
+					Steps: []InferenceStep{
+						{
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service1",
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.MatchError(fmt.Errorf(WeightNotProvidedError, "foo-bar", GraphRootNodeName, "service1")),
+		},
+		"custom aggregation without target": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RouterType:  "Ensemble",
+					Aggregation: &EnsembleAggregation{Strategy: CustomAggregation},
+					Steps: []InferenceStep{
+						{
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service1",
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.MatchError(fmt.Errorf(InvalidAggregationTargetError, "foo-bar", GraphRootNodeName)),
+		},
+		"mean aggregation with target": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RouterType: "Ensemble",
+					Aggregation: &EnsembleAggregation{
+						Strategy:        Mean,
+						InferenceTarget: InferenceTarget{ServiceName: "aggregator"},
+					},
+					Steps: []InferenceStep{
+						{
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service1",
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.MatchError(fmt.Errorf(InvalidAggregationTargetError, "foo-bar", GraphRootNodeName)),
+		},
+		"aggregation on sequence": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RouterType:  "Sequence",
+					Aggregation: &EnsembleAggregation{Strategy: MajorityVote},
+					Steps: []InferenceStep{
+						{
+							InferenceTarget: InferenceTarget{
+								ServiceName: "service1",
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.MatchError(fmt.Errorf(InvalidAggregationError, "foo-bar", GraphRootNodeName)),
+		},
+		"node retry policy within graph timeout": {
+			ig: func() InferenceGraph {
+				ig := makeTestInferenceGraph()
+				ig.Spec.TimeoutSeconds = proto.Int64(60)
+				ig.Spec.RetryPolicy = &RetryPolicy{TimeoutSeconds: proto.Int64(10)}
+				return ig
+			}(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RetryPolicy: &RetryPolicy{
+						TimeoutSeconds:      proto.Int64(20),
+						Retries:             proto.Int(2),
+						BackoffMilliseconds: proto.Int64(200),
+					},
+				},
+			},
+			matcher: gomega.MatchError(nil),
+		},
+		"node retry policy with negative retries": {
+			ig: makeTestInferenceGraph(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {
+					RetryPolicy: &RetryPolicy{Retries: proto.Int(-1)},
+				},
+			},
+			matcher: gomega.MatchError(fmt.Errorf(InvalidRetryPolicyError, "foo-bar", "Node[root]")),
+		},
+		"retry policy timeout exceeds graph timeout": {
+			ig: func() InferenceGraph {
+				ig := makeTestInferenceGraph()
+				ig.Spec.TimeoutSeconds = proto.Int64(10)
+				ig.Spec.RetryPolicy = &RetryPolicy{TimeoutSeconds: proto.Int64(30)}
+				return ig
+			}(),
+			nodes: map[string]InferenceRouter{
+				GraphRootNodeName: {},
+			},
+			matcher: gomega.MatchError(fmt.Errorf(RetryTimeoutExceedsGraphTimeoutError, "foo-bar", "Graph", 30, 10)),
+		},
 	}
 
 	for testName, scenario := range scenarios {
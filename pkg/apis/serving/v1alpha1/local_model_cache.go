@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// LocalModelCache is the Schema for the LocalModelCache API, it pre-downloads a model into the model cache of the
+// selected nodes so that InferenceServices scheduled there start without downloading it
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".spec.sourceModelUri"
+// +kubebuilder:printcolumn:name="Cached",type="integer",JSONPath=".status.cachedNodes"
+// +kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".status.desiredNodes"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:path=localmodelcaches,shortName=lmc,singular=localmodelcache
+type LocalModelCache struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LocalModelCacheSpec   `json:"spec,omitempty"`
+	Status            LocalModelCacheStatus `json:"status,omitempty"`
+}
+
+// LocalModelCacheSpec defines the model to cache and the nodes to cache it on
+// +k8s:openapi-gen=true
+type LocalModelCacheSpec struct {
+	// SourceModelUri is the storage URI of the model, it has to be the same as the storageUri of the
+	// InferenceServices which should be served from the cache
+	SourceModelUri string `json:"sourceModelUri"`
+	// NodeSelector selects the node group to cache the model on, the model is cached on all nodes when it is empty
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the model to be cached on tainted nodes, e.g. a GPU node group
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// ServiceAccountName is the service account whose secrets are used to download the model
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// LocalModelCacheStatus defines the LocalModelCache conditions and the progress of caching the model
+// +k8s:openapi-gen=true
+type LocalModelCacheStatus struct {
+	// Conditions for LocalModelCache
+	duckv1.Status `json:",inline"`
+	// DesiredNodes is the number of nodes selected to cache the model on
+	// +optional
+	DesiredNodes int32 `json:"desiredNodes,omitempty"`
+	// CachedNodes is the number of nodes which have the model cached
+	// +optional
+	CachedNodes int32 `json:"cachedNodes,omitempty"`
+}
+
+// LocalModelCacheList contains a list of LocalModelCache
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+type LocalModelCacheList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=set
+	Items []LocalModelCache `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LocalModelCache{}, &LocalModelCacheList{})
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Error messages of the ClusterServingPolicy violations
+const (
+	ServingPolicyMissingLabelError    = "The label [%s] required by the ClusterServingPolicy [%s] is not set."
+	ServingPolicyStorageSchemeError   = "The storage URI [%s] is not allowed by the ClusterServingPolicy [%s], allowed schemes are: [%s]."
+	ServingPolicyImageRegistryError   = "The image [%s] is not allowed by the ClusterServingPolicy [%s], allowed registries are: [%s]."
+	ServingPolicyMaxGPUsExceededError = "The InferenceService requests %d GPUs, the ClusterServingPolicy [%s] allows at most %d."
+	ServingQuotaExceededError         = "The InferenceServices of the namespace [%s] would request %s %s at their max replicas, the serving quota of the ClusterServingPolicy [%s] is %s."
+	ServingQuotaUnboundedError        = "The maxReplicas of the %s component must be set, the ClusterServingPolicy [%s] sets a serving quota."
+)
+
+// Registry of the images which do not name one
+const defaultImageRegistry = "docker.io"
+
+// ClusterServingPolicy is the Schema for the ClusterServingPolicy API, it constrains the InferenceServices of the
+// namespaces it selects. The constraints are enforced by the validation webhook, the pod mutator and the controller.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="MaxGPUs",type="integer",JSONPath=".spec.maxGPUsPerService"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:path=clusterservingpolicies,scope="Cluster",singular=clusterservingpolicy
+type ClusterServingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ServingPolicySpec `json:"spec,omitempty"`
+}
+
+// ServingPolicySpec defines the constraints of the InferenceServices, the constraints which are not set are not enforced
+// +k8s:openapi-gen=true
+type ServingPolicySpec struct {
+	// NamespaceSelector selects the namespaces the policy applies to, it applies to all the namespaces when it is not set
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// MaxGPUsPerService limits the GPUs requested by a replica of every component of an InferenceService together
+	// +optional
+	MaxGPUsPerService *int64 `json:"maxGPUsPerService,omitempty"`
+	// AllowedStorageSchemes lists the schemes of the storage URIs the models may be loaded from, e.g. s3 or gs
+	// +optional
+	AllowedStorageSchemes []string `json:"allowedStorageSchemes,omitempty"`
+	// RequiredLabels lists the label keys the InferenceServices have to set
+	// +optional
+	RequiredLabels []string `json:"requiredLabels,omitempty"`
+	// AllowedImageRegistries lists the registries the container images may be pulled from, e.g. gcr.io/my-project,
+	// the images which do not name a registry are pulled from docker.io
+	// +optional
+	AllowedImageRegistries []string `json:"allowedImageRegistries,omitempty"`
+	// Quota limits the resources the InferenceServices of a selected namespace request together
+	// +optional
+	Quota *ServingQuota `json:"quota,omitempty"`
+}
+
+// ServingQuota limits the resources the InferenceServices of a namespace request when all of their components run at
+// their max replicas, so the autoscaling of a namespace cannot exhaust the nodes of the cluster
+// +k8s:openapi-gen=true
+type ServingQuota struct {
+	// GPUs limits the GPUs of the InferenceServices of the namespace
+	// +optional
+	GPUs *int64 `json:"gpus,omitempty"`
+	// CPU limits the CPU requests of the InferenceServices of the namespace
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+}
+
+// ClusterServingPolicyList contains a list of ClusterServingPolicy
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+type ClusterServingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=set
+	Items []ClusterServingPolicy `json:"items"`
+}
+
+// GetServingPolicies returns the ClusterServingPolicies applying to the namespace, no policies are returned when the
+// ClusterServingPolicy CRD is not installed
+func GetServingPolicies(cli client.Client, namespace string) ([]ClusterServingPolicy, error) {
+	policies := &ClusterServingPolicyList{}
+	if err := cli.List(context.TODO(), policies); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+	ns := &v1.Namespace{}
+	if err := cli.Get(context.TODO(), types.NamespacedName{Name: namespace}, ns); err != nil {
+		return nil, err
+	}
+	applying := []ClusterServingPolicy{}
+	for _, policy := range policies.Items {
+		applies, err := policy.AppliesTo(ns.Labels)
+		if err != nil {
+			return nil, err
+		}
+		if applies {
+			applying = append(applying, policy)
+		}
+	}
+	return applying, nil
+}
+
+// AppliesTo returns true when the namespace selector matches the namespace labels
+func (p *ClusterServingPolicy) AppliesTo(namespaceLabels map[string]string) (bool, error) {
+	if p.Spec.NamespaceSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.NamespaceSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid namespace selector of the ClusterServingPolicy [%s]: %v", p.Name, err)
+	}
+	return selector.Matches(labels.Set(namespaceLabels)), nil
+}
+
+// ValidateLabels checks the required labels are set
+func (p *ClusterServingPolicy) ValidateLabels(objectLabels map[string]string) error {
+	for _, key := range p.Spec.RequiredLabels {
+		if _, ok := objectLabels[key]; !ok {
+			return fmt.Errorf(ServingPolicyMissingLabelError, key, p.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateStorageUri checks the scheme of the storage URI is allowed, the local paths are always allowed
+func (p *ClusterServingPolicy) ValidateStorageUri(storageUri string) error {
+	if len(p.Spec.AllowedStorageSchemes) == 0 {
+		return nil
+	}
+	scheme, _, found := strings.Cut(storageUri, "://")
+	if !found {
+		return nil
+	}
+	for _, allowed := range p.Spec.AllowedStorageSchemes {
+		if scheme == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf(ServingPolicyStorageSchemeError, storageUri, p.Name, strings.Join(p.Spec.AllowedStorageSchemes, ", "))
+}
+
+// ValidateImage checks the image is pulled from an allowed registry
+func (p *ClusterServingPolicy) ValidateImage(image string) error {
+	if len(p.Spec.AllowedImageRegistries) == 0 {
+		return nil
+	}
+	// The first path component is a registry when it is a host name, e.g. gcr.io or localhost:5000
+	normalized := image
+	firstComponent, _, _ := strings.Cut(image, "/")
+	if !strings.Contains(image, "/") ||
+		!strings.ContainsAny(firstComponent, ".:") && firstComponent != "localhost" {
+		normalized = defaultImageRegistry + "/" + image
+	}
+	for _, registry := range p.Spec.AllowedImageRegistries {
+		if strings.HasPrefix(normalized, strings.TrimSuffix(registry, "/")+"/") {
+			return nil
+		}
+	}
+	return fmt.Errorf(ServingPolicyImageRegistryError, image, p.Name, strings.Join(p.Spec.AllowedImageRegistries, ", "))
+}
+
+// ValidateGPUs checks the GPUs requested by the InferenceService do not exceed the limit
+func (p *ClusterServingPolicy) ValidateGPUs(gpus int64) error {
+	if p.Spec.MaxGPUsPerService != nil && gpus > *p.Spec.MaxGPUsPerService {
+		return fmt.Errorf(ServingPolicyMaxGPUsExceededError, gpus, p.Name, *p.Spec.MaxGPUsPerService)
+	}
+	return nil
+}
+
+// ValidateQuota checks the resources requested by the InferenceServices of the namespace do not exceed the quota
+func (p *ClusterServingPolicy) ValidateQuota(namespace string, gpus int64, cpu resource.Quantity) error {
+	quota := p.Spec.Quota
+	if quota == nil {
+		return nil
+	}
+	if quota.GPUs != nil && gpus > *quota.GPUs {
+		return fmt.Errorf(ServingQuotaExceededError, namespace, fmt.Sprint(gpus), "GPUs", p.Name, fmt.Sprint(*quota.GPUs))
+	}
+	if quota.CPU != nil && cpu.Cmp(*quota.CPU) > 0 {
+		return fmt.Errorf(ServingQuotaExceededError, namespace, cpu.String(), "CPU", p.Name, quota.CPU.String())
+	}
+	return nil
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterServingPolicy{}, &ClusterServingPolicyList{})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestServingPolicyValidation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	policy := &ClusterServingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: ServingPolicySpec{
+			MaxGPUsPerService:      proto.Int64(2),
+			AllowedStorageSchemes:  []string{"s3", "gs"},
+			RequiredLabels:         []string{"team"},
+			AllowedImageRegistries: []string{"gcr.io/my-project/", "docker.io/kserve", "localhost:5000"},
+		},
+	}
+
+	g.Expect(policy.ValidateLabels(map[string]string{"team": "ml"})).To(gomega.Succeed())
+	g.Expect(policy.ValidateLabels(nil)).To(gomega.MatchError(fmt.Sprintf(ServingPolicyMissingLabelError, "team", "production")))
+
+	g.Expect(policy.ValidateGPUs(2)).To(gomega.Succeed())
+	g.Expect(policy.ValidateGPUs(3)).To(gomega.MatchError(fmt.Sprintf(ServingPolicyMaxGPUsExceededError, 3, "production", 2)))
+
+	for storageUri, allowed := range map[string]bool{
+		"s3://models/iris":          true,
+		"gs://models/iris":          true,
+		"/mnt/models":               true,
+		"https://example.com/model": false,
+		"pvc://models-volume/iris":  false,
+	} {
+		matcher := gomega.Succeed()
+		if !allowed {
+			matcher = gomega.HaveOccurred()
+		}
+		g.Expect(policy.ValidateStorageUri(storageUri)).To(matcher, storageUri)
+	}
+
+	for image, allowed := range map[string]bool{
+		"gcr.io/my-project/sklearnserver:v0.9.0": true,
+		"kserve/sklearnserver:v0.9.0":            true,
+		"docker.io/kserve/sklearnserver":         true,
+		"localhost:5000/sklearnserver":           true,
+		"gcr.io/my-project-2/sklearnserver":      false,
+		"pytorch/torchserve:0.6.0":               false,
+		"python:3.9":                             false,
+	} {
+		matcher := gomega.Succeed()
+		if !allowed {
+			matcher = gomega.HaveOccurred()
+		}
+		g.Expect(policy.ValidateImage(image)).To(matcher, image)
+	}
+
+	// Nothing is enforced by an empty policy
+	empty := &ClusterServingPolicy{}
+	g.Expect(empty.ValidateLabels(nil)).To(gomega.Succeed())
+	g.Expect(empty.ValidateGPUs(8)).To(gomega.Succeed())
+	g.Expect(empty.ValidateStorageUri("https://example.com/model")).To(gomega.Succeed())
+	g.Expect(empty.ValidateImage("python:3.9")).To(gomega.Succeed())
+}
+
+func TestGetServingPolicies(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(AddToScheme(scheme)).To(gomega.Succeed())
+
+	// No policies are returned and the namespace is not read when there are no policies
+	g.Expect(GetServingPolicies(fake.NewClientBuilder().WithScheme(scheme).Build(), "default")).To(gomega.BeEmpty())
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"env": "dev"}}},
+		&ClusterServingPolicy{ObjectMeta: metav1.ObjectMeta{Name: "all"}},
+		&ClusterServingPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "production"},
+			Spec: ServingPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+		},
+	).Build()
+	policyNames := func(namespace string) []string {
+		policies, err := GetServingPolicies(cli, namespace)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		names := []string{}
+		for _, policy := range policies {
+			names = append(names, policy.Name)
+		}
+		return names
+	}
+	g.Expect(policyNames("prod")).To(gomega.ConsistOf("all", "production"))
+	g.Expect(policyNames("dev")).To(gomega.ConsistOf("all"))
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClusterStorageContainer is the Schema for the ClusterStorageContainer API, it plugs a custom container in place of
+// the storage initializer to download models from the storage URIs it supports
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.container.image"
+// +kubebuilder:printcolumn:name="Disabled",type="boolean",JSONPath=".disabled"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:path=clusterstoragecontainers,scope="Cluster",singular=clusterstoragecontainer
+type ClusterStorageContainer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              StorageContainerSpec `json:"spec,omitempty"`
+	// Disabled skips the storage container when the storage initializer is injected
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+}
+
+// StorageContainerSpec defines the container which downloads the model and the storage URIs it supports
+// +k8s:openapi-gen=true
+type StorageContainerSpec struct {
+	// Container is run as the storage initializer init container, it is called with the storage URI and the model
+	// directory as arguments and the model directory is mounted into it
+	Container v1.Container `json:"container"`
+	// SupportedUriFormats lists the storage URIs the container can download from
+	SupportedUriFormats []SupportedUriFormat `json:"supportedUriFormats"`
+}
+
+// SupportedUriFormat matches storage URIs by their prefix or by a regular expression
+// +k8s:openapi-gen=true
+type SupportedUriFormat struct {
+	// Prefix of the storage URI, e.g. ftp://
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// Regex the storage URI has to match, e.g. https://(.+?).example.com/(.+)
+	// +optional
+	Regex string `json:"regex,omitempty"`
+}
+
+// ClusterStorageContainerList contains a list of ClusterStorageContainer
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+type ClusterStorageContainerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=set
+	Items []ClusterStorageContainer `json:"items"`
+}
+
+// IsDisabled returns true when the storage container must not be used
+func (c *ClusterStorageContainer) IsDisabled() bool {
+	return c.Disabled != nil && *c.Disabled
+}
+
+// IsStorageUriSupported returns true when one of the supported URI formats matches the storage URI
+func (s *StorageContainerSpec) IsStorageUriSupported(storageUri string) (bool, error) {
+	for _, format := range s.SupportedUriFormats {
+		if format.Prefix != "" && strings.HasPrefix(storageUri, format.Prefix) {
+			return true, nil
+		}
+		if format.Regex != "" {
+			matched, err := regexp.MatchString(format.Regex, storageUri)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// GetStorageContainer returns the container of the first enabled ClusterStorageContainer by name which supports the
+// storage URI, nil is returned when there is none or the ClusterStorageContainer CRD is not installed
+func GetStorageContainer(cli client.Client, storageUri string) (*v1.Container, error) {
+	storageContainers := &ClusterStorageContainerList{}
+	if err := cli.List(context.TODO(), storageContainers); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	sort.Slice(storageContainers.Items, func(i, j int) bool {
+		return storageContainers.Items[i].Name < storageContainers.Items[j].Name
+	})
+	for _, storageContainer := range storageContainers.Items {
+		if storageContainer.IsDisabled() {
+			continue
+		}
+		supported, err := storageContainer.Spec.IsStorageUriSupported(storageUri)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid supported URI format of ClusterStorageContainer %s: %v", storageContainer.Name, err)
+		}
+		if supported {
+			return storageContainer.Spec.Container.DeepCopy(), nil
+		}
+	}
+	return nil, nil
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterStorageContainer{}, &ClusterStorageContainerList{})
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestIsStorageUriSupported(t *testing.T) {
+	spec := StorageContainerSpec{
+		SupportedUriFormats: []SupportedUriFormat{
+			{Prefix: "ftp://"},
+			{Regex: "https://(.+?).models.example.com/(.+)"},
+		},
+	}
+	scenarios := map[string]struct {
+		storageUri string
+		expected   bool
+	}{
+		"Prefix": {
+			storageUri: "ftp://models/iris",
+			expected:   true,
+		},
+		"Regex": {
+			storageUri: "https://team.models.example.com/iris",
+			expected:   true,
+		},
+		"Unsupported": {
+			storageUri: "https://example.com/iris",
+			expected:   false,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			supported, err := spec.IsStorageUriSupported(scenario.storageUri)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(supported).To(gomega.Equal(scenario.expected))
+		})
+	}
+
+	invalid := StorageContainerSpec{SupportedUriFormats: []SupportedUriFormat{{Regex: "ftp://("}}}
+	_, err := invalid.IsStorageUriSupported("ftp://models/iris")
+	gomega.NewGomegaWithT(t).Expect(err).To(gomega.HaveOccurred())
+}
@@ -24,11 +24,115 @@ package v1alpha1
 import (
 	"github.com/kserve/kserve/pkg/constants"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchInferenceJob) DeepCopyInto(out *BatchInferenceJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchInferenceJob.
+func (in *BatchInferenceJob) DeepCopy() *BatchInferenceJob {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchInferenceJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BatchInferenceJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchInferenceJobList) DeepCopyInto(out *BatchInferenceJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BatchInferenceJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchInferenceJobList.
+func (in *BatchInferenceJobList) DeepCopy() *BatchInferenceJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchInferenceJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BatchInferenceJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchInferenceJobSpec) DeepCopyInto(out *BatchInferenceJobSpec) {
+	*out = *in
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchInferenceJobSpec.
+func (in *BatchInferenceJobSpec) DeepCopy() *BatchInferenceJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchInferenceJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchInferenceJobStatus) DeepCopyInto(out *BatchInferenceJobStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchInferenceJobStatus.
+func (in *BatchInferenceJobStatus) DeepCopy() *BatchInferenceJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchInferenceJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuiltInAdapter) DeepCopyInto(out *BuiltInAdapter) {
 	*out = *in
@@ -51,6 +155,64 @@ func (in *BuiltInAdapter) DeepCopy() *BuiltInAdapter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServingPolicy) DeepCopyInto(out *ClusterServingPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServingPolicy.
+func (in *ClusterServingPolicy) DeepCopy() *ClusterServingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterServingPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterServingPolicyList) DeepCopyInto(out *ClusterServingPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterServingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterServingPolicyList.
+func (in *ClusterServingPolicyList) DeepCopy() *ClusterServingPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterServingPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterServingPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterServingRuntime) DeepCopyInto(out *ClusterServingRuntime) {
 	*out = *in
@@ -110,6 +272,85 @@ func (in *ClusterServingRuntimeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStorageContainer) DeepCopyInto(out *ClusterStorageContainer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStorageContainer.
+func (in *ClusterStorageContainer) DeepCopy() *ClusterStorageContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStorageContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStorageContainer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStorageContainerList) DeepCopyInto(out *ClusterStorageContainerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterStorageContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStorageContainerList.
+func (in *ClusterStorageContainerList) DeepCopy() *ClusterStorageContainerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStorageContainerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStorageContainerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnsembleAggregation) DeepCopyInto(out *EnsembleAggregation) {
+	*out = *in
+	out.InferenceTarget = in.InferenceTarget
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnsembleAggregation.
+func (in *EnsembleAggregation) DeepCopy() *EnsembleAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnsembleAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InferenceGraph) DeepCopyInto(out *InferenceGraph) {
 	*out = *in
@@ -179,6 +420,16 @@ func (in *InferenceGraphSpec) DeepCopyInto(out *InferenceGraphSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceGraphSpec.
@@ -222,6 +473,21 @@ func (in *InferenceRouter) DeepCopyInto(out *InferenceRouter) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(SessionAffinity)
+		**out = **in
+	}
+	if in.Aggregation != nil {
+		in, out := &in.Aggregation, &out.Aggregation
+		*out = new(EnsembleAggregation)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceRouter.
@@ -270,6 +536,110 @@ func (in *InferenceTarget) DeepCopy() *InferenceTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalModelCache) DeepCopyInto(out *LocalModelCache) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelCache.
+func (in *LocalModelCache) DeepCopy() *LocalModelCache {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalModelCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalModelCache) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalModelCacheList) DeepCopyInto(out *LocalModelCacheList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocalModelCache, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelCacheList.
+func (in *LocalModelCacheList) DeepCopy() *LocalModelCacheList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalModelCacheList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalModelCacheList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalModelCacheSpec) DeepCopyInto(out *LocalModelCacheSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelCacheSpec.
+func (in *LocalModelCacheSpec) DeepCopy() *LocalModelCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalModelCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalModelCacheStatus) DeepCopyInto(out *LocalModelCacheStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalModelCacheStatus.
+func (in *LocalModelCacheStatus) DeepCopy() *LocalModelCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalModelCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
 	*out = *in
@@ -286,6 +656,106 @@ func (in *ModelSpec) DeepCopy() *ModelSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffMilliseconds != nil {
+		in, out := &in.BackoffMilliseconds, &out.BackoffMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServingPolicySpec) DeepCopyInto(out *ServingPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxGPUsPerService != nil {
+		in, out := &in.MaxGPUsPerService, &out.MaxGPUsPerService
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllowedStorageSchemes != nil {
+		in, out := &in.AllowedStorageSchemes, &out.AllowedStorageSchemes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredLabels != nil {
+		in, out := &in.RequiredLabels, &out.RequiredLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedImageRegistries != nil {
+		in, out := &in.AllowedImageRegistries, &out.AllowedImageRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ServingQuota)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServingPolicySpec.
+func (in *ServingPolicySpec) DeepCopy() *ServingPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServingPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServingQuota) DeepCopyInto(out *ServingQuota) {
+	*out = *in
+	if in.GPUs != nil {
+		in, out := &in.GPUs, &out.GPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServingQuota.
+func (in *ServingQuota) DeepCopy() *ServingQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ServingQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServingRuntime) DeepCopyInto(out *ServingRuntime) {
 	*out = *in
@@ -495,6 +965,42 @@ func (in *ServingRuntimeStatus) DeepCopy() *ServingRuntimeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionAffinity) DeepCopyInto(out *SessionAffinity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionAffinity.
+func (in *SessionAffinity) DeepCopy() *SessionAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageContainerSpec) DeepCopyInto(out *StorageContainerSpec) {
+	*out = *in
+	in.Container.DeepCopyInto(&out.Container)
+	if in.SupportedUriFormats != nil {
+		in, out := &in.SupportedUriFormats, &out.SupportedUriFormats
+		*out = make([]SupportedUriFormat, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageContainerSpec.
+func (in *StorageContainerSpec) DeepCopy() *StorageContainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageContainerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageHelper) DeepCopyInto(out *StorageHelper) {
 	*out = *in
@@ -551,6 +1057,21 @@ func (in *SupportedRuntime) DeepCopy() *SupportedRuntime {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupportedUriFormat) DeepCopyInto(out *SupportedUriFormat) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportedUriFormat.
+func (in *SupportedUriFormat) DeepCopy() *SupportedUriFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(SupportedUriFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrainedModel) DeepCopyInto(out *TrainedModel) {
 	*out = *in
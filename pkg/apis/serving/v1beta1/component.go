@@ -22,34 +22,86 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/utils"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/serving/pkg/apis/autoscaling"
 )
 
 // Known error messages
 const (
-	MinReplicasShouldBeLessThanMaxError = "MinReplicas cannot be greater than MaxReplicas."
-	MinReplicasLowerBoundExceededError  = "MinReplicas cannot be less than 0."
-	MaxReplicasLowerBoundExceededError  = "MaxReplicas cannot be less than 0."
-	ParallelismLowerBoundExceededError  = "Parallelism cannot be less than 0."
-	UnsupportedStorageURIFormatError    = "storageUri, must be one of: [%s] or match https://{}.blob.core.windows.net/{}/{} or be an absolute or relative local path. StorageUri [%s] is not supported."
-	UnsupportedStorageSpecFormatError   = "storage.spec.type, must be one of: [%s]. storage.spec.type [%s] is not supported."
-	InvalidLoggerType                   = "Invalid logger type"
-	InvalidISVCNameFormatError          = "The InferenceService \"%s\" is invalid: a InferenceService name must consist of lower case alphanumeric characters or '-', and must start with alphabetical character. (e.g. \"my-name\" or \"abc-123\", regex used for validation is '%s')"
-	MaxWorkersShouldBeLessThanMaxError  = "Workers cannot be greater than %d"
-	InvalidWorkerArgument               = "Invalid workers argument"
-	InvalidProtocol                     = "Invalid protocol %s. Must be one of [%s]"
+	MinReplicasShouldBeLessThanMaxError   = "MinReplicas cannot be greater than MaxReplicas."
+	MinReplicasLowerBoundExceededError    = "MinReplicas cannot be less than 0."
+	MaxReplicasLowerBoundExceededError    = "MaxReplicas cannot be less than 0."
+	IdleReplicasLowerBoundExceededError   = "IdleReplicas cannot be less than 0."
+	DrainSecondsLowerBoundExceededError   = "DrainSeconds cannot be less than 0."
+	InvalidRequestQueueError              = "requestQueue must set a positive maxInFlight, a non negative maxQueueDepth and a positive retryAfterSeconds."
+	QueueDepthWithoutRequestQueueError    = "The queue-depth scale metric requires the requestQueue to be set."
+	OpenAIComponentError                  = "The openAI API can only be served by the predictor."
+	OpenAIProtocolError                   = "The openAI API requires the v2 protocol, the predictor serves the %s protocol."
+	InvalidResponseCacheError             = "responseCache must set a positive ttlSeconds and maxEntries, and the address of the redis server."
+	ResponseCacheComponentError           = "The responseCache can only be set on the predictor."
+	InvalidTracingError                   = "tracing must set the http or https endpoint of the collector and a samplingPercent between 0 and 100."
+	InvalidAuthenticationError            = "authentication must set non empty audiences and the https issuerUrl of the oidc provider."
+	ScaleToZeroDisabledError              = "MinReplicas cannot be 0 when scaleToZero is false."
+	ScaleToZeroMinReplicasError           = "MinReplicas must be 0 or unset when scaleToZero is true."
+	InvalidExternalMetricError            = "autoScaling.metrics[%d] must set exactly one of prometheus or kafka."
+	InvalidPrometheusMetricError          = "autoScaling.metrics[%d].prometheus must set serverAddress, query and a positive threshold."
+	InvalidKafkaMetricError               = "autoScaling.metrics[%d].kafka must set bootstrapServers, consumerGroup, topic and a positive lagThreshold."
+	InvalidScalingWindowTimeError         = "scalingSchedule[%d] start and end must be times in the HH:MM format."
+	InvalidScalingWindowDayError          = "scalingSchedule[%d].days must be one of: [Mon, Tue, Wed, Thu, Fri, Sat, Sun]. Day [%s] is not supported."
+	InvalidScalingWindowTimeZoneError     = "scalingSchedule[%d].timeZone [%s] is not a valid IANA time zone."
+	InvalidScalingWindowReplicasError     = "scalingSchedule[%d] must override minReplicas or maxReplicas, minReplicas cannot be greater than maxReplicas."
+	ParallelismLowerBoundExceededError    = "Parallelism cannot be less than 0."
+	InvalidGPUQuantityError               = "GPU quantity [%s] of the %s resource of the %s is not a whole number, device plugins only allocate whole units."
+	InvalidGPUResourceNameError           = "[%s] is not a valid GPU resource name, it must be an extended resource name with a domain prefix, e.g. nvidia.com/gpu."
+	UnsupportedStorageURIFormatError      = "storageUri, must be one of: [%s] or match https://{}.blob.core.windows.net/{}/{} or azure://{}/{}/{} or be an absolute or relative local path. StorageUri [%s] is not supported."
+	UnsupportedStorageSpecFormatError     = "storage.spec.type, must be one of: [%s]. storage.spec.type [%s] is not supported."
+	InvalidStorageSha256Error             = "storageSha256 must be a hex encoded sha256 digest. StorageSha256 [%s] is not valid."
+	UnsupportedStorageSha256URIError      = "storageSha256 is only supported for http(s) storageUri. StorageUri [%s] is not supported."
+	InvalidModelVerificationTypeError     = "verification.type must be one of: [%s]. verification.type [%s] is not supported."
+	MissingModelVerificationKeyError      = "verification.publicKey must be set to verify the model signature."
+	UnsupportedVerificationURIError       = "verification is only supported for http(s) storageUri and signatureUri. Uri [%s] is not supported."
+	InvalidLoggerType                     = "Invalid logger type"
+	InvalidLoggerSamplingPercentError     = "The logger samplingPercent must be between 0 and 100."
+	InvalidLoggerKafkaError               = "The logger kafka sink must set brokers and topic, and cannot be set with the logger url."
+	InvalidLoggerStorageError             = "The logger storage sink must set an s3:// or gs:// storageUri and a positive batchSize and flushIntervalSeconds, and cannot be set with the logger url or kafka."
+	InvalidLoggerRedactPathError          = "The logger redact path [%s] is invalid, paths consist of $ followed by .field, .*, [*] or [index] selectors."
+	InvalidISVCNameFormatError            = "The InferenceService \"%s\" is invalid: a InferenceService name must consist of lower case alphanumeric characters or '-', and must start with alphabetical character. (e.g. \"my-name\" or \"abc-123\", regex used for validation is '%s')"
+	MaxWorkersShouldBeLessThanMaxError    = "Workers cannot be greater than %d"
+	InvalidWorkerArgument                 = "Invalid workers argument"
+	InvalidProtocol                       = "Invalid protocol %s. Must be one of [%s]"
+	InvalidHealthCheckPortError           = "HealthCheckPort must be between 1 and 65535."
+	InvalidModelLoadTimeoutError          = "ModelLoadTimeoutSeconds must be greater than 0."
+	InvalidMirrorTrafficModeError         = "ManualTrafficShift cannot be used with the Mirror traffic mode."
+	InvalidCanaryRoutingError             = "CanaryRouting must match at least one header or cookie."
+	InvalidBlueGreenStrategyError         = "The BlueGreen deployment strategy cannot be used with canary settings."
+	ImmutableDeploymentModeError          = "The deployment mode cannot be changed from [%s] to [%s], delete and recreate the InferenceService instead."
+	ModelMeshComponentDeploymentModeError = "The deployment mode of the components cannot be set in ModelMesh mode."
+	IngressComponentDeploymentModeError   = "The %s is deployed in the deployment mode of the InferenceService, set the serving.kserve.io/deploymentMode annotation instead."
+	UnsupportedRuntimeProtocolError       = "The serving runtime [%s] does not support the protocol version [%s], supported versions are: [%s]."
+	InvalidExperimentNameError            = "experiments[%d].name [%s] must be a unique DNS-1123 label other than default, and the InferenceService name followed by - and the variant name must be a valid InferenceService name."
+	ExperimentTrafficPercentError         = "The trafficPercent of the experiments cannot add up to more than 100."
+	ExperimentUnsupportedError            = "The experiments are only supported in Serverless mode without a transformer."
+	ExperimentCanaryError                 = "The experiments cannot be combined with the canaryRouting or the Mirror traffic mode of the predictor."
 )
 
 // Constants
 var (
-	SupportedStorageURIPrefixList     = []string{"gs://", "s3://", "pvc://", "file://", "https://", "http://", "hdfs://", "webhdfs://"}
+	SupportedStorageURIPrefixList     = []string{"gs://", "s3://", "pvc://", "file://", "https://", "http://", "hdfs://", "webhdfs://", "azure://", "oci://"}
 	SupportedStorageSpecURIPrefixList = []string{"s3://", "hdfs://", "webhdfs://"}
 	AzureBlobURL                      = "blob.core.windows.net"
 	AzureBlobURIRegEx                 = "https://(.+?).blob.core.windows.net/(.+)"
+	AzureURIPrefix                    = "azure://"
+	AzureURIRegEx                     = "azure://([^/]+)/([^/]+)"
+	StorageURISchemeRegEx             = "^[a-zA-Z][a-zA-Z0-9+.-]*://"
+	Sha256RegEx                       = "^[a-fA-F0-9]{64}$"
+	LoggerRedactPathRegEx             = `^\$(\.[^.\[\],]+|\[(\*|\d+)\])+$`
 )
 
 // ComponentImplementation interface is implemented by predictor, transformer, and explainer implementations
@@ -79,36 +131,138 @@ type ComponentExtensionSpec struct {
 	// Maximum number of replicas for autoscaling.
 	// +optional
 	MaxReplicas int `json:"maxReplicas,omitempty"`
+	// ScaleToZero allows the component to scale down to zero replicas when it receives no traffic, minReplicas
+	// defaults to 0 when it is true. Set it to false for latency sensitive models which must never incur a cold start.
+	// +optional
+	ScaleToZero *bool `json:"scaleToZero,omitempty"`
+	// IdleReplicas is the number of warm pool replicas kept provisioned with the model loaded but excluded from routing,
+	// a ready warm pool replica is activated instantly when the autoscaler scales the component out and the pool is
+	// refilled. Supported for raw deployments.
+	// +optional
+	IdleReplicas int `json:"idleReplicas,omitempty"`
+	// ScalingSchedule overrides the minimum and maximum number of replicas during recurring time windows, e.g. to
+	// scale out before a known traffic peak and scale in overnight. The first window active at a time applies.
+	// +optional
+	ScalingSchedule []ScalingWindow `json:"scalingSchedule,omitempty"`
 	// ScaleTarget specifies the integer target value of the metric type the Autoscaler watches for.
 	// concurrency and rps targets are supported by Knative Pod Autoscaler
 	//(https://knative.dev/docs/serving/autoscaling/autoscaling-targets/).
 	// +optional
 	ScaleTarget *int `json:"scaleTarget,omitempty"`
 	// ScaleMetric defines the scaling metric type watched by autoscaler
-	// possible values are concurrency, rps, cpu, memory. concurrency, rps are supported via
+	// possible values are concurrency, rps, cpu, memory, gpu, gpu-memory, queue-depth. concurrency, rps are supported via
 	// Knative Pod Autoscaler(https://knative.dev/docs/serving/autoscaling/autoscaling-metrics).
+	// gpu and gpu-memory scale on the DCGM utilization of the GPUs with the HPA, the metrics are served by the
+	// Prometheus adapter rules in config/prometheus-adapter. queue-depth scales on the average number of requests
+	// waiting in the request queue of the pods with the HPA.
 	// +optional
 	ScaleMetric *ScaleMetric `json:"scaleMetric,omitempty"`
+	// AutoscalerClass overrides the autoscaler class of the InferenceService for the component, so that components
+	// with different workloads scale independently. hpa and keda scale raw deployments, kpa and hpa scale serverless
+	// deployments.
+	// +optional
+	AutoscalerClass AutoscalerClass `json:"autoscalerClass,omitempty"`
+	// DeploymentMode overrides the deployment mode of the InferenceService for the component, e.g. to serve a GPU
+	// predictor as a raw deployment with fixed replicas behind a serverless transformer which scales to zero.
+	// The component receiving the InferenceService traffic and the explainer are routed by the ingress and keep the
+	// deployment mode of the InferenceService. Serverless components of a raw InferenceService require Knative.
+	// +optional
+	DeploymentMode DeploymentMode `json:"deploymentMode,omitempty"`
 	// ContainerConcurrency specifies how many requests can be processed concurrently, this sets the hard limit of the container
 	// concurrency(https://knative.dev/docs/serving/autoscaling/concurrency).
 	// +optional
 	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+	// AutoScaling defines the external metrics the component is scaled on with the keda autoscaler class
+	// (https://keda.sh/docs/latest/scalers/), e.g. the GPU utilization or the depth of a request queue.
+	// +optional
+	AutoScaling *AutoScalingSpec `json:"autoScaling,omitempty"`
 	// TimeoutSeconds specifies the number of seconds to wait before timing out a request to the component.
 	// +optional
 	TimeoutSeconds *int64 `json:"timeout,omitempty"`
+	// DrainSeconds delays the termination of the component pods with a pre-stop hook, so that the pods are removed
+	// from the service endpoints and long running requests, e.g. LLM generations, finish before the containers of an
+	// old revision are stopped. The terminationGracePeriodSeconds of the pods defaults to the drain seconds plus 30
+	// seconds to leave time for the shutdown of the model server. Supported for raw deployments, serverless revisions
+	// drain the requests within the timeout of the component.
+	// +optional
+	DrainSeconds *int64 `json:"drainSeconds,omitempty"`
 	// CanaryTrafficPercent defines the traffic split percentage between the candidate revision and the last ready revision
 	// +optional
 	CanaryTrafficPercent *int64 `json:"canaryTrafficPercent,omitempty"`
+	// ManualTrafficShift holds the candidate revision at 0 percent traffic until it is approved with the
+	// serving.kserve.io/approved-canary-revision annotation, the canary traffic percent is applied after approval.
+	// +optional
+	ManualTrafficShift bool `json:"manualTrafficShift,omitempty"`
+	// RevisionRef pins all the traffic to a revision from the revision history in the component status,
+	// use it to roll back to a specific model version.
+	// +optional
+	RevisionRef *string `json:"revisionRef,omitempty"`
+	// TrafficMode defines how the candidate revision receives the canary traffic, Split routes the canary traffic
+	// percent to the candidate revision, Mirror sends a copy of the canary traffic percent to the candidate revision
+	// while all responses are still served by the last rolled out revision. The traffic is mirrored by the
+	// InferenceService ingress, so a predictor behind a transformer is not mirrored.
+	// +optional
+	TrafficMode TrafficMode `json:"trafficMode,omitempty"`
+	// CanaryRouting routes the requests matching a header or cookie to the candidate revision
+	// in addition to the canary traffic percent. Only the component receiving the InferenceService ingress traffic is routed.
+	// +optional
+	CanaryRouting *CanaryRoutingSpec `json:"canaryRouting,omitempty"`
+	// DeploymentStrategy defines how a new revision replaces the rolled out revision. Canary splits the traffic with
+	// the canary settings, BlueGreen switches all the traffic to the new revision in one step once it is ready and
+	// retains the previous revision for fast rollback during the serving.kserve.io/blue-green-retention period.
+	// +optional
+	DeploymentStrategy DeploymentStrategy `json:"deploymentStrategy,omitempty"`
 	// Activate request/response logging and logger configurations
 	// +optional
 	Logger *LoggerSpec `json:"logger,omitempty"`
 	// Activate request batching and batching configurations
 	// +optional
 	Batcher *Batcher `json:"batcher,omitempty"`
+	// RequestQueue enforces the max number of in-flight requests of the model server with the agent sidecar, requests
+	// are queued up to a max depth and rejected with 429 Too Many Requests under overload. The queue depth is
+	// exported for the queue-depth scale metric. Supported for raw deployments.
+	// +optional
+	RequestQueue *RequestQueue `json:"requestQueue,omitempty"`
+	// OpenAI serves the OpenAI completions, chat completions and embeddings APIs with the agent sidecar, so the OpenAI
+	// SDK clients can call the model. The requests are translated to the v2 inference protocol of the predictor.
+	// Supported for the predictor.
+	// +optional
+	OpenAI *OpenAISpec `json:"openAI,omitempty"`
+	// ResponseCache caches the successful responses of the predictor with the agent sidecar, keyed by the hash of the
+	// request, so the repeated requests are answered without calling the model server. The cached responses are served
+	// until their TTL expires, including after the model is updated. Supported for the predictor.
+	// +optional
+	ResponseCache *ResponseCacheSpec `json:"responseCache,omitempty"`
+	// HealthCheckPort specifies the container port serving health checks when it differs from the port serving
+	// inference requests, readiness and liveness probes without a port are pointed to this port.
+	// The port must be declared in the container ports. Only supported in RawDeployment mode, knative only probes the
+	// port serving the requests.
+	// +optional
+	HealthCheckPort *int32 `json:"healthCheckPort,omitempty"`
+	// GPU configures the GPU resource requested by the containers of the component.
+	// +optional
+	GPU *GPUSpec `json:"gpu,omitempty"`
+}
+
+// GPUSpec configures the GPU resource of a component
+type GPUSpec struct {
+	// ResourceName is the extended resource exposed by the GPU device plugin, e.g. example.com/gpu-fraction for a
+	// fractional GPU device plugin, defaults to nvidia.com/gpu. The request of the resource is set to its limit.
+	// +optional
+	ResourceName v1.ResourceName `json:"resourceName,omitempty"`
 }
 
+// DeploymentMode enum
+// +kubebuilder:validation:Enum=Serverless;RawDeployment
+type DeploymentMode string
+
+const (
+	DeploymentModeServerless DeploymentMode = "Serverless"
+	DeploymentModeRaw        DeploymentMode = "RawDeployment"
+)
+
 // ScaleMetric enum
-// +kubebuilder:validation:Enum=cpu;memory;concurrency;rps
+// +kubebuilder:validation:Enum=cpu;memory;concurrency;rps;gpu;gpu-memory;queue-depth
 type ScaleMetric string
 
 const (
@@ -116,8 +270,199 @@ const (
 	MetricMemory      ScaleMetric = "memory"
 	MetricConcurrency ScaleMetric = "concurrency"
 	MetricRPS         ScaleMetric = "rps"
+	MetricGPU         ScaleMetric = "gpu"
+	MetricGPUMemory   ScaleMetric = "gpu-memory"
+	MetricQueueDepth  ScaleMetric = "queue-depth"
 )
 
+// AutoscalerClass enum
+// +kubebuilder:validation:Enum=hpa;keda;kpa
+type AutoscalerClass string
+
+const (
+	AutoscalerClassHPA  AutoscalerClass = "hpa"
+	AutoscalerClassKEDA AutoscalerClass = "keda"
+	AutoscalerClassKPA  AutoscalerClass = "kpa"
+)
+
+// TrafficMode enum
+// +kubebuilder:validation:Enum=Split;Mirror
+type TrafficMode string
+
+const (
+	TrafficModeSplit  TrafficMode = "Split"
+	TrafficModeMirror TrafficMode = "Mirror"
+)
+
+// DeploymentStrategy enum
+// +kubebuilder:validation:Enum=Canary;BlueGreen
+type DeploymentStrategy string
+
+const (
+	DeploymentStrategyCanary    DeploymentStrategy = "Canary"
+	DeploymentStrategyBlueGreen DeploymentStrategy = "BlueGreen"
+)
+
+// CanaryRoutingSpec defines the requests which are routed to the candidate revision,
+// a request matching any of the headers or cookies is routed to the candidate revision.
+type CanaryRoutingSpec struct {
+	// Headers maps a request header to the exact value which routes the request, e.g. x-model-version: canary
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// Cookies maps a cookie name to the exact value which routes the request
+	// +optional
+	Cookies map[string]string `json:"cookies,omitempty"`
+}
+
+// AutoScalingSpec defines the metrics KEDA scales the component on
+type AutoScalingSpec struct {
+	// Metrics the component is scaled on, the component is scaled to the highest replica count any metric requires
+	Metrics []ExternalMetricSpec `json:"metrics"`
+}
+
+// ExternalMetricSpec defines a metric source, exactly one source has to be set
+type ExternalMetricSpec struct {
+	// Prometheus scales the component on the value of a Prometheus query
+	// +optional
+	Prometheus *PrometheusMetricSource `json:"prometheus,omitempty"`
+	// Kafka scales the component on the lag of a Kafka consumer group
+	// +optional
+	Kafka *KafkaMetricSource `json:"kafka,omitempty"`
+}
+
+// PrometheusMetricSource defines a Prometheus query the component is scaled on
+type PrometheusMetricSource struct {
+	// ServerAddress of the Prometheus server, e.g. http://prometheus.monitoring:9090
+	ServerAddress string `json:"serverAddress"`
+	// Query returning a single value, e.g. avg(DCGM_FI_DEV_GPU_UTIL{pod=~"llama-predictor-.*"})
+	Query string `json:"query"`
+	// Threshold is the query value per replica above which the component is scaled out, e.g. 0.8
+	Threshold string `json:"threshold"`
+}
+
+// KafkaMetricSource defines a Kafka consumer group the component is scaled on
+type KafkaMetricSource struct {
+	// BootstrapServers is a comma separated list of Kafka brokers
+	BootstrapServers string `json:"bootstrapServers"`
+	// ConsumerGroup whose lag is measured
+	ConsumerGroup string `json:"consumerGroup"`
+	// Topic the consumer group reads from
+	Topic string `json:"topic"`
+	// LagThreshold is the lag per replica above which the component is scaled out, defaults to 10
+	// +optional
+	LagThreshold *int64 `json:"lagThreshold,omitempty"`
+}
+
+// ScalingWindow is a recurring time window overriding the replica bounds of a component
+type ScalingWindow struct {
+	// Start time of the window in the HH:MM 24-hour format
+	Start string `json:"start"`
+	// End time of the window in the HH:MM 24-hour format, the window ends on the next day when it is not after
+	// the start time.
+	End string `json:"end"`
+	// Days of the week on which the window starts, one of Mon, Tue, Wed, Thu, Fri, Sat, Sun.
+	// The window starts every day when it is empty.
+	// +optional
+	Days []string `json:"days,omitempty"`
+	// TimeZone of the start and end times as an IANA time zone name, defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// MinReplicas overrides the minimum number of replicas during the window
+	// +optional
+	MinReplicas *int `json:"minReplicas,omitempty"`
+	// MaxReplicas overrides the maximum number of replicas during the window
+	// +optional
+	MaxReplicas *int `json:"maxReplicas,omitempty"`
+}
+
+var scalingWindowDays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// Occurrence returns the start and end of the occurrence of the window which is active at the given time,
+// or of the next occurrence when the window is not active.
+func (w *ScalingWindow) Occurrence(now time.Time) (time.Time, time.Time, error) {
+	location := time.UTC
+	if w.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(w.TimeZone); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	start, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := time.Parse("15:04", w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	duration := end.Sub(start)
+	if duration <= 0 {
+		duration += 24 * time.Hour
+	}
+	days := map[time.Weekday]bool{}
+	for _, day := range w.Days {
+		weekday, ok := scalingWindowDays[day]
+		if !ok {
+			return time.Time{}, time.Time{}, fmt.Errorf("unknown day %s", day)
+		}
+		days[weekday] = true
+	}
+
+	local := now.In(location)
+	// The window which started the day before may still be active, a window starts at most once a week
+	for offset := -1; offset <= 7; offset++ {
+		occurrenceStart := time.Date(local.Year(), local.Month(), local.Day()+offset, start.Hour(), start.Minute(), 0, 0,
+			location)
+		if len(days) != 0 && !days[occurrenceStart.Weekday()] {
+			continue
+		}
+		if occurrenceEnd := occurrenceStart.Add(duration); occurrenceEnd.After(now) {
+			return occurrenceStart, occurrenceEnd, nil
+		}
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("no occurrence of the scaling window")
+}
+
+// SetAutoscalerClassAnnotations overrides the autoscaler class annotations of the InferenceService with the
+// autoscaler class of the component
+func (s *ComponentExtensionSpec) SetAutoscalerClassAnnotations(annotations map[string]string) {
+	switch s.AutoscalerClass {
+	case AutoscalerClassHPA:
+		annotations[constants.AutoscalerClass] = string(constants.AutoscalerClassHPA)
+		annotations[autoscaling.ClassAnnotationKey] = autoscaling.HPA
+	case AutoscalerClassKEDA:
+		annotations[constants.AutoscalerClass] = string(constants.AutoscalerClassKEDA)
+	case AutoscalerClassKPA:
+		delete(annotations, constants.AutoscalerClass)
+		annotations[autoscaling.ClassAnnotationKey] = autoscaling.KPA
+	}
+}
+
+// GetGPUResourceName returns the GPU resource name of the component, the nvidia GPU resource name is returned when it is
+// not set
+func (s *ComponentExtensionSpec) GetGPUResourceName() v1.ResourceName {
+	if s.GPU != nil && s.GPU.ResourceName != "" {
+		return s.GPU.ResourceName
+	}
+	return constants.NvidiaGPUResourceType
+}
+
+// SetDeploymentModeAnnotations overrides the deployment mode annotation of the InferenceService with the deployment
+// mode of the component
+func (s *ComponentExtensionSpec) SetDeploymentModeAnnotations(annotations map[string]string) {
+	if s.DeploymentMode != "" {
+		annotations[constants.DeploymentMode] = string(s.DeploymentMode)
+	}
+}
+
 // Default the ComponentExtensionSpec
 func (s *ComponentExtensionSpec) Default(config *InferenceServicesConfig) {}
 
@@ -126,7 +471,19 @@ func (s *ComponentExtensionSpec) Validate() error {
 	return utils.FirstNonNilError([]error{
 		validateContainerConcurrency(s.ContainerConcurrency),
 		validateReplicas(s.MinReplicas, s.MaxReplicas),
+		validateScaleToZero(s.MinReplicas, s.ScaleToZero),
+		validateScalingSchedule(s.ScalingSchedule),
+		validateIdleReplicas(s.IdleReplicas),
+		validateDrainSeconds(s.DrainSeconds),
+		validateRequestQueue(s),
+		validateResponseCache(s.ResponseCache),
+		validateAutoScaling(s.AutoScaling),
 		validateLogger(s.Logger),
+		validateHealthCheckPort(s.HealthCheckPort),
+		validateGPU(s.GPU),
+		validateTrafficMode(s),
+		validateCanaryRouting(s.CanaryRouting),
+		validateDeploymentStrategy(s),
 	})
 }
 
@@ -171,15 +528,83 @@ func validateStorageURI(storageURI *string) error {
 		if parts := azureURIMatcher.FindStringSubmatch(*storageURI); parts != nil {
 			return nil
 		}
+	} else if strings.HasPrefix(*storageURI, AzureURIPrefix) {
+		// azure://{account}/{container}/{path} is a short form of the blob URL
+		if regexp.MustCompile(AzureURIRegEx).MatchString(*storageURI) {
+			return nil
+		}
 	} else {
 		if utils.IsPrefixSupported(*storageURI, SupportedStorageURIPrefixList) {
 			return nil
 		}
 	}
+	// other storage URIs are supported when a ClusterStorageContainer downloads from them
+	if validatorClient != nil {
+		storageContainer, err := v1alpha1.GetStorageContainer(validatorClient, *storageURI)
+		if err != nil {
+			return err
+		}
+		if storageContainer != nil {
+			return nil
+		}
+	}
 
 	return fmt.Errorf(UnsupportedStorageURIFormatError, strings.Join(SupportedStorageURIPrefixList, ", "), *storageURI)
 }
 
+// validateStorageSha256 checks the checksum of the model artifact, which is only verified for http(s) downloads
+func validateStorageSha256(storageURI *string, sha256 *string) error {
+	if sha256 == nil {
+		return nil
+	}
+	if !regexp.MustCompile(Sha256RegEx).MatchString(*sha256) {
+		return fmt.Errorf(InvalidStorageSha256Error, *sha256)
+	}
+	if storageURI == nil || strings.Contains(*storageURI, AzureBlobURL) ||
+		!utils.IsPrefixSupported(*storageURI, []string{"https://", "http://"}) {
+		uri := ""
+		if storageURI != nil {
+			uri = *storageURI
+		}
+		return fmt.Errorf(UnsupportedStorageSha256URIError, uri)
+	}
+	return nil
+}
+
+func validateModelLoadTimeoutSeconds(modelLoadTimeoutSeconds *int64) error {
+	if modelLoadTimeoutSeconds != nil && *modelLoadTimeoutSeconds <= 0 {
+		return fmt.Errorf(InvalidModelLoadTimeoutError)
+	}
+	return nil
+}
+
+// validateModelVerification checks the signature settings, the signature is only verified for http(s) downloads
+func validateModelVerification(storageURI *string, verification *ModelVerification) error {
+	if verification == nil {
+		return nil
+	}
+	if verification.Type != CosignVerificationType && verification.Type != GPGVerificationType {
+		return fmt.Errorf(InvalidModelVerificationTypeError,
+			strings.Join([]string{string(CosignVerificationType), string(GPGVerificationType)}, ", "), verification.Type)
+	}
+	if strings.TrimSpace(verification.PublicKey) == "" {
+		return fmt.Errorf(MissingModelVerificationKeyError)
+	}
+	uris := []*string{storageURI}
+	if verification.SignatureUri != nil {
+		uris = append(uris, verification.SignatureUri)
+	}
+	for _, uri := range uris {
+		if uri == nil {
+			return fmt.Errorf(UnsupportedVerificationURIError, "")
+		}
+		if strings.Contains(*uri, AzureBlobURL) || !utils.IsPrefixSupported(*uri, []string{"https://", "http://"}) {
+			return fmt.Errorf(UnsupportedVerificationURIError, *uri)
+		}
+	}
+	return nil
+}
+
 func validateReplicas(minReplicas *int, maxReplicas int) error {
 	if minReplicas == nil {
 		minReplicas = &constants.DefaultMinReplicas
@@ -196,6 +621,121 @@ func validateReplicas(minReplicas *int, maxReplicas int) error {
 	return nil
 }
 
+// validateScaleToZero rejects a minReplicas which contradicts the scale-to-zero setting
+func validateScaleToZero(minReplicas *int, scaleToZero *bool) error {
+	if scaleToZero == nil || minReplicas == nil {
+		return nil
+	}
+	if !*scaleToZero && *minReplicas == 0 {
+		return fmt.Errorf(ScaleToZeroDisabledError)
+	}
+	if *scaleToZero && *minReplicas > 0 {
+		return fmt.Errorf(ScaleToZeroMinReplicasError)
+	}
+	return nil
+}
+
+func validateIdleReplicas(idleReplicas int) error {
+	if idleReplicas < 0 {
+		return fmt.Errorf(IdleReplicasLowerBoundExceededError)
+	}
+	return nil
+}
+
+func validateDrainSeconds(drainSeconds *int64) error {
+	if drainSeconds != nil && *drainSeconds < 0 {
+		return fmt.Errorf(DrainSecondsLowerBoundExceededError)
+	}
+	return nil
+}
+
+func validateRequestQueue(s *ComponentExtensionSpec) error {
+	if s.RequestQueue == nil {
+		if s.ScaleMetric != nil && *s.ScaleMetric == MetricQueueDepth {
+			return fmt.Errorf(QueueDepthWithoutRequestQueueError)
+		}
+		return nil
+	}
+	if s.RequestQueue.MaxInFlight < 1 ||
+		s.RequestQueue.MaxQueueDepth != nil && *s.RequestQueue.MaxQueueDepth < 0 ||
+		s.RequestQueue.RetryAfterSeconds != nil && *s.RequestQueue.RetryAfterSeconds < 1 {
+		return fmt.Errorf(InvalidRequestQueueError)
+	}
+	return nil
+}
+
+func validateResponseCache(responseCache *ResponseCacheSpec) error {
+	if responseCache == nil {
+		return nil
+	}
+	if responseCache.TTLSeconds != nil && *responseCache.TTLSeconds < 1 ||
+		responseCache.MaxEntries != nil && *responseCache.MaxEntries < 1 ||
+		responseCache.Redis != nil && responseCache.Redis.Address == "" {
+		return fmt.Errorf(InvalidResponseCacheError)
+	}
+	return nil
+}
+
+func validateScalingSchedule(schedule []ScalingWindow) error {
+	for i, window := range schedule {
+		if _, err := time.Parse("15:04", window.Start); err != nil {
+			return fmt.Errorf(InvalidScalingWindowTimeError, i)
+		}
+		if _, err := time.Parse("15:04", window.End); err != nil {
+			return fmt.Errorf(InvalidScalingWindowTimeError, i)
+		}
+		for _, day := range window.Days {
+			if _, ok := scalingWindowDays[day]; !ok {
+				return fmt.Errorf(InvalidScalingWindowDayError, i, day)
+			}
+		}
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			return fmt.Errorf(InvalidScalingWindowTimeZoneError, i, window.TimeZone)
+		}
+		if window.MinReplicas == nil && window.MaxReplicas == nil {
+			return fmt.Errorf(InvalidScalingWindowReplicasError, i)
+		}
+		maxReplicas := 0
+		if window.MaxReplicas != nil {
+			if *window.MaxReplicas < 1 {
+				return fmt.Errorf(InvalidScalingWindowReplicasError, i)
+			}
+			maxReplicas = *window.MaxReplicas
+		}
+		if window.MinReplicas != nil {
+			if err := validateReplicas(window.MinReplicas, maxReplicas); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateAutoScaling checks that every metric has exactly one complete source
+func validateAutoScaling(autoScaling *AutoScalingSpec) error {
+	if autoScaling == nil {
+		return nil
+	}
+	for i, metric := range autoScaling.Metrics {
+		if (metric.Prometheus == nil) == (metric.Kafka == nil) {
+			return fmt.Errorf(InvalidExternalMetricError, i)
+		}
+		if prometheus := metric.Prometheus; prometheus != nil {
+			threshold, err := strconv.ParseFloat(prometheus.Threshold, 64)
+			if prometheus.ServerAddress == "" || prometheus.Query == "" || err != nil || threshold <= 0 {
+				return fmt.Errorf(InvalidPrometheusMetricError, i)
+			}
+		}
+		if kafka := metric.Kafka; kafka != nil {
+			if kafka.BootstrapServers == "" || kafka.ConsumerGroup == "" || kafka.Topic == "" ||
+				(kafka.LagThreshold != nil && *kafka.LagThreshold <= 0) {
+				return fmt.Errorf(InvalidKafkaMetricError, i)
+			}
+		}
+	}
+	return nil
+}
+
 func validateContainerConcurrency(containerConcurrency *int64) error {
 	if containerConcurrency == nil {
 		return nil
@@ -211,6 +751,72 @@ func validateLogger(logger *LoggerSpec) error {
 		if !(logger.Mode == LogAll || logger.Mode == LogRequest || logger.Mode == LogResponse) {
 			return fmt.Errorf(InvalidLoggerType)
 		}
+		if logger.SamplingPercent != nil && (*logger.SamplingPercent < 0 || *logger.SamplingPercent > 100) {
+			return fmt.Errorf(InvalidLoggerSamplingPercentError)
+		}
+		if logger.Kafka != nil && (logger.URL != nil || len(logger.Kafka.Brokers) == 0 || logger.Kafka.Topic == "") {
+			return fmt.Errorf(InvalidLoggerKafkaError)
+		}
+		if logger.Storage != nil {
+			storage := logger.Storage
+			if logger.URL != nil || logger.Kafka != nil ||
+				!(strings.HasPrefix(storage.StorageUri, "s3://") || strings.HasPrefix(storage.StorageUri, "gs://")) ||
+				(storage.BatchSize != nil && *storage.BatchSize <= 0) ||
+				(storage.FlushIntervalSeconds != nil && *storage.FlushIntervalSeconds <= 0) {
+				return fmt.Errorf(InvalidLoggerStorageError)
+			}
+		}
+		for _, path := range logger.Redact {
+			if !regexp.MustCompile(LoggerRedactPathRegEx).MatchString(path) {
+				return fmt.Errorf(InvalidLoggerRedactPathError, path)
+			}
+		}
+	}
+	return nil
+}
+
+func validateHealthCheckPort(healthCheckPort *int32) error {
+	if healthCheckPort != nil && (*healthCheckPort < 1 || *healthCheckPort > 65535) {
+		return fmt.Errorf(InvalidHealthCheckPortError)
+	}
+	return nil
+}
+
+// validateGPU checks that the GPU resource name is an extended resource name of a device plugin
+func validateGPU(gpu *GPUSpec) error {
+	if gpu == nil || gpu.ResourceName == "" {
+		return nil
+	}
+	resourceName := string(gpu.ResourceName)
+	if !strings.Contains(resourceName, "/") ||
+		strings.HasPrefix(resourceName, v1.DefaultResourceRequestsPrefix) ||
+		strings.Contains(resourceName, v1.ResourceDefaultNamespacePrefix) ||
+		len(validation.IsQualifiedName(resourceName)) != 0 {
+		return fmt.Errorf(InvalidGPUResourceNameError, resourceName)
+	}
+	return nil
+}
+
+// validateTrafficMode checks that the mirrored candidate revision is not also held back for manual approval
+func validateTrafficMode(s *ComponentExtensionSpec) error {
+	if s.TrafficMode == TrafficModeMirror && s.ManualTrafficShift {
+		return fmt.Errorf(InvalidMirrorTrafficModeError)
+	}
+	return nil
+}
+
+func validateCanaryRouting(canaryRouting *CanaryRoutingSpec) error {
+	if canaryRouting != nil && len(canaryRouting.Headers) == 0 && len(canaryRouting.Cookies) == 0 {
+		return fmt.Errorf(InvalidCanaryRoutingError)
+	}
+	return nil
+}
+
+// validateDeploymentStrategy checks that a blue green deployment does not set any canary settings
+func validateDeploymentStrategy(s *ComponentExtensionSpec) error {
+	if s.DeploymentStrategy == DeploymentStrategyBlueGreen && (s.CanaryTrafficPercent != nil || s.ManualTrafficShift ||
+		s.TrafficMode == TrafficModeMirror || s.CanaryRouting != nil) {
+		return fmt.Errorf(InvalidBlueGreenStrategyError)
 	}
 	return nil
 }
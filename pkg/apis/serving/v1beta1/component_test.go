@@ -22,12 +22,16 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
 	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	"knative.dev/serving/pkg/apis/autoscaling"
 )
 
 func TestComponentExtensionSpec_Validate(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
+	queueDepth := MetricQueueDepth
 
 	scenarios := map[string]struct {
 		spec    ComponentExtensionSpec
@@ -40,12 +44,204 @@ func TestComponentExtensionSpec_Validate(t *testing.T) {
 			},
 			matcher: gomega.Not(gomega.BeNil()),
 		},
+		"ScaleToZero": {
+			spec: ComponentExtensionSpec{
+				MinReplicas: GetIntReference(0),
+				ScaleToZero: proto.Bool(true),
+			},
+			matcher: gomega.BeNil(),
+		},
+		"ScaleToZeroDisabled": {
+			spec: ComponentExtensionSpec{
+				MinReplicas: GetIntReference(0),
+				ScaleToZero: proto.Bool(false),
+			},
+			matcher: gomega.MatchError(ScaleToZeroDisabledError),
+		},
+		"ScaleToZeroWithMinReplicas": {
+			spec: ComponentExtensionSpec{
+				MinReplicas: GetIntReference(2),
+				ScaleToZero: proto.Bool(true),
+			},
+			matcher: gomega.MatchError(ScaleToZeroMinReplicasError),
+		},
+		"NegativeIdleReplicas": {
+			spec: ComponentExtensionSpec{
+				IdleReplicas: -1,
+			},
+			matcher: gomega.MatchError(IdleReplicasLowerBoundExceededError),
+		},
+		"NegativeDrainSeconds": {
+			spec: ComponentExtensionSpec{
+				DrainSeconds: proto.Int64(-1),
+			},
+			matcher: gomega.MatchError(DrainSecondsLowerBoundExceededError),
+		},
+		"InvalidRequestQueue": {
+			spec: ComponentExtensionSpec{
+				RequestQueue: &RequestQueue{MaxInFlight: 4, MaxQueueDepth: GetIntReference(-1)},
+			},
+			matcher: gomega.MatchError(InvalidRequestQueueError),
+		},
+		"QueueDepthWithoutRequestQueue": {
+			spec: ComponentExtensionSpec{
+				ScaleMetric: &queueDepth,
+			},
+			matcher: gomega.MatchError(QueueDepthWithoutRequestQueueError),
+		},
+		"ValidResponseCache": {
+			spec: ComponentExtensionSpec{
+				ResponseCache: &ResponseCacheSpec{
+					TTLSeconds: GetIntReference(60),
+					Redis:      &ResponseCacheRedisSpec{Address: "redis:6379", SecretName: "redis"},
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"InvalidResponseCacheTTL": {
+			spec: ComponentExtensionSpec{
+				ResponseCache: &ResponseCacheSpec{TTLSeconds: GetIntReference(0)},
+			},
+			matcher: gomega.MatchError(InvalidResponseCacheError),
+		},
+		"ResponseCacheWithoutRedisAddress": {
+			spec: ComponentExtensionSpec{
+				ResponseCache: &ResponseCacheSpec{Redis: &ResponseCacheRedisSpec{SecretName: "redis"}},
+			},
+			matcher: gomega.MatchError(InvalidResponseCacheError),
+		},
+		"ValidScalingSchedule": {
+			spec: ComponentExtensionSpec{
+				ScalingSchedule: []ScalingWindow{
+					{Start: "08:00", End: "18:00", Days: []string{"Mon", "Fri"}, TimeZone: "Europe/London",
+						MinReplicas: GetIntReference(4), MaxReplicas: GetIntReference(8)},
+					{Start: "22:00", End: "06:00", MaxReplicas: GetIntReference(1)},
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"InvalidScalingWindowTime": {
+			spec: ComponentExtensionSpec{
+				ScalingSchedule: []ScalingWindow{{Start: "8am", End: "18:00", MinReplicas: GetIntReference(4)}},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidScalingWindowTimeError, 0)),
+		},
+		"InvalidScalingWindowDay": {
+			spec: ComponentExtensionSpec{
+				ScalingSchedule: []ScalingWindow{{Start: "08:00", End: "18:00", Days: []string{"Monday"},
+					MinReplicas: GetIntReference(4)}},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidScalingWindowDayError, 0, "Monday")),
+		},
+		"InvalidScalingWindowTimeZone": {
+			spec: ComponentExtensionSpec{
+				ScalingSchedule: []ScalingWindow{{Start: "08:00", End: "18:00", TimeZone: "Mars/Olympus",
+					MinReplicas: GetIntReference(4)}},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidScalingWindowTimeZoneError, 0, "Mars/Olympus")),
+		},
+		"ScalingWindowWithoutReplicas": {
+			spec: ComponentExtensionSpec{
+				ScalingSchedule: []ScalingWindow{{Start: "08:00", End: "18:00"}},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidScalingWindowReplicasError, 0)),
+		},
+		"ScalingWindowMinReplicasGreaterThanMax": {
+			spec: ComponentExtensionSpec{
+				ScalingSchedule: []ScalingWindow{{Start: "08:00", End: "18:00", MinReplicas: GetIntReference(4),
+					MaxReplicas: GetIntReference(2)}},
+			},
+			matcher: gomega.MatchError(MinReplicasShouldBeLessThanMaxError),
+		},
+		"ValidAutoScalingMetrics": {
+			spec: ComponentExtensionSpec{
+				AutoScaling: &AutoScalingSpec{Metrics: []ExternalMetricSpec{
+					{Prometheus: &PrometheusMetricSource{ServerAddress: "http://prometheus:9090", Query: "up", Threshold: "0.5"}},
+					{Kafka: &KafkaMetricSource{BootstrapServers: "kafka:9092", ConsumerGroup: "group", Topic: "topic"}},
+				}},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"AutoScalingMetricWithoutSource": {
+			spec: ComponentExtensionSpec{
+				AutoScaling: &AutoScalingSpec{Metrics: []ExternalMetricSpec{{}}},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidExternalMetricError, 0)),
+		},
+		"InvalidPrometheusThreshold": {
+			spec: ComponentExtensionSpec{
+				AutoScaling: &AutoScalingSpec{Metrics: []ExternalMetricSpec{
+					{Prometheus: &PrometheusMetricSource{ServerAddress: "http://prometheus:9090", Query: "up", Threshold: "-1"}},
+				}},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidPrometheusMetricError, 0)),
+		},
+		"InvalidKafkaLagThreshold": {
+			spec: ComponentExtensionSpec{
+				AutoScaling: &AutoScalingSpec{Metrics: []ExternalMetricSpec{
+					{Kafka: &KafkaMetricSource{BootstrapServers: "kafka:9092", ConsumerGroup: "group", Topic: "topic",
+						LagThreshold: proto.Int64(0)}},
+				}},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidKafkaMetricError, 0)),
+		},
 		"InvalidContainerConcurrency": {
 			spec: ComponentExtensionSpec{
 				ContainerConcurrency: proto.Int64(-1),
 			},
 			matcher: gomega.Not(gomega.BeNil()),
 		},
+		"ValidHealthCheckPort": {
+			spec: ComponentExtensionSpec{
+				HealthCheckPort: proto.Int32(8081),
+			},
+			matcher: gomega.BeNil(),
+		},
+		"InvalidHealthCheckPort": {
+			spec: ComponentExtensionSpec{
+				HealthCheckPort: proto.Int32(0),
+			},
+			matcher: gomega.MatchError(InvalidHealthCheckPortError),
+		},
+		"MirrorTrafficMode": {
+			spec: ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				TrafficMode:          TrafficModeMirror,
+			},
+			matcher: gomega.BeNil(),
+		},
+		"MirrorTrafficModeWithManualTrafficShift": {
+			spec: ComponentExtensionSpec{
+				TrafficMode:        TrafficModeMirror,
+				ManualTrafficShift: true,
+			},
+			matcher: gomega.MatchError(InvalidMirrorTrafficModeError),
+		},
+		"ValidCanaryRouting": {
+			spec: ComponentExtensionSpec{
+				CanaryRouting: &CanaryRoutingSpec{Headers: map[string]string{"x-model-version": "canary"}},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"EmptyCanaryRouting": {
+			spec: ComponentExtensionSpec{
+				CanaryRouting: &CanaryRoutingSpec{},
+			},
+			matcher: gomega.MatchError(InvalidCanaryRoutingError),
+		},
+		"BlueGreenStrategy": {
+			spec: ComponentExtensionSpec{
+				DeploymentStrategy: DeploymentStrategyBlueGreen,
+			},
+			matcher: gomega.BeNil(),
+		},
+		"BlueGreenStrategyWithCanaryTrafficPercent": {
+			spec: ComponentExtensionSpec{
+				DeploymentStrategy:   DeploymentStrategyBlueGreen,
+				CanaryTrafficPercent: proto.Int64(20),
+			},
+			matcher: gomega.MatchError(InvalidBlueGreenStrategyError),
+		},
 	}
 
 	for name, scenario := range scenarios {
@@ -144,6 +340,85 @@ func TestComponentExtensionSpec_validateLogger(t *testing.T) {
 			},
 			matcher: gomega.MatchError(fmt.Errorf(InvalidLoggerType)),
 		},
+		"LoggerWithSamplingPercent": {
+			logger: &LoggerSpec{
+				Mode:            LogAll,
+				SamplingPercent: proto.Int64(10),
+			},
+			matcher: gomega.BeNil(),
+		},
+		"InvalidLoggerSamplingPercent": {
+			logger: &LoggerSpec{
+				Mode:            LogAll,
+				SamplingPercent: proto.Int64(101),
+			},
+			matcher: gomega.MatchError(InvalidLoggerSamplingPercentError),
+		},
+		"LoggerWithKafka": {
+			logger: &LoggerSpec{
+				Mode:  LogAll,
+				Kafka: &LoggerKafkaSpec{Brokers: []string{"kafka:9092"}, Topic: "payloads"},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"LoggerWithKafkaAndUrl": {
+			logger: &LoggerSpec{
+				Mode:  LogAll,
+				URL:   proto.String("http://message-dumper"),
+				Kafka: &LoggerKafkaSpec{Brokers: []string{"kafka:9092"}, Topic: "payloads"},
+			},
+			matcher: gomega.MatchError(InvalidLoggerKafkaError),
+		},
+		"LoggerWithRedact": {
+			logger: &LoggerSpec{
+				Mode:   LogAll,
+				Redact: []string{"$.instances[*].email", "$.inputs[0].*"},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"InvalidLoggerRedactPath": {
+			logger: &LoggerSpec{
+				Mode:   LogAll,
+				Redact: []string{"instances.email"},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidLoggerRedactPathError, "instances.email")),
+		},
+		"LoggerWithKafkaWithoutTopic": {
+			logger: &LoggerSpec{
+				Mode:  LogAll,
+				Kafka: &LoggerKafkaSpec{Brokers: []string{"kafka:9092"}},
+			},
+			matcher: gomega.MatchError(InvalidLoggerKafkaError),
+		},
+		"LoggerWithStorage": {
+			logger: &LoggerSpec{
+				Mode:    LogAll,
+				Storage: &LoggerStorageSpec{StorageUri: "s3://audit/payloads", BatchSize: proto.Int64(100)},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"LoggerWithStorageAndKafka": {
+			logger: &LoggerSpec{
+				Mode:    LogAll,
+				Kafka:   &LoggerKafkaSpec{Brokers: []string{"kafka:9092"}, Topic: "payloads"},
+				Storage: &LoggerStorageSpec{StorageUri: "gs://audit/payloads"},
+			},
+			matcher: gomega.MatchError(InvalidLoggerStorageError),
+		},
+		"LoggerWithStorageUnsupportedUri": {
+			logger: &LoggerSpec{
+				Mode:    LogAll,
+				Storage: &LoggerStorageSpec{StorageUri: "pvc://audit/payloads"},
+			},
+			matcher: gomega.MatchError(InvalidLoggerStorageError),
+		},
+		"LoggerWithStorageInvalidFlushInterval": {
+			logger: &LoggerSpec{
+				Mode:    LogAll,
+				Storage: &LoggerStorageSpec{StorageUri: "s3://audit/payloads", FlushIntervalSeconds: proto.Int64(0)},
+			},
+			matcher: gomega.MatchError(InvalidLoggerStorageError),
+		},
 		"LoggerIsNil": {
 			logger:  nil,
 			matcher: gomega.BeNil(),
@@ -190,3 +465,42 @@ func TestFirstNonNilComponent(t *testing.T) {
 		})
 	}
 }
+
+func TestSetAutoscalerClassAnnotations(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvcAnnotations := map[string]string{
+		constants.AutoscalerClass:      string(constants.AutoscalerClassKEDA),
+		autoscaling.ClassAnnotationKey: autoscaling.KPA,
+	}
+
+	scenarios := map[string]struct {
+		autoscalerClass AutoscalerClass
+		expected        map[string]string
+	}{
+		"InferenceServiceClass": {
+			expected: isvcAnnotations,
+		},
+		"HPA": {
+			autoscalerClass: AutoscalerClassHPA,
+			expected: map[string]string{
+				constants.AutoscalerClass:      string(constants.AutoscalerClassHPA),
+				autoscaling.ClassAnnotationKey: autoscaling.HPA,
+			},
+		},
+		"KPA": {
+			autoscalerClass: AutoscalerClassKPA,
+			expected: map[string]string{
+				autoscaling.ClassAnnotationKey: autoscaling.KPA,
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			annotations := utils.Union(isvcAnnotations)
+			spec := ComponentExtensionSpec{AutoscalerClass: scenario.autoscalerClass}
+			spec.SetAutoscalerClassAnnotations(annotations)
+			g.Expect(annotations).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
@@ -29,17 +29,24 @@ import (
 
 // ConfigMap Keys
 const (
-	ExplainerConfigKeyName = "explainers"
+	ExplainerConfigKeyName       = "explainers"
+	ModelMountPathConfigKeyName  = "modelMountPaths"
+	CanaryAnalysisConfigKeyName  = "canaryAnalysis"
+	DefaultsConfigKeyName        = "defaults"
+	RuntimeVersionsConfigKeyName = "runtimeVersions"
 )
 
 const (
 	IngressConfigKeyName = "ingress"
 	DeployConfigName     = "deploy"
+	SecurityConfigName   = "security"
 
 	DefaultDomainTemplate = "{{ .Name }}-{{ .Namespace }}.{{ .IngressDomain }}"
 	DefaultIngressDomain  = "example.com"
 
 	DefaultUrlScheme = "http"
+
+	DefaultTrustDomain = "cluster.local"
 )
 
 // +kubebuilder:object:generate=false
@@ -57,10 +64,51 @@ type ExplainersConfig struct {
 	ARTExplainer   ExplainerConfig `json:"art,omitempty"`
 }
 
+// +kubebuilder:object:generate=false
+type ModelFormatDefaults struct {
+	// Runtime version of the predictors of the model format when it is not set
+	RuntimeVersion string `json:"runtimeVersion,omitempty"`
+	// Protocol version of the predictors of the model format when it is not set
+	ProtocolVersion constants.InferenceServiceProtocol `json:"protocolVersion,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type DefaultsConfig struct {
+	// Resource requests and limits filled in the component containers by the mutating webhook, they replace the
+	// built-in defaults of 1 cpu and 2Gi memory. The predictors of a serving runtime only get the configured defaults.
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// Runtime and protocol versions of the predictors keyed by model format name
+	ModelFormats map[string]ModelFormatDefaults `json:"modelFormats,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type RuntimeVersionsConfig struct {
+	// Runtime versions the predictors may pin, any version which is not end of life is allowed when it is empty
+	Allowed []string `json:"allowed,omitempty"`
+	// Runtime versions which are end of life and rejected
+	EndOfLife []string `json:"endOfLife,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type CanaryAnalysisConfig struct {
+	// Prometheus server which is queried for the request metrics of the canary revisions
+	PrometheusURL string `json:"prometheusUrl,omitempty"`
+	// Analysis webhook URLs keyed by name, the canary analysis webhook annotation selects one of them by name
+	Webhooks map[string]string `json:"webhooks,omitempty"`
+}
+
 // +kubebuilder:object:generate=false
 type InferenceServicesConfig struct {
 	// Explainer configurations
 	Explainers ExplainersConfig `json:"explainers"`
+	// Model mount paths keyed by model format name, models are mounted at the default path if not configured
+	ModelMountPaths map[string]string `json:"modelMountPaths,omitempty"`
+	// Canary analysis configurations
+	CanaryAnalysis CanaryAnalysisConfig `json:"canaryAnalysis,omitempty"`
+	// Defaults applied to the components by the mutating webhook
+	Defaults DefaultsConfig `json:"defaults,omitempty"`
+	// Runtime versions the predictors may pin keyed by model format name, enforced by the validation webhook
+	RuntimeVersions map[string]RuntimeVersionsConfig `json:"runtimeVersions,omitempty"`
 }
 
 // +kubebuilder:object:generate=false
@@ -74,6 +122,11 @@ type IngressConfig struct {
 	DomainTemplate          string  `json:"domainTemplate,omitempty"`
 	UrlScheme               string  `json:"urlScheme,omitempty"`
 	DisableIstioVirtualHost bool    `json:"disableIstioVirtualHost,omitempty"`
+	EnableGatewayApi        bool    `json:"enableGatewayApi,omitempty"`
+	KserveIngressGateway    string  `json:"kserveIngressGateway,omitempty"`
+	PathTemplate            string  `json:"pathTemplate,omitempty"`
+	EnableAutoTLS           bool    `json:"enableAutoTLS,omitempty"`
+	CertManagerIssuer       string  `json:"certManagerIssuer,omitempty"`
 }
 
 // +kubebuilder:object:generate=false
@@ -81,6 +134,25 @@ type DeployConfig struct {
 	DefaultDeploymentMode string `json:"defaultDeploymentMode,omitempty"`
 }
 
+// +kubebuilder:object:generate=false
+type SecurityConfig struct {
+	// Require mutual TLS for the traffic reaching the component pods of the InferenceServices
+	EnableMTLS bool `json:"enableMTLS,omitempty"`
+	// Only allow the expected callers to reach the component pods, the components are identified by the service
+	// account of their pods which requires mutual TLS
+	EnableAuthorizationPolicy bool `json:"enableAuthorizationPolicy,omitempty"`
+	// Trust domain of the mesh in the principals of the components, defaults to cluster.local
+	TrustDomain string `json:"trustDomain,omitempty"`
+	// Namespaces of the gateways which forward the external requests, they may only call the components which are
+	// exposed by the ingress
+	GatewayNamespaces []string `json:"gatewayNamespaces,omitempty"`
+	// Namespaces of the system workloads which may call every component, e.g. the Knative activator
+	SystemNamespaces []string `json:"systemNamespaces,omitempty"`
+	// Principals of the Knative local gateway which forwards the requests of the transformer and the explainer to the
+	// predictor in Serverless mode, the gateway namespaces are allowed to call the predictor when it is not set
+	LocalGatewayPrincipals []string `json:"localGatewayPrincipals,omitempty"`
+}
+
 func NewInferenceServicesConfig(cli client.Client) (*InferenceServicesConfig, error) {
 	configMap := &v1.ConfigMap{}
 	err := cli.Get(context.TODO(), types.NamespacedName{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace}, configMap)
@@ -90,6 +162,10 @@ func NewInferenceServicesConfig(cli client.Client) (*InferenceServicesConfig, er
 	icfg := &InferenceServicesConfig{}
 	for _, err := range []error{
 		getComponentConfig(ExplainerConfigKeyName, configMap, &icfg.Explainers),
+		getComponentConfig(ModelMountPathConfigKeyName, configMap, &icfg.ModelMountPaths),
+		getComponentConfig(CanaryAnalysisConfigKeyName, configMap, &icfg.CanaryAnalysis),
+		getComponentConfig(DefaultsConfigKeyName, configMap, &icfg.Defaults),
+		getComponentConfig(RuntimeVersionsConfigKeyName, configMap, &icfg.RuntimeVersions),
 	} {
 		if err != nil {
 			return nil, err
@@ -98,6 +174,14 @@ func NewInferenceServicesConfig(cli client.Client) (*InferenceServicesConfig, er
 	return icfg, nil
 }
 
+// GetModelMountPath returns the path where the model of the given format is mounted in the containers
+func (c *InferenceServicesConfig) GetModelMountPath(modelFormat string) string {
+	if mountPath, ok := c.ModelMountPaths[modelFormat]; ok && mountPath != "" {
+		return mountPath
+	}
+	return constants.DefaultModelLocalMountPath
+}
+
 func NewIngressConfig(cli client.Client) (*IngressConfig, error) {
 	configMap := &v1.ConfigMap{}
 	err := cli.Get(context.TODO(), types.NamespacedName{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace}, configMap)
@@ -167,3 +251,19 @@ func NewDeployConfig(cli client.Client) (*DeployConfig, error) {
 	}
 	return deployConfig, nil
 }
+
+func NewSecurityConfig(cli client.Client) (*SecurityConfig, error) {
+	configMap := &v1.ConfigMap{}
+	err := cli.Get(context.TODO(), types.NamespacedName{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace}, configMap)
+	if err != nil {
+		return nil, err
+	}
+	securityConfig := &SecurityConfig{}
+	if err := getComponentConfig(SecurityConfigName, configMap, securityConfig); err != nil {
+		return nil, err
+	}
+	if securityConfig.TrustDomain == "" {
+		securityConfig.TrustDomain = DefaultTrustDomain
+	}
+	return securityConfig, nil
+}
@@ -106,3 +106,17 @@ func TestNewDeployConfig(t *testing.T) {
 	g.Expect(err).Should(gomega.BeNil())
 	g.Expect(deployConfig).ShouldNot(gomega.BeNil())
 }
+
+func TestGetModelMountPath(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &InferenceServicesConfig{
+		ModelMountPaths: map[string]string{
+			"tensorflow": "/models",
+			"sklearn":    "",
+		},
+	}
+
+	g.Expect(config.GetModelMountPath("tensorflow")).To(gomega.Equal("/models"))
+	g.Expect(config.GetModelMountPath("sklearn")).To(gomega.Equal(constants.DefaultModelLocalMountPath))
+	g.Expect(config.GetModelMountPath("pytorch")).To(gomega.Equal(constants.DefaultModelLocalMountPath))
+}
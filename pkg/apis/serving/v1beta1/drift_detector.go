@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1beta1
+
+// DriftDetectorSpec defines the drift detector service which compares the payloads sent to the predictor against a
+// reference distribution. The predictor requests are streamed to the detector through the payload logger.
+type DriftDetectorSpec struct {
+	// ReferenceURI is the location of the reference distribution the payloads are compared against, it is
+	// downloaded by the storage initializer before the detector starts.
+	ReferenceURI string `json:"referenceUri"`
+	// Number of payloads collected before the drift is computed.
+	// +optional
+	DriftBatchSize *int `json:"driftBatchSize,omitempty"`
+	// EventSink is the URL the detector publishes the drift results to as CloudEvents, e.g. a broker which
+	// triggers alerting or retraining pipelines.
+	// +optional
+	EventSink *string `json:"eventSink,omitempty"`
+	// Provide a full PodSpec for the custom drift detector, the detector receives the request payloads
+	// as CloudEvents on its root path.
+	PodSpec `json:",inline"`
+	// Component extension defines the deployment configurations for a drift detector
+	ComponentExtensionSpec `json:",inline"`
+}
+
+// GetImplementations returns the implementations for the component
+func (s *DriftDetectorSpec) GetImplementations() []ComponentImplementation {
+	implementations := []ComponentImplementation{}
+	if len(s.PodSpec.Containers) != 0 {
+		implementations = append(implementations, NewCustomDriftDetector(s))
+	}
+	return implementations
+}
+
+// GetImplementation returns the implementation for the component
+func (s *DriftDetectorSpec) GetImplementation() ComponentImplementation {
+	return s.GetImplementations()[0]
+}
+
+// GetExtensions returns the extensions for the component
+func (s *DriftDetectorSpec) GetExtensions() *ComponentExtensionSpec {
+	return &s.ComponentExtensionSpec
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1beta1
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CustomDriftDetector defines arguments for configuring a custom drift detector.
+type CustomDriftDetector struct {
+	ReferenceURI   string  `json:"referenceUri"`
+	DriftBatchSize *int    `json:"driftBatchSize,omitempty"`
+	EventSink      *string `json:"eventSink,omitempty"`
+	v1.PodSpec     `json:",inline"`
+}
+
+var _ ComponentImplementation = &CustomDriftDetector{}
+
+func NewCustomDriftDetector(spec *DriftDetectorSpec) *CustomDriftDetector {
+	return &CustomDriftDetector{
+		ReferenceURI:   spec.ReferenceURI,
+		DriftBatchSize: spec.DriftBatchSize,
+		EventSink:      spec.EventSink,
+		PodSpec:        v1.PodSpec(spec.PodSpec),
+	}
+}
+
+// Validate returns an error if invalid
+func (c *CustomDriftDetector) Validate() error {
+	return utils.FirstNonNilError([]error{
+		validateReferenceURI(c.ReferenceURI),
+		validateStorageURI(c.GetStorageUri()),
+	})
+}
+
+func validateReferenceURI(referenceURI string) error {
+	if referenceURI == "" {
+		return fmt.Errorf("the referenceUri of the drift detector must be set")
+	}
+	return nil
+}
+
+// Default sets defaults on the resource
+func (c *CustomDriftDetector) Default(config *InferenceServicesConfig) {
+	if len(c.Containers) == 0 {
+		c.Containers = append(c.Containers, v1.Container{})
+	}
+	c.Containers[0].Name = constants.InferenceServiceContainerName
+	setResourceRequirementDefaults(config, &c.Containers[0].Resources)
+}
+
+// GetStorageUri returns the reference distribution location
+func (c *CustomDriftDetector) GetStorageUri() *string {
+	return &c.ReferenceURI
+}
+
+func (c *CustomDriftDetector) GetStorageSpec() *StorageSpec {
+	return nil
+}
+
+// GetContainer transforms the resource into a container spec
+func (c *CustomDriftDetector) GetContainer(metadata metav1.ObjectMeta, extensions *ComponentExtensionSpec, config *InferenceServicesConfig) *v1.Container {
+	container := &c.Containers[0]
+	if !utils.IncludesArg(container.Args, constants.ArgumentModelName) {
+		container.Args = append(container.Args, constants.ArgumentModelName, metadata.Name)
+	}
+	if !utils.IncludesArg(container.Args, constants.ArgumentHttpPort) {
+		container.Args = append(container.Args, constants.ArgumentHttpPort, constants.InferenceServiceDefaultHttpPort)
+	}
+	if !utils.IncludesArg(container.Args, constants.ArgumentStorageUri) {
+		container.Args = append(container.Args, constants.ArgumentStorageUri, constants.DefaultModelLocalMountPath)
+	}
+	if !utils.IncludesArg(container.Args, constants.ArgumentDriftBatchSize) && c.DriftBatchSize != nil {
+		container.Args = append(container.Args, constants.ArgumentDriftBatchSize, strconv.Itoa(*c.DriftBatchSize))
+	}
+	if !utils.IncludesArg(container.Args, constants.ArgumentEventSink) && c.EventSink != nil {
+		container.Args = append(container.Args, constants.ArgumentEventSink, *c.EventSink)
+	}
+	return container
+}
+
+func (c *CustomDriftDetector) GetProtocol() constants.InferenceServiceProtocol {
+	return constants.ProtocolV1
+}
+
+func (c *CustomDriftDetector) IsMMS(config *InferenceServicesConfig) bool {
+	return false
+}
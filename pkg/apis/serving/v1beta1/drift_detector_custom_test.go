@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDriftDetectorValidation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	scenarios := map[string]struct {
+		spec    DriftDetectorSpec
+		matcher types.GomegaMatcher
+	}{
+		"ValidReferenceUri": {
+			spec: DriftDetectorSpec{
+				ReferenceURI: "s3://drift/reference",
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{Image: "seldonio/alibi-detect-server:1.13.0"},
+					},
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"MissingReferenceUri": {
+			spec: DriftDetectorSpec{
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{Image: "seldonio/alibi-detect-server:1.13.0"},
+					},
+				},
+			},
+			matcher: gomega.Not(gomega.BeNil()),
+		},
+		"InvalidReferenceUri": {
+			spec: DriftDetectorSpec{
+				ReferenceURI: "invaliduri://drift/reference",
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{Image: "seldonio/alibi-detect-server:1.13.0"},
+					},
+				},
+			},
+			matcher: gomega.Not(gomega.BeNil()),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			res := scenario.spec.GetImplementation().Validate()
+			if !g.Expect(res).To(scenario.matcher) {
+				t.Errorf("got %q, want %q", res, scenario.matcher)
+			}
+		})
+	}
+}
+
+func TestCreateDriftDetectorContainer(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &InferenceServicesConfig{}
+	batchSize := 20
+	eventSink := "http://broker-ingress.knative-eventing.svc.cluster.local/default/default"
+	scenarios := map[string]struct {
+		spec                  DriftDetectorSpec
+		expectedContainerSpec *v1.Container
+	}{
+		"ContainerSpecWithDefaultArgs": {
+			spec: DriftDetectorSpec{
+				ReferenceURI: "s3://drift/reference",
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{Image: "seldonio/alibi-detect-server:1.13.0"},
+					},
+				},
+			},
+			expectedContainerSpec: &v1.Container{
+				Image: "seldonio/alibi-detect-server:1.13.0",
+				Args: []string{
+					constants.ArgumentModelName,
+					"sklearn",
+					constants.ArgumentHttpPort,
+					constants.InferenceServiceDefaultHttpPort,
+					constants.ArgumentStorageUri,
+					constants.DefaultModelLocalMountPath,
+				},
+			},
+		},
+		"ContainerSpecWithBatchSizeAndEventSink": {
+			spec: DriftDetectorSpec{
+				ReferenceURI:   "s3://drift/reference",
+				DriftBatchSize: &batchSize,
+				EventSink:      &eventSink,
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{Image: "seldonio/alibi-detect-server:1.13.0"},
+					},
+				},
+			},
+			expectedContainerSpec: &v1.Container{
+				Image: "seldonio/alibi-detect-server:1.13.0",
+				Args: []string{
+					constants.ArgumentModelName,
+					"sklearn",
+					constants.ArgumentHttpPort,
+					constants.InferenceServiceDefaultHttpPort,
+					constants.ArgumentStorageUri,
+					constants.DefaultModelLocalMountPath,
+					constants.ArgumentDriftBatchSize,
+					"20",
+					constants.ArgumentEventSink,
+					eventSink,
+				},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			res := scenario.spec.GetImplementation().GetContainer(metav1.ObjectMeta{Name: "sklearn", Namespace: "default"},
+				scenario.spec.GetExtensions(), config)
+			if !g.Expect(res).To(gomega.Equal(scenario.expectedContainerSpec)) {
+				t.Errorf("got %q, want %q", res, scenario.expectedContainerSpec)
+			}
+		})
+	}
+}
@@ -80,6 +80,11 @@ func (e *ExplainerExtensionSpec) GetStorageSpec() *StorageSpec {
 	return e.Storage
 }
 
+// getEmbeddedContainer returns the container overrides of the explainer
+func (e *ExplainerExtensionSpec) getEmbeddedContainer() *v1.Container {
+	return &e.Container
+}
+
 // GetImplementations returns the implementations for the component
 func (s *ExplainerSpec) GetImplementations() []ComponentImplementation {
 	implementations := NonNilComponents([]ComponentImplementation{
@@ -96,7 +96,7 @@ func (s *AIXExplainerSpec) Default(config *InferenceServicesConfig) {
 	if s.RuntimeVersion == nil {
 		s.RuntimeVersion = proto.String(config.Explainers.AIXExplainer.DefaultImageVersion)
 	}
-	setResourceRequirementDefaults(&s.Resources)
+	setResourceRequirementDefaults(config, &s.Resources)
 }
 
 func (s *AIXExplainerSpec) GetProtocol() constants.InferenceServiceProtocol {
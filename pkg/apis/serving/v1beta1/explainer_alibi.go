@@ -26,6 +26,7 @@ import (
 	"github.com/kserve/kserve/pkg/utils"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // AlibiExplainerType is the explanation method
@@ -38,6 +39,7 @@ const (
 	AlibiAnchorsTextExplainer     AlibiExplainerType = "AnchorText"
 	AlibiCounterfactualsExplainer AlibiExplainerType = "Counterfactuals"
 	AlibiContrastiveExplainer     AlibiExplainerType = "Contrastive"
+	AlibiKernelShapExplainer      AlibiExplainerType = "KernelShap"
 )
 
 // AlibiExplainerSpec defines the arguments for configuring an Alibi Explanation Server
@@ -49,6 +51,7 @@ type AlibiExplainerSpec struct {
 	// - "AnchorText"; <br />
 	// - "Counterfactuals"; <br />
 	// - "Contrastive"; <br />
+	// - "KernelShap"; <br />
 	Type AlibiExplainerType `json:"type"`
 	// Contains fields shared across all explainers
 	ExplainerExtensionSpec `json:",inline"`
@@ -56,6 +59,32 @@ type AlibiExplainerSpec struct {
 
 var _ ComponentImplementation = &AlibiExplainerSpec{}
 
+// alibiPrebuiltExplainerTypes are the explainer types which cannot be built by the explanation server,
+// a pre-built explainer has to be loaded from the storage.
+var alibiPrebuiltExplainerTypes = []string{
+	string(AlibiAnchorsTabularExplainer),
+	string(AlibiAnchorsImageExplainer),
+	string(AlibiKernelShapExplainer),
+}
+
+// Validate the spec
+func (s *AlibiExplainerSpec) Validate() error {
+	return utils.FirstNonNilError([]error{
+		s.ExplainerExtensionSpec.Validate(),
+		s.validateRequiredFields(field.NewPath("spec", "explainer", "alibi")),
+	})
+}
+
+// validateRequiredFields returns field errors for the fields required by the explainer type
+func (s *AlibiExplainerSpec) validateRequiredFields(fldPath *field.Path) error {
+	allErrs := field.ErrorList{}
+	if utils.Includes(alibiPrebuiltExplainerTypes, string(s.Type)) && s.GetStorageUri() == nil && s.GetStorageSpec() == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("storageUri"),
+			fmt.Sprintf("%s explainer requires a pre-built explainer", s.Type)))
+	}
+	return allErrs.ToAggregate()
+}
+
 func (s *AlibiExplainerSpec) GetResourceRequirements() *v1.ResourceRequirements {
 	// return the ResourceRequirements value if set on the spec
 	return &s.Resources
@@ -105,7 +134,7 @@ func (s *AlibiExplainerSpec) Default(config *InferenceServicesConfig) {
 	if s.RuntimeVersion == nil {
 		s.RuntimeVersion = proto.String(config.Explainers.AlibiExplainer.DefaultImageVersion)
 	}
-	setResourceRequirementDefaults(&s.Resources)
+	setResourceRequirementDefaults(config, &s.Resources)
 }
 
 func (s *AlibiExplainerSpec) GetProtocol() constants.InferenceServiceProtocol {
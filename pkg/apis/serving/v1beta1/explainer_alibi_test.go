@@ -48,6 +48,7 @@ func TestAlibiValidation(t *testing.T) {
 				Alibi: &AlibiExplainerSpec{
 					Type: "AnchorTabular",
 					ExplainerExtensionSpec: ExplainerExtensionSpec{
+						StorageURI:     "s3://modelzoo",
 						RuntimeVersion: proto.String("latest"),
 					},
 				},
@@ -88,6 +89,86 @@ func TestAlibiValidation(t *testing.T) {
 	}
 }
 
+func TestAlibiRequiredFields(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		spec    AlibiExplainerSpec
+		matcher types.GomegaMatcher
+	}{
+		"AnchorTabularWithStorageUri": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiAnchorsTabularExplainer,
+				ExplainerExtensionSpec: ExplainerExtensionSpec{
+					StorageURI: "s3://modelzoo",
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"AnchorTabularWithStorageSpec": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiAnchorsTabularExplainer,
+				ExplainerExtensionSpec: ExplainerExtensionSpec{
+					Storage: &StorageSpec{
+						Path: proto.String("explainers/income"),
+					},
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"AnchorTabularWithoutStorage": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiAnchorsTabularExplainer,
+			},
+			matcher: gomega.MatchError(gomega.ContainSubstring("spec.explainer.alibi.storageUri: Required value")),
+		},
+		"AnchorImagesWithStorageUri": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiAnchorsImageExplainer,
+				ExplainerExtensionSpec: ExplainerExtensionSpec{
+					StorageURI: "gs://modelzoo",
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"AnchorImagesWithoutStorage": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiAnchorsImageExplainer,
+			},
+			matcher: gomega.MatchError(gomega.ContainSubstring("spec.explainer.alibi.storageUri: Required value")),
+		},
+		"KernelShapWithStorageUri": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiKernelShapExplainer,
+				ExplainerExtensionSpec: ExplainerExtensionSpec{
+					StorageURI: "gs://modelzoo",
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"KernelShapWithoutStorage": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiKernelShapExplainer,
+			},
+			matcher: gomega.MatchError(gomega.ContainSubstring("spec.explainer.alibi.storageUri: Required value")),
+		},
+		"AnchorTextWithoutStorage": {
+			spec: AlibiExplainerSpec{
+				Type: AlibiAnchorsTextExplainer,
+			},
+			matcher: gomega.BeNil(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			res := scenario.spec.Validate()
+			if !g.Expect(res).To(scenario.matcher) {
+				t.Errorf("got %q, want %q", res, scenario.matcher)
+			}
+		})
+	}
+}
+
 func TestAlibiDefaulter(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	config := InferenceServicesConfig{
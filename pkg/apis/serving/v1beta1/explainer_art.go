@@ -32,11 +32,16 @@ type ARTExplainerType string
 
 const (
 	ARTSquareAttackExplainer ARTExplainerType = "SquareAttack"
+	// ARTFeatureSqueezingDetector scores the requests sent to the :adversarial verb for adversarial perturbation
+	ARTFeatureSqueezingDetector ARTExplainerType = "FeatureSqueezing"
 )
 
 // ARTExplainerType defines the arguments for configuring an ART Explanation Server
 type ARTExplainerSpec struct {
-	// The type of ART explainer
+	// The type of ART explainer <br />
+	// Valid values are: <br />
+	// - "SquareAttack"; <br />
+	// - "FeatureSqueezing": adversarial detector served on the :adversarial verb; <br />
 	Type ARTExplainerType `json:"type"`
 	// Contains fields shared across all explainers
 	ExplainerExtensionSpec `json:",inline"`
@@ -94,7 +99,7 @@ func (s *ARTExplainerSpec) Default(config *InferenceServicesConfig) {
 	if s.RuntimeVersion == nil {
 		s.RuntimeVersion = proto.String(config.Explainers.ARTExplainer.DefaultImageVersion)
 	}
-	setResourceRequirementDefaults(&s.Resources)
+	setResourceRequirementDefaults(config, &s.Resources)
 }
 
 func (s *ARTExplainerSpec) GetProtocol() constants.InferenceServiceProtocol {
@@ -33,6 +33,76 @@ type InferenceServiceSpec struct {
 	// transformer service calls to predictor service.
 	// +optional
 	Transformer *TransformerSpec `json:"transformer,omitempty"`
+	// OutlierDetector defines the service which scores the predictor requests for outliers.
+	// +optional
+	OutlierDetector *OutlierDetectorSpec `json:"outlierDetector,omitempty"`
+	// DriftDetector defines the service which monitors the predictor requests for drift from a reference distribution.
+	// +optional
+	DriftDetector *DriftDetectorSpec `json:"driftDetector,omitempty"`
+	// Tracing defines the OpenTelemetry spans emitted by the agent sidecars of the components, the W3C trace context
+	// is propagated from the transformer to the predictor and the explainer.
+	// +optional
+	Tracing *TracingSpec `json:"tracing,omitempty"`
+	// Authentication requires a bearer token on the inference requests, the token is verified by the agent sidecars
+	// of the predictor, the transformer and the explainer. The transformers and explainers built with the KServe SDK
+	// forward the Authorization header of the request to the predictor, custom ones must forward it as well.
+	// The model server port of the pods remains reachable by pod IP without a token, enable the mTLS and the
+	// authorization policies of the security config to restrict the traffic to the pods.
+	// +optional
+	Authentication *AuthenticationSpec `json:"authentication,omitempty"`
+	// Experiments split the traffic of the predictor between the predictor and weighted variants, e.g. for A/B/n
+	// tests. Every variant is deployed as an InferenceService named <name>-<variant name> with its own predictor spec,
+	// the predictor receives the traffic which is not sent to the variants. Only supported in Serverless mode
+	// without a transformer.
+	// +optional
+	Experiments []ExperimentSpec `json:"experiments,omitempty"`
+}
+
+// ExperimentSpec defines a variant of the predictor which receives a share of the traffic of the InferenceService
+type ExperimentSpec struct {
+	// Name of the variant, unique within the InferenceService
+	// +required
+	Name string `json:"name"`
+	// Percentage of the traffic of the InferenceService sent to the variant
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +required
+	TrafficPercent int64 `json:"trafficPercent"`
+	// Predictor of the variant
+	// +required
+	Predictor PredictorSpec `json:"predictor"`
+}
+
+// TracingSpec defines the collector the spans of the InferenceService are exported to
+type TracingSpec struct {
+	// Endpoint of the OTLP/HTTP collector, e.g. http://otel-collector.observability:4318
+	// +required
+	Endpoint string `json:"endpoint"`
+	// Percentage of the traces started by the InferenceService which are sampled, the traces started upstream
+	// follow the sampling decision of the caller. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SamplingPercent *int `json:"samplingPercent,omitempty"`
+}
+
+// AuthenticationSpec defines the tokens accepted by the InferenceService
+type AuthenticationSpec struct {
+	// Audiences the tokens must be issued for, a token is accepted when it holds one of them.
+	// Defaults to <name>.<namespace> of the InferenceService.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+	// OIDC verifies the JWTs issued by an OpenID Connect provider, the Kubernetes service account tokens are
+	// verified when it is not set.
+	// +optional
+	OIDC *OIDCSpec `json:"oidc,omitempty"`
+}
+
+// OIDCSpec specifies the OpenID Connect provider issuing the tokens
+type OIDCSpec struct {
+	// IssuerURL of the provider, the signing keys are discovered from <issuerUrl>/.well-known/openid-configuration
+	// +required
+	IssuerURL string `json:"issuerUrl"`
 }
 
 // LoggerType controls the scope of log publishing
@@ -61,6 +131,47 @@ type LoggerSpec struct {
 	// - "response": log only response <br />
 	// +optional
 	Mode LoggerType `json:"mode,omitempty"`
+	// Specifies the percentage of the requests whose payloads are logged, the request and the response of a request
+	// are either both logged or both skipped. Defaults to 100.
+	// +optional
+	SamplingPercent *int64 `json:"samplingPercent,omitempty"`
+	// Specifies the Kafka topic to send the logging events to instead of the URL
+	// +optional
+	Kafka *LoggerKafkaSpec `json:"kafka,omitempty"`
+	// Specifies the JSON paths of the payload fields which are masked before the payloads are logged, e.g.
+	// $.instances[*].email. A * matches every element of an array or every field of an object. The payloads which
+	// are not JSON are not logged when paths are set.
+	// +optional
+	Redact []string `json:"redact,omitempty"`
+	// Specifies the bucket to write the logging events to in batches of JSON lines instead of the URL
+	// +optional
+	Storage *LoggerStorageSpec `json:"storage,omitempty"`
+}
+
+// LoggerKafkaSpec specifies the Kafka topic the payloads are logged to as binary cloud events
+type LoggerKafkaSpec struct {
+	// The bootstrap brokers of the Kafka cluster
+	Brokers []string `json:"brokers"`
+	// The topic to produce the logging events to
+	Topic string `json:"topic"`
+	// The name of the secret in the namespace of the InferenceService holding the TLS and SASL configuration of the
+	// Kafka cluster under the protocol, sasl.mechanism, user, password, ca.crt, user.crt and user.key keys
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// LoggerStorageSpec specifies the bucket the payloads are written to. The events are written to objects partitioned
+// by InferenceService and date, e.g. <storageUri>/namespace=default/inferenceservice=sklearn/date=2022-10-17/, with
+// the credentials of the service account of the InferenceService.
+type LoggerStorageSpec struct {
+	// The s3:// or gs:// URI of the bucket and the prefix to write the logging events to
+	StorageUri string `json:"storageUri"`
+	// The max number of logging events written to an object, defaults to 1000
+	// +optional
+	BatchSize *int64 `json:"batchSize,omitempty"`
+	// The max seconds the logging events are buffered before they are written, defaults to 60
+	// +optional
+	FlushIntervalSeconds *int64 `json:"flushIntervalSeconds,omitempty"`
 }
 
 // Batcher specifies optional payload batching available for all components
@@ -76,6 +187,56 @@ type Batcher struct {
 	Timeout *int `json:"timeout,omitempty"`
 }
 
+// RequestQueue specifies the admission control of the agent sidecar in front of the model server of a raw deployment
+type RequestQueue struct {
+	// Specifies the max number of requests forwarded to the model server concurrently
+	MaxInFlight int `json:"maxInFlight"`
+	// Specifies the max number of requests waiting for the model server, further requests are rejected with 429
+	// Too Many Requests. Defaults to 0, so requests above the max in-flight requests are rejected.
+	// +optional
+	MaxQueueDepth *int `json:"maxQueueDepth,omitempty"`
+	// Specifies the Retry-After seconds of the rejected requests, defaults to 1
+	// +optional
+	RetryAfterSeconds *int `json:"retryAfterSeconds,omitempty"`
+}
+
+// OpenAISpec defines the tensors the OpenAI API requests are translated to, the prompts are sent as a BYTES input
+// tensor of the open inference protocol
+type OpenAISpec struct {
+	// Specifies the name of the input tensor, defaults to text_input
+	// +optional
+	InputName string `json:"inputName,omitempty"`
+	// Specifies the name of the output tensor, defaults to text_output for the completions and to embedding for the
+	// embeddings
+	// +optional
+	OutputName string `json:"outputName,omitempty"`
+}
+
+// ResponseCacheSpec defines how long and where the responses are cached
+type ResponseCacheSpec struct {
+	// Specifies the seconds the responses are cached, defaults to 300
+	// +optional
+	TTLSeconds *int `json:"ttlSeconds,omitempty"`
+	// Specifies the max number of responses cached in memory by each replica, the least recently used responses are
+	// evicted first. Defaults to 1000, ignored when the responses are cached in Redis.
+	// +optional
+	MaxEntries *int `json:"maxEntries,omitempty"`
+	// Specifies the Redis server to cache the responses in instead of the memory of the replicas, so the replicas
+	// share the cached responses
+	// +optional
+	Redis *ResponseCacheRedisSpec `json:"redis,omitempty"`
+}
+
+// ResponseCacheRedisSpec specifies the Redis server the responses are cached in
+type ResponseCacheRedisSpec struct {
+	// The host:port address of the Redis server
+	Address string `json:"address"`
+	// The name of the secret in the namespace of the InferenceService holding the password of the Redis server under
+	// the password key
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
 // InferenceService is the Schema for the InferenceServices API
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -84,10 +245,11 @@ type Batcher struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
-// +kubebuilder:printcolumn:name="Prev",type="integer",JSONPath=".status.components.predictor.traffic[?(@.tag=='prev')].percent"
-// +kubebuilder:printcolumn:name="Latest",type="integer",JSONPath=".status.components.predictor.traffic[?(@.latestRevision==true)].percent"
-// +kubebuilder:printcolumn:name="PrevRolledoutRevision",type="string",JSONPath=".status.components.predictor.traffic[?(@.tag=='prev')].revisionName"
-// +kubebuilder:printcolumn:name="LatestReadyRevision",type="string",JSONPath=".status.components.predictor.traffic[?(@.latestRevision==true)].revisionName"
+// +kubebuilder:printcolumn:name="Default",type="integer",JSONPath=".status.components.predictor.default.trafficPercent"
+// +kubebuilder:printcolumn:name="Canary",type="integer",JSONPath=".status.components.predictor.canary.trafficPercent"
+// +kubebuilder:printcolumn:name="Rollout",type="string",priority=1,JSONPath=".status.rolloutStatus.phase"
+// +kubebuilder:printcolumn:name="DefaultRevision",type="string",priority=1,JSONPath=".status.components.predictor.default.name"
+// +kubebuilder:printcolumn:name="CanaryRevision",type="string",priority=1,JSONPath=".status.components.predictor.canary.name"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:path=inferenceservices,shortName=isvc
 // +kubebuilder:storageversion
@@ -42,20 +42,34 @@ var (
 // +kubebuilder:webhook:path=/mutate-inferenceservices,mutating=true,failurePolicy=fail,groups=serving.kserve.io,resources=inferenceservices,verbs=create;update,versions=v1beta1,name=inferenceservice.kserve-webhook-server.defaulter
 var _ webhook.Defaulter = &InferenceService{}
 
-func setResourceRequirementDefaults(requirements *v1.ResourceRequirements) {
-	if requirements.Requests == nil {
+// setResourceRequirementDefaults fills in the missing resource requests and limits, the defaults of the
+// inferenceservice configmap replace the built-in defaults when they are configured
+func setResourceRequirementDefaults(config *InferenceServicesConfig, requirements *v1.ResourceRequirements) {
+	defaultRequests, defaultLimits := defaultResource, defaultResource
+	if config != nil && config.Defaults.Resources.Requests != nil {
+		defaultRequests = config.Defaults.Resources.Requests
+	}
+	if config != nil && config.Defaults.Resources.Limits != nil {
+		defaultLimits = config.Defaults.Resources.Limits
+	}
+
+	setResourceDefaults(requirements, defaultRequests, defaultLimits)
+}
+
+func setResourceDefaults(requirements *v1.ResourceRequirements, defaultRequests v1.ResourceList, defaultLimits v1.ResourceList) {
+	if len(defaultRequests) > 0 && requirements.Requests == nil {
 		requirements.Requests = v1.ResourceList{}
 	}
-	for k, v := range defaultResource {
+	for k, v := range defaultRequests {
 		if _, ok := requirements.Requests[k]; !ok {
 			requirements.Requests[k] = v
 		}
 	}
 
-	if requirements.Limits == nil {
+	if len(defaultLimits) > 0 && requirements.Limits == nil {
 		requirements.Limits = v1.ResourceList{}
 	}
-	for k, v := range defaultResource {
+	for k, v := range defaultLimits {
 		if _, ok := requirements.Limits[k]; !ok {
 			requirements.Limits[k] = v
 		}
@@ -88,7 +102,7 @@ func (isvc *InferenceService) DefaultInferenceService(config *InferenceServicesC
 			isvc.ObjectMeta.Annotations[constants.DeploymentMode] = deployConfig.DefaultDeploymentMode
 		}
 	}
-	components := []Component{isvc.Spec.Transformer, isvc.Spec.Explainer}
+	components := []Component{isvc.Spec.Transformer, isvc.Spec.Explainer, isvc.Spec.OutlierDetector, isvc.Spec.DriftDetector}
 	if !ok || deploymentMode != string(constants.ModelMeshDeployment) {
 		// Only attempt to assign runtimes and apply defaulting logic for non-modelmesh predictors
 		isvc.setPredictorModelDefaults()
@@ -212,6 +226,10 @@ func (isvc *InferenceService) assignPMMLRuntime() {
 		ModelFormat:            ModelFormat{Name: constants.SupportedModelPMML},
 		PredictorExtensionSpec: isvc.Spec.Predictor.PMML.PredictorExtensionSpec,
 	}
+	if version := isvc.Spec.Predictor.PMML.PMMLVersion; version != nil {
+		majorVersion := pmmlMajorVersion(*version)
+		isvc.Spec.Predictor.Model.ModelFormat.Version = &majorVersion
+	}
 	// remove pmml spec
 	isvc.Spec.Predictor.PMML = nil
 }
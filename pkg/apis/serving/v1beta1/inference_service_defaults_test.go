@@ -26,6 +26,7 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -90,6 +91,52 @@ func TestInferenceServiceDefaults(t *testing.T) {
 	g.Expect(isvc.Spec.Explainer.Alibi.Resources).To(gomega.Equal(resources))
 }
 
+func TestConfiguredDefaults(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	requests := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("500m"),
+		v1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+	config := &InferenceServicesConfig{
+		Defaults: DefaultsConfig{
+			Resources: v1.ResourceRequirements{Requests: requests},
+			ModelFormats: map[string]ModelFormatDefaults{
+				constants.SupportedModelSKLearn: {RuntimeVersion: "v0.9.0", ProtocolVersion: constants.ProtocolV2},
+			},
+		},
+	}
+	deployConfig := &DeployConfig{
+		DefaultDeploymentMode: "Serverless",
+	}
+	isvc := InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+		},
+		Spec: InferenceServiceSpec{
+			Predictor: PredictorSpec{
+				SKLearn: &SKLearnSpec{
+					PredictorExtensionSpec: PredictorExtensionSpec{
+						StorageURI: proto.String("gs://testbucket/testmodel"),
+					},
+				},
+			},
+			Transformer: &TransformerSpec{
+				PodSpec: PodSpec{
+					Containers: []v1.Container{{}},
+				},
+			},
+		},
+	}
+	isvc.DefaultInferenceService(config, deployConfig)
+	g.Expect(*isvc.Spec.Predictor.Model.RuntimeVersion).To(gomega.Equal("v0.9.0"))
+	g.Expect(*isvc.Spec.Predictor.Model.ProtocolVersion).To(gomega.Equal(constants.ProtocolV2))
+	// the limits of the serving runtime are kept as only the requests are configured
+	g.Expect(isvc.Spec.Predictor.Model.Resources).To(gomega.Equal(v1.ResourceRequirements{Requests: requests}))
+	g.Expect(isvc.Spec.Transformer.PodSpec.Containers[0].Resources).
+		To(gomega.Equal(v1.ResourceRequirements{Requests: requests, Limits: defaultResource}))
+}
+
 func TestCustomPredictorDefaults(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	config := &InferenceServicesConfig{
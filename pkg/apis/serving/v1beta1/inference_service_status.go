@@ -17,7 +17,11 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/json"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/kserve/kserve/pkg/constants"
 	appsv1 "k8s.io/api/apps/v1"
@@ -25,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/network"
 	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
@@ -34,8 +39,11 @@ type InferenceServiceStatus struct {
 	// - PredictorReady: predictor readiness condition; <br/>
 	// - TransformerReady: transformer readiness condition; <br/>
 	// - ExplainerReady: explainer readiness condition; <br/>
+	// - PredictorFailed: failure of the predictor pods with the message of the failing container; <br/>
+	// - RolledBack: the components whose traffic is rolled back to a previous revision; <br/>
 	// - RoutesReady: aggregated routing condition; <br/>
 	// - Ready: aggregated condition; <br/>
+	// The observed generation is the generation of the spec the status reflects
 	duckv1.Status `json:",inline"`
 	// Addressable endpoint for the InferenceService
 	// +optional
@@ -48,6 +56,64 @@ type InferenceServiceStatus struct {
 	Components map[ComponentType]ComponentStatusSpec `json:"components,omitempty"`
 	// Model related statuses
 	ModelStatus ModelStatus `json:"modelStatus,omitempty"`
+	// Progress of the rollout of the observed generation of the spec
+	// +optional
+	RolloutStatus *RolloutStatus `json:"rolloutStatus,omitempty"`
+	// Readiness and traffic of the predictor and of the variants of the experiments
+	// +optional
+	Experiments []ExperimentStatus `json:"experiments,omitempty"`
+}
+
+// RolloutPhase enum
+// +kubebuilder:validation:Enum=Progressing;Complete;Failed
+type RolloutPhase string
+
+// RolloutPhase Enum values
+const (
+	// The components are rolling out the spec
+	RolloutProgressing RolloutPhase = "Progressing"
+	// The latest revisions of all the components are ready and serve all the traffic
+	RolloutComplete RolloutPhase = "Complete"
+	// The spec failed to roll out
+	RolloutFailed RolloutPhase = "Failed"
+)
+
+// Reasons of the rollout phases which are not reported by the components
+const (
+	RolloutReasonComplete          = "AllComponentsReady"
+	RolloutReasonComponentNotReady = "ComponentNotReady"
+	RolloutReasonRevisionNotReady  = "RevisionNotReady"
+	RolloutReasonCanaryInProgress  = "CanaryInProgress"
+	RolloutReasonReplicasUpdating  = "ReplicasUpdating"
+)
+
+// RolloutStatus describes the progress of the rollout of the spec
+type RolloutStatus struct {
+	// Phase of the rollout: Progressing, Complete or Failed
+	Phase RolloutPhase `json:"phase"`
+	// Reason of the phase
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable details of the phase
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Last time the phase changed
+	// +optional
+	LastTransitionTime apis.VolatileTime `json:"lastTransitionTime,omitempty"`
+}
+
+// ExperimentStatus describes the state of a variant of the experiments, the predictor of the InferenceService is
+// reported as the default variant
+type ExperimentStatus struct {
+	// Name of the variant
+	Name string `json:"name"`
+	// Name of the InferenceService serving the variant
+	ServiceName string `json:"serviceName"`
+	// Percentage of the traffic sent to the variant, the traffic of the variants which are not ready is sent to the
+	// predictor
+	TrafficPercent int64 `json:"trafficPercent"`
+	// Ready is true when the InferenceService of the variant is ready
+	Ready bool `json:"ready"`
 }
 
 // ComponentStatusSpec describes the state of the component
@@ -64,6 +130,12 @@ type ComponentStatusSpec struct {
 	// Latest revision name that is rolled out with 100 percent traffic
 	// +optional
 	LatestRolledoutRevision string `json:"latestRolledoutRevision,omitempty"`
+	// Revision name that the traffic is rolled back to when a rollback is requested
+	// +optional
+	RollbackRevision string `json:"rollbackRevision,omitempty"`
+	// History of the revisions rolled out with 100 percent traffic, the most recent first
+	// +optional
+	RevisionHistory []RevisionHistoryEntry `json:"revisionHistory,omitempty"`
 	// Traffic holds the configured traffic distribution for latest ready revision and previous rolled out revision.
 	// +optional
 	Traffic []knservingv1.TrafficTarget `json:"traffic,omitempty"`
@@ -81,6 +153,55 @@ type ComponentStatusSpec struct {
 	// Addressable endpoint for the InferenceService
 	// +optional
 	Address *duckv1.Addressable `json:"address,omitempty"`
+	// Number of replicas of the component in RawDeployment mode
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+	// Number of ready replicas of the component in RawDeployment mode
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Number of replicas the HorizontalPodAutoscaler scales the component to in RawDeployment mode
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+	// Conditions of the component <br/>
+	// - Ready: component readiness condition; <br/>
+	// - RoutesReady: routing condition of the component in Serverless mode; <br/>
+	// - ConfigurationsReady: condition of the rollout of the pods of the latest revision; <br/>
+	// - ScalingReady: scaling condition of the component in RawDeployment mode; <br/>
+	// +optional
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
+	// Status of the revision serving the traffic which is not routed to the canary revision
+	// +optional
+	Default *RevisionStatusSpec `json:"default,omitempty"`
+	// Status of the latest revision while it serves part of the traffic as a canary
+	// +optional
+	Canary *RevisionStatusSpec `json:"canary,omitempty"`
+}
+
+// RevisionStatusSpec describes a revision serving the traffic of the component
+type RevisionStatusSpec struct {
+	// Name of the revision
+	Name string `json:"name"`
+	// Percentage of the traffic of the component routed to the revision
+	// +optional
+	TrafficPercent int64 `json:"trafficPercent,omitempty"`
+	// URL of the revision when it is tagged, e.g. the latest revision with tag-based routing
+	// +optional
+	URL *apis.URL `json:"url,omitempty"`
+	// Number of ready replicas of the revision
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+}
+
+// RevisionHistoryEntry describes a revision which has been rolled out with 100 percent traffic
+type RevisionHistoryEntry struct {
+	// Name of the revision
+	Name string `json:"name"`
+	// Hash of the revision configuration
+	// +optional
+	ConfigHash string `json:"configHash,omitempty"`
+	// Storage URI of the model served by the revision
+	// +optional
+	StorageURI string `json:"storageUri,omitempty"`
 }
 
 // ComponentType contains the different types of components of the service
@@ -91,6 +212,21 @@ const (
 	PredictorComponent   ComponentType = "predictor"
 	ExplainerComponent   ComponentType = "explainer"
 	TransformerComponent ComponentType = "transformer"
+	// OutlierDetectorComponent is not exposed through the ingress, it scores the predictor requests
+	OutlierDetectorComponent ComponentType = "outlierDetector"
+	// DriftDetectorComponent is not exposed through the ingress, it receives the predictor requests from the logger
+	DriftDetectorComponent ComponentType = "driftDetector"
+)
+
+// ConditionType of the component conditions, the readiness of the component is the Ready condition
+const (
+	// ComponentRoutesReady is set when the network configuration of the component has completed.
+	ComponentRoutesReady apis.ConditionType = "RoutesReady"
+	// ComponentConfigurationsReady is set when the pods of the latest revision of the component are ready, in
+	// RawDeployment mode it reflects the progressing condition of the deployment.
+	ComponentConfigurationsReady apis.ConditionType = "ConfigurationsReady"
+	// ComponentScalingReady is set to false when the replicas of the component cannot be created.
+	ComponentScalingReady apis.ConditionType = "ScalingReady"
 )
 
 // ConditionType represents a Service condition value
@@ -101,6 +237,10 @@ const (
 	TransformerRouteReady apis.ConditionType = "TransformerRouteReady"
 	// ExplainerRoutesReady is set when network configuration has completed.
 	ExplainerRoutesReady apis.ConditionType = "ExplainerRoutesReady"
+	// OutlierDetectorRouteReady is set when network configuration has completed.
+	OutlierDetectorRouteReady apis.ConditionType = "OutlierDetectorRouteReady"
+	// DriftDetectorRouteReady is set when network configuration has completed.
+	DriftDetectorRouteReady apis.ConditionType = "DriftDetectorRouteReady"
 	// PredictorConfigurationReady is set when predictor pods are ready.
 	PredictorConfigurationReady apis.ConditionType = "PredictorConfigurationReady"
 	// TransformerConfigurationReady is set when transformer pods are ready.
@@ -108,14 +248,40 @@ const (
 	TransformerConfigurationeReady apis.ConditionType = "TransformerConfigurationeReady"
 	// ExplainerConfigurationReady is set when explainer pods are ready.
 	ExplainerConfigurationReady apis.ConditionType = "ExplainerConfigurationReady"
+	// OutlierDetectorConfigurationReady is set when outlier detector pods are ready.
+	OutlierDetectorConfigurationReady apis.ConditionType = "OutlierDetectorConfigurationReady"
+	// DriftDetectorConfigurationReady is set when drift detector pods are ready.
+	DriftDetectorConfigurationReady apis.ConditionType = "DriftDetectorConfigurationReady"
 	// PredictorReady is set when predictor has reported readiness.
 	PredictorReady apis.ConditionType = "PredictorReady"
 	// TransformerReady is set when transformer has reported readiness.
 	TransformerReady apis.ConditionType = "TransformerReady"
 	// ExplainerReady is set when explainer has reported readiness.
 	ExplainerReady apis.ConditionType = "ExplainerReady"
+	// OutlierDetectorReady is set when outlier detector has reported readiness.
+	OutlierDetectorReady apis.ConditionType = "OutlierDetectorReady"
+	// DriftDetectorReady is set when drift detector has reported readiness.
+	DriftDetectorReady apis.ConditionType = "DriftDetectorReady"
 	// IngressReady is set when Ingress is created
 	IngressReady apis.ConditionType = "IngressReady"
+	// PredictorFailed is set while the storage initializer or the kserve container of a predictor pod fails, its
+	// message is the terminal message of the failing container.
+	PredictorFailed apis.ConditionType = "PredictorFailed"
+	// PredictorScalingReady is set to false in RawDeployment mode when the predictor replicas cannot be created.
+	PredictorScalingReady apis.ConditionType = "PredictorScalingReady"
+	// TransformerScalingReady is set to false in RawDeployment mode when the transformer replicas cannot be created.
+	TransformerScalingReady apis.ConditionType = "TransformerScalingReady"
+	// ExplainerScalingReady is set to false in RawDeployment mode when the explainer replicas cannot be created.
+	ExplainerScalingReady apis.ConditionType = "ExplainerScalingReady"
+	// OutlierDetectorScalingReady is set to false in RawDeployment mode when the outlier detector replicas cannot be
+	// created.
+	OutlierDetectorScalingReady apis.ConditionType = "OutlierDetectorScalingReady"
+	// DriftDetectorScalingReady is set to false in RawDeployment mode when the drift detector replicas cannot be
+	// created.
+	DriftDetectorScalingReady apis.ConditionType = "DriftDetectorScalingReady"
+	// RolledBack is set while the traffic of a component is rolled back to a previous revision, the latest revision of
+	// the spec does not serve traffic until the spec changes.
+	RolledBack apis.ConditionType = "RolledBack"
 )
 
 type ModelStatus struct {
@@ -134,6 +300,33 @@ type ModelStatus struct {
 	// Model copy information of the predictor's model.
 	// +optional
 	ModelCopies *ModelCopies `json:"copies,omitempty"`
+
+	// Metadata of the model artifacts resolved by the storage initializer.
+	// +optional
+	ResolvedModel *ResolvedModelInfo `json:"resolvedModel,omitempty"`
+}
+
+// ResolvedModelInfo describes the model artifacts downloaded by the storage initializer, so that the deployed model
+// can be checked against the model registry
+type ResolvedModelInfo struct {
+	// Storage URI the artifacts are downloaded from
+	// +optional
+	StorageURI string `json:"storageUri,omitempty"`
+	// Total size of the artifacts in bytes
+	// +optional
+	ArtifactSize int64 `json:"artifactSize,omitempty"`
+	// Checksum over the relative paths and the content of the artifacts, e.g. sha256:<hex>
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+	// Framework detected from the artifacts, e.g. from the flavors of an MLflow model
+	// +optional
+	Framework string `json:"framework,omitempty"`
+	// Version of the framework the model is saved with
+	// +optional
+	FrameworkVersion string `json:"frameworkVersion,omitempty"`
+	// Time the storage initializer took to download the artifacts
+	// +optional
+	DownloadDuration *metav1.Duration `json:"downloadDuration,omitempty"`
 }
 
 type ModelRevisionStates struct {
@@ -188,7 +381,7 @@ const (
 )
 
 // FailureReason enum
-// +kubebuilder:validation:Enum=ModelLoadFailed;RuntimeUnhealthy;RuntimeDisabled;NoSupportingRuntime;RuntimeNotRecognized;InvalidPredictorSpec
+// +kubebuilder:validation:Enum=ModelLoadFailed;RuntimeUnhealthy;RuntimeDisabled;NoSupportingRuntime;RuntimeNotRecognized;InvalidPredictorSpec;PolicyViolation
 type FailureReason string
 
 // FailureReason enum values
@@ -205,6 +398,8 @@ const (
 	RuntimeNotRecognized FailureReason = "RuntimeNotRecognized"
 	// The current Predictor Spec is invalid or unsupported
 	InvalidPredictorSpec FailureReason = "InvalidPredictorSpec"
+	// The InferenceService violates a ClusterServingPolicy applying to its namespace
+	PolicyViolation FailureReason = "PolicyViolation"
 )
 
 type FailureInfo struct {
@@ -229,24 +424,40 @@ type FailureInfo struct {
 }
 
 var conditionsMap = map[ComponentType]apis.ConditionType{
-	PredictorComponent:   PredictorReady,
-	ExplainerComponent:   ExplainerReady,
-	TransformerComponent: TransformerReady,
+	PredictorComponent:       PredictorReady,
+	ExplainerComponent:       ExplainerReady,
+	TransformerComponent:     TransformerReady,
+	OutlierDetectorComponent: OutlierDetectorReady,
+	DriftDetectorComponent:   DriftDetectorReady,
 }
 
 var routeConditionsMap = map[ComponentType]apis.ConditionType{
-	PredictorComponent:   PredictorRouteReady,
-	ExplainerComponent:   ExplainerRoutesReady,
-	TransformerComponent: TransformerRouteReady,
+	PredictorComponent:       PredictorRouteReady,
+	ExplainerComponent:       ExplainerRoutesReady,
+	TransformerComponent:     TransformerRouteReady,
+	OutlierDetectorComponent: OutlierDetectorRouteReady,
+	DriftDetectorComponent:   DriftDetectorRouteReady,
 }
 
 var configurationConditionsMap = map[ComponentType]apis.ConditionType{
-	PredictorComponent:   PredictorConfigurationReady,
-	ExplainerComponent:   ExplainerConfigurationReady,
-	TransformerComponent: TransformerConfigurationReady,
+	PredictorComponent:       PredictorConfigurationReady,
+	ExplainerComponent:       ExplainerConfigurationReady,
+	TransformerComponent:     TransformerConfigurationReady,
+	OutlierDetectorComponent: OutlierDetectorConfigurationReady,
+	DriftDetectorComponent:   DriftDetectorConfigurationReady,
+}
+
+var scalingConditionsMap = map[ComponentType]apis.ConditionType{
+	PredictorComponent:       PredictorScalingReady,
+	ExplainerComponent:       ExplainerScalingReady,
+	TransformerComponent:     TransformerScalingReady,
+	OutlierDetectorComponent: OutlierDetectorScalingReady,
+	DriftDetectorComponent:   DriftDetectorScalingReady,
 }
 
-// InferenceService Ready condition is depending on predictor and route readiness condition
+// InferenceService Ready condition is depending on predictor and route readiness condition, the ingress is only
+// ready once the transformer and the explainer are ready so the reason of the first component which is not ready is
+// surfaced on the Ready condition
 var conditionSet = apis.NewLivingConditionSet(
 	PredictorReady,
 	IngressReady,
@@ -273,6 +484,17 @@ func (ss *InferenceServiceStatus) IsConditionReady(t apis.ConditionType) bool {
 	return conditionSet.Manage(ss).GetCondition(t) != nil && conditionSet.Manage(ss).GetCondition(t).Status == v1.ConditionTrue
 }
 
+// IsComponentReady returns if the component has reported readiness
+func (ss *InferenceServiceStatus) IsComponentReady(component ComponentType) bool {
+	return ss.IsConditionReady(conditionsMap[component])
+}
+
+// GetScalingCondition returns the scaling condition of the component, it is only set once the replicas of the
+// component have failed to be created
+func (ss *InferenceServiceStatus) GetScalingCondition(component ComponentType) *apis.Condition {
+	return ss.GetCondition(scalingConditionsMap[component])
+}
+
 func (ss *InferenceServiceStatus) PropagateRawStatus(
 	component ComponentType,
 	deployment *appsv1.Deployment,
@@ -286,15 +508,59 @@ func (ss *InferenceServiceStatus) PropagateRawStatus(
 	}
 
 	statusSpec.LatestCreatedRevision = deployment.GetObjectMeta().GetAnnotations()["deployment.kubernetes.io/revision"]
+	// The HorizontalPodAutoscaler sets the desired replicas on the deployment spec
+	statusSpec.CurrentReplicas = deployment.Status.Replicas
+	statusSpec.ReadyReplicas = deployment.Status.ReadyReplicas
+	if deployment.Spec.Replicas != nil {
+		statusSpec.DesiredReplicas = *deployment.Spec.Replicas
+	}
 	condition := getDeploymentCondition(deployment, appsv1.DeploymentAvailable)
 	if condition != nil && condition.Status == v1.ConditionTrue {
 		statusSpec.URL = url
+		statusSpec.Address = &duckv1.Addressable{
+			URL: &apis.URL{
+				Scheme: "http",
+				Host:   network.GetServiceHostname(deployment.Name, deployment.Namespace),
+			},
+		}
+	}
+	// The deployment serves all the traffic of the component with a single revision
+	statusSpec.Default = &RevisionStatusSpec{
+		Name:           statusSpec.LatestCreatedRevision,
+		TrafficPercent: 100,
+		ReadyReplicas:  deployment.Status.ReadyReplicas,
 	}
+	statusSpec.Canary = nil
 	readyCondition := conditionsMap[component]
 	ss.SetCondition(readyCondition, condition)
+	statusSpec.setCondition(apis.ConditionReady, condition)
+	// The deployment reports a replica failure when the replicas the autoscaler scales it to cannot be created, e.g.
+	// when the resource quota of the namespace is exceeded. The scaling condition is left unset until it happens.
+	scalingCondition := scalingConditionsMap[component]
+	if replicaFailure := getDeploymentCondition(deployment, appsv1.DeploymentReplicaFailure); replicaFailure.Status == v1.ConditionTrue {
+		conditionSet.Manage(ss).MarkFalse(scalingCondition, replicaFailure.Reason, replicaFailure.Message)
+	} else if ss.GetCondition(scalingCondition) != nil {
+		conditionSet.Manage(ss).MarkTrue(scalingCondition)
+	}
+	statusSpec.setCondition(ComponentScalingReady, ss.GetCondition(scalingCondition))
+	// The new replica set of the deployment is available once its rollout is complete, the deployment stops
+	// progressing when the rollout exceeds its progress deadline
+	if progressing := getDeploymentCondition(deployment, appsv1.DeploymentProgressing); progressing.Status != "" {
+		configurationCondition := *progressing
+		if progressing.Status == v1.ConditionTrue {
+			configurationCondition.Status = v1.ConditionUnknown
+			if progressing.Reason == newReplicaSetAvailableReason {
+				configurationCondition.Status = v1.ConditionTrue
+			}
+		}
+		statusSpec.setCondition(ComponentConfigurationsReady, &configurationCondition)
+	}
 	ss.Components[component] = statusSpec
 }
 
+// newReplicaSetAvailableReason is the reason of the progressing condition of a deployment whose rollout is complete
+const newReplicaSetAvailableReason = "NewReplicaSetAvailable"
+
 func getDeploymentCondition(deployment *appsv1.Deployment, conditionType appsv1.DeploymentConditionType) *apis.Condition {
 	condition := apis.Condition{}
 	for _, con := range deployment.Status.Conditions {
@@ -381,7 +647,234 @@ func (ss *InferenceServiceStatus) PropagateStatus(component ComponentType, servi
 	ss.SetCondition(configurationConditionType, configurationCondition)
 	// Fix previously incorrectly named condition type
 	ss.ClearCondition(TransformerConfigurationeReady)
+	statusSpec.setCondition(apis.ConditionReady, serviceCondition)
+	statusSpec.setCondition(ComponentRoutesReady, routeCondition)
+	statusSpec.setCondition(ComponentConfigurationsReady, configurationCondition)
+	statusSpec.Default, statusSpec.Canary = revisionStatuses(serviceStatus.Traffic)
+
+	ss.Components[component] = statusSpec
+}
+
+// revisionStatuses splits the traffic of the component between the default revision and the canary revision, the
+// latest revision is the canary while it serves part of the traffic and the default revision is the other revision
+// serving the most traffic
+func revisionStatuses(traffic []knservingv1.TrafficTarget) (*RevisionStatusSpec, *RevisionStatusSpec) {
+	var revisions []*RevisionStatusSpec
+	byName := map[string]*RevisionStatusSpec{}
+	latest := ""
+	for _, target := range traffic {
+		if target.RevisionName == "" {
+			continue
+		}
+		if target.LatestRevision != nil && *target.LatestRevision {
+			latest = target.RevisionName
+		}
+		revision, ok := byName[target.RevisionName]
+		if !ok {
+			revision = &RevisionStatusSpec{Name: target.RevisionName}
+			byName[target.RevisionName] = revision
+			revisions = append(revisions, revision)
+		}
+		if target.Percent != nil {
+			revision.TrafficPercent += *target.Percent
+		}
+		if target.URL != nil {
+			revision.URL = target.URL
+		}
+	}
+	var defaultRevision, canary *RevisionStatusSpec
+	if revision, ok := byName[latest]; ok && revision.TrafficPercent > 0 && revision.TrafficPercent < 100 {
+		canary = revision
+	}
+	for _, revision := range revisions {
+		if revision == canary || revision.TrafficPercent == 0 {
+			continue
+		}
+		if defaultRevision == nil || revision.TrafficPercent > defaultRevision.TrafficPercent {
+			defaultRevision = revision
+		}
+	}
+	if defaultRevision == nil {
+		// The canary serves the traffic which is split over the tags of a single revision
+		return canary, nil
+	}
+	return defaultRevision, canary
+}
+
+// setCondition sets the condition of the component, the transition time is kept while the status does not change
+func (cs *ComponentStatusSpec) setCondition(conditionType apis.ConditionType, condition *apis.Condition) {
+	if condition == nil || condition.Status == "" {
+		return
+	}
+	updated := apis.Condition{
+		Type:               conditionType,
+		Status:             condition.Status,
+		Reason:             condition.Reason,
+		Message:            condition.Message,
+		LastTransitionTime: condition.LastTransitionTime,
+	}
+	if updated.LastTransitionTime.Inner.IsZero() {
+		updated.LastTransitionTime = apis.VolatileTime{Inner: metav1.Now()}
+	}
+	for i := range cs.Conditions {
+		if cs.Conditions[i].Type == conditionType {
+			if cs.Conditions[i].Status == updated.Status {
+				updated.LastTransitionTime = cs.Conditions[i].LastTransitionTime
+			}
+			cs.Conditions[i] = updated
+			return
+		}
+	}
+	cs.Conditions = append(cs.Conditions, updated)
+	sort.Slice(cs.Conditions, func(i, j int) bool {
+		return cs.Conditions[i].Type < cs.Conditions[j].Type
+	})
+}
+
+// GetCondition returns the condition of the component by type
+func (cs *ComponentStatusSpec) GetCondition(conditionType apis.ConditionType) *apis.Condition {
+	for i := range cs.Conditions {
+		if cs.Conditions[i].Type == conditionType {
+			return &cs.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// PropagateRolloutStatus derives the progress of the rollout of the spec from the statuses of the components and the
+// model, the transition time is kept while the phase does not change
+func (ss *InferenceServiceStatus) PropagateRolloutStatus() {
+	rollout := ss.rolloutStatus()
+	if ss.RolloutStatus != nil && ss.RolloutStatus.Phase == rollout.Phase {
+		rollout.LastTransitionTime = ss.RolloutStatus.LastTransitionTime
+	} else {
+		rollout.LastTransitionTime = apis.VolatileTime{Inner: metav1.Now()}
+	}
+	ss.RolloutStatus = &rollout
+}
+
+func (ss *InferenceServiceStatus) rolloutStatus() RolloutStatus {
+	if failure := ss.ModelStatus.LastFailureInfo; failure != nil &&
+		(ss.ModelStatus.TransitionStatus == BlockedByFailedLoad || ss.ModelStatus.TransitionStatus == InvalidSpec) {
+		return RolloutStatus{Phase: RolloutFailed, Reason: string(failure.Reason), Message: failure.Message}
+	}
+	if condition := ss.GetCondition(PredictorFailed); condition != nil && condition.IsTrue() {
+		return RolloutStatus{Phase: RolloutFailed, Reason: condition.Reason, Message: condition.Message}
+	}
+	components := make([]string, 0, len(ss.Components))
+	for component := range ss.Components {
+		components = append(components, string(component))
+	}
+	sort.Strings(components)
+	// A component fails to roll out when its latest revision fails or its traffic cannot be routed, the readiness
+	// alone is not enough as the pods of a deployment are not available while it is progressing
+	for _, name := range components {
+		statusSpec := ss.Components[ComponentType(name)]
+		for _, conditionType := range []apis.ConditionType{ComponentConfigurationsReady, ComponentRoutesReady} {
+			if condition := statusSpec.GetCondition(conditionType); condition != nil && condition.IsFalse() {
+				return RolloutStatus{Phase: RolloutFailed, Reason: condition.Reason,
+					Message: fmt.Sprintf("%s: %s", name, condition.Message)}
+			}
+		}
+	}
+	for _, name := range components {
+		component := ComponentType(name)
+		statusSpec := ss.Components[component]
+		if !ss.IsComponentReady(component) {
+			message := fmt.Sprintf("%s is not ready", name)
+			if condition := ss.GetCondition(conditionsMap[component]); condition != nil && condition.Message != "" {
+				message += ": " + condition.Message
+			}
+			return RolloutStatus{Phase: RolloutProgressing, Reason: RolloutReasonComponentNotReady, Message: message}
+		}
+		if statusSpec.LatestReadyRevision != "" && statusSpec.LatestReadyRevision != statusSpec.LatestCreatedRevision {
+			return RolloutStatus{Phase: RolloutProgressing, Reason: RolloutReasonRevisionNotReady,
+				Message: fmt.Sprintf("%s revision %s is not ready", name, statusSpec.LatestCreatedRevision)}
+		}
+		if canary := statusSpec.Canary; canary != nil {
+			return RolloutStatus{Phase: RolloutProgressing, Reason: RolloutReasonCanaryInProgress,
+				Message: fmt.Sprintf("%s canary revision %s serves %d%% of the traffic", name, canary.Name,
+					canary.TrafficPercent)}
+		}
+		if condition := statusSpec.GetCondition(ComponentConfigurationsReady); condition != nil && condition.IsUnknown() {
+			return RolloutStatus{Phase: RolloutProgressing, Reason: RolloutReasonReplicasUpdating,
+				Message: fmt.Sprintf("%s: %s", name, condition.Message)}
+		}
+	}
+	if condition := ss.GetCondition(apis.ConditionReady); condition == nil || !condition.IsTrue() {
+		rollout := RolloutStatus{Phase: RolloutProgressing}
+		if condition != nil {
+			rollout.Reason, rollout.Message = condition.Reason, condition.Message
+		}
+		return rollout
+	}
+	return RolloutStatus{Phase: RolloutComplete, Reason: RolloutReasonComplete}
+}
+
+// PropagateRollbackStatus surfaces the components whose traffic is rolled back to a previous revision in the RolledBack
+// condition, so that the divergence between the spec and the served revisions is visible. The condition is cleared
+// once no component is rolled back.
+func (ss *InferenceServiceStatus) PropagateRollbackStatus() {
+	components := make([]string, 0, len(ss.Components))
+	for component, statusSpec := range ss.Components {
+		if statusSpec.RollbackRevision != "" {
+			components = append(components, string(component))
+		}
+	}
+	if len(components) == 0 {
+		ss.ClearCondition(RolledBack)
+		return
+	}
+	sort.Strings(components)
+	messages := make([]string, 0, len(components))
+	for _, name := range components {
+		messages = append(messages, fmt.Sprintf("%s traffic is rolled back to revision %s", name,
+			ss.Components[ComponentType(name)].RollbackRevision))
+	}
+	conditionSet.Manage(ss).SetCondition(apis.Condition{
+		Type:     RolledBack,
+		Status:   v1.ConditionTrue,
+		Severity: apis.ConditionSeverityInfo,
+		Reason:   "RollbackInEffect",
+		Message:  strings.Join(messages, ", ") + ", the spec is not served until it changes",
+	})
+}
 
+// SetRollbackRevision records the revision that the traffic of the component is rolled back to
+func (ss *InferenceServiceStatus) SetRollbackRevision(component ComponentType, revision string) {
+	if len(ss.Components) == 0 {
+		ss.Components = make(map[ComponentType]ComponentStatusSpec)
+	}
+	statusSpec := ss.Components[component]
+	statusSpec.RollbackRevision = revision
+	ss.Components[component] = statusSpec
+}
+
+// RecordRolledoutRevision adds the latest rolled out revision of the component to the revision history, the revision is
+// only recorded when it is also the latest created revision so that the config hash and storage uri match it.
+func (ss *InferenceServiceStatus) RecordRolledoutRevision(component ComponentType, configHash string, storageURI *string) {
+	statusSpec, ok := ss.Components[component]
+	if !ok || statusSpec.LatestRolledoutRevision == "" ||
+		statusSpec.LatestRolledoutRevision != statusSpec.LatestCreatedRevision {
+		return
+	}
+	if len(statusSpec.RevisionHistory) > 0 && statusSpec.RevisionHistory[0].Name == statusSpec.LatestRolledoutRevision {
+		return
+	}
+	entry := RevisionHistoryEntry{
+		Name:       statusSpec.LatestRolledoutRevision,
+		ConfigHash: configHash,
+	}
+	if storageURI != nil {
+		entry.StorageURI = *storageURI
+	}
+	history := []RevisionHistoryEntry{entry}
+	for _, previous := range statusSpec.RevisionHistory {
+		if previous.Name != entry.Name && len(history) < constants.RevisionHistoryLimit {
+			history = append(history, previous)
+		}
+	}
+	statusSpec.RevisionHistory = history
 	ss.Components[component] = statusSpec
 }
 
@@ -447,13 +940,92 @@ func (ss *InferenceServiceStatus) SetModelFailureInfo(info *FailureInfo) bool {
 	return true
 }
 
+// propagateResolvedModel records the model metadata that the storage initializer writes to its termination message once
+// the artifacts are downloaded
+func (ss *InferenceServiceStatus) propagateResolvedModel(pod *v1.Pod) {
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.Name != constants.StorageInitializerContainerName || cs.State.Terminated == nil ||
+			cs.State.Terminated.ExitCode != 0 || cs.State.Terminated.Message == "" {
+			continue
+		}
+		// The storage initializer images which predate the metadata do not write a termination message
+		resolved := &ResolvedModelInfo{}
+		if err := json.Unmarshal([]byte(cs.State.Terminated.Message), resolved); err == nil {
+			ss.ModelStatus.ResolvedModel = resolved
+		}
+	}
+}
+
+// propagatePredictorFailure surfaces the failure of the first failing predictor pod in the PredictorFailed condition,
+// the condition is cleared once none of the pods fails
+func (ss *InferenceServiceStatus) propagatePredictorFailure(podList *v1.PodList) {
+	for i := range podList.Items {
+		if reason, message := podFailure(&podList.Items[i]); reason != "" {
+			conditionSet.Manage(ss).SetCondition(apis.Condition{
+				Type:     PredictorFailed,
+				Status:   v1.ConditionTrue,
+				Severity: apis.ConditionSeverityInfo,
+				Reason:   reason,
+				Message:  message,
+			})
+			return
+		}
+	}
+	ss.ClearCondition(PredictorFailed)
+}
+
+// podFailure returns the reason and the message of the storage initializer or the kserve container of the pod when it
+// has failed or cannot start
+func podFailure(pod *v1.Pod) (string, string) {
+	statuses := []v1.ContainerStatus{}
+	for _, cs := range pod.Status.InitContainerStatuses {
+		if cs.Name == constants.StorageInitializerContainerName {
+			statuses = append(statuses, cs)
+		}
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == constants.InferenceServiceContainerName {
+			statuses = append(statuses, cs)
+		}
+	}
+	for _, cs := range statuses {
+		terminated := cs.State.Terminated
+		if waiting := cs.State.Waiting; waiting != nil {
+			switch waiting.Reason {
+			case constants.StateReasonCrashLoopBackOff:
+				// The message of the crash is the one of the last termination
+				terminated = cs.LastTerminationState.Terminated
+			case constants.StateReasonErrImagePull, constants.StateReasonImagePullBackOff,
+				constants.StateReasonInvalidImageName, constants.StateReasonCreateContainerConfigError,
+				constants.StateReasonCreateContainerError:
+				return waiting.Reason, fmt.Sprintf("container %s of pod %s: %s", cs.Name, pod.Name, waiting.Message)
+			}
+		}
+		if terminated == nil || terminated.ExitCode == 0 {
+			continue
+		}
+		reason := terminated.Reason
+		if reason == "" {
+			reason = constants.StateReasonError
+		}
+		detail := strings.TrimSpace(terminated.Message)
+		if detail == "" {
+			detail = fmt.Sprintf("%s with exit code %d", reason, terminated.ExitCode)
+		}
+		return reason, fmt.Sprintf("container %s of pod %s: %s", cs.Name, pod.Name, detail)
+	}
+	return "", ""
+}
+
 func (ss *InferenceServiceStatus) PropagateModelStatus(statusSpec ComponentStatusSpec, podList *v1.PodList, rawDeplyment bool) {
+	ss.propagatePredictorFailure(podList)
 	// Check at least one pod is running for the latest revision of inferenceservice
 	totalCopies := len(podList.Items)
 	if totalCopies == 0 {
 		ss.UpdateModelRevisionStates(Pending, totalCopies, nil)
 		return
 	}
+	ss.propagateResolvedModel(&podList.Items[0])
 	// Update model state to 'Loaded' if inferenceservice status is ready.
 	// For serverless deployment, the latest created revision and the latest ready revision should be equal
 	if ss.IsReady() {
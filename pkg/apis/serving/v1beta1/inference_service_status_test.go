@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/onsi/gomega"
 	"net/url"
@@ -186,12 +187,18 @@ func TestPropagateRawStatus(t *testing.T) {
 	deployment := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{},
 		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-predictor-default",
+			Namespace: "default",
 			Annotations: map[string]string{
 				"deployment.kubernetes.io/revision": "1",
 			},
 		},
-		Spec: appsv1.DeploymentSpec{},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: proto.Int32(3),
+		},
 		Status: appsv1.DeploymentStatus{
+			Replicas:      2,
+			ReadyReplicas: 2,
 			Conditions: []appsv1.DeploymentCondition{
 				{
 					Type:    appsv1.DeploymentAvailable,
@@ -217,6 +224,48 @@ func TestPropagateRawStatus(t *testing.T) {
 	if res := status.IsConditionReady(PredictorReady); !res {
 		t.Errorf("expected: %v got: %v conditions: %v", true, res, status.Conditions)
 	}
+	if predictor := status.Components[PredictorComponent]; predictor.CurrentReplicas != 2 || predictor.DesiredReplicas != 3 {
+		t.Errorf("expected replicas: 2/3 got: %v/%v", predictor.CurrentReplicas, predictor.DesiredReplicas)
+	}
+	if condition := status.GetScalingCondition(PredictorComponent); condition != nil {
+		t.Errorf("expected no scaling condition got: %v", condition)
+	}
+	predictor := status.Components[PredictorComponent]
+	if address := predictor.Address; address == nil || address.URL.String() != "http://test-predictor-default.default.svc.cluster.local" {
+		t.Errorf("expected the internal address of the predictor service got: %v", address)
+	}
+	if e, a := (&RevisionStatusSpec{Name: "1", TrafficPercent: 100, ReadyReplicas: 2}), predictor.Default; *e != *a {
+		t.Errorf("expected default revision: %v got: %v", e, a)
+	}
+	if condition := predictor.GetCondition(apis.ConditionReady); condition == nil || condition.Status != v1.ConditionTrue ||
+		condition.Reason != "MinimumReplicasAvailable" {
+		t.Errorf("expected component Ready condition: True/MinimumReplicasAvailable got: %v", condition)
+	}
+
+	deployment.Status.Conditions = append(deployment.Status.Conditions, appsv1.DeploymentCondition{
+		Type:    appsv1.DeploymentReplicaFailure,
+		Status:  v1.ConditionTrue,
+		Reason:  "FailedCreate",
+		Message: "pods \"test-predictor-default-5d8f\" is forbidden: exceeded quota: compute",
+	})
+	status.PropagateRawStatus(PredictorComponent, deployment, url)
+	if condition := status.GetScalingCondition(PredictorComponent); condition == nil || condition.Status != v1.ConditionFalse ||
+		condition.Reason != "FailedCreate" {
+		t.Errorf("expected scaling condition: False/FailedCreate got: %v", condition)
+	}
+	if res := status.IsConditionReady(PredictorReady); !res {
+		t.Errorf("expected the scaling condition not to affect readiness got conditions: %v", status.Conditions)
+	}
+	predictor = status.Components[PredictorComponent]
+	if condition := predictor.GetCondition(ComponentScalingReady); condition == nil || condition.Status != v1.ConditionFalse {
+		t.Errorf("expected component ScalingReady condition: False got: %v", condition)
+	}
+
+	deployment.Status.Conditions = deployment.Status.Conditions[:1]
+	status.PropagateRawStatus(PredictorComponent, deployment, url)
+	if res := status.IsConditionReady(PredictorScalingReady); !res {
+		t.Errorf("expected scaling condition ready got conditions: %v", status.Conditions)
+	}
 }
 
 func TestPropagateStatus(t *testing.T) {
@@ -331,6 +380,84 @@ func TestPropagateStatus(t *testing.T) {
 	}
 }
 
+func TestInferenceServiceStatus_PropagateRevisionStatuses(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	tagURL, _ := apis.ParseURL("http://latest-test-predictor-default.default.example.com")
+	serviceStatus := &knservingv1.ServiceStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{
+				{
+					Type:   knservingv1.ServiceConditionReady,
+					Status: v1.ConditionTrue,
+				},
+				{
+					Type:    "RoutesReady",
+					Status:  v1.ConditionTrue,
+					Message: "Traffic is routed.",
+				},
+				{
+					Type:   "ConfigurationsReady",
+					Status: v1.ConditionTrue,
+				},
+			},
+		},
+		ConfigurationStatusFields: knservingv1.ConfigurationStatusFields{
+			LatestReadyRevisionName:   "test-predictor-default-0002",
+			LatestCreatedRevisionName: "test-predictor-default-0002",
+		},
+		RouteStatusFields: knservingv1.RouteStatusFields{
+			Traffic: []knservingv1.TrafficTarget{
+				{
+					Tag:            "latest",
+					RevisionName:   "test-predictor-default-0002",
+					Percent:        proto.Int64(10),
+					LatestRevision: proto.Bool(true),
+					URL:            tagURL,
+				},
+				{
+					Tag:            "prev",
+					RevisionName:   "test-predictor-default-0001",
+					Percent:        proto.Int64(90),
+					LatestRevision: proto.Bool(false),
+				},
+			},
+		},
+	}
+	status := &InferenceServiceStatus{}
+
+	// The latest revision is the canary while it serves part of the traffic
+	status.PropagateStatus(PredictorComponent, serviceStatus)
+	predictor := status.Components[PredictorComponent]
+	g.Expect(predictor.Default).To(gomega.Equal(&RevisionStatusSpec{Name: "test-predictor-default-0001", TrafficPercent: 90}))
+	g.Expect(predictor.Canary).To(gomega.Equal(&RevisionStatusSpec{Name: "test-predictor-default-0002", TrafficPercent: 10, URL: tagURL}))
+	g.Expect(predictor.Conditions).To(gomega.HaveLen(3))
+	routesReady := predictor.GetCondition(ComponentRoutesReady)
+	g.Expect(routesReady.Status).To(gomega.Equal(v1.ConditionTrue))
+	g.Expect(routesReady.Message).To(gomega.Equal("Traffic is routed."))
+	transitionTime := routesReady.LastTransitionTime
+
+	// The latest revision is the default revision once it is promoted, the transition time is kept
+	serviceStatus.Traffic[0].Percent = proto.Int64(100)
+	serviceStatus.Traffic[1].Percent = proto.Int64(0)
+	status.PropagateStatus(PredictorComponent, serviceStatus)
+	predictor = status.Components[PredictorComponent]
+	g.Expect(predictor.Default).To(gomega.Equal(&RevisionStatusSpec{Name: "test-predictor-default-0002", TrafficPercent: 100, URL: tagURL}))
+	g.Expect(predictor.Canary).To(gomega.BeNil())
+	g.Expect(predictor.GetCondition(ComponentRoutesReady).LastTransitionTime).To(gomega.Equal(transitionTime))
+
+	// The reason of the component which is not ready is surfaced on the component and the InferenceService
+	serviceStatus.Conditions[0] = apis.Condition{
+		Type:    knservingv1.ServiceConditionReady,
+		Status:  v1.ConditionFalse,
+		Reason:  "RevisionMissing",
+		Message: "Revision \"test-predictor-default-0002\" failed with message: OOMKilled.",
+	}
+	status.PropagateStatus(PredictorComponent, serviceStatus)
+	predictor = status.Components[PredictorComponent]
+	g.Expect(predictor.GetCondition(apis.ConditionReady).Reason).To(gomega.Equal("RevisionMissing"))
+	g.Expect(status.GetCondition(apis.ConditionReady).Reason).To(gomega.Equal("RevisionMissing"))
+}
+
 func TestInferenceServiceStatus_PropagateModelStatus(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -1109,6 +1236,196 @@ func TestInferenceServiceStatus_PropagateModelStatus(t *testing.T) {
 	}
 }
 
+func TestInferenceServiceStatus_PropagateResolvedModel(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	podList := func(terminated *v1.ContainerStateTerminated) *v1.PodList {
+		return &v1.PodList{
+			Items: []v1.Pod{
+				{
+					Status: v1.PodStatus{
+						InitContainerStatuses: []v1.ContainerStatus{
+							{
+								Name:  constants.StorageInitializerContainerName,
+								State: v1.ContainerState{Terminated: terminated},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	status := &InferenceServiceStatus{}
+
+	// The storage initializer is still downloading the model
+	status.PropagateModelStatus(ComponentStatusSpec{}, podList(nil), false)
+	g.Expect(status.ModelStatus.ResolvedModel).To(gomega.BeNil())
+
+	status.PropagateModelStatus(ComponentStatusSpec{}, podList(&v1.ContainerStateTerminated{
+		Reason: constants.StateReasonCompleted,
+		Message: `{"artifactSize": 1024, "checksum": "sha256:5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8", ` +
+			`"framework": "sklearn", "frameworkVersion": "1.0.2", "storageUri": "gs://kfserving-examples/models/sklearn/1.0/model", ` +
+			`"downloadDuration": "12.345s"}`,
+	}), false)
+	g.Expect(status.ModelStatus.ResolvedModel).To(gomega.Equal(&ResolvedModelInfo{
+		StorageURI:       "gs://kfserving-examples/models/sklearn/1.0/model",
+		ArtifactSize:     1024,
+		Checksum:         "sha256:5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8",
+		Framework:        "sklearn",
+		FrameworkVersion: "1.0.2",
+		DownloadDuration: &metav1.Duration{Duration: 12345 * time.Millisecond},
+	}))
+
+	// The metadata is kept when the storage initializer does not report it, e.g. when it failed
+	status.PropagateModelStatus(ComponentStatusSpec{}, podList(&v1.ContainerStateTerminated{
+		Reason:   constants.StateReasonError,
+		Message:  "RuntimeError: Failed to fetch model. No model found in gs://kfserving-examples/models/sklearn/2.0/model.",
+		ExitCode: 1,
+	}), false)
+	g.Expect(status.ModelStatus.ResolvedModel.Checksum).To(gomega.HavePrefix("sha256:5e88"))
+}
+
+func TestInferenceServiceStatus_PropagateRolloutStatus(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	deployment := func(available v1.ConditionStatus, progressing v1.ConditionStatus, reason string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-predictor-default",
+				Namespace:   "default",
+				Annotations: map[string]string{"deployment.kubernetes.io/revision": "2"},
+			},
+			Status: appsv1.DeploymentStatus{
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: available},
+					{Type: appsv1.DeploymentProgressing, Status: progressing, Reason: reason,
+						Message: "ReplicaSet \"test-predictor-default-5d8f\" is progressing."},
+				},
+			},
+		}
+	}
+	status := &InferenceServiceStatus{}
+	status.InitializeConditions()
+	status.PropagateRawStatus(PredictorComponent, deployment(v1.ConditionTrue, v1.ConditionTrue, "ReplicaSetUpdated"), nil)
+	status.SetCondition(IngressReady, &apis.Condition{Status: v1.ConditionTrue})
+	status.PropagateRolloutStatus()
+	g.Expect(status.RolloutStatus.Phase).To(gomega.Equal(RolloutProgressing))
+	g.Expect(status.RolloutStatus.Reason).To(gomega.Equal(RolloutReasonReplicasUpdating))
+
+	// The rollout of the deployment exceeded its progress deadline
+	status.PropagateRawStatus(PredictorComponent, deployment(v1.ConditionTrue, v1.ConditionFalse, "ProgressDeadlineExceeded"), nil)
+	status.PropagateRolloutStatus()
+	g.Expect(status.RolloutStatus.Phase).To(gomega.Equal(RolloutFailed))
+	g.Expect(status.RolloutStatus.Reason).To(gomega.Equal("ProgressDeadlineExceeded"))
+
+	status.PropagateRawStatus(PredictorComponent, deployment(v1.ConditionTrue, v1.ConditionTrue, "NewReplicaSetAvailable"), nil)
+	status.PropagateRolloutStatus()
+	g.Expect(status.RolloutStatus.Phase).To(gomega.Equal(RolloutComplete))
+	transitionTime := status.RolloutStatus.LastTransitionTime
+	status.PropagateRolloutStatus()
+	g.Expect(status.RolloutStatus.LastTransitionTime).To(gomega.Equal(transitionTime))
+
+	// A canary is still rolling out until it is promoted
+	predictor := status.Components[PredictorComponent]
+	predictor.Canary = &RevisionStatusSpec{Name: "test-predictor-default-0002", TrafficPercent: 10}
+	status.Components[PredictorComponent] = predictor
+	status.PropagateRolloutStatus()
+	g.Expect(*status.RolloutStatus).To(gomega.Equal(RolloutStatus{
+		Phase:              RolloutProgressing,
+		Reason:             RolloutReasonCanaryInProgress,
+		Message:            "predictor canary revision test-predictor-default-0002 serves 10% of the traffic",
+		LastTransitionTime: status.RolloutStatus.LastTransitionTime,
+	}))
+
+	// The model failing to load fails the rollout
+	status.UpdateModelRevisionStates(FailedToLoad, 1, &FailureInfo{Reason: ModelLoadFailed, Message: "No model found."})
+	status.PropagateRolloutStatus()
+	g.Expect(status.RolloutStatus.Phase).To(gomega.Equal(RolloutFailed))
+	g.Expect(status.RolloutStatus.Reason).To(gomega.Equal(string(ModelLoadFailed)))
+}
+
+func TestInferenceServiceStatus_PropagatePredictorFailure(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	pod := func(name string, initStatus v1.ContainerStatus, status v1.ContainerStatus) v1.Pod {
+		initStatus.Name = constants.StorageInitializerContainerName
+		status.Name = constants.InferenceServiceContainerName
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1.PodStatus{
+				InitContainerStatuses: []v1.ContainerStatus{initStatus},
+				ContainerStatuses:     []v1.ContainerStatus{status},
+			},
+		}
+	}
+	completed := v1.ContainerStatus{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+		Reason: constants.StateReasonCompleted}}}
+	running := v1.ContainerStatus{State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}
+	scenarios := map[string]struct {
+		pods            []v1.Pod
+		expectedReason  string
+		expectedMessage string
+	}{
+		"StorageInitializerFailed": {
+			pods: []v1.Pod{
+				pod("sklearn-predictor-default-1", completed, running),
+				pod("sklearn-predictor-default-2", v1.ContainerStatus{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+					Reason:   constants.StateReasonError,
+					Message:  "botocore.exceptions.ClientError: An error occurred (403) when calling the HeadObject operation: Forbidden\n",
+					ExitCode: 1,
+				}}}, v1.ContainerStatus{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "PodInitializing"}}}),
+			},
+			expectedReason: constants.StateReasonError,
+			expectedMessage: "container storage-initializer of pod sklearn-predictor-default-2: botocore.exceptions.ClientError: " +
+				"An error occurred (403) when calling the HeadObject operation: Forbidden",
+		},
+		"KServeContainerCrashLooping": {
+			pods: []v1.Pod{
+				pod("sklearn-predictor-default-1", completed, v1.ContainerStatus{
+					State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: constants.StateReasonCrashLoopBackOff}},
+					LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+						Reason:   "OOMKilled",
+						ExitCode: 137,
+					}},
+				}),
+			},
+			expectedReason:  "OOMKilled",
+			expectedMessage: "container kserve-container of pod sklearn-predictor-default-1: OOMKilled with exit code 137",
+		},
+		"ImagePullBackOff": {
+			pods: []v1.Pod{
+				pod("sklearn-predictor-default-1", completed, v1.ContainerStatus{
+					State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{
+						Reason:  constants.StateReasonImagePullBackOff,
+						Message: "Back-off pulling image \"kserve/sklearnserver:missing\"",
+					}},
+				}),
+			},
+			expectedReason:  constants.StateReasonImagePullBackOff,
+			expectedMessage: "container kserve-container of pod sklearn-predictor-default-1: Back-off pulling image \"kserve/sklearnserver:missing\"",
+		},
+		"Running": {
+			pods: []v1.Pod{pod("sklearn-predictor-default-1", completed, running)},
+		},
+	}
+	for name, scenario := range scenarios {
+		status := &InferenceServiceStatus{}
+		status.PropagateModelStatus(ComponentStatusSpec{}, &v1.PodList{Items: scenario.pods}, false)
+		condition := status.GetCondition(PredictorFailed)
+		if scenario.expectedReason == "" {
+			g.Expect(condition).To(gomega.BeNil(), name)
+			continue
+		}
+		g.Expect(condition).NotTo(gomega.BeNil(), name)
+		g.Expect(condition.Status).To(gomega.Equal(v1.ConditionTrue), name)
+		g.Expect(condition.Reason).To(gomega.Equal(scenario.expectedReason), name)
+		g.Expect(condition.Message).To(gomega.Equal(scenario.expectedMessage), name)
+
+		// The rollout fails while the predictor fails and the condition is cleared once the pods recover
+		status.PropagateRolloutStatus()
+		g.Expect(status.RolloutStatus.Phase).To(gomega.Equal(RolloutFailed), name)
+		status.PropagateModelStatus(ComponentStatusSpec{}, &v1.PodList{}, false)
+		g.Expect(status.GetCondition(PredictorFailed)).To(gomega.BeNil(), name)
+	}
+}
+
 func TestInferenceServiceStatus_UpdateModelRevisionStates(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -1226,3 +1543,116 @@ func TestInferenceServiceStatus_UpdateModelRevisionStates(t *testing.T) {
 		})
 	}
 }
+
+func TestInferenceServiceStatus_SetRollbackRevision(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	status := &InferenceServiceStatus{
+		Components: map[ComponentType]ComponentStatusSpec{
+			PredictorComponent: {
+				LatestReadyRevision:       "sklearn-predictor-default-00002",
+				PreviousRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+		},
+	}
+
+	status.SetRollbackRevision(PredictorComponent, "sklearn-predictor-default-00001")
+	g.Expect(status.Components[PredictorComponent].RollbackRevision).To(gomega.Equal("sklearn-predictor-default-00001"))
+	g.Expect(status.Components[PredictorComponent].LatestReadyRevision).To(gomega.Equal("sklearn-predictor-default-00002"))
+
+	status.SetRollbackRevision(PredictorComponent, "")
+	g.Expect(status.Components[PredictorComponent].RollbackRevision).To(gomega.BeEmpty())
+}
+
+func TestInferenceServiceStatus_RecordRolledoutRevision(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	storageURI := "gs://kfserving-examples/models/sklearn/1.0/model"
+	history := func(names ...string) []RevisionHistoryEntry {
+		entries := []RevisionHistoryEntry{}
+		for _, name := range names {
+			entries = append(entries, RevisionHistoryEntry{Name: name})
+		}
+		return entries
+	}
+	limitedHistory := []string{}
+	for i := constants.RevisionHistoryLimit; i > 0; i-- {
+		limitedHistory = append(limitedHistory, fmt.Sprintf("sklearn-predictor-default-%05d", i))
+	}
+
+	scenarios := map[string]struct {
+		statusSpec ComponentStatusSpec
+		expected   []RevisionHistoryEntry
+	}{
+		"RecordRolledoutRevision": {
+			statusSpec: ComponentStatusSpec{
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00002",
+				RevisionHistory:         history("sklearn-predictor-default-00001"),
+			},
+			expected: append([]RevisionHistoryEntry{
+				{Name: "sklearn-predictor-default-00002", ConfigHash: "hash", StorageURI: storageURI},
+			}, history("sklearn-predictor-default-00001")...),
+		},
+		"AlreadyRecorded": {
+			statusSpec: ComponentStatusSpec{
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00002",
+				RevisionHistory:         history("sklearn-predictor-default-00002", "sklearn-predictor-default-00001"),
+			},
+			expected: history("sklearn-predictor-default-00002", "sklearn-predictor-default-00001"),
+		},
+		"SkipWhenNewerRevisionIsCreated": {
+			statusSpec: ComponentStatusSpec{
+				LatestCreatedRevision:   "sklearn-predictor-default-00003",
+				LatestRolledoutRevision: "sklearn-predictor-default-00002",
+				RevisionHistory:         history("sklearn-predictor-default-00001"),
+			},
+			expected: history("sklearn-predictor-default-00001"),
+		},
+		"KeepHistoryLimit": {
+			statusSpec: ComponentStatusSpec{
+				LatestCreatedRevision:   "sklearn-predictor-default-00099",
+				LatestRolledoutRevision: "sklearn-predictor-default-00099",
+				RevisionHistory:         history(limitedHistory...),
+			},
+			expected: append([]RevisionHistoryEntry{
+				{Name: "sklearn-predictor-default-00099", ConfigHash: "hash", StorageURI: storageURI},
+			}, history(limitedHistory[:constants.RevisionHistoryLimit-1]...)...),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			status := &InferenceServiceStatus{
+				Components: map[ComponentType]ComponentStatusSpec{
+					PredictorComponent: scenario.statusSpec,
+				},
+			}
+			status.RecordRolledoutRevision(PredictorComponent, "hash", &storageURI)
+			g.Expect(status.Components[PredictorComponent].RevisionHistory).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestInferenceServiceStatus_PropagateRollbackStatus(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	status := &InferenceServiceStatus{}
+	status.InitializeConditions()
+	status.SetRollbackRevision(PredictorComponent, "")
+	status.PropagateRollbackStatus()
+	g.Expect(status.GetCondition(RolledBack)).To(gomega.BeNil())
+
+	// The rolled back revision diverges from the spec until the spec changes
+	status.SetRollbackRevision(PredictorComponent, "test-predictor-default-00001")
+	status.PropagateRollbackStatus()
+	condition := status.GetCondition(RolledBack)
+	g.Expect(condition).NotTo(gomega.BeNil())
+	g.Expect(condition.IsTrue()).To(gomega.BeTrue())
+	g.Expect(condition.Message).To(gomega.ContainSubstring("predictor traffic is rolled back to revision test-predictor-default-00001"))
+	// the rollback does not affect the readiness
+	g.Expect(status.GetCondition(apis.ConditionReady).IsTrue()).To(gomega.BeFalse())
+	g.Expect(status.GetCondition(apis.ConditionReady).Reason).NotTo(gomega.Equal("RollbackInEffect"))
+
+	status.SetRollbackRevision(PredictorComponent, "")
+	status.PropagateRollbackStatus()
+	g.Expect(status.GetCondition(RolledBack)).To(gomega.BeNil())
+}
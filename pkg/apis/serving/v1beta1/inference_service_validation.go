@@ -17,16 +17,25 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"regexp"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"knative.dev/serving/pkg/apis/autoscaling"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -41,8 +50,16 @@ var (
 	validatorLogger = logf.Log.WithName("inferenceservice-v1beta1-validation-webhook")
 	// regular expressions for validation of isvc name
 	IsvcRegexp = regexp.MustCompile("^" + IsvcNameFmt + "$")
+	// client reading the inferenceservice configmap for the checks configured by the administrators, the checks are
+	// skipped when it is not set
+	validatorClient client.Client
 )
 
+// SetValidatorClient sets the client the validation webhook reads the inferenceservice configmap with
+func SetValidatorClient(c client.Client) {
+	validatorClient = c
+}
+
 // +kubebuilder:webhook:verbs=create;update,path=/validate-inferenceservices,mutating=false,failurePolicy=fail,groups=serving.kserve.io,resources=inferenceservices,versions=v1beta1,name=inferenceservice.kserve-webhook-server.validator
 var _ webhook.Validator = &InferenceService{}
 
@@ -64,11 +81,61 @@ func (isvc *InferenceService) ValidateCreate() error {
 		return err
 	}
 
-	for _, component := range []Component{
+	if err := validateIngressDomain(isvc); err != nil {
+		return err
+	}
+
+	if err := validateUserPortName(isvc); err != nil {
+		return err
+	}
+
+	if err := validateOutlierDetectorMode(isvc); err != nil {
+		return err
+	}
+
+	if err := validateDriftDetector(isvc); err != nil {
+		return err
+	}
+
+	if err := validateOpenAI(isvc); err != nil {
+		return err
+	}
+
+	if err := validateResponseCacheComponent(isvc); err != nil {
+		return err
+	}
+
+	if err := validateTracing(isvc.Spec.Tracing); err != nil {
+		return err
+	}
+	if err := validateAuthentication(isvc.Spec.Authentication); err != nil {
+		return err
+	}
+
+	if err := validateComponentDeploymentModes(isvc); err != nil {
+		return err
+	}
+
+	if err := validateExperiments(isvc); err != nil {
+		return err
+	}
+
+	defaultDeploymentMode, err := getDefaultDeploymentMode()
+	if err != nil {
+		return err
+	}
+
+	components := []Component{
 		&isvc.Spec.Predictor,
 		isvc.Spec.Transformer,
 		isvc.Spec.Explainer,
-	} {
+		isvc.Spec.OutlierDetector,
+		isvc.Spec.DriftDetector,
+	}
+	for i := range isvc.Spec.Experiments {
+		components = append(components, &isvc.Spec.Experiments[i].Predictor)
+	}
+	for _, component := range components {
 		if !reflect.ValueOf(component).IsNil() {
 			if err := validateExactlyOneImplementation(component); err != nil {
 				return err
@@ -76,19 +143,539 @@ func (isvc *InferenceService) ValidateCreate() error {
 			if err := utils.FirstNonNilError([]error{
 				component.GetImplementation().Validate(),
 				component.GetExtensions().Validate(),
-				validateAutoScalingCompExtension(annotations, component.GetExtensions()),
+				validateAutoScalingCompExtension(annotations, defaultDeploymentMode, component.GetExtensions()),
 			}); err != nil {
 				return err
 			}
+			if predictor, ok := component.(*PredictorSpec); ok {
+				if err := predictor.validateGPURuntimeVersion(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if err := validateGPUQuantities(isvc); err != nil {
+		return err
+	}
+
+	if validatorClient != nil {
+		config, err := NewInferenceServicesConfig(validatorClient)
+		if err != nil {
+			return err
+		}
+		if err := validateRuntimeVersion(isvc, config); err != nil {
+			return err
+		}
+		if err := validateRuntimeProtocol(validatorClient, isvc); err != nil {
+			return err
+		}
+		policies, err := v1alpha1.GetServingPolicies(validatorClient, isvc.Namespace)
+		if err != nil {
+			return err
+		}
+		if err := ValidateServingPolicies(isvc, config, policies); err != nil {
+			return err
+		}
+		if err := validateServingQuota(validatorClient, isvc, config, policies); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// validateGPUQuantities rejects fractional GPU requests and limits, the device plugins only allocate whole units of
+// their resource, e.g. a fractional GPU device plugin exposes the fractions as units of its own resource name. Every
+// container of the component is checked, sidecars and init containers get their GPUs from the same device plugin.
+func validateGPUQuantities(isvc *InferenceService) error {
+	components := []struct {
+		name      string
+		component Component
+	}{
+		{string(constants.Predictor), &isvc.Spec.Predictor},
+		{string(constants.Transformer), isvc.Spec.Transformer},
+		{string(constants.Explainer), isvc.Spec.Explainer},
+		{string(constants.OutlierDetector), isvc.Spec.OutlierDetector},
+		{string(constants.DriftDetector), isvc.Spec.DriftDetector},
+	}
+	for i := range isvc.Spec.Experiments {
+		components = append(components, struct {
+			name      string
+			component Component
+		}{"experiment " + isvc.Spec.Experiments[i].Name, &isvc.Spec.Experiments[i].Predictor})
+	}
+	for _, c := range components {
+		component := c.component
+		if reflect.ValueOf(component).IsNil() {
+			continue
+		}
+		gpuResourceName := component.GetExtensions().GetGPUResourceName()
+		for _, container := range getComponentContainers(component) {
+			for _, resources := range []v1.ResourceList{container.Resources.Requests, container.Resources.Limits} {
+				if quantity, ok := resources[gpuResourceName]; ok && quantity.MilliValue()%1000 != 0 {
+					return fmt.Errorf(InvalidGPUQuantityError, quantity.String(), gpuResourceName, c.name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// embeddedContainerSpec is implemented by the component implementations overriding the container of the component
+type embeddedContainerSpec interface {
+	getEmbeddedContainer() *v1.Container
+}
+
+// getComponentContainers returns the containers and init containers of the component as written in the spec,
+// including the container embedded in its implementation
+func getComponentContainers(component Component) []v1.Container {
+	var podSpec *PodSpec
+	switch c := component.(type) {
+	case *PredictorSpec:
+		podSpec = &c.PodSpec
+	case *TransformerSpec:
+		podSpec = &c.PodSpec
+	case *ExplainerSpec:
+		podSpec = &c.PodSpec
+	case *OutlierDetectorSpec:
+		podSpec = &c.PodSpec
+	case *DriftDetectorSpec:
+		podSpec = &c.PodSpec
+	}
+	containers := []v1.Container{}
+	if implementations := component.GetImplementations(); len(implementations) != 0 {
+		if implementation, ok := implementations[0].(embeddedContainerSpec); ok {
+			containers = append(containers, *implementation.getEmbeddedContainer())
+		}
+	}
+	if podSpec != nil {
+		containers = append(containers, podSpec.Containers...)
+		containers = append(containers, podSpec.InitContainers...)
+	}
+	return containers
+}
+
+// validateServingQuota rejects an InferenceService which would take the InferenceServices of its namespace over the
+// serving quota when all of their components run at their max replicas, the updated InferenceService replaces its
+// previous version in the sum
+func validateServingQuota(cli client.Client, isvc *InferenceService, config *InferenceServicesConfig, policies []v1alpha1.ClusterServingPolicy) error {
+	quotaPolicies := []v1alpha1.ClusterServingPolicy{}
+	for _, policy := range policies {
+		if policy.Spec.Quota != nil {
+			quotaPolicies = append(quotaPolicies, policy)
+		}
+	}
+	if len(quotaPolicies) == 0 {
+		return nil
+	}
+
+	gpus, cpu, unbounded := servingQuotaUsage(isvc, config)
+	if unbounded != "" {
+		return fmt.Errorf(v1alpha1.ServingQuotaUnboundedError, unbounded, quotaPolicies[0].Name)
+	}
+	isvcs := &InferenceServiceList{}
+	if err := cli.List(context.TODO(), isvcs, client.InNamespace(isvc.Namespace)); err != nil {
+		return err
+	}
+	for i := range isvcs.Items {
+		if isvcs.Items[i].Name == isvc.Name {
+			continue
+		}
+		// The InferenceServices created before the quota are counted at their min replicas when they are unbounded
+		otherGPUs, otherCPU, _ := servingQuotaUsage(&isvcs.Items[i], config)
+		gpus += otherGPUs
+		cpu.Add(otherCPU)
+	}
+	for i := range quotaPolicies {
+		if err := quotaPolicies[i].ValidateQuota(isvc.Namespace, gpus, cpu); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// servingQuotaUsage returns the GPUs and the CPU requested by the InferenceService when all of its components run at
+// their max replicas, along with the first serverless component which sets no max replicas
+func servingQuotaUsage(isvc *InferenceService, config *InferenceServicesConfig) (int64, resource.Quantity, string) {
+	var gpus int64
+	cpu := resource.Quantity{}
+	unbounded := ""
+	deploymentMode := isvc.Annotations[constants.DeploymentMode]
+	if deploymentMode == string(constants.ModelMeshDeployment) {
+		return gpus, cpu, unbounded
+	}
+	// GetContainer may fill in the container of the spec
+	isvc = isvc.DeepCopy()
+	components := []struct {
+		name      constants.InferenceServiceComponent
+		component Component
+	}{
+		{constants.Predictor, &isvc.Spec.Predictor},
+		{constants.Transformer, isvc.Spec.Transformer},
+		{constants.Explainer, isvc.Spec.Explainer},
+		{constants.OutlierDetector, isvc.Spec.OutlierDetector},
+		{constants.DriftDetector, isvc.Spec.DriftDetector},
+	}
+	for _, c := range components {
+		component := c.component
+		if reflect.ValueOf(component).IsNil() {
+			continue
+		}
+		extensions := component.GetExtensions()
+		// The replicas are bounded by the min replicas when the max replicas are not set, as the HPA does
+		replicas := constants.DefaultMinReplicas
+		if extensions.MinReplicas != nil && *extensions.MinReplicas > replicas {
+			replicas = *extensions.MinReplicas
+		}
+		if extensions.MaxReplicas > replicas {
+			replicas = extensions.MaxReplicas
+		} else if extensions.MaxReplicas == 0 && deploymentMode != string(constants.RawDeployment) && unbounded == "" {
+			unbounded = string(c.name)
+		}
+		container := component.GetImplementation().GetContainer(isvc.ObjectMeta, extensions, config)
+		if quantity, ok := container.Resources.Limits[extensions.GetGPUResourceName()]; ok {
+			gpus += quantity.Value() * int64(replicas)
+		}
+		quantity, ok := container.Resources.Requests[v1.ResourceCPU]
+		if !ok {
+			quantity, ok = container.Resources.Limits[v1.ResourceCPU]
+		}
+		if ok {
+			cpu.Add(*resource.NewMilliQuantity(quantity.MilliValue()*int64(replicas), resource.DecimalSI))
+		}
+	}
+	return gpus, cpu, unbounded
+}
+
+// ValidateServingPolicies checks the InferenceService against the ClusterServingPolicies applying to its namespace, the
+// images are the ones set on the InferenceService, the images of the serving runtimes are checked by the pod mutator
+func ValidateServingPolicies(isvc *InferenceService, config *InferenceServicesConfig, policies []v1alpha1.ClusterServingPolicy) error {
+	if len(policies) == 0 {
+		return nil
+	}
+	// GetContainer may fill in the container of the spec
+	isvc = isvc.DeepCopy()
+	var gpus int64
+	storageUris := []string{}
+	images := []string{}
+	for _, component := range []Component{
+		&isvc.Spec.Predictor,
+		isvc.Spec.Transformer,
+		isvc.Spec.Explainer,
+		isvc.Spec.OutlierDetector,
+		isvc.Spec.DriftDetector,
+	} {
+		if reflect.ValueOf(component).IsNil() {
+			continue
+		}
+		implementation := component.GetImplementation()
+		if storageUri := implementation.GetStorageUri(); storageUri != nil {
+			storageUris = append(storageUris, *storageUri)
+		}
+		container := implementation.GetContainer(isvc.ObjectMeta, component.GetExtensions(), config)
+		if container.Image != "" {
+			images = append(images, container.Image)
+		}
+		if quantity, ok := container.Resources.Limits[component.GetExtensions().GetGPUResourceName()]; ok {
+			gpus += quantity.Value()
+		}
+	}
+
+	for i := range policies {
+		policy := &policies[i]
+		errs := []error{policy.ValidateLabels(isvc.Labels), policy.ValidateGPUs(gpus)}
+		for _, storageUri := range storageUris {
+			errs = append(errs, policy.ValidateStorageUri(storageUri))
+		}
+		for _, image := range images {
+			errs = append(errs, policy.ValidateImage(image))
+		}
+		if err := utils.FirstNonNilError(errs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRuntimeProtocol rejects a predictor pinning a serving runtime which does not support its protocol version, a
+// runtime which does not exist yet is reported by the controller
+func validateRuntimeProtocol(cli client.Client, isvc *InferenceService) error {
+	model := isvc.Spec.Predictor.Model
+	if model == nil || model.Runtime == nil || model.ProtocolVersion == nil {
+		return nil
+	}
+	var runtimeSpec *v1alpha1.ServingRuntimeSpec
+	servingRuntime := &v1alpha1.ServingRuntime{}
+	clusterServingRuntime := &v1alpha1.ClusterServingRuntime{}
+	if err := cli.Get(context.TODO(), client.ObjectKey{Name: *model.Runtime, Namespace: isvc.Namespace}, servingRuntime); err == nil {
+		runtimeSpec = &servingRuntime.Spec
+	} else if !apierr.IsNotFound(err) {
+		return err
+	} else if err := cli.Get(context.TODO(), client.ObjectKey{Name: *model.Runtime}, clusterServingRuntime); err == nil {
+		runtimeSpec = &clusterServingRuntime.Spec
+	} else if !apierr.IsNotFound(err) {
+		return err
+	}
+	if runtimeSpec == nil || runtimeSpec.IsProtocolVersionSupported(*model.ProtocolVersion) {
+		return nil
+	}
+	supported := []string{}
+	for _, protocol := range runtimeSpec.ProtocolVersions {
+		supported = append(supported, string(protocol))
+	}
+	return fmt.Errorf(UnsupportedRuntimeProtocolError, *model.Runtime, *model.ProtocolVersion, strings.Join(supported, ", "))
+}
+
+// validateRuntimeVersion rejects a predictor pinning a runtime version which is not allowed or end of life for its
+// model format, the framework predictors are converted to model predictors by the mutating webhook beforehand
+func validateRuntimeVersion(isvc *InferenceService, config *InferenceServicesConfig) error {
+	model := isvc.Spec.Predictor.Model
+	if model == nil || model.RuntimeVersion == nil {
+		return nil
+	}
+	versions, ok := config.RuntimeVersions[model.ModelFormat.Name]
+	if !ok {
+		return nil
+	}
+	runtimeVersion := *model.RuntimeVersion
+	if utils.Includes(versions.EndOfLife, runtimeVersion) ||
+		len(versions.Allowed) > 0 && !utils.Includes(versions.Allowed, runtimeVersion) {
+		return fmt.Errorf("runtime version %s of the %s model format is not supported, supported versions are: %s",
+			runtimeVersion, model.ModelFormat.Name, strings.Join(versions.Allowed, ", "))
+	}
+	return nil
+}
+
+// validateOutlierDetectorMode rejects an async outlier detector when the predictor has its own logger, as the payload
+// logger of the predictor is used to send the requests to the detector
+func validateOutlierDetectorMode(isvc *InferenceService) error {
+	if isvc.Spec.OutlierDetector != nil && isvc.Spec.OutlierDetector.GetMode() == OutlierDetectionAsync &&
+		isvc.Spec.Predictor.Logger != nil {
+		return fmt.Errorf("the predictor logger can not be set with an outlier detector in %s mode, use %s mode instead",
+			OutlierDetectionAsync, OutlierDetectionInline)
+	}
+	return nil
+}
+
+// validateDriftDetector rejects a drift detector when the payload logger of the predictor is already used by the
+// predictor logger or an async outlier detector
+func validateDriftDetector(isvc *InferenceService) error {
+	if isvc.Spec.DriftDetector == nil {
+		return nil
+	}
+	if isvc.Spec.Predictor.Logger != nil {
+		return fmt.Errorf("the predictor logger can not be set with a drift detector")
+	}
+	if isvc.Spec.OutlierDetector != nil && isvc.Spec.OutlierDetector.GetMode() == OutlierDetectionAsync {
+		return fmt.Errorf("a drift detector can only be combined with an outlier detector in %s mode", OutlierDetectionInline)
+	}
+	return nil
+}
+
+// validateOpenAI rejects the OpenAI API on the components other than the predictor, and on the predictors which do
+// not serve the v2 protocol the requests are translated to. The protocol of a model without a protocol version is
+// the protocol of its serving runtime.
+func validateOpenAI(isvc *InferenceService) error {
+	for _, component := range []Component{
+		isvc.Spec.Transformer,
+		isvc.Spec.Explainer,
+		isvc.Spec.OutlierDetector,
+		isvc.Spec.DriftDetector,
+	} {
+		if !reflect.ValueOf(component).IsNil() && component.GetExtensions().OpenAI != nil {
+			return fmt.Errorf(OpenAIComponentError)
+		}
+	}
+	predictor := &isvc.Spec.Predictor
+	if predictor.OpenAI == nil {
+		return nil
+	}
+	if predictor.Model != nil && predictor.Model.ProtocolVersion == nil {
+		return nil
+	}
+	if implementation := predictor.GetPredictorImplementation(); implementation != nil &&
+		(*implementation).GetProtocol() != constants.ProtocolV2 {
+		return fmt.Errorf(OpenAIProtocolError, (*implementation).GetProtocol())
+	}
+	return nil
+}
+
+// validateResponseCacheComponent rejects the response cache on the components other than the predictor
+func validateResponseCacheComponent(isvc *InferenceService) error {
+	for _, component := range []Component{
+		isvc.Spec.Transformer,
+		isvc.Spec.Explainer,
+		isvc.Spec.OutlierDetector,
+		isvc.Spec.DriftDetector,
+	} {
+		if !reflect.ValueOf(component).IsNil() && component.GetExtensions().ResponseCache != nil {
+			return fmt.Errorf(ResponseCacheComponentError)
+		}
+	}
+	return nil
+}
+
+// validateComponentDeploymentModes rejects the component deployment modes in ModelMesh mode. The ingress routes the
+// InferenceService traffic with the InferenceService deployment mode, so the component receiving the traffic and the
+// explainer cannot be deployed in another mode.
+func validateComponentDeploymentModes(isvc *InferenceService) error {
+	components := componentExtensions(isvc)
+	isvcMode := isvc.Annotations[constants.DeploymentMode]
+	if isvcMode == string(constants.ModelMeshDeployment) {
+		for _, spec := range components {
+			if spec.DeploymentMode != "" {
+				return fmt.Errorf(ModelMeshComponentDeploymentModeError)
+			}
+		}
+		return nil
+	}
+	ingressComponent := PredictorComponent
+	if isvc.Spec.Transformer != nil {
+		ingressComponent = TransformerComponent
+	}
+	for _, component := range []ComponentType{ingressComponent, ExplainerComponent} {
+		if spec, ok := components[component]; ok && spec.DeploymentMode != "" && string(spec.DeploymentMode) != isvcMode {
+			return fmt.Errorf(IngressComponentDeploymentModeError, component)
+		}
+	}
+	return nil
+}
+
+// validateExperiments checks the variants of the experiments, they are deployed as InferenceServices named after the
+// variants and the traffic is split by the Serverless ingress in front of the predictor
+func validateExperiments(isvc *InferenceService) error {
+	if len(isvc.Spec.Experiments) == 0 {
+		return nil
+	}
+	mode := isvc.Annotations[constants.DeploymentMode]
+	if mode == string(constants.RawDeployment) || mode == string(constants.ModelMeshDeployment) ||
+		isvc.Spec.Predictor.DeploymentMode == DeploymentModeRaw || isvc.Spec.Transformer != nil {
+		return fmt.Errorf(ExperimentUnsupportedError)
+	}
+	if isvc.Spec.Predictor.CanaryRouting != nil || isvc.Spec.Predictor.TrafficMode == TrafficModeMirror {
+		return fmt.Errorf(ExperimentCanaryError)
+	}
+	names := map[string]bool{}
+	total := int64(0)
+	for i, experiment := range isvc.Spec.Experiments {
+		if len(validation.IsDNS1123Label(experiment.Name)) > 0 || experiment.Name == "default" || names[experiment.Name] ||
+			!IsvcRegexp.MatchString(isvc.Name+"-"+experiment.Name) {
+			return fmt.Errorf(InvalidExperimentNameError, i, experiment.Name)
+		}
+		if experiment.Predictor.DeploymentMode == DeploymentModeRaw {
+			return fmt.Errorf(ExperimentUnsupportedError)
+		}
+		names[experiment.Name] = true
+		total += experiment.TrafficPercent
+	}
+	if total > 100 {
+		return fmt.Errorf(ExperimentTrafficPercentError)
+	}
+	return nil
+}
+
+// componentExtensions returns the extension specs of the components of the InferenceService
+func componentExtensions(isvc *InferenceService) map[ComponentType]*ComponentExtensionSpec {
+	components := map[ComponentType]*ComponentExtensionSpec{
+		PredictorComponent: isvc.Spec.Predictor.GetExtensions(),
+	}
+	if isvc.Spec.Transformer != nil {
+		components[TransformerComponent] = isvc.Spec.Transformer.GetExtensions()
+	}
+	if isvc.Spec.Explainer != nil {
+		components[ExplainerComponent] = isvc.Spec.Explainer.GetExtensions()
+	}
+	if isvc.Spec.OutlierDetector != nil {
+		components[OutlierDetectorComponent] = isvc.Spec.OutlierDetector.GetExtensions()
+	}
+	if isvc.Spec.DriftDetector != nil {
+		components[DriftDetectorComponent] = isvc.Spec.DriftDetector.GetExtensions()
+	}
+	return components
+}
+
+// validateTracing checks the collector endpoint, the spans are exported over OTLP/HTTP
+func validateTracing(tracing *TracingSpec) error {
+	if tracing == nil {
+		return nil
+	}
+	if !strings.HasPrefix(tracing.Endpoint, "http://") && !strings.HasPrefix(tracing.Endpoint, "https://") {
+		return fmt.Errorf(InvalidTracingError)
+	}
+	if tracing.SamplingPercent != nil && (*tracing.SamplingPercent < 0 || *tracing.SamplingPercent > 100) {
+		return fmt.Errorf(InvalidTracingError)
+	}
+	return nil
+}
+
+// validateAuthentication checks the audiences and the issuer, the signing keys of the provider are only served over https
+func validateAuthentication(authentication *AuthenticationSpec) error {
+	if authentication == nil {
+		return nil
+	}
+	for _, audience := range authentication.Audiences {
+		if audience == "" {
+			return fmt.Errorf(InvalidAuthenticationError)
+		}
+	}
+	if authentication.OIDC != nil && !strings.HasPrefix(authentication.OIDC.IssuerURL, "https://") {
+		return fmt.Errorf(InvalidAuthenticationError)
+	}
+	return nil
+}
+
 // Validate scaling options component extensions
-func validateAutoScalingCompExtension(annotations map[string]string, compExtSpec *ComponentExtensionSpec) error {
-	deploymentMode := annotations["serving.kserve.io/deploymentMode"]
+// getDefaultDeploymentMode returns the deployment mode of the InferenceServices without the deployment mode
+// annotation, the defaulter only writes the annotation for the RawDeployment and ModelMesh defaults
+func getDefaultDeploymentMode() (string, error) {
+	if validatorClient == nil {
+		return string(constants.Serverless), nil
+	}
+	deployConfig, err := NewDeployConfig(validatorClient)
+	if err != nil {
+		return "", err
+	}
+	if deployConfig.DefaultDeploymentMode == "" {
+		return string(constants.Serverless), nil
+	}
+	return deployConfig.DefaultDeploymentMode, nil
+}
+
+func validateAutoScalingCompExtension(isvcAnnotations map[string]string, defaultDeploymentMode string,
+	compExtSpec *ComponentExtensionSpec) error {
+	annotations := utils.Union(isvcAnnotations)
+	compExtSpec.SetAutoscalerClassAnnotations(annotations)
+	compExtSpec.SetDeploymentModeAnnotations(annotations)
+	deploymentMode := annotations[constants.DeploymentMode]
+	if deploymentMode == "" {
+		deploymentMode = defaultDeploymentMode
+	}
+	if compExtSpec.AutoscalerClass == AutoscalerClassKPA && deploymentMode == string(constants.RawDeployment) ||
+		compExtSpec.AutoscalerClass == AutoscalerClassKEDA && deploymentMode == string(constants.Serverless) {
+		return fmt.Errorf("the %s autoscaler class is not supported in %s mode", compExtSpec.AutoscalerClass, deploymentMode)
+	}
+	if compExtSpec.IdleReplicas > 0 && deploymentMode == string(constants.Serverless) {
+		return fmt.Errorf("idleReplicas is not supported in %s mode", deploymentMode)
+	}
+	if compExtSpec.DrainSeconds != nil && deploymentMode == string(constants.Serverless) {
+		return fmt.Errorf("drainSeconds is not supported in %s mode, set the timeout of the component instead",
+			deploymentMode)
+	}
+	if compExtSpec.HealthCheckPort != nil && deploymentMode == string(constants.Serverless) {
+		return fmt.Errorf("healthCheckPort is not supported in %s mode, knative only probes the port serving the requests",
+			deploymentMode)
+	}
+	if compExtSpec.RequestQueue != nil && deploymentMode == string(constants.Serverless) {
+		return fmt.Errorf("requestQueue is not supported in %s mode, set the containerConcurrency of the component instead",
+			deploymentMode)
+	}
 	annotationClass := annotations[autoscaling.ClassAnnotationKey]
+	if annotations[constants.AutoscalerClass] == string(constants.AutoscalerClassKEDA) {
+		return validateScalingKEDACompExtension(compExtSpec)
+	}
+	if compExtSpec.AutoScaling != nil {
+		return fmt.Errorf("autoScaling.metrics requires the %s autoscaler class", constants.AutoscalerClassKEDA)
+	}
 	if deploymentMode == string(constants.RawDeployment) || annotationClass == string(autoscaling.HPA) {
 		return validateScalingHPACompExtension(compExtSpec)
 	}
@@ -100,8 +687,34 @@ func validateAutoScalingCompExtension(annotations map[string]string, compExtSpec
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (isvc *InferenceService) ValidateUpdate(old runtime.Object) error {
 	validatorLogger.Info("validate update", "name", isvc.Name)
+	oldIsvc := old.(*InferenceService)
+
+	return utils.FirstNonNilError([]error{
+		isvc.ValidateCreate(),
+		validateImmutableFields(isvc, oldIsvc),
+	})
+}
 
-	return isvc.ValidateCreate()
+// validateImmutableFields rejects the updates the controller cannot roll out in place, instead of leaving the
+// reconcile loop failing on them
+func validateImmutableFields(isvc *InferenceService, oldIsvc *InferenceService) error {
+	allErrs := field.ErrorList{}
+	oldMode, newMode := oldIsvc.Annotations[constants.DeploymentMode], isvc.Annotations[constants.DeploymentMode]
+	if oldMode != newMode {
+		allErrs = append(allErrs, field.Forbidden(
+			field.NewPath("metadata", "annotations").Key(constants.DeploymentMode),
+			fmt.Sprintf(ImmutableDeploymentModeError, oldMode, newMode)))
+	}
+	oldComponents, newComponents := componentExtensions(oldIsvc), componentExtensions(isvc)
+	for _, component := range []ComponentType{PredictorComponent, TransformerComponent, ExplainerComponent,
+		OutlierDetectorComponent, DriftDetectorComponent} {
+		oldSpec, newSpec := oldComponents[component], newComponents[component]
+		if oldSpec != nil && newSpec != nil && oldSpec.DeploymentMode != newSpec.DeploymentMode {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", string(component), "deploymentMode"),
+				fmt.Sprintf(ImmutableDeploymentModeError, oldSpec.DeploymentMode, newSpec.DeploymentMode)))
+		}
+	}
+	return allErrs.ToAggregate()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -139,6 +752,8 @@ func validateInferenceServiceAutoscaler(isvc *InferenceService) error {
 					} else {
 						return nil
 					}
+				case constants.AutoscalerClassKEDA:
+					return nil
 				default:
 					return fmt.Errorf("unknown autoscaler class [%s]", class)
 				}
@@ -178,7 +793,37 @@ func validateAutoscalerTargetUtilizationPercentage(isvc *InferenceService) error
 	return nil
 }
 
+// Validation of the ingress domain which overrides the configured one for the InferenceService
+func validateIngressDomain(isvc *InferenceService) error {
+	domain, ok := isvc.ObjectMeta.Annotations[constants.IngressDomainAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if errs := validation.IsDNS1123Subdomain(domain); len(errs) != 0 {
+		return fmt.Errorf("[%s] is not a valid ingress domain: %s", domain, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// validateUserPortName only accepts the user port names knative routes with, http1 for HTTP/1 and h2c for HTTP/2
+// traffic such as gRPC
+func validateUserPortName(isvc *InferenceService) error {
+	portName, ok := isvc.ObjectMeta.Annotations[constants.UserPortNameAnnotationKey]
+	if !ok {
+		return nil
+	}
+	if portName != constants.KnativeHTTP1PortName && portName != constants.KnativeH2CPortName {
+		return fmt.Errorf("[%s] is not a valid user port name, the %s annotation must be %s or %s", portName,
+			constants.UserPortNameAnnotationKey, constants.KnativeHTTP1PortName, constants.KnativeH2CPortName)
+	}
+	return nil
+}
+
 func validateScalingHPACompExtension(compExtSpec *ComponentExtensionSpec) error {
+	if compExtSpec.ScaleToZero != nil && *compExtSpec.ScaleToZero {
+		return fmt.Errorf("Scale to zero is not supported by the HPA autoscaler.")
+	}
+
 	metric := MetricCPU
 	if compExtSpec.ScaleMetric != nil {
 		metric = *compExtSpec.ScaleMetric
@@ -192,16 +837,30 @@ func validateScalingHPACompExtension(compExtSpec *ComponentExtensionSpec) error
 
 	if compExtSpec.ScaleTarget != nil {
 		target := *compExtSpec.ScaleTarget
-		if metric == MetricCPU && target < 1 || target > 100 {
-			return fmt.Errorf("The target utilization percentage should be a [1-100] integer.")
+		// Only the utilization metrics are percentages, the queue depth and the memory are absolute values
+		switch metric {
+		case MetricCPU, MetricGPU, MetricGPUMemory:
+			if target < 1 || target > 100 {
+				return fmt.Errorf("The target utilization percentage should be a [1-100] integer.")
+			}
+		case MetricQueueDepth:
+			if target < 1 {
+				return fmt.Errorf("The target queue depth should be a positive integer.")
+			}
+		case MetricMemory:
+			if target < 1 {
+				return fmt.Errorf("The target memory should be greater than 1 MiB")
+			}
 		}
+	}
 
-		if metric == MetricMemory && target < 1 {
-			return fmt.Errorf("The target memory should be greater than 1 MiB")
-		}
+	return nil
+}
 
+func validateScalingKEDACompExtension(compExtSpec *ComponentExtensionSpec) error {
+	if compExtSpec.AutoScaling == nil || len(compExtSpec.AutoScaling.Metrics) == 0 {
+		return fmt.Errorf("autoScaling.metrics must be set for the %s autoscaler class", constants.AutoscalerClassKEDA)
 	}
-
 	return nil
 }
 
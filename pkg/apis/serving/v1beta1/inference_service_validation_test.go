@@ -21,10 +21,16 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
 
 	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func makeTestRawInferenceService() InferenceService {
@@ -116,6 +122,427 @@ func TestInvalidAutoscalerHPAMetrics(t *testing.T) {
 	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
 }
 
+func TestScaleToZeroWithHPA(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	isvc.Spec.Predictor.ScaleToZero = proto.Bool(false)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.Spec.Predictor.ScaleToZero = proto.Bool(true)
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestGPUScaleMetricWithHPA(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	metric := MetricGPU
+	isvc.Spec.Predictor.ScaleMetric = &metric
+	isvc.Spec.Predictor.ScaleTarget = GetIntReference(70)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.Spec.Predictor.ScaleTarget = GetIntReference(0)
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc = makeTestRawInferenceService()
+	isvc.ObjectMeta.Annotations["serving.kserve.io/metrics"] = "gpu-memory"
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+}
+
+func TestIngressDomainAnnotation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	isvc.ObjectMeta.Annotations[constants.IngressDomainAnnotationKey] = "models.corp.internal"
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.ObjectMeta.Annotations[constants.IngressDomainAnnotationKey] = "https://models.corp.internal"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestUserPortNameAnnotation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.ObjectMeta.Annotations = map[string]string{constants.UserPortNameAnnotationKey: constants.KnativeH2CPortName}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.ObjectMeta.Annotations[constants.UserPortNameAnnotationKey] = constants.KnativeHTTP1PortName
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.ObjectMeta.Annotations[constants.UserPortNameAnnotationKey] = "grpc"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+	isvc.ObjectMeta.Annotations[constants.UserPortNameAnnotationKey] = ""
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestComponentAutoscalerClass(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	isvc.Spec.Transformer = &TransformerSpec{
+		PodSpec: PodSpec{Containers: []v1.Container{{Image: "transformer:latest"}}},
+		ComponentExtensionSpec: ComponentExtensionSpec{
+			AutoscalerClass: AutoscalerClassKEDA,
+			AutoScaling: &AutoScalingSpec{Metrics: []ExternalMetricSpec{
+				{Kafka: &KafkaMetricSource{BootstrapServers: "kafka:9092", ConsumerGroup: "group", Topic: "topic"}},
+			}},
+		},
+	}
+	// the predictor keeps scaling with the hpa autoscaler class of the InferenceService
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Transformer.AutoscalerClass = AutoscalerClassHPA
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc.Spec.Transformer.AutoScaling = nil
+	isvc.Spec.Predictor.AutoscalerClass = AutoscalerClassKPA
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestIdleReplicas(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	isvc.Spec.Predictor.IdleReplicas = 2
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	// the warm pool is only kept for raw deployments
+	isvc.ObjectMeta.Annotations["serving.kserve.io/deploymentMode"] = "Serverless"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	// the defaulter does not write the annotation for the default Serverless mode
+	isvc = makeTestInferenceService()
+	isvc.Spec.Predictor.IdleReplicas = 2
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestDrainSeconds(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	isvc.Spec.Predictor.DrainSeconds = proto.Int64(300)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	// knative drains the requests of a serverless revision within the component timeout
+	isvc.ObjectMeta.Annotations["serving.kserve.io/deploymentMode"] = "Serverless"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	// the defaulter does not write the annotation for the default Serverless mode
+	isvc = makeTestInferenceService()
+	isvc.Spec.Predictor.DrainSeconds = proto.Int64(300)
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestHealthCheckPort(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	isvc.Spec.Predictor.HealthCheckPort = proto.Int32(8081)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	// knative rejects a second container port and probes on a port other than the one serving the requests
+	isvc.ObjectMeta.Annotations["serving.kserve.io/deploymentMode"] = "Serverless"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	// the defaulter does not write the annotation for the default Serverless mode
+	isvc = makeTestInferenceService()
+	isvc.Spec.Predictor.HealthCheckPort = proto.Int32(8081)
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	// the deployment mode of the component overrides the mode of the InferenceService
+	isvc.Spec.Transformer = &TransformerSpec{PodSpec: PodSpec{Containers: []v1.Container{{Image: "transformer:latest"}}}}
+	isvc.Spec.Predictor.DeploymentMode = DeploymentModeRaw
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+}
+
+func TestDefaultDeploymentMode(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(getDefaultDeploymentMode()).To(gomega.Equal(string(constants.Serverless)))
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.InferenceServiceConfigMapName,
+			Namespace: constants.KServeNamespace,
+		},
+	}
+	SetValidatorClient(fakeclient.NewClientBuilder().WithObjects(configMap).Build())
+	defer SetValidatorClient(nil)
+	g.Expect(getDefaultDeploymentMode()).To(gomega.Equal(string(constants.Serverless)))
+
+	configMap.Data = map[string]string{DeployConfigName: `{"defaultDeploymentMode": "RawDeployment"}`}
+	SetValidatorClient(fakeclient.NewClientBuilder().WithObjects(configMap).Build())
+	g.Expect(getDefaultDeploymentMode()).To(gomega.Equal(string(constants.RawDeployment)))
+}
+
+func TestRequestQueue(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	metric := MetricQueueDepth
+	isvc.Spec.Predictor.ScaleMetric = &metric
+	isvc.Spec.Predictor.ScaleTarget = GetIntReference(5)
+	isvc.Spec.Predictor.RequestQueue = &RequestQueue{MaxInFlight: 4, MaxQueueDepth: GetIntReference(16)}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	// the queue depth is not a percentage
+	isvc.Spec.Predictor.ScaleTarget = GetIntReference(200)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Predictor.ScaleTarget = GetIntReference(0)
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	// knative limits the concurrency of a serverless revision with the queue proxy
+	isvc.Spec.Predictor.ScaleMetric = nil
+	isvc.Spec.Predictor.ScaleTarget = nil
+	isvc.ObjectMeta.Annotations["serving.kserve.io/deploymentMode"] = "Serverless"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	// the defaulter does not write the annotation for the default Serverless mode
+	isvc = makeTestInferenceService()
+	isvc.Spec.Predictor.RequestQueue = &RequestQueue{MaxInFlight: 4, MaxQueueDepth: GetIntReference(16)}
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestKEDAAutoScaling(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestRawInferenceService()
+	isvc.Spec.Predictor.AutoScaling = &AutoScalingSpec{
+		Metrics: []ExternalMetricSpec{
+			{
+				Prometheus: &PrometheusMetricSource{
+					ServerAddress: "http://prometheus.monitoring:9090",
+					Query:         "avg(DCGM_FI_DEV_GPU_UTIL)",
+					Threshold:     "80",
+				},
+			},
+		},
+	}
+	// autoScaling metrics are only used by the keda autoscaler class
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc.ObjectMeta.Annotations["serving.kserve.io/autoscalerClass"] = "keda"
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Predictor.AutoScaling.Metrics[0].Prometheus.Threshold = "high"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc.Spec.Predictor.AutoScaling = nil
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestValidGPUResourceName(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.GPU = &GPUSpec{ResourceName: "example.com/gpu-fraction"}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Predictor.GPU.ResourceName = "nvidia.com/mig-1g.5gb"
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+}
+
+func TestInvalidGPUResourceName(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.GPU = &GPUSpec{ResourceName: "gpu-fraction"}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(fmt.Sprintf(InvalidGPUResourceNameError, "gpu-fraction")))
+
+	isvc.Spec.Predictor.GPU.ResourceName = "kubernetes.io/gpu"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc.Spec.Predictor.GPU.ResourceName = "requests.example.com/gpu"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc.Spec.Predictor.GPU.ResourceName = "example.com/gpu fraction"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestGPUQuantities(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	gpuFraction := v1.ResourceName("example.com/gpu-fraction")
+	scenarios := map[string]struct {
+		gpu       *GPUSpec
+		sidecar   bool
+		init      bool
+		resources v1.ResourceRequirements
+		matcher   types.GomegaMatcher
+	}{
+		"WholeGPU": {
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("2")},
+			},
+			matcher: gomega.Succeed(),
+		},
+		"FractionalGPULimit": {
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("500m")},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidGPUQuantityError, "500m", constants.NvidiaGPUResourceType, "predictor")),
+		},
+		"FractionalGPURequest": {
+			resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("0.5")},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidGPUQuantityError, "500m", constants.NvidiaGPUResourceType, "predictor")),
+		},
+		"WholeUnitsOfCustomResource": {
+			gpu: &GPUSpec{ResourceName: gpuFraction},
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{gpuFraction: resource.MustParse("50")},
+			},
+			matcher: gomega.Succeed(),
+		},
+		"FractionalUnitsOfCustomResource": {
+			gpu: &GPUSpec{ResourceName: gpuFraction},
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{gpuFraction: resource.MustParse("1.5")},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidGPUQuantityError, "1500m", gpuFraction, "predictor")),
+		},
+		"FractionalCPU": {
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+			},
+			matcher: gomega.Succeed(),
+		},
+		"FractionalGPUSidecar": {
+			sidecar: true,
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("500m")},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidGPUQuantityError, "500m", constants.NvidiaGPUResourceType, "predictor")),
+		},
+		"FractionalGPUInitContainer": {
+			init: true,
+			resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("500m")},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidGPUQuantityError, "500m", constants.NvidiaGPUResourceType, "predictor")),
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			isvc := makeTestInferenceService()
+			isvc.Spec.Predictor.GPU = scenario.gpu
+			container := v1.Container{Name: "sidecar", Image: "sidecar:latest", Resources: scenario.resources}
+			switch {
+			case scenario.sidecar:
+				isvc.Spec.Predictor.Containers = append(isvc.Spec.Predictor.Containers, container)
+			case scenario.init:
+				isvc.Spec.Predictor.InitContainers = append(isvc.Spec.Predictor.InitContainers, container)
+			default:
+				isvc.Spec.Predictor.Tensorflow.Resources = scenario.resources
+			}
+			g.Expect(validateGPUQuantities(&isvc)).To(scenario.matcher)
+		})
+	}
+}
+
+func TestOutlierDetectorModeWithPredictorLogger(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.Logger = &LoggerSpec{Mode: LogAll}
+	isvc.Spec.OutlierDetector = &OutlierDetectorSpec{
+		PodSpec: PodSpec{
+			Containers: []v1.Container{
+				{Image: "seldonio/alibi-detect-server:1.13.0"},
+			},
+		},
+	}
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc.Spec.OutlierDetector.Mode = OutlierDetectionInline
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+}
+
+func TestDriftDetectorWithPayloadLogger(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.DriftDetector = &DriftDetectorSpec{
+		ReferenceURI: "gs://testbucket/reference",
+		PodSpec: PodSpec{
+			Containers: []v1.Container{
+				{Image: "seldonio/alibi-detect-server:1.13.0"},
+			},
+		},
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.OutlierDetector = &OutlierDetectorSpec{
+		PodSpec: PodSpec{
+			Containers: []v1.Container{
+				{Image: "seldonio/alibi-detect-server:1.13.0"},
+			},
+		},
+	}
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+
+	isvc.Spec.OutlierDetector.Mode = OutlierDetectionInline
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Predictor.Logger = &LoggerSpec{Mode: LogAll}
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestOpenAI(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.OpenAI = &OpenAISpec{}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(fmt.Sprintf(OpenAIProtocolError, constants.ProtocolV1)))
+
+	isvc.Spec.Predictor.Tensorflow = nil
+	isvc.Spec.Predictor.Triton = &TritonSpec{
+		PredictorExtensionSpec: PredictorExtensionSpec{StorageURI: proto.String("gs://testbucket/testmodel")},
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	// The protocol of the serving runtime is validated against the cluster
+	isvc.Spec.Predictor.Triton = nil
+	isvc.Spec.Predictor.Model = &ModelSpec{
+		ModelFormat:            ModelFormat{Name: "huggingface"},
+		PredictorExtensionSpec: PredictorExtensionSpec{StorageURI: proto.String("gs://testbucket/testmodel")},
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Transformer = &TransformerSpec{
+		PodSpec:                PodSpec{Containers: []v1.Container{{Image: "transformer:latest"}}},
+		ComponentExtensionSpec: ComponentExtensionSpec{OpenAI: &OpenAISpec{}},
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(OpenAIComponentError))
+}
+
+func TestResponseCacheComponent(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.ResponseCache = &ResponseCacheSpec{}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Transformer = &TransformerSpec{
+		PodSpec:                PodSpec{Containers: []v1.Container{{Image: "transformer:latest"}}},
+		ComponentExtensionSpec: ComponentExtensionSpec{ResponseCache: &ResponseCacheSpec{}},
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(ResponseCacheComponentError))
+}
+
+func TestTracing(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Tracing = &TracingSpec{Endpoint: "http://otel-collector.observability:4318"}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Tracing.SamplingPercent = GetIntReference(101)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(InvalidTracingError))
+
+	isvc.Spec.Tracing = &TracingSpec{Endpoint: "otel-collector.observability:4317"}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(InvalidTracingError))
+}
+
+func TestAuthentication(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Authentication = &AuthenticationSpec{}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Authentication = &AuthenticationSpec{
+		Audiences: []string{"sklearn"},
+		OIDC:      &OIDCSpec{IssuerURL: "https://accounts.example.com"},
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+
+	isvc.Spec.Authentication.Audiences = []string{""}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(InvalidAuthenticationError))
+
+	isvc.Spec.Authentication = &AuthenticationSpec{OIDC: &OIDCSpec{IssuerURL: "http://accounts.example.com"}}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(InvalidAuthenticationError))
+}
+
 func TestValidStorageURIPrefixOK(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	for _, prefix := range SupportedStorageURIPrefixList {
@@ -158,6 +585,24 @@ func TestAzureBlobOK(t *testing.T) {
 	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
 }
 
+func TestAzureSchemeOK(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("azure://kfserving/triton/simple_string/")
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("azure://kfserving/triton")
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+}
+
+func TestAzureSchemeNoContainerFails(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("azure://kfserving/")
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("azure://kfserving")
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
 func TestAzureBlobNoAccountFails(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	isvc := makeTestInferenceService()
@@ -172,6 +617,59 @@ func TestAzureBlobNoContainerFails(t *testing.T) {
 	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
 }
 
+func TestOciStorageURIOK(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("oci://registry.example.com/models/flowers:v1")
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+}
+
+func TestStorageSha256(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("https://raw.githubusercontent.com/someOrg/someRepo/model.tar.gz")
+	isvc.Spec.Predictor.Tensorflow.StorageSha256 = proto.String("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.StorageSha256 = proto.String("9f86d081")
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("gs://kfserving/model")
+	isvc.Spec.Predictor.Tensorflow.StorageSha256 = proto.String("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("https://kfserving.blob.core.windows.net/triton/model")
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
+func TestModelLoadTimeoutSeconds(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.Tensorflow.ModelLoadTimeoutSeconds = proto.Int64(1800)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.ModelLoadTimeoutSeconds = proto.Int64(0)
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(InvalidModelLoadTimeoutError))
+}
+
+func TestModelVerification(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("https://raw.githubusercontent.com/someOrg/someRepo/model.tar.gz")
+	isvc.Spec.Predictor.Tensorflow.Verification = &ModelVerification{
+		Type:      CosignVerificationType,
+		PublicKey: "-----BEGIN PUBLIC KEY-----",
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.Verification.SignatureUri = proto.String("s3://models/model.tar.gz.sig")
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.Verification.SignatureUri = nil
+	isvc.Spec.Predictor.Tensorflow.Verification.Type = "x509"
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.Verification.Type = GPGVerificationType
+	isvc.Spec.Predictor.Tensorflow.Verification.PublicKey = ""
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+	isvc.Spec.Predictor.Tensorflow.Verification.PublicKey = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	isvc.Spec.Predictor.Tensorflow.StorageURI = proto.String("gs://kfserving/model")
+	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
+}
+
 func TestHttpStorageURIPrefixOK(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	isvc := makeTestInferenceService()
@@ -188,6 +686,35 @@ func TestUnknownStorageURIPrefixFails(t *testing.T) {
 	g.Expect(isvc.ValidateCreate()).ShouldNot(gomega.Succeed())
 }
 
+func TestStorageContainerURIPrefix(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(gomega.Succeed())
+	storageContainer := &v1alpha1.ClusterStorageContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "blob"},
+		Spec: v1alpha1.StorageContainerSpec{
+			Container:           v1.Container{Image: "kserve/blob-initializer:latest"},
+			SupportedUriFormats: []v1alpha1.SupportedUriFormat{{Prefix: "blob://"}},
+		},
+	}
+	disabledStorageContainer := &v1alpha1.ClusterStorageContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ftp"},
+		Spec: v1alpha1.StorageContainerSpec{
+			Container:           v1.Container{Image: "kserve/ftp-initializer:latest"},
+			SupportedUriFormats: []v1alpha1.SupportedUriFormat{{Prefix: "ftp://"}},
+		},
+		Disabled: proto.Bool(true),
+	}
+	g.Expect(validateStorageURI(proto.String("blob://foo/bar"))).ShouldNot(gomega.Succeed())
+
+	SetValidatorClient(fakeclient.NewClientBuilder().WithScheme(scheme).
+		WithObjects(storageContainer, disabledStorageContainer).Build())
+	defer SetValidatorClient(nil)
+	g.Expect(validateStorageURI(proto.String("blob://foo/bar"))).Should(gomega.Succeed())
+	g.Expect(validateStorageURI(proto.String("ftp://foo/bar"))).ShouldNot(gomega.Succeed())
+	g.Expect(validateStorageURI(proto.String("invaliduri://foo/bar"))).ShouldNot(gomega.Succeed())
+}
+
 func TestRejectMultipleModelSpecs(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	isvc := makeTestInferenceService()
@@ -310,6 +837,75 @@ func TestGoodExplainer(t *testing.T) {
 	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
 }
 
+func TestComponentDeploymentMode(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	transformer := func() *TransformerSpec {
+		return &TransformerSpec{
+			PodSpec: PodSpec{Containers: []v1.Container{{Image: "some-image"}}},
+		}
+	}
+	scenarios := map[string]struct {
+		update  func(isvc *InferenceService)
+		matcher gomega.OmegaMatcher
+	}{
+		"RawPredictorBehindServerlessTransformer": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Predictor.DeploymentMode = DeploymentModeRaw
+				isvc.Spec.Transformer = transformer()
+			},
+			matcher: gomega.Succeed(),
+		},
+		"RawPredictorReceivingTraffic": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Predictor.DeploymentMode = DeploymentModeRaw
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(IngressComponentDeploymentModeError, PredictorComponent)),
+		},
+		"RawTransformerOfRawInferenceService": {
+			update: func(isvc *InferenceService) {
+				isvc.Annotations = map[string]string{constants.DeploymentMode: string(constants.RawDeployment)}
+				isvc.Spec.Transformer = transformer()
+				isvc.Spec.Transformer.DeploymentMode = DeploymentModeRaw
+			},
+			matcher: gomega.Succeed(),
+		},
+		"RawTransformerOfServerlessInferenceService": {
+			update: func(isvc *InferenceService) {
+				isvc.Annotations = map[string]string{constants.DeploymentMode: string(constants.Serverless)}
+				isvc.Spec.Transformer = transformer()
+				isvc.Spec.Transformer.DeploymentMode = DeploymentModeRaw
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(IngressComponentDeploymentModeError, TransformerComponent)),
+		},
+		"RawExplainer": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Transformer = transformer()
+				isvc.Spec.Explainer = &ExplainerSpec{
+					Alibi: &AlibiExplainerSpec{
+						ExplainerExtensionSpec: ExplainerExtensionSpec{StorageURI: "gs://testbucket/testmodel"},
+					},
+				}
+				isvc.Spec.Explainer.DeploymentMode = DeploymentModeRaw
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(IngressComponentDeploymentModeError, ExplainerComponent)),
+		},
+		"ModelMesh": {
+			update: func(isvc *InferenceService) {
+				isvc.Annotations = map[string]string{constants.DeploymentMode: string(constants.ModelMeshDeployment)}
+				isvc.Spec.Transformer = transformer()
+				isvc.Spec.Predictor.DeploymentMode = DeploymentModeRaw
+			},
+			matcher: gomega.MatchError(ModelMeshComponentDeploymentModeError),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		isvc := makeTestInferenceService()
+		scenario.update(&isvc)
+		g.Expect(isvc.ValidateCreate()).To(scenario.matcher, name)
+	}
+}
+
 func TestGoodName(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	isvc := makeTestInferenceService()
@@ -357,3 +953,404 @@ func TestPMMLWorkersArguments(t *testing.T) {
 	isvc.Spec.Predictor.PMML.Container.Args = []string{"--workers=1"}
 	g.Expect(isvc.ValidateCreate()).Should(gomega.Succeed())
 }
+
+func TestRuntimeVersion(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &InferenceServicesConfig{
+		RuntimeVersions: map[string]RuntimeVersionsConfig{
+			"sklearn": {Allowed: []string{"v0.9.0", "v0.10.0"}, EndOfLife: []string{"v0.7.0"}},
+			"xgboost": {EndOfLife: []string{"v0.3.0"}},
+		},
+	}
+	isvc := &InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+		},
+		Spec: InferenceServiceSpec{
+			Predictor: PredictorSpec{
+				Model: &ModelSpec{
+					ModelFormat: ModelFormat{Name: "sklearn"},
+				},
+			},
+		},
+	}
+
+	scenarios := map[string]struct {
+		format         string
+		runtimeVersion *string
+		matcher        gomega.OmegaMatcher
+	}{
+		"NoRuntimeVersion": {
+			format:  "sklearn",
+			matcher: gomega.Succeed(),
+		},
+		"AllowedRuntimeVersion": {
+			format:         "sklearn",
+			runtimeVersion: proto.String("v0.10.0"),
+			matcher:        gomega.Succeed(),
+		},
+		"UnlistedRuntimeVersion": {
+			format:         "sklearn",
+			runtimeVersion: proto.String("v0.8.0"),
+			matcher: gomega.MatchError("runtime version v0.8.0 of the sklearn model format is not supported, " +
+				"supported versions are: v0.9.0, v0.10.0"),
+		},
+		"EndOfLifeRuntimeVersion": {
+			format:         "xgboost",
+			runtimeVersion: proto.String("v0.3.0"),
+			matcher:        gomega.HaveOccurred(),
+		},
+		"NotEndOfLifeRuntimeVersion": {
+			format:         "xgboost",
+			runtimeVersion: proto.String("v0.4.0"),
+			matcher:        gomega.Succeed(),
+		},
+		"UnconfiguredModelFormat": {
+			format:         "pytorch",
+			runtimeVersion: proto.String("v0.1.0"),
+			matcher:        gomega.Succeed(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		isvc.Spec.Predictor.Model.ModelFormat.Name = scenario.format
+		isvc.Spec.Predictor.Model.RuntimeVersion = scenario.runtimeVersion
+		g.Expect(validateRuntimeVersion(isvc, config)).To(scenario.matcher, name)
+	}
+}
+
+func TestImmutableFields(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	makeModelInferenceService := func(format string) *InferenceService {
+		return &InferenceService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "default",
+			},
+			Spec: InferenceServiceSpec{
+				Predictor: PredictorSpec{
+					Model: &ModelSpec{
+						ModelFormat: ModelFormat{Name: format},
+						PredictorExtensionSpec: PredictorExtensionSpec{
+							StorageURI: proto.String("gs://testbucket/testmodel"),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	scenarios := map[string]struct {
+		update  func(isvc *InferenceService)
+		matcher gomega.OmegaMatcher
+	}{
+		"UpdateStorageURI": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Predictor.Model.StorageURI = proto.String("gs://testbucket/testmodel2")
+			},
+			matcher: gomega.Succeed(),
+		},
+		"UpdateDeploymentMode": {
+			update: func(isvc *InferenceService) {
+				isvc.Annotations = map[string]string{constants.DeploymentMode: string(constants.RawDeployment)}
+			},
+			matcher: gomega.MatchError("metadata.annotations[serving.kserve.io/deploymentMode]: Forbidden: " +
+				fmt.Sprintf(ImmutableDeploymentModeError, "", constants.RawDeployment)),
+		},
+		"UpdateComponentDeploymentMode": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Transformer = &TransformerSpec{
+					PodSpec: PodSpec{Containers: []v1.Container{{Image: "some-image"}}},
+				}
+				isvc.Spec.Predictor.DeploymentMode = DeploymentModeRaw
+			},
+			matcher: gomega.MatchError("spec.predictor.deploymentMode: Forbidden: " +
+				fmt.Sprintf(ImmutableDeploymentModeError, "", DeploymentModeRaw)),
+		},
+		"UpdateFramework": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Predictor.Model.ModelFormat.Name = "xgboost"
+			},
+			matcher: gomega.Succeed(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		old := makeModelInferenceService("sklearn")
+		isvc := makeModelInferenceService("sklearn")
+		scenario.update(isvc)
+		g.Expect(isvc.ValidateUpdate(old)).To(scenario.matcher, name)
+	}
+}
+
+func TestExperiments(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	variant := func(name string, traffic int64) ExperimentSpec {
+		return ExperimentSpec{
+			Name:           name,
+			TrafficPercent: traffic,
+			Predictor: PredictorSpec{
+				Model: &ModelSpec{
+					ModelFormat: ModelFormat{Name: "sklearn"},
+					PredictorExtensionSpec: PredictorExtensionSpec{
+						StorageURI: proto.String("gs://testbucket/testmodel2"),
+					},
+				},
+			},
+		}
+	}
+
+	scenarios := map[string]struct {
+		update  func(isvc *InferenceService)
+		matcher gomega.OmegaMatcher
+	}{
+		"Variants": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Experiments = []ExperimentSpec{variant("b", 30), variant("c", 70)}
+			},
+			matcher: gomega.Succeed(),
+		},
+		"TrafficAboveTotal": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Experiments = []ExperimentSpec{variant("b", 60), variant("c", 50)}
+			},
+			matcher: gomega.MatchError(ExperimentTrafficPercentError),
+		},
+		"DuplicateName": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Experiments = []ExperimentSpec{variant("b", 10), variant("b", 10)}
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidExperimentNameError, 1, "b")),
+		},
+		"DefaultName": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Experiments = []ExperimentSpec{variant("default", 10)}
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidExperimentNameError, 0, "default")),
+		},
+		"InvalidName": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Experiments = []ExperimentSpec{variant("B.1", 10)}
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(InvalidExperimentNameError, 0, "B.1")),
+		},
+		"RawDeployment": {
+			update: func(isvc *InferenceService) {
+				isvc.Annotations = map[string]string{constants.DeploymentMode: string(constants.RawDeployment)}
+				isvc.Spec.Experiments = []ExperimentSpec{variant("b", 10)}
+			},
+			matcher: gomega.MatchError(ExperimentUnsupportedError),
+		},
+		"Transformer": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Transformer = &TransformerSpec{
+					PodSpec: PodSpec{Containers: []v1.Container{{Image: "some-image"}}},
+				}
+				isvc.Spec.Experiments = []ExperimentSpec{variant("b", 10)}
+			},
+			matcher: gomega.MatchError(ExperimentUnsupportedError),
+		},
+		"MirrorTrafficMode": {
+			update: func(isvc *InferenceService) {
+				isvc.Spec.Predictor.TrafficMode = TrafficModeMirror
+				isvc.Spec.Experiments = []ExperimentSpec{variant("b", 10)}
+			},
+			matcher: gomega.MatchError(ExperimentCanaryError),
+		},
+		"InvalidVariantPredictor": {
+			update: func(isvc *InferenceService) {
+				experiment := variant("b", 10)
+				experiment.Predictor.Tensorflow = &TFServingSpec{}
+				isvc.Spec.Experiments = []ExperimentSpec{experiment}
+			},
+			matcher: gomega.MatchError(ExactlyOneErrorFor(&PredictorSpec{})),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		isvc := makeTestInferenceService()
+		scenario.update(&isvc)
+		g.Expect(isvc.ValidateCreate()).To(scenario.matcher, name)
+	}
+}
+
+func TestServingPolicies(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	gpus := func(count string) v1.ResourceRequirements {
+		return v1.ResourceRequirements{Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse(count)}}
+	}
+	isvc := &InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "default",
+			Labels:    map[string]string{"team": "ml"},
+		},
+		Spec: InferenceServiceSpec{
+			Predictor: PredictorSpec{
+				Model: &ModelSpec{
+					ModelFormat: ModelFormat{Name: "sklearn"},
+					PredictorExtensionSpec: PredictorExtensionSpec{
+						StorageURI: proto.String("s3://testbucket/testmodel"),
+						Container:  v1.Container{Resources: gpus("1")},
+					},
+				},
+			},
+			Transformer: &TransformerSpec{
+				PodSpec: PodSpec{Containers: []v1.Container{{Image: "gcr.io/ml/transformer:latest", Resources: gpus("1")}}},
+			},
+		},
+	}
+	policy := v1alpha1.ClusterServingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: v1alpha1.ServingPolicySpec{
+			MaxGPUsPerService:      proto.Int64(2),
+			AllowedStorageSchemes:  []string{"s3"},
+			RequiredLabels:         []string{"team"},
+			AllowedImageRegistries: []string{"gcr.io/ml"},
+		},
+	}
+	policies := []v1alpha1.ClusterServingPolicy{policy}
+	config := &InferenceServicesConfig{}
+
+	g.Expect(ValidateServingPolicies(isvc, config, nil)).To(gomega.Succeed())
+	g.Expect(ValidateServingPolicies(isvc, config, policies)).To(gomega.Succeed())
+
+	// The GPUs of the components are added up
+	policies[0].Spec.MaxGPUsPerService = proto.Int64(1)
+	g.Expect(ValidateServingPolicies(isvc, config, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingPolicyMaxGPUsExceededError, 2, "production", 1)))
+	policies[0].Spec.MaxGPUsPerService = proto.Int64(2)
+
+	isvc.Spec.Predictor.Model.StorageURI = proto.String("gs://testbucket/testmodel")
+	g.Expect(ValidateServingPolicies(isvc, config, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingPolicyStorageSchemeError, "gs://testbucket/testmodel", "production", "s3")))
+	isvc.Spec.Predictor.Model.StorageURI = proto.String("s3://testbucket/testmodel")
+
+	isvc.Spec.Transformer.Containers[0].Image = "transformer:latest"
+	g.Expect(ValidateServingPolicies(isvc, config, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingPolicyImageRegistryError, "transformer:latest", "production", "gcr.io/ml")))
+	isvc.Spec.Transformer.Containers[0].Image = "gcr.io/ml/transformer:latest"
+
+	isvc.Labels = nil
+	g.Expect(ValidateServingPolicies(isvc, config, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingPolicyMissingLabelError, "team", "production")))
+}
+
+func TestServingQuota(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(AddToScheme(scheme)).To(gomega.Succeed())
+	newIsvc := func(name string, maxReplicas int) *InferenceService {
+		return &InferenceService{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: InferenceServiceSpec{
+				Predictor: PredictorSpec{
+					ComponentExtensionSpec: ComponentExtensionSpec{MaxReplicas: maxReplicas},
+					Model: &ModelSpec{
+						ModelFormat: ModelFormat{Name: "sklearn"},
+						PredictorExtensionSpec: PredictorExtensionSpec{
+							Container: v1.Container{Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+								Limits:   v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("1")},
+							}},
+						},
+					},
+				},
+			},
+		}
+	}
+	existing := newIsvc("existing", 2)
+	cli := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	policies := []v1alpha1.ClusterServingPolicy{{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: v1alpha1.ServingPolicySpec{Quota: &v1alpha1.ServingQuota{
+			GPUs: proto.Int64(4),
+			CPU:  resource.NewQuantity(3, resource.DecimalSI),
+		}},
+	}}
+	config := &InferenceServicesConfig{}
+
+	g.Expect(validateServingQuota(cli, newIsvc("foo", 3), config, nil)).To(gomega.Succeed())
+	g.Expect(validateServingQuota(cli, newIsvc("foo", 2), config, policies)).To(gomega.Succeed())
+	g.Expect(validateServingQuota(cli, newIsvc("foo", 3), config, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingQuotaExceededError, "default", "5", "GPUs", "production", "4")))
+
+	// The updated InferenceService is not counted twice
+	g.Expect(validateServingQuota(cli, newIsvc("existing", 4), config, policies)).To(gomega.Succeed())
+
+	policies[0].Spec.Quota.GPUs = nil
+	policies[0].Spec.Quota.CPU = resource.NewMilliQuantity(2500, resource.DecimalSI)
+	g.Expect(validateServingQuota(cli, newIsvc("foo", 4), config, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingQuotaExceededError, "default", "3", "CPU", "production", "2500m")))
+
+	// The serverless components have to bound their replicas
+	g.Expect(validateServingQuota(cli, newIsvc("foo", 0), config, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingQuotaUnboundedError, "predictor", "production")))
+	raw := newIsvc("foo", 0)
+	raw.Annotations = map[string]string{constants.DeploymentMode: string(constants.RawDeployment)}
+	g.Expect(validateServingQuota(cli, raw, config, policies)).To(gomega.Succeed())
+}
+
+func TestRuntimeProtocol(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(gomega.Succeed())
+	servingRuntime := &v1alpha1.ServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlserver", Namespace: "default"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			ProtocolVersions: []constants.InferenceServiceProtocol{constants.ProtocolV2, constants.ProtocolGRPCV2},
+		},
+	}
+	clusterServingRuntime := &v1alpha1.ClusterServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "kserve-sklearnserver"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			ProtocolVersions: []constants.InferenceServiceProtocol{constants.ProtocolV1, constants.ProtocolV2},
+		},
+	}
+	cli := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(servingRuntime, clusterServingRuntime).Build()
+	newIsvc := func(runtime string, protocol constants.InferenceServiceProtocol) *InferenceService {
+		return &InferenceService{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+			Spec: InferenceServiceSpec{
+				Predictor: PredictorSpec{
+					Model: &ModelSpec{
+						ModelFormat: ModelFormat{Name: "sklearn"},
+						Runtime:     proto.String(runtime),
+						PredictorExtensionSpec: PredictorExtensionSpec{
+							ProtocolVersion: &protocol,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	g.Expect(validateRuntimeProtocol(cli, newIsvc("mlserver", constants.ProtocolV2))).To(gomega.Succeed())
+	g.Expect(validateRuntimeProtocol(cli, newIsvc("mlserver", constants.ProtocolV1))).To(gomega.MatchError(
+		fmt.Sprintf(UnsupportedRuntimeProtocolError, "mlserver", "v1", "v2, grpc-v2")))
+	g.Expect(validateRuntimeProtocol(cli, newIsvc("kserve-sklearnserver", constants.ProtocolV1))).To(gomega.Succeed())
+	g.Expect(validateRuntimeProtocol(cli, newIsvc("kserve-sklearnserver", constants.ProtocolGRPCV2))).To(gomega.MatchError(
+		fmt.Sprintf(UnsupportedRuntimeProtocolError, "kserve-sklearnserver", "grpc-v2", "v1, v2")))
+	// The controller reports the runtimes which do not exist
+	g.Expect(validateRuntimeProtocol(cli, newIsvc("triton", constants.ProtocolV2))).To(gomega.Succeed())
+}
+
+func TestGPUQuantitiesWithoutClient(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := makeTestInferenceService()
+	isvc.Spec.Transformer = &TransformerSpec{
+		PodSpec: PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  constants.InferenceServiceContainerName,
+					Image: "transformer:latest",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("500m")},
+					},
+				},
+			},
+		},
+	}
+	g.Expect(isvc.ValidateCreate()).Should(gomega.MatchError(fmt.Sprintf(InvalidGPUQuantityError, "500m", constants.NvidiaGPUResourceType, "transformer")))
+}
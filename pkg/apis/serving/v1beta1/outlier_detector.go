@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// OutlierDetectionMode controls how the predictor payloads reach the outlier detector
+// +kubebuilder:validation:Enum=Async;Inline
+type OutlierDetectionMode string
+
+// OutlierDetectionMode Enum
+const (
+	// OutlierDetectionAsync streams the predictor requests to the outlier detector through the payload logger,
+	// the detector publishes the outlier scores itself, e.g. as CloudEvents
+	OutlierDetectionAsync OutlierDetectionMode = "Async"
+	// OutlierDetectionInline scores each predictor request before the response is returned and attaches the
+	// detector response to the response headers
+	OutlierDetectionInline OutlierDetectionMode = "Inline"
+)
+
+// OutlierDetectorSpec defines the outlier detector service which scores the payloads sent to the predictor
+type OutlierDetectorSpec struct {
+	// Specifies how the predictor payloads are scored. <br />
+	// Valid values are: <br />
+	// - "Async" (default): requests are sent to the detector through the payload logger; <br />
+	// - "Inline": requests are scored before the predictor responds and the detector response is returned
+	// in the Outlier-Score response header <br />
+	// +optional
+	Mode OutlierDetectionMode `json:"mode,omitempty"`
+	// Provide a full PodSpec for the custom outlier detector, the detector receives the request payloads
+	// as CloudEvents on its root path.
+	PodSpec `json:",inline"`
+	// Component extension defines the deployment configurations for an outlier detector
+	ComponentExtensionSpec `json:",inline"`
+}
+
+// GetImplementations returns the implementations for the component
+func (s *OutlierDetectorSpec) GetImplementations() []ComponentImplementation {
+	implementations := []ComponentImplementation{}
+	if len(s.PodSpec.Containers) != 0 {
+		implementations = append(implementations, NewCustomOutlierDetector(&s.PodSpec))
+	}
+	return implementations
+}
+
+// GetImplementation returns the implementation for the component
+func (s *OutlierDetectorSpec) GetImplementation() ComponentImplementation {
+	return s.GetImplementations()[0]
+}
+
+// GetExtensions returns the extensions for the component
+func (s *OutlierDetectorSpec) GetExtensions() *ComponentExtensionSpec {
+	return &s.ComponentExtensionSpec
+}
+
+// GetMode returns the outlier detection mode, payloads are scored asynchronously by default
+func (s *OutlierDetectorSpec) GetMode() OutlierDetectionMode {
+	if s.Mode == "" {
+		return OutlierDetectionAsync
+	}
+	return s.Mode
+}
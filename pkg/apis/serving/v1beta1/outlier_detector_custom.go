@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"strconv"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CustomOutlierDetector defines arguments for configuring a custom outlier detector.
+type CustomOutlierDetector struct {
+	v1.PodSpec `json:",inline"`
+}
+
+var _ ComponentImplementation = &CustomOutlierDetector{}
+
+func NewCustomOutlierDetector(podSpec *PodSpec) *CustomOutlierDetector {
+	return &CustomOutlierDetector{PodSpec: v1.PodSpec(*podSpec)}
+}
+
+// Validate returns an error if invalid
+func (c *CustomOutlierDetector) Validate() error {
+	return utils.FirstNonNilError([]error{
+		validateStorageURI(c.GetStorageUri()),
+	})
+}
+
+// Default sets defaults on the resource
+func (c *CustomOutlierDetector) Default(config *InferenceServicesConfig) {
+	if len(c.Containers) == 0 {
+		c.Containers = append(c.Containers, v1.Container{})
+	}
+	c.Containers[0].Name = constants.InferenceServiceContainerName
+	setResourceRequirementDefaults(config, &c.Containers[0].Resources)
+}
+
+func (c *CustomOutlierDetector) GetStorageUri() *string {
+	// return the CustomSpecStorageUri env variable value if set on the spec
+	for _, envVar := range c.Containers[0].Env {
+		if envVar.Name == constants.CustomSpecStorageUriEnvVarKey {
+			return &envVar.Value
+		}
+	}
+	return nil
+}
+
+func (c *CustomOutlierDetector) GetStorageSpec() *StorageSpec {
+	return nil
+}
+
+// GetContainer transforms the resource into a container spec
+func (c *CustomOutlierDetector) GetContainer(metadata metav1.ObjectMeta, extensions *ComponentExtensionSpec, config *InferenceServicesConfig) *v1.Container {
+	container := &c.Containers[0]
+	if !utils.IncludesArg(container.Args, constants.ArgumentModelName) {
+		container.Args = append(container.Args, constants.ArgumentModelName, metadata.Name)
+	}
+	if !utils.IncludesArg(container.Args, constants.ArgumentHttpPort) {
+		container.Args = append(container.Args, constants.ArgumentHttpPort, constants.InferenceServiceDefaultHttpPort)
+	}
+	if !utils.IncludesArg(container.Args, constants.ArgumentWorkers) {
+		if extensions.ContainerConcurrency != nil {
+			container.Args = append(container.Args, constants.ArgumentWorkers, strconv.FormatInt(*extensions.ContainerConcurrency, 10))
+		}
+	}
+	return container
+}
+
+func (c *CustomOutlierDetector) GetProtocol() constants.InferenceServiceProtocol {
+	return constants.ProtocolV1
+}
+
+func (c *CustomOutlierDetector) IsMMS(config *InferenceServicesConfig) bool {
+	return false
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOutlierDetectorValidation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	scenarios := map[string]struct {
+		spec    OutlierDetectorSpec
+		matcher types.GomegaMatcher
+	}{
+		"ValidStorageUri": {
+			spec: OutlierDetectorSpec{
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{
+							Env: []v1.EnvVar{
+								{
+									Name:  "STORAGE_URI",
+									Value: "s3://modelzoo",
+								},
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"InvalidStorageUri": {
+			spec: OutlierDetectorSpec{
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{
+							Env: []v1.EnvVar{
+								{
+									Name:  "STORAGE_URI",
+									Value: "invaliduri://modelzoo",
+								},
+							},
+						},
+					},
+				},
+			},
+			matcher: gomega.Not(gomega.BeNil()),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			res := scenario.spec.GetImplementation().Validate()
+			if !g.Expect(res).To(scenario.matcher) {
+				t.Errorf("got %q, want %q", res, scenario.matcher)
+			}
+		})
+	}
+}
+
+func TestOutlierDetectorMode(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	spec := OutlierDetectorSpec{}
+	g.Expect(spec.GetMode()).To(gomega.Equal(OutlierDetectionAsync))
+	spec.Mode = OutlierDetectionInline
+	g.Expect(spec.GetMode()).To(gomega.Equal(OutlierDetectionInline))
+}
+
+func TestCreateOutlierDetectorContainer(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &InferenceServicesConfig{}
+	scenarios := map[string]struct {
+		spec                  OutlierDetectorSpec
+		expectedContainerSpec *v1.Container
+	}{
+		"ContainerSpecWithDefaultArgs": {
+			spec: OutlierDetectorSpec{
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{
+							Image: "seldonio/alibi-detect-server:1.13.0",
+						},
+					},
+				},
+				ComponentExtensionSpec: ComponentExtensionSpec{
+					ContainerConcurrency: proto.Int64(2),
+				},
+			},
+			expectedContainerSpec: &v1.Container{
+				Image: "seldonio/alibi-detect-server:1.13.0",
+				Args: []string{
+					constants.ArgumentModelName,
+					"sklearn",
+					constants.ArgumentHttpPort,
+					constants.InferenceServiceDefaultHttpPort,
+					constants.ArgumentWorkers,
+					"2",
+				},
+			},
+		},
+		"ContainerSpecWithModelNameArg": {
+			spec: OutlierDetectorSpec{
+				PodSpec: PodSpec{
+					Containers: []v1.Container{
+						{
+							Image: "seldonio/alibi-detect-server:1.13.0",
+							Args: []string{
+								constants.ArgumentModelName,
+								"detector",
+							},
+						},
+					},
+				},
+			},
+			expectedContainerSpec: &v1.Container{
+				Image: "seldonio/alibi-detect-server:1.13.0",
+				Args: []string{
+					constants.ArgumentModelName,
+					"detector",
+					constants.ArgumentHttpPort,
+					constants.InferenceServiceDefaultHttpPort,
+				},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			res := scenario.spec.GetImplementation().GetContainer(metav1.ObjectMeta{Name: "sklearn", Namespace: "default"},
+				scenario.spec.GetExtensions(), config)
+			if !g.Expect(res).To(gomega.Equal(scenario.expectedContainerSpec)) {
+				t.Errorf("got %q, want %q", res, scenario.expectedContainerSpec)
+			}
+		})
+	}
+}
@@ -67,17 +67,83 @@ type PredictorSpec struct {
 
 var _ Component = &PredictorSpec{}
 
+// PredictorFrameworks is the registry of built-in predictor frameworks, keyed by model format name.
+// Each entry returns a PredictorSpec with the framework's field populated from the given extension spec.
+var PredictorFrameworks = map[string]func(PredictorExtensionSpec) *PredictorSpec{
+	constants.SupportedModelSKLearn: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{SKLearn: &SKLearnSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelXGBoost: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{XGBoost: &XGBoostSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelTensorflow: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{Tensorflow: &TFServingSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelPyTorch: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{PyTorch: &TorchServeSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelTriton: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{Triton: &TritonSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelONNX: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{ONNX: &ONNXRuntimeSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelPMML: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{PMML: &PMMLSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelLightGBM: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{LightGBM: &LightGBMSpec{PredictorExtensionSpec: ext}}
+	},
+	constants.SupportedModelPaddle: func(ext PredictorExtensionSpec) *PredictorSpec {
+		return &PredictorSpec{Paddle: &PaddleServerSpec{PredictorExtensionSpec: ext}}
+	},
+}
+
+// ModelVerificationType is the kind of signature the model artifact is signed with
+// +kubebuilder:validation:Enum=cosign;gpg
+type ModelVerificationType string
+
+// ModelVerificationType enums
+const (
+	CosignVerificationType ModelVerificationType = "cosign"
+	GPGVerificationType    ModelVerificationType = "gpg"
+)
+
+// ModelVerification defines the detached signature the model artifact is verified with
+type ModelVerification struct {
+	// Type of the signature, either cosign or gpg
+	Type ModelVerificationType `json:"type"`
+	// PublicKey is the PEM encoded cosign public key or the ASCII armored GPG public key of the signer
+	PublicKey string `json:"publicKey"`
+	// SignatureUri is the http(s) location of the detached signature, it defaults to the storageUri with a .sig suffix
+	// +optional
+	SignatureUri *string `json:"signatureUri,omitempty"`
+}
+
 // PredictorExtensionSpec defines configuration shared across all predictor frameworks
 type PredictorExtensionSpec struct {
 	// This field points to the location of the trained model which is mounted onto the pod.
 	// +optional
 	StorageURI *string `json:"storageUri,omitempty"`
+	// The hex encoded sha256 digest of the model file or archive downloaded from an http(s) storageUri.
+	// The storage initializer fails when the downloaded artifact does not match it.
+	// +optional
+	StorageSha256 *string `json:"storageSha256,omitempty"`
+	// Verification checks the signature of the model file or archive downloaded from an http(s) storageUri,
+	// the model is not loaded when the signature does not verify against the public key.
+	// +optional
+	Verification *ModelVerification `json:"verification,omitempty"`
 	// Runtime version of the predictor docker image
 	// +optional
 	RuntimeVersion *string `json:"runtimeVersion,omitempty"`
 	// Protocol version to use by the predictor (i.e. v1 or v2 or grpc-v1 or grpc-v2)
 	// +optional
 	ProtocolVersion *constants.InferenceServiceProtocol `json:"protocolVersion,omitempty"`
+	// ModelLoadTimeoutSeconds is the time the model server is given to load the model before it is considered
+	// failed. Raw deployments get a startup probe restarting the container after the timeout, serverless revisions
+	// get the timeout as their progress deadline. Set it for large models, small models keep failing fast without it.
+	// +optional
+	ModelLoadTimeoutSeconds *int64 `json:"modelLoadTimeoutSeconds,omitempty"`
 	// Container enables overrides for the predictor.
 	// Each framework will have different defaults that are populated in the underlying container spec.
 	// +optional
@@ -135,10 +201,26 @@ func (s *PredictorSpec) GetExtensions() *ComponentExtensionSpec {
 	return &s.ComponentExtensionSpec
 }
 
+// validateGPURuntimeVersion checks the runtime version of the TFServing and TorchServe predictors against the GPU
+// resource of the predictor, their GPU images are tagged with a suffix
+func (s *PredictorSpec) validateGPURuntimeVersion() error {
+	gpuResourceName := s.GetGPUResourceName()
+	if s.Tensorflow != nil {
+		return s.Tensorflow.validateGPU(gpuResourceName)
+	}
+	if s.PyTorch != nil {
+		return s.PyTorch.validateGPU(gpuResourceName)
+	}
+	return nil
+}
+
 // Validate returns an error if invalid
 func (p *PredictorExtensionSpec) Validate() error {
 	return utils.FirstNonNilError([]error{
 		validateStorageURI(p.GetStorageUri()),
+		validateStorageSha256(p.GetStorageUri(), p.StorageSha256),
+		validateModelLoadTimeoutSeconds(p.ModelLoadTimeoutSeconds),
+		validateModelVerification(p.GetStorageUri(), p.Verification),
 		// TODO: Re-enable storage spec validation once azure/gcs are supported.
 		// Enabling this currently prevents those storage types from working with ModelMesh.
 		// validateStorageSpec(p.GetStorageSpec(), p.GetStorageUri()),
@@ -155,6 +237,11 @@ func (p *PredictorExtensionSpec) GetStorageSpec() *StorageSpec {
 	return p.Storage
 }
 
+// getEmbeddedContainer returns the container overrides of the predictor
+func (p *PredictorExtensionSpec) getEmbeddedContainer() *v1.Container {
+	return &p.Container
+}
+
 // GetPredictorImplementations GetPredictor returns the implementation for the predictor
 func (s *PredictorSpec) GetPredictorImplementations() []ComponentImplementation {
 	implementations := NonNilPredictors([]ComponentImplementation{
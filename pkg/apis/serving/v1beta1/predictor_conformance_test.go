@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestPredictorFrameworkConformance runs the baseline checks every registered predictor framework must pass.
+func TestPredictorFrameworkConformance(t *testing.T) {
+	config := &InferenceServicesConfig{}
+	deployConfig := &DeployConfig{
+		DefaultDeploymentMode: "Serverless",
+	}
+	storageUri := "gs://kfserving-examples/models/example"
+
+	for framework, newPredictor := range PredictorFrameworks {
+		t.Run(framework, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			ext := PredictorExtensionSpec{StorageURI: proto.String(storageUri)}
+
+			// deep copy round trip
+			spec := newPredictor(ext)
+			copied := spec.DeepCopy()
+			g.Expect(copied).To(gomega.Equal(spec))
+			*copied.GetImplementation().GetStorageUri() = "s3://changed"
+			g.Expect(*spec.GetImplementation().GetStorageUri()).To(gomega.Equal(storageUri))
+
+			// exactly one implementation, which validates and defaults
+			g.Expect(validateExactlyOneImplementation(spec)).To(gomega.Succeed())
+			impl := spec.GetImplementation()
+			g.Expect(impl.Validate()).To(gomega.Succeed())
+			impl.Default(config)
+			g.Expect(impl.Validate()).To(gomega.Succeed())
+
+			// container is built and points at the model
+			container := impl.GetContainer(metav1.ObjectMeta{Name: "foo", Namespace: "default"}, spec.GetExtensions(), config)
+			g.Expect(container).NotTo(gomega.BeNil())
+			g.Expect(container.Name).To(gomega.Equal(constants.InferenceServiceContainerName))
+			g.Expect(impl.GetStorageUri()).NotTo(gomega.BeNil())
+			g.Expect(*impl.GetStorageUri()).To(gomega.Equal(storageUri))
+
+			// invalid storage uri is rejected
+			bad := newPredictor(PredictorExtensionSpec{StorageURI: proto.String("invaliduri://modelzoo")})
+			g.Expect(bad.GetImplementation().Validate()).NotTo(gomega.Succeed())
+
+			// defaulting the inference service converts the framework into a model spec
+			isvc := InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "foo",
+					Namespace:   "default",
+					Annotations: map[string]string{},
+				},
+				Spec: InferenceServiceSpec{
+					Predictor: *newPredictor(ext),
+				},
+			}
+			isvc.DefaultInferenceService(config, deployConfig)
+			g.Expect(isvc.Spec.Predictor.GetPredictorImplementations()).To(gomega.HaveLen(1))
+			g.Expect(isvc.Spec.Predictor.Model).NotTo(gomega.BeNil())
+			g.Expect(isvc.Spec.Predictor.Model.ModelFormat.Name).To(gomega.Equal(framework))
+			g.Expect(*isvc.Spec.Predictor.Model.StorageURI).To(gomega.Equal(storageUri))
+			g.Expect(isvc.Spec.Predictor.Model.Validate()).To(gomega.Succeed())
+		})
+	}
+}
+
+// TestPredictorFrameworksRegistered ensures new framework fields on PredictorSpec are added to the registry.
+func TestPredictorFrameworksRegistered(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	specType := reflect.TypeOf(PredictorSpec{})
+	registered := map[string]bool{}
+	for _, newPredictor := range PredictorFrameworks {
+		spec := reflect.ValueOf(newPredictor(PredictorExtensionSpec{})).Elem()
+		for i := 0; i < specType.NumField(); i++ {
+			if specType.Field(i).Type.Kind() == reflect.Ptr && !spec.Field(i).IsNil() {
+				registered[specType.Field(i).Name] = true
+			}
+		}
+	}
+	for i := 0; i < specType.NumField(); i++ {
+		field := specType.Field(i)
+		if field.Type.Kind() != reflect.Ptr || field.Name == "Model" {
+			continue
+		}
+		g.Expect(registered).To(gomega.HaveKey(field.Name), "framework %s is missing from PredictorFrameworks", field.Name)
+	}
+}
@@ -67,7 +67,7 @@ func (c *CustomPredictor) Default(config *InferenceServicesConfig) {
 		c.Containers = append(c.Containers, v1.Container{})
 	}
 	c.Containers[0].Name = constants.InferenceServiceContainerName
-	setResourceRequirementDefaults(&c.Containers[0].Resources)
+	setResourceRequirementDefaults(config, &c.Containers[0].Resources)
 }
 
 func (c *CustomPredictor) GetStorageUri() *string {
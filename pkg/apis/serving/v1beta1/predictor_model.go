@@ -57,7 +57,34 @@ var (
 // Here, the ComponentImplementation interface is implemented in order to maintain the
 // component validation logic. This will probably be refactored out eventually.
 
-func (m *ModelSpec) Default(config *InferenceServicesConfig) {}
+// Validate returns an error if invalid, the version of the pmml model format is checked like the PMML predictor
+func (m *ModelSpec) Validate() error {
+	if m.ModelFormat.Name == constants.SupportedModelPMML {
+		if err := validatePMMLVersion(m.ModelFormat.Version); err != nil {
+			return err
+		}
+	}
+	return m.PredictorExtensionSpec.Validate()
+}
+
+// Default sets the runtime and protocol versions of the model format and the resources configured in the
+// inferenceservice configmap, the resources of the serving runtime apply when no default resources are configured
+func (m *ModelSpec) Default(config *InferenceServicesConfig) {
+	if config == nil {
+		return
+	}
+	if defaults, ok := config.Defaults.ModelFormats[m.ModelFormat.Name]; ok {
+		if m.RuntimeVersion == nil && defaults.RuntimeVersion != "" {
+			runtimeVersion := defaults.RuntimeVersion
+			m.RuntimeVersion = &runtimeVersion
+		}
+		if m.ProtocolVersion == nil && defaults.ProtocolVersion != "" {
+			protocolVersion := defaults.ProtocolVersion
+			m.ProtocolVersion = &protocolVersion
+		}
+	}
+	setResourceDefaults(&m.Resources, config.Defaults.Resources.Requests, config.Defaults.Resources.Limits)
+}
 
 func (m *ModelSpec) GetContainer(metadata metav1.ObjectMeta, extensions *ComponentExtensionSpec, config *InferenceServicesConfig) *v1.Container {
 	return &m.Container
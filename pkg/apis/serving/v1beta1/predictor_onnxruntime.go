@@ -50,6 +50,9 @@ func (o *ONNXRuntimeSpec) Validate() error {
 
 	return utils.FirstNonNilError([]error{
 		validateStorageURI(o.GetStorageUri()),
+		validateStorageSha256(o.GetStorageUri(), o.StorageSha256),
+		validateModelLoadTimeoutSeconds(o.ModelLoadTimeoutSeconds),
+		validateModelVerification(o.GetStorageUri(), o.Verification),
 		validateStorageSpec(o.GetStorageSpec(), o.GetStorageUri()),
 	})
 }
@@ -57,7 +60,7 @@ func (o *ONNXRuntimeSpec) Validate() error {
 // Default sets defaults on the resource
 func (o *ONNXRuntimeSpec) Default(config *InferenceServicesConfig) {
 	o.Container.Name = constants.InferenceServiceContainerName
-	setResourceRequirementDefaults(&o.Resources)
+	setResourceRequirementDefaults(config, &o.Resources)
 }
 
 // GetContainers transforms the resource into a container spec
@@ -17,14 +17,30 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/utils"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+var (
+	// PMMLVersionRegEx matches the versions of the PMML standard the PMML server loads, 3.0 to 4.4
+	PMMLVersionRegEx            = `^(3(\.[0-2])?|4(\.[0-4])?)$`
+	UnsupportedPMMLVersionError = "PMML version %s is not supported, the PMML server loads models of PMML versions 3.0 to 4.4."
+	// regular expression for validation of the PMML version
+	PMMLVersionRegexp = regexp.MustCompile(PMMLVersionRegEx)
+)
+
 // PMMLSpec defines arguments for configuring PMML model serving.
 type PMMLSpec struct {
+	// Version of the PMML standard the model is exported with, e.g. 4.4. The model is served by a runtime supporting
+	// the major version.
+	// +optional
+	PMMLVersion *string `json:"pmmlVersion,omitempty"`
 	// Contains fields shared across all predictors
 	PredictorExtensionSpec `json:",inline"`
 }
@@ -37,7 +53,11 @@ var (
 func (p *PMMLSpec) Validate() error {
 	return utils.FirstNonNilError([]error{
 		ValidateMaxArgumentWorkers(p.Container.Args, 1),
+		validatePMMLVersion(p.PMMLVersion),
 		validateStorageURI(p.GetStorageUri()),
+		validateStorageSha256(p.GetStorageUri(), p.StorageSha256),
+		validateModelLoadTimeoutSeconds(p.ModelLoadTimeoutSeconds),
+		validateModelVerification(p.GetStorageUri(), p.Verification),
 		validateStorageSpec(p.GetStorageSpec(), p.GetStorageUri()),
 	})
 }
@@ -45,7 +65,7 @@ func (p *PMMLSpec) Validate() error {
 // Default sets defaults on the resource
 func (p *PMMLSpec) Default(config *InferenceServicesConfig) {
 	p.Container.Name = constants.InferenceServiceContainerName
-	setResourceRequirementDefaults(&p.Resources)
+	setResourceRequirementDefaults(config, &p.Resources)
 }
 
 func (p *PMMLSpec) GetContainer(metadata metav1.ObjectMeta, extensions *ComponentExtensionSpec, config *InferenceServicesConfig) *v1.Container {
@@ -55,3 +75,19 @@ func (p *PMMLSpec) GetContainer(metadata metav1.ObjectMeta, extensions *Componen
 func (p *PMMLSpec) GetProtocol() constants.InferenceServiceProtocol {
 	return constants.ProtocolV1
 }
+
+// validatePMMLVersion rejects the models exported with a PMML version the PMML server cannot load
+func validatePMMLVersion(version *string) error {
+	if version == nil {
+		return nil
+	}
+	if !PMMLVersionRegexp.MatchString(*version) {
+		return fmt.Errorf(UnsupportedPMMLVersionError, *version)
+	}
+	return nil
+}
+
+// pmmlMajorVersion returns the major version the PMML runtimes declare in their supported model formats
+func pmmlMajorVersion(version string) string {
+	return strings.SplitN(version, ".", 2)[0]
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package v1beta1
 
 import (
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"fmt"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/golang/protobuf/proto"
 
 	"github.com/kserve/kserve/pkg/constants"
@@ -66,6 +68,38 @@ func TestPMMLValidation(t *testing.T) {
 			},
 			matcher: gomega.Not(gomega.BeNil()),
 		},
+		"AcceptSupportedPMMLVersion": {
+			spec: PredictorSpec{
+				PMML: &PMMLSpec{
+					PMMLVersion: proto.String("4.4"),
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"AcceptSupportedPMMLMajorVersion": {
+			spec: PredictorSpec{
+				PMML: &PMMLSpec{
+					PMMLVersion: proto.String("3"),
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
+		"RejectUnsupportedPMMLVersion": {
+			spec: PredictorSpec{
+				PMML: &PMMLSpec{
+					PMMLVersion: proto.String("2.1"),
+				},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(UnsupportedPMMLVersionError, "2.1")),
+		},
+		"RejectUnsupportedPMMLMinorVersion": {
+			spec: PredictorSpec{
+				PMML: &PMMLSpec{
+					PMMLVersion: proto.String("4.5"),
+				},
+			},
+			matcher: gomega.MatchError(fmt.Sprintf(UnsupportedPMMLVersionError, "4.5")),
+		},
 	}
 
 	for name, scenario := range scenarios {
@@ -194,3 +228,59 @@ func TestPMMLSpec_GetContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestPMMLModelFormatVersion(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		version *string
+		matcher types.GomegaMatcher
+	}{
+		"NoVersion": {
+			matcher: gomega.BeNil(),
+		},
+		"SupportedVersion": {
+			version: proto.String("4"),
+			matcher: gomega.BeNil(),
+		},
+		"UnsupportedVersion": {
+			version: proto.String("5"),
+			matcher: gomega.MatchError(fmt.Sprintf(UnsupportedPMMLVersionError, "5")),
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			model := &ModelSpec{
+				ModelFormat: ModelFormat{Name: constants.SupportedModelPMML, Version: scenario.version},
+			}
+			g.Expect(model.Validate()).To(scenario.matcher)
+		})
+	}
+
+	// the version of other model formats is not checked
+	model := &ModelSpec{ModelFormat: ModelFormat{Name: constants.SupportedModelSKLearn, Version: proto.String("5")}}
+	g.Expect(model.Validate()).To(gomega.Succeed())
+}
+
+func TestPMMLVersionDefaults(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: InferenceServiceSpec{
+			Predictor: PredictorSpec{
+				PMML: &PMMLSpec{
+					PMMLVersion: proto.String("4.3"),
+					PredictorExtensionSpec: PredictorExtensionSpec{
+						StorageURI: proto.String("gs://models/pmml"),
+					},
+				},
+			},
+		},
+	}
+	isvc.DefaultInferenceService(&InferenceServicesConfig{}, &DeployConfig{DefaultDeploymentMode: "Serverless"})
+	g.Expect(isvc.Spec.Predictor.PMML).To(gomega.BeNil())
+	// the runtimes declare the major PMML versions they support
+	g.Expect(isvc.Spec.Predictor.Model.ModelFormat).To(gomega.Equal(ModelFormat{
+		Name:    constants.SupportedModelPMML,
+		Version: proto.String("4"),
+	}))
+}
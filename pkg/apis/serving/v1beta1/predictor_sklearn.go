@@ -43,7 +43,7 @@ func (k *SKLearnSpec) Default(config *InferenceServicesConfig) {
 		k.ProtocolVersion = &defaultProtocol
 	}
 
-	setResourceRequirementDefaults(&k.Resources)
+	setResourceRequirementDefaults(config, &k.Resources)
 }
 
 func (k *SKLearnSpec) getEnvVarsV2() []v1.EnvVar {
@@ -50,20 +50,23 @@ var (
 func (t *TFServingSpec) Validate() error {
 	return utils.FirstNonNilError([]error{
 		validateStorageURI(t.GetStorageUri()),
-		t.validateGPU(),
+		validateStorageSha256(t.GetStorageUri(), t.StorageSha256),
+		validateModelLoadTimeoutSeconds(t.ModelLoadTimeoutSeconds),
+		validateModelVerification(t.GetStorageUri(), t.Verification),
 		validateStorageSpec(t.GetStorageSpec(), t.GetStorageUri()),
 	})
 }
 
-func (t *TFServingSpec) validateGPU() error {
+// validateGPU checks that the GPU runtime version is used when the predictor requests the GPU resource
+func (t *TFServingSpec) validateGPU(gpuResourceName v1.ResourceName) error {
 	if t.RuntimeVersion == nil {
 		return nil
 	}
-	if utils.IsGPUEnabled(t.Resources) && !strings.Contains(*t.RuntimeVersion, TensorflowServingGPUSuffix) {
+	if utils.IsGPUEnabled(t.Resources, gpuResourceName) && !strings.Contains(*t.RuntimeVersion, TensorflowServingGPUSuffix) {
 		return fmt.Errorf(InvalidTensorflowRuntimeIncludesGPU)
 	}
 
-	if !utils.IsGPUEnabled(t.Resources) && strings.Contains(*t.RuntimeVersion, TensorflowServingGPUSuffix) {
+	if !utils.IsGPUEnabled(t.Resources, gpuResourceName) && strings.Contains(*t.RuntimeVersion, TensorflowServingGPUSuffix) {
 		return fmt.Errorf(InvalidTensorflowRuntimeExcludesGPU)
 	}
 	return nil
@@ -72,7 +75,7 @@ func (t *TFServingSpec) validateGPU() error {
 // Default sets defaults on the resource
 func (t *TFServingSpec) Default(config *InferenceServicesConfig) {
 	t.Container.Name = constants.InferenceServiceContainerName
-	setResourceRequirementDefaults(&t.Resources)
+	setResourceRequirementDefaults(config, &t.Resources)
 }
 
 func (t *TFServingSpec) GetContainer(metadata metav1.ObjectMeta, extensions *ComponentExtensionSpec, config *InferenceServicesConfig) *v1.Container {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
 	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	v1 "k8s.io/api/core/v1"
@@ -70,6 +71,24 @@ func TestTensorflowValidation(t *testing.T) {
 			},
 			matcher: gomega.MatchError(fmt.Sprintf(InvalidTensorflowRuntimeIncludesGPU)),
 		},
+		"AcceptGpuRuntimeWithCustomGpuResource": {
+			spec: PredictorSpec{
+				Tensorflow: &TFServingSpec{
+					PredictorExtensionSpec: PredictorExtensionSpec{
+						RuntimeVersion: proto.String("latest-gpu"),
+						Container: v1.Container{
+							Resources: v1.ResourceRequirements{
+								Limits: v1.ResourceList{"example.com/gpu-fraction": resource.MustParse("50")},
+							},
+						},
+					},
+				},
+				ComponentExtensionSpec: ComponentExtensionSpec{
+					GPU: &GPUSpec{ResourceName: "example.com/gpu-fraction"},
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
 		"ValidStorageUri": {
 			spec: PredictorSpec{
 				Tensorflow: &TFServingSpec{
@@ -95,7 +114,10 @@ func TestTensorflowValidation(t *testing.T) {
 	for name, scenario := range scenarios {
 		t.Run(name, func(t *testing.T) {
 			scenario.spec.Tensorflow.Default(nil)
-			res := scenario.spec.Tensorflow.Validate()
+			res := utils.FirstNonNilError([]error{
+				scenario.spec.Tensorflow.Validate(),
+				scenario.spec.validateGPURuntimeVersion(),
+			})
 			if !g.Expect(res).To(scenario.matcher) {
 				t.Errorf("got %q, want %q", res, scenario.matcher)
 			}
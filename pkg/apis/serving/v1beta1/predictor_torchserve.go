@@ -48,20 +48,23 @@ var (
 func (t *TorchServeSpec) Validate() error {
 	return utils.FirstNonNilError([]error{
 		validateStorageURI(t.GetStorageUri()),
-		t.validateGPU(),
+		validateStorageSha256(t.GetStorageUri(), t.StorageSha256),
+		validateModelLoadTimeoutSeconds(t.ModelLoadTimeoutSeconds),
+		validateModelVerification(t.GetStorageUri(), t.Verification),
 		validateStorageSpec(t.GetStorageSpec(), t.GetStorageUri()),
 	})
 }
 
-func (t *TorchServeSpec) validateGPU() error {
+// validateGPU checks that the GPU runtime version is used when the predictor requests the GPU resource
+func (t *TorchServeSpec) validateGPU(gpuResourceName v1.ResourceName) error {
 	if t.RuntimeVersion == nil {
 		return nil
 	}
-	if utils.IsGPUEnabled(t.Resources) && !strings.Contains(*t.RuntimeVersion, PyTorchServingGPUSuffix) {
+	if utils.IsGPUEnabled(t.Resources, gpuResourceName) && !strings.Contains(*t.RuntimeVersion, PyTorchServingGPUSuffix) {
 		return fmt.Errorf(InvalidPyTorchRuntimeIncludesGPU)
 	}
 
-	if !utils.IsGPUEnabled(t.Resources) && strings.Contains(*t.RuntimeVersion, PyTorchServingGPUSuffix) {
+	if !utils.IsGPUEnabled(t.Resources, gpuResourceName) && strings.Contains(*t.RuntimeVersion, PyTorchServingGPUSuffix) {
 		return fmt.Errorf(InvalidPyTorchRuntimeExcludesGPU)
 	}
 	return nil
@@ -74,7 +77,7 @@ func (t *TorchServeSpec) Default(config *InferenceServicesConfig) {
 		defaultProtocol := constants.ProtocolV1
 		t.ProtocolVersion = &defaultProtocol
 	}
-	setResourceRequirementDefaults(&t.Resources)
+	setResourceRequirementDefaults(config, &t.Resources)
 }
 
 func (t *TorchServeSpec) GetContainer(metadata metav1.ObjectMeta, extensions *ComponentExtensionSpec, config *InferenceServicesConfig) *v1.Container {
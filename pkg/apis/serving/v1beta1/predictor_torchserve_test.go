@@ -24,6 +24,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
 	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	v1 "k8s.io/api/core/v1"
@@ -71,6 +72,24 @@ func TestTorchServeValidation(t *testing.T) {
 			},
 			matcher: gomega.MatchError(fmt.Sprintf(InvalidPyTorchRuntimeIncludesGPU)),
 		},
+		"AcceptGpuRuntimeWithCustomGpuResource": {
+			spec: PredictorSpec{
+				PyTorch: &TorchServeSpec{
+					PredictorExtensionSpec: PredictorExtensionSpec{
+						RuntimeVersion: proto.String("0.6.0-gpu"),
+						Container: v1.Container{
+							Resources: v1.ResourceRequirements{
+								Limits: v1.ResourceList{"example.com/gpu-fraction": resource.MustParse("50")},
+							},
+						},
+					},
+				},
+				ComponentExtensionSpec: ComponentExtensionSpec{
+					GPU: &GPUSpec{ResourceName: "example.com/gpu-fraction"},
+				},
+			},
+			matcher: gomega.BeNil(),
+		},
 		"ValidStorageUri": {
 			spec: PredictorSpec{
 				PyTorch: &TorchServeSpec{
@@ -96,7 +115,10 @@ func TestTorchServeValidation(t *testing.T) {
 	for name, scenario := range scenarios {
 		t.Run(name, func(t *testing.T) {
 			scenario.spec.PyTorch.Default(nil)
-			res := scenario.spec.PyTorch.Validate()
+			res := utils.FirstNonNilError([]error{
+				scenario.spec.PyTorch.Validate(),
+				scenario.spec.validateGPURuntimeVersion(),
+			})
 			if !g.Expect(res).To(scenario.matcher) {
 				t.Errorf("got %q, want %q", res, scenario.matcher)
 			}
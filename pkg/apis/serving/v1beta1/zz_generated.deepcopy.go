@@ -24,6 +24,7 @@ package v1beta1
 import (
 	"github.com/kserve/kserve/pkg/constants"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/apis/duck/v1"
@@ -78,6 +79,53 @@ func (in *AlibiExplainerSpec) DeepCopy() *AlibiExplainerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
+func (in *AuthenticationSpec) DeepCopy() *AuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingSpec) DeepCopyInto(out *AutoScalingSpec) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]ExternalMetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingSpec.
+func (in *AutoScalingSpec) DeepCopy() *AutoScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Batcher) DeepCopyInto(out *Batcher) {
 	*out = *in
@@ -108,6 +156,35 @@ func (in *Batcher) DeepCopy() *Batcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRoutingSpec) DeepCopyInto(out *CanaryRoutingSpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRoutingSpec.
+func (in *CanaryRoutingSpec) DeepCopy() *CanaryRoutingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRoutingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentExtensionSpec) DeepCopyInto(out *ComponentExtensionSpec) {
 	*out = *in
@@ -116,6 +193,18 @@ func (in *ComponentExtensionSpec) DeepCopyInto(out *ComponentExtensionSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScalingSchedule != nil {
+		in, out := &in.ScalingSchedule, &out.ScalingSchedule
+		*out = make([]ScalingWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ScaleTarget != nil {
 		in, out := &in.ScaleTarget, &out.ScaleTarget
 		*out = new(int)
@@ -131,16 +220,36 @@ func (in *ComponentExtensionSpec) DeepCopyInto(out *ComponentExtensionSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.AutoScaling != nil {
+		in, out := &in.AutoScaling, &out.AutoScaling
+		*out = new(AutoScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TimeoutSeconds != nil {
 		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DrainSeconds != nil {
+		in, out := &in.DrainSeconds, &out.DrainSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	if in.CanaryTrafficPercent != nil {
 		in, out := &in.CanaryTrafficPercent, &out.CanaryTrafficPercent
 		*out = new(int64)
 		**out = **in
 	}
+	if in.RevisionRef != nil {
+		in, out := &in.RevisionRef, &out.RevisionRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.CanaryRouting != nil {
+		in, out := &in.CanaryRouting, &out.CanaryRouting
+		*out = new(CanaryRoutingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Logger != nil {
 		in, out := &in.Logger, &out.Logger
 		*out = new(LoggerSpec)
@@ -151,6 +260,31 @@ func (in *ComponentExtensionSpec) DeepCopyInto(out *ComponentExtensionSpec) {
 		*out = new(Batcher)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestQueue != nil {
+		in, out := &in.RequestQueue, &out.RequestQueue
+		*out = new(RequestQueue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenAI != nil {
+		in, out := &in.OpenAI, &out.OpenAI
+		*out = new(OpenAISpec)
+		**out = **in
+	}
+	if in.ResponseCache != nil {
+		in, out := &in.ResponseCache, &out.ResponseCache
+		*out = new(ResponseCacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheckPort != nil {
+		in, out := &in.HealthCheckPort, &out.HealthCheckPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		*out = new(GPUSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentExtensionSpec.
@@ -166,6 +300,11 @@ func (in *ComponentExtensionSpec) DeepCopy() *ComponentExtensionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentStatusSpec) DeepCopyInto(out *ComponentStatusSpec) {
 	*out = *in
+	if in.RevisionHistory != nil {
+		in, out := &in.RevisionHistory, &out.RevisionHistory
+		*out = make([]RevisionHistoryEntry, len(*in))
+		copy(*out, *in)
+	}
 	if in.Traffic != nil {
 		in, out := &in.Traffic, &out.Traffic
 		*out = make([]servingv1.TrafficTarget, len(*in))
@@ -193,6 +332,23 @@ func (in *ComponentStatusSpec) DeepCopyInto(out *ComponentStatusSpec) {
 		*out = new(v1.Addressable)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(RevisionStatusSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(RevisionStatusSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatusSpec.
@@ -205,6 +361,32 @@ func (in *ComponentStatusSpec) DeepCopy() *ComponentStatusSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDriftDetector) DeepCopyInto(out *CustomDriftDetector) {
+	*out = *in
+	if in.DriftBatchSize != nil {
+		in, out := &in.DriftBatchSize, &out.DriftBatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.EventSink != nil {
+		in, out := &in.EventSink, &out.EventSink
+		*out = new(string)
+		**out = **in
+	}
+	in.PodSpec.DeepCopyInto(&out.PodSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDriftDetector.
+func (in *CustomDriftDetector) DeepCopy() *CustomDriftDetector {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDriftDetector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomExplainer) DeepCopyInto(out *CustomExplainer) {
 	*out = *in
@@ -221,6 +403,22 @@ func (in *CustomExplainer) DeepCopy() *CustomExplainer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomOutlierDetector) DeepCopyInto(out *CustomOutlierDetector) {
+	*out = *in
+	in.PodSpec.DeepCopyInto(&out.PodSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomOutlierDetector.
+func (in *CustomOutlierDetector) DeepCopy() *CustomOutlierDetector {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomOutlierDetector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomPredictor) DeepCopyInto(out *CustomPredictor) {
 	*out = *in
@@ -253,6 +451,64 @@ func (in *CustomTransformer) DeepCopy() *CustomTransformer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectorSpec) DeepCopyInto(out *DriftDetectorSpec) {
+	*out = *in
+	if in.DriftBatchSize != nil {
+		in, out := &in.DriftBatchSize, &out.DriftBatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.EventSink != nil {
+		in, out := &in.EventSink, &out.EventSink
+		*out = new(string)
+		**out = **in
+	}
+	in.PodSpec.DeepCopyInto(&out.PodSpec)
+	in.ComponentExtensionSpec.DeepCopyInto(&out.ComponentExtensionSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectorSpec.
+func (in *DriftDetectorSpec) DeepCopy() *DriftDetectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
+	*out = *in
+	in.Predictor.DeepCopyInto(&out.Predictor)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentSpec.
+func (in *ExperimentSpec) DeepCopy() *ExperimentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentStatus.
+func (in *ExperimentStatus) DeepCopy() *ExperimentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExplainerExtensionSpec) DeepCopyInto(out *ExplainerExtensionSpec) {
 	*out = *in
@@ -318,6 +574,31 @@ func (in *ExplainerSpec) DeepCopy() *ExplainerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalMetricSpec) DeepCopyInto(out *ExternalMetricSpec) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusMetricSource)
+		**out = **in
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaMetricSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalMetricSpec.
+func (in *ExternalMetricSpec) DeepCopy() *ExternalMetricSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalMetricSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailureInfo) DeepCopyInto(out *FailureInfo) {
 	*out = *in
@@ -337,6 +618,21 @@ func (in *FailureInfo) DeepCopy() *FailureInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUSpec) DeepCopyInto(out *GPUSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUSpec.
+func (in *GPUSpec) DeepCopy() *GPUSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InferenceService) DeepCopyInto(out *InferenceService) {
 	*out = *in
@@ -410,6 +706,33 @@ func (in *InferenceServiceSpec) DeepCopyInto(out *InferenceServiceSpec) {
 		*out = new(TransformerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OutlierDetector != nil {
+		in, out := &in.OutlierDetector, &out.OutlierDetector
+		*out = new(OutlierDetectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetector != nil {
+		in, out := &in.DriftDetector, &out.DriftDetector
+		*out = new(DriftDetectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]ExperimentSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceServiceSpec.
@@ -444,6 +767,16 @@ func (in *InferenceServiceStatus) DeepCopyInto(out *InferenceServiceStatus) {
 		}
 	}
 	in.ModelStatus.DeepCopyInto(&out.ModelStatus)
+	if in.RolloutStatus != nil {
+		in, out := &in.RolloutStatus, &out.RolloutStatus
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]ExperimentStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceServiceStatus.
@@ -456,6 +789,26 @@ func (in *InferenceServiceStatus) DeepCopy() *InferenceServiceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMetricSource) DeepCopyInto(out *KafkaMetricSource) {
+	*out = *in
+	if in.LagThreshold != nil {
+		in, out := &in.LagThreshold, &out.LagThreshold
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMetricSource.
+func (in *KafkaMetricSource) DeepCopy() *KafkaMetricSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMetricSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LightGBMSpec) DeepCopyInto(out *LightGBMSpec) {
 	*out = *in
@@ -472,6 +825,26 @@ func (in *LightGBMSpec) DeepCopy() *LightGBMSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggerKafkaSpec) DeepCopyInto(out *LoggerKafkaSpec) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggerKafkaSpec.
+func (in *LoggerKafkaSpec) DeepCopy() *LoggerKafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggerKafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggerSpec) DeepCopyInto(out *LoggerSpec) {
 	*out = *in
@@ -480,6 +853,26 @@ func (in *LoggerSpec) DeepCopyInto(out *LoggerSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SamplingPercent != nil {
+		in, out := &in.SamplingPercent, &out.SamplingPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(LoggerKafkaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redact != nil {
+		in, out := &in.Redact, &out.Redact
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(LoggerStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggerSpec.
@@ -492,6 +885,31 @@ func (in *LoggerSpec) DeepCopy() *LoggerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggerStorageSpec) DeepCopyInto(out *LoggerStorageSpec) {
+	*out = *in
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushIntervalSeconds != nil {
+		in, out := &in.FlushIntervalSeconds, &out.FlushIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggerStorageSpec.
+func (in *LoggerStorageSpec) DeepCopy() *LoggerStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggerStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelCopies) DeepCopyInto(out *ModelCopies) {
 	*out = *in
@@ -582,6 +1000,11 @@ func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 		*out = new(ModelCopies)
 		**out = **in
 	}
+	if in.ResolvedModel != nil {
+		in, out := &in.ResolvedModel, &out.ResolvedModel
+		*out = new(ResolvedModelInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
@@ -594,6 +1017,41 @@ func (in *ModelStatus) DeepCopy() *ModelStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelVerification) DeepCopyInto(out *ModelVerification) {
+	*out = *in
+	if in.SignatureUri != nil {
+		in, out := &in.SignatureUri, &out.SignatureUri
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelVerification.
+func (in *ModelVerification) DeepCopy() *ModelVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCSpec) DeepCopyInto(out *OIDCSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCSpec.
+func (in *OIDCSpec) DeepCopy() *OIDCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ONNXRuntimeSpec) DeepCopyInto(out *ONNXRuntimeSpec) {
 	*out = *in
@@ -610,9 +1068,46 @@ func (in *ONNXRuntimeSpec) DeepCopy() *ONNXRuntimeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenAISpec) DeepCopyInto(out *OpenAISpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAISpec.
+func (in *OpenAISpec) DeepCopy() *OpenAISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenAISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetectorSpec) DeepCopyInto(out *OutlierDetectorSpec) {
+	*out = *in
+	in.PodSpec.DeepCopyInto(&out.PodSpec)
+	in.ComponentExtensionSpec.DeepCopyInto(&out.ComponentExtensionSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetectorSpec.
+func (in *OutlierDetectorSpec) DeepCopy() *OutlierDetectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PMMLSpec) DeepCopyInto(out *PMMLSpec) {
 	*out = *in
+	if in.PMMLVersion != nil {
+		in, out := &in.PMMLVersion, &out.PMMLVersion
+		*out = new(string)
+		**out = **in
+	}
 	in.PredictorExtensionSpec.DeepCopyInto(&out.PredictorExtensionSpec)
 }
 
@@ -803,6 +1298,16 @@ func (in *PredictorExtensionSpec) DeepCopyInto(out *PredictorExtensionSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.StorageSha256 != nil {
+		in, out := &in.StorageSha256, &out.StorageSha256
+		*out = new(string)
+		**out = **in
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ModelVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RuntimeVersion != nil {
 		in, out := &in.RuntimeVersion, &out.RuntimeVersion
 		*out = new(string)
@@ -813,6 +1318,11 @@ func (in *PredictorExtensionSpec) DeepCopyInto(out *PredictorExtensionSpec) {
 		*out = new(constants.InferenceServiceProtocol)
 		**out = **in
 	}
+	if in.ModelLoadTimeoutSeconds != nil {
+		in, out := &in.ModelLoadTimeoutSeconds, &out.ModelLoadTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	in.Container.DeepCopyInto(&out.Container)
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
@@ -898,6 +1408,162 @@ func (in *PredictorSpec) DeepCopy() *PredictorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusMetricSource) DeepCopyInto(out *PrometheusMetricSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMetricSource.
+func (in *PrometheusMetricSource) DeepCopy() *PrometheusMetricSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusMetricSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestQueue) DeepCopyInto(out *RequestQueue) {
+	*out = *in
+	if in.MaxQueueDepth != nil {
+		in, out := &in.MaxQueueDepth, &out.MaxQueueDepth
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryAfterSeconds != nil {
+		in, out := &in.RetryAfterSeconds, &out.RetryAfterSeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestQueue.
+func (in *RequestQueue) DeepCopy() *RequestQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedModelInfo) DeepCopyInto(out *ResolvedModelInfo) {
+	*out = *in
+	if in.DownloadDuration != nil {
+		in, out := &in.DownloadDuration, &out.DownloadDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedModelInfo.
+func (in *ResolvedModelInfo) DeepCopy() *ResolvedModelInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedModelInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCacheRedisSpec) DeepCopyInto(out *ResponseCacheRedisSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCacheRedisSpec.
+func (in *ResponseCacheRedisSpec) DeepCopy() *ResponseCacheRedisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCacheRedisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCacheSpec) DeepCopyInto(out *ResponseCacheSpec) {
+	*out = *in
+	if in.TTLSeconds != nil {
+		in, out := &in.TTLSeconds, &out.TTLSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int)
+		**out = **in
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(ResponseCacheRedisSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCacheSpec.
+func (in *ResponseCacheSpec) DeepCopy() *ResponseCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionHistoryEntry) DeepCopyInto(out *RevisionHistoryEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionHistoryEntry.
+func (in *RevisionHistoryEntry) DeepCopy() *RevisionHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionStatusSpec) DeepCopyInto(out *RevisionStatusSpec) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionStatusSpec.
+func (in *RevisionStatusSpec) DeepCopy() *RevisionStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SKLearnSpec) DeepCopyInto(out *SKLearnSpec) {
 	*out = *in
@@ -914,6 +1580,36 @@ func (in *SKLearnSpec) DeepCopy() *SKLearnSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingWindow) DeepCopyInto(out *ScalingWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingWindow.
+func (in *ScalingWindow) DeepCopy() *ScalingWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
 	*out = *in
@@ -987,6 +1683,26 @@ func (in *TorchServeSpec) DeepCopy() *TorchServeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
+	*out = *in
+	if in.SamplingPercent != nil {
+		in, out := &in.SamplingPercent, &out.SamplingPercent
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingSpec.
+func (in *TracingSpec) DeepCopy() *TracingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransformerSpec) DeepCopyInto(out *TransformerSpec) {
 	*out = *in
@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"go.uber.org/zap"
+)
+
+const bearerPrefix = "Bearer "
+
+// TokenVerifier verifies the bearer token of a request
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (*oidc.IDToken, error)
+}
+
+// AuthHandler rejects the requests without a valid bearer token with 401 Unauthorized, the requests are rejected with
+// 503 Service Unavailable until the signing keys have been fetched
+type AuthHandler struct {
+	log      *zap.SugaredLogger
+	verifier TokenVerifier
+	next     http.Handler
+}
+
+func New(verifier TokenVerifier, next http.Handler, log *zap.SugaredLogger) *AuthHandler {
+	return &AuthHandler{
+		log:      log,
+		verifier: verifier,
+		next:     next,
+	}
+}
+
+func (h *AuthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+		return
+	}
+	token, err := h.verifier.Verify(r.Context(), authorization[len(bearerPrefix):])
+	if err != nil {
+		if errors.Is(err, ErrInvalidToken) {
+			h.log.Debugw("Rejected request", "error", err)
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+		h.log.Errorw("Failed to verify the bearer token", "error", err)
+		http.Error(w, "failed to verify the bearer token", http.StatusServiceUnavailable)
+		return
+	}
+	h.log.Debugw("Authenticated request", "subject", token.Subject)
+	h.next.ServeHTTP(w, r)
+}
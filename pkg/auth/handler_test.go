@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+func TestAuthHandler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	issuer := newTestIssuer(t)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	verifier := NewOIDCVerifier(issuer.server.URL, []string{"sklearn.default"})
+	g.Expect(verifier.refreshKeys(context.TODO())).To(gomega.Succeed())
+	handler := New(verifier, next, zap.NewNop().Sugar())
+
+	scenarios := map[string]struct {
+		authorization string
+		status        int
+	}{
+		"Valid":         {authorization: "Bearer " + issuer.token(t, "rsa", nil), status: http.StatusOK},
+		"LowerCase":     {authorization: "bearer " + issuer.token(t, "rsa", nil), status: http.StatusOK},
+		"Missing":       {status: http.StatusUnauthorized},
+		"Basic":         {authorization: "Basic dXNlcjpwYXNz", status: http.StatusUnauthorized},
+		"OtherAudience": {authorization: "Bearer " + issuer.token(t, "rsa", func(c map[string]interface{}) { c["aud"] = "other" }), status: http.StatusUnauthorized},
+	}
+	for name, scenario := range scenarios {
+		req := httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", nil)
+		if scenario.authorization != "" {
+			req.Header.Set("Authorization", scenario.authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		g.Expect(rec.Code).To(gomega.Equal(scenario.status), name)
+		if scenario.status == http.StatusUnauthorized {
+			g.Expect(rec.Header().Get("WWW-Authenticate")).To(gomega.HavePrefix("Bearer"), name)
+		}
+	}
+
+	// the requests are not rejected as unauthorized until the signing keys have been fetched
+	handler = New(NewOIDCVerifier(issuer.server.URL, []string{"sklearn.default"}), next, zap.NewNop().Sugar())
+	req := httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", nil)
+	req.Header.Set("Authorization", "Bearer "+issuer.token(t, "rsa", nil))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	g.Expect(rec.Code).To(gomega.Equal(http.StatusServiceUnavailable))
+}
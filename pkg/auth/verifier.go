@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-jose/go-jose/v3"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// DefaultKeyRefreshInterval is the interval the signing keys are fetched again at, so that rotated keys are
+	// picked up
+	DefaultKeyRefreshInterval = 5 * time.Minute
+	// MinKeyRefreshInterval bounds the fetches triggered by the tokens signed with an unknown key
+	MinKeyRefreshInterval = time.Minute
+	// fetchTimeout bounds the requests to the discovery document and the signing keys
+	fetchTimeout = 10 * time.Second
+	// minRSAKeyBits is the size below which the RSA signing keys are ignored
+	minRSAKeyBits = 2048
+)
+
+var (
+	// ErrInvalidToken is returned when the token is malformed, expired, not issued for the audiences or not signed by
+	// the issuer
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrKeysNotLoaded is returned until the signing keys have been fetched once
+	ErrKeysNotLoaded = errors.New("the signing keys have not been loaded")
+)
+
+// signingAlgorithms are the asymmetric algorithms accepted for the token signatures
+var signingAlgorithms = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+}
+
+type discovery struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// Verifier verifies the bearer tokens of an issuer. The parsing and the verification of the tokens are delegated to
+// go-oidc, the issuer and its signing keys are fetched by Run in the background so that the requests never wait on
+// the issuer.
+type Verifier struct {
+	discoveryURL    string
+	jwksURL         string
+	issuer          string
+	audiences       []string
+	client          *http.Client
+	refreshInterval time.Duration
+
+	// verifier holds the *oidc.IDTokenVerifier of the last fetched keys
+	verifier atomic.Value
+	refresh  chan struct{}
+}
+
+// NewOIDCVerifier verifies the tokens of an OpenID Connect provider, the issuer of the tokens must match the issuer URL
+func NewOIDCVerifier(issuerURL string, audiences []string) *Verifier {
+	issuerURL = strings.TrimSuffix(issuerURL, "/")
+	return &Verifier{
+		discoveryURL:    issuerURL + "/.well-known/openid-configuration",
+		issuer:          issuerURL,
+		audiences:       audiences,
+		client:          http.DefaultClient,
+		refreshInterval: DefaultKeyRefreshInterval,
+		refresh:         make(chan struct{}, 1),
+	}
+}
+
+// NewKubernetesVerifier verifies the service account tokens of the cluster. The keys are read from the API server
+// with the token of the pod, as the jwks_uri advertised by the API server may only be reachable from outside the cluster.
+func NewKubernetesVerifier(audiences []string) (*Verifier, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{
+		discoveryURL:    config.Host + "/.well-known/openid-configuration",
+		jwksURL:         config.Host + "/openid/v1/jwks",
+		audiences:       audiences,
+		client:          &http.Client{Transport: transport},
+		refreshInterval: DefaultKeyRefreshInterval,
+		refresh:         make(chan struct{}, 1),
+	}, nil
+}
+
+func (v *Verifier) getJSON(ctx context.Context, url string, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// refreshKeys fetches the discovery document and the signing keys and replaces the verifier of the previous keys
+func (v *Verifier) refreshKeys(ctx context.Context) error {
+	doc := discovery{}
+	if err := v.getJSON(ctx, v.discoveryURL, &doc); err != nil {
+		return fmt.Errorf("failed to fetch the discovery document: %w", err)
+	}
+	if v.issuer != "" && doc.Issuer != v.issuer {
+		return fmt.Errorf("the discovery document is issued by %q instead of %q", doc.Issuer, v.issuer)
+	}
+	jwksURL := v.jwksURL
+	if jwksURL == "" {
+		jwksURL = doc.JWKSURI
+	}
+	jwks := jose.JSONWebKeySet{}
+	if err := v.getJSON(ctx, jwksURL, &jwks); err != nil {
+		return fmt.Errorf("failed to fetch the signing keys: %w", err)
+	}
+	keys := []crypto.PublicKey{}
+	for _, key := range jwks.Keys {
+		if (key.Use != "" && key.Use != "sig") || !key.IsPublic() || !key.Valid() {
+			continue
+		}
+		if rsaKey, ok := key.Key.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+			continue
+		}
+		keys = append(keys, key.Key)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no usable signing key in %s", jwksURL)
+	}
+	v.verifier.Store(oidc.NewVerifier(doc.Issuer, &oidc.StaticKeySet{PublicKeys: keys}, &oidc.Config{
+		// the audiences are checked by Verify, as a token may be issued for any of them
+		SkipClientIDCheck:    true,
+		SupportedSigningAlgs: signingAlgorithms,
+	}))
+	return nil
+}
+
+// Run fetches the signing keys every refresh interval, and when a token is signed with an unknown key, until the
+// context is done
+func (v *Verifier) Run(ctx context.Context, logger *zap.SugaredLogger) {
+	var refreshed time.Time
+	for {
+		interval := v.refreshInterval
+		if err := v.refreshKeys(ctx); err != nil {
+			logger.Errorw("Failed to refresh the signing keys", zap.Error(err))
+			interval = MinKeyRefreshInterval
+		}
+		refreshed = time.Now()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		case <-v.refresh:
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Until(refreshed.Add(MinKeyRefreshInterval))):
+			}
+		}
+	}
+}
+
+// requestRefresh asks Run to fetch the keys again without waiting for it
+func (v *Verifier) requestRefresh() {
+	select {
+	case v.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// Verify returns the token when it is signed by the issuer, valid now and issued for one of the audiences. The errors
+// of invalid tokens wrap ErrInvalidToken, ErrKeysNotLoaded is returned until the keys have been fetched.
+func (v *Verifier) Verify(ctx context.Context, token string) (*oidc.IDToken, error) {
+	verifier, _ := v.verifier.Load().(*oidc.IDTokenVerifier)
+	if verifier == nil {
+		return nil, ErrKeysNotLoaded
+	}
+	// The tokens of other types, e.g. the logout tokens of the issuer, are not accepted as bearer tokens
+	jws, err := jose.ParseSigned(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	for _, signature := range jws.Signatures {
+		if typ, ok := signature.Protected.ExtraHeaders[jose.HeaderType].(string); ok {
+			typ = strings.TrimPrefix(strings.ToLower(typ), "application/")
+			if typ != "jwt" && typ != "at+jwt" {
+				return nil, fmt.Errorf("%w: unsupported token type %q", ErrInvalidToken, typ)
+			}
+		}
+	}
+	idToken, err := verifier.Verify(ctx, token)
+	if err != nil {
+		// The issuer may have rotated its keys
+		v.requestRefresh()
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	for _, aud := range idToken.Audience {
+		for _, expected := range v.audiences {
+			if aud == expected {
+				return idToken, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: not issued for the audiences %v", ErrInvalidToken, v.audiences)
+}
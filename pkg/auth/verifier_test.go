@@ -0,0 +1,205 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+// testIssuer publishes the "rsa", "ec" and "weak" keys, the "unpublished" key is not part of its signing keys
+type testIssuer struct {
+	server   *httptest.Server
+	rsaKeys  map[string]*rsa.PrivateKey
+	ecKey    *ecdsa.PrivateKey
+	jwksHits int32
+}
+
+func (i *testIssuer) hits() int {
+	return int(atomic.LoadInt32(&i.jwksHits))
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	rsaKeys := map[string]*rsa.PrivateKey{}
+	for kid, bits := range map[string]int{"rsa": 2048, "weak": 1024, "unpublished": 2048} {
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rsaKeys[kid] = key
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := &testIssuer{rsaKeys: rsaKeys, ecKey: ecKey}
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	rsaJWK := func(kid string) map[string]string {
+		return map[string]string{"kty": "RSA", "kid": kid, "use": "sig", "n": encode(rsaKeys[kid].N.Bytes()),
+			"e": encode(big.NewInt(int64(rsaKeys[kid].E)).Bytes())}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&issuer.jwksHits, 1)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{
+			rsaJWK("rsa"),
+			rsaJWK("weak"),
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(ecKey.X.Bytes()), "y": encode(ecKey.Y.Bytes())},
+		}})
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+// sign returns a token of the given type signed with the key of the kid
+func (i *testIssuer) sign(t *testing.T, kid string, typ string, claims map[string]interface{}) string {
+	algorithm := "RS256"
+	if kid == "ec" {
+		algorithm = "ES256"
+	}
+	header, _ := json.Marshal(map[string]string{"alg": algorithm, "kid": kid, "typ": typ})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	var err error
+	if kid == "ec" {
+		var r, s *big.Int
+		if r, s, err = ecdsa.Sign(rand.Reader, i.ecKey, digest[:]); err == nil {
+			signature = make([]byte, 64)
+			r.FillBytes(signature[:32])
+			s.FillBytes(signature[32:])
+		}
+	} else {
+		signature, err = rsa.SignPKCS1v15(rand.Reader, i.rsaKeys[kid], crypto.SHA256, digest[:])
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (i *testIssuer) claims(mutate func(map[string]interface{})) map[string]interface{} {
+	claims := map[string]interface{}{
+		"iss": i.server.URL,
+		"sub": "system:serviceaccount:default:client",
+		"aud": []string{"sklearn.default"},
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	if mutate != nil {
+		mutate(claims)
+	}
+	return claims
+}
+
+// token returns a JWT signed with the key of the kid
+func (i *testIssuer) token(t *testing.T, kid string, mutate func(map[string]interface{})) string {
+	return i.sign(t, kid, "JWT", i.claims(mutate))
+}
+
+func TestVerify(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	issuer := newTestIssuer(t)
+	verifier := NewOIDCVerifier(issuer.server.URL+"/", []string{"sklearn.default", "sklearn"})
+	g.Expect(verifier.refreshKeys(context.TODO())).To(gomega.Succeed())
+
+	tampered := issuer.token(t, "rsa", nil)
+	tampered = tampered[:len(tampered)-4] + "AAAA"
+	scenarios := map[string]struct {
+		token string
+		valid bool
+	}{
+		"RSA":               {token: issuer.token(t, "rsa", nil), valid: true},
+		"EC":                {token: issuer.token(t, "ec", nil), valid: true},
+		"AccessToken":       {token: issuer.sign(t, "rsa", "at+jwt", issuer.claims(nil)), valid: true},
+		"SingleAudience":    {token: issuer.token(t, "rsa", func(c map[string]interface{}) { c["aud"] = "sklearn" }), valid: true},
+		"OtherAudience":     {token: issuer.token(t, "rsa", func(c map[string]interface{}) { c["aud"] = "other" })},
+		"OtherIssuer":       {token: issuer.token(t, "rsa", func(c map[string]interface{}) { c["iss"] = "https://other" })},
+		"Expired":           {token: issuer.token(t, "rsa", func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Hour).Unix() })},
+		"NoExpiry":          {token: issuer.token(t, "rsa", func(c map[string]interface{}) { delete(c, "exp") })},
+		"NotYetValid":       {token: issuer.token(t, "rsa", func(c map[string]interface{}) { c["nbf"] = time.Now().Add(time.Hour).Unix() })},
+		"OtherType":         {token: issuer.sign(t, "rsa", "logout+jwt", issuer.claims(nil))},
+		"WeakKey":           {token: issuer.token(t, "weak", nil)},
+		"UnpublishedKey":    {token: issuer.token(t, "unpublished", nil)},
+		"TamperedSignature": {token: tampered},
+		"Malformed":         {token: "not.a-token"},
+	}
+	for name, scenario := range scenarios {
+		token, err := verifier.Verify(context.TODO(), scenario.token)
+		if scenario.valid {
+			g.Expect(err).NotTo(gomega.HaveOccurred(), name)
+			g.Expect(token.Subject).To(gomega.Equal("system:serviceaccount:default:client"), name)
+		} else {
+			g.Expect(errors.Is(err, ErrInvalidToken)).To(gomega.BeTrue(), name)
+		}
+	}
+	// the invalid tokens ask for the keys to be fetched again, in case the issuer rotated them
+	g.Expect(verifier.refresh).To(gomega.HaveLen(1))
+	g.Expect(issuer.hits()).To(gomega.Equal(1))
+}
+
+func TestVerifyUnavailableIssuer(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	issuer := newTestIssuer(t)
+	token := issuer.token(t, "rsa", nil)
+	issuer.server.Close()
+
+	verifier := NewOIDCVerifier(issuer.server.URL, []string{"sklearn.default"})
+	g.Expect(verifier.refreshKeys(context.TODO())).NotTo(gomega.Succeed())
+	_, err := verifier.Verify(context.TODO(), token)
+	g.Expect(err).To(gomega.MatchError(ErrKeysNotLoaded))
+}
+
+func TestRunRefreshesKeys(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	issuer := newTestIssuer(t)
+	verifier := NewOIDCVerifier(issuer.server.URL, []string{"sklearn.default"})
+	verifier.refreshInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go verifier.Run(ctx, zap.NewNop().Sugar())
+
+	g.Eventually(func() error {
+		_, err := verifier.Verify(context.TODO(), issuer.token(t, "rsa", nil))
+		return err
+	}).Should(gomega.Succeed())
+	g.Eventually(func() int { return issuer.hits() }).Should(gomega.BeNumerically(">", 1))
+}
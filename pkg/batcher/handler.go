@@ -158,6 +158,11 @@ func (handler *BatchHandler) batch() {
 	for {
 		select {
 		case req := <-handler.channelIn:
+			// a batch is posted to a single model, the pending batch of another model is flushed first
+			if len(handler.batcherInfo.Instances) > 0 && req.Path != handler.batcherInfo.Path {
+				handler.log.Infof("batch predict with size %d %s", len(handler.batcherInfo.Instances), handler.batcherInfo.Path)
+				handler.batchPredict()
+			}
 			if len(handler.batcherInfo.Instances) == 0 {
 				handler.batcherInfo.Start = GetNowTime()
 			}
@@ -170,3 +170,50 @@ func TestBatcherDefaults(t *testing.T) {
 	g.Expect(batchHandler.MaxBatchSize).To(gomega.Equal(MaxBatchSize))
 	g.Expect(batchHandler.MaxLatency).To(gomega.Equal(MaxLatency))
 }
+
+// Tests batcher does not batch the requests of different models together
+func TestBatcherModels(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	logger, _ := pkglogging.NewLogger("", "INFO")
+
+	// Start a local HTTP server which predicts the path of the batch for every instance
+	predictor := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		b, err := ioutil.ReadAll(req.Body)
+		g.Expect(err).To(gomega.BeNil())
+		var request Request
+		err = json.Unmarshal(b, &request)
+		g.Expect(err).To(gomega.BeNil())
+		predictions := make([]interface{}, len(request.Instances))
+		for i := range predictions {
+			predictions[i] = req.URL.Path
+		}
+		responseBytes, err := json.Marshal(Response{Predictions: predictions})
+		g.Expect(err).To(gomega.BeNil())
+		_, err = rw.Write(responseBytes)
+		g.Expect(err).To(gomega.BeNil())
+	}))
+	// Close the server when test finishes
+	defer predictor.Close()
+	predictorSvcUrl, err := url.Parse(predictor.URL)
+	g.Expect(err).To(gomega.BeNil())
+	httpProxy := httputil.NewSingleHostReverseProxy(predictorSvcUrl)
+	batchHandler := New(32, 50, httpProxy, logger)
+	var wg sync.WaitGroup
+	responses := make([]Response, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			path := fmt.Sprintf("/v1/models/model-%d:predict", i%2)
+			r := httptest.NewRequest("POST", path, bytes.NewReader([]byte(`{"instances": [[1, 2, 3]]}`)))
+			w := httptest.NewRecorder()
+			batchHandler.ServeHTTP(w, r)
+			_ = json.Unmarshal(w.Body.Bytes(), &responses[i])
+		}(i)
+	}
+	wg.Wait()
+	for i, response := range responses {
+		g.Expect(response.Predictions).To(gomega.Equal([]interface{}{fmt.Sprintf("/v1/models/model-%d:predict", i%2)}))
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchworker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kserve/kserve/pkg/agent/storage"
+)
+
+// OutputProtocols are the protocols of the storage the responses can be written to
+var OutputProtocols = []storage.Protocol{storage.S3, storage.GCS}
+
+// OutputProtocol returns the protocol of the output URI, it returns false when the responses cannot be written to it
+func OutputProtocol(outputUri string) (storage.Protocol, bool) {
+	for _, protocol := range OutputProtocols {
+		if strings.HasPrefix(outputUri, string(protocol)) {
+			return protocol, true
+		}
+	}
+	return "", false
+}
+
+// OutputObject returns the URI of the object the shard writes the responses to
+func OutputObject(outputUri string, shard int) string {
+	return fmt.Sprintf("%s/part-%05d.jsonl", strings.TrimSuffix(outputUri, "/"), shard)
+}
+
+// Upload writes the file to the object of the s3 or gs URI
+func Upload(ctx context.Context, providers map[storage.Protocol]storage.Provider, file string, uri string) error {
+	if _, ok := OutputProtocol(uri); !ok {
+		return fmt.Errorf("unsupported output URI %s, supported protocols are %v", uri, OutputProtocols)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return storage.Upload(ctx, providers, uri, f)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchworker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// The max size of a record, the records are read line by line
+const maxRecordSize = 16 * 1024 * 1024
+
+// errShardDone stops reading the records once the last record of the shard is scored
+var errShardDone = errors.New("shard done")
+
+// Worker scores a contiguous range of the records with the InferenceService. The records are split evenly into
+// as many ranges as there are workers, so the outputs of the workers concatenated in the order of the workers hold the
+// responses in the order of the records.
+type Worker struct {
+	// PredictURL is the URL every record is posted to
+	PredictURL string
+	// InputPath is the file or the directory of files holding the records
+	InputPath string
+	// Shard is the index of the worker
+	Shard int
+	// Shards is the number of workers
+	Shards int
+	// Retries is the number of times the records are retried when the InferenceService is unavailable
+	Retries int
+	// RetryInterval is the interval before the first retry, it is doubled for every further retry
+	RetryInterval time.Duration
+	Client        *http.Client
+	Log           *zap.SugaredLogger
+}
+
+// ShardRange returns the range of the records scored by the shard
+func ShardRange(records int, shard int, shards int) (start int, end int) {
+	return records * shard / shards, records * (shard + 1) / shards
+}
+
+// Run writes the responses to the records of the shard to out, one line per record. The worker fails on the first
+// record which cannot be scored so that the job retries the worker.
+func (w *Worker) Run(ctx context.Context, out io.Writer) (int, error) {
+	records := 0
+	if err := forEachRecord(w.InputPath, func(int, []byte) error {
+		records++
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	start, end := ShardRange(records, w.Shard, w.Shards)
+	w.Log.Infow("Scoring records", "shard", w.Shard, "shards", w.Shards, "start", start, "end", end)
+
+	writer := bufio.NewWriter(out)
+	scored := 0
+	err := forEachRecord(w.InputPath, func(i int, record []byte) error {
+		if i < start {
+			return nil
+		}
+		if i >= end {
+			return errShardDone
+		}
+		response, err := w.score(ctx, record)
+		if err != nil {
+			return fmt.Errorf("failed to score record %d: %w", i, err)
+		}
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, response); err != nil {
+			return fmt.Errorf("the response to record %d is not JSON: %w", i, err)
+		}
+		compacted.WriteByte('\n')
+		if _, err := writer.Write(compacted.Bytes()); err != nil {
+			return err
+		}
+		scored++
+		return nil
+	})
+	if err != nil && !errors.Is(err, errShardDone) {
+		return scored, err
+	}
+	return scored, writer.Flush()
+}
+
+// score posts the record to the InferenceService, the records are retried on the errors of an unavailable or
+// overloaded InferenceService
+func (w *Worker) score(ctx context.Context, record []byte) ([]byte, error) {
+	interval := w.RetryInterval
+	for attempt := 0; ; attempt++ {
+		response, retryable, err := w.post(ctx, record)
+		if err == nil || !retryable || attempt >= w.Retries {
+			return response, err
+		}
+		w.Log.Infow("Retrying record", "attempt", attempt+1, "error", err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+func (w *Worker) post(ctx context.Context, record []byte) ([]byte, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.PredictURL, bytes.NewReader(record))
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		retryable := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		return nil, retryable, fmt.Errorf("status code %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return body, false, nil
+}
+
+// forEachRecord calls fn with the index and the content of every record, the files of a directory are read in the
+// lexical order of their paths and the empty lines are skipped
+func forEachRecord(inputPath string, fn func(int, []byte) error) error {
+	files := []string{}
+	if err := filepath.Walk(inputPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	i := 0
+	for _, path := range files {
+		if err := func() error {
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			scanner := bufio.NewScanner(file)
+			scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+			for scanner.Scan() {
+				record := bytes.TrimSpace(scanner.Bytes())
+				if len(record) == 0 {
+					continue
+				}
+				if err := fn(i, record); err != nil {
+					return err
+				}
+				i++
+			}
+			return scanner.Err()
+		}(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchworker
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+func TestShardRange(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	next := 0
+	for shard := 0; shard < 3; shard++ {
+		start, end := ShardRange(10, shard, 3)
+		g.Expect(start).To(gomega.Equal(next))
+		next = end
+	}
+	g.Expect(next).To(gomega.Equal(10))
+}
+
+func TestWorkerRun(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{ "predictions": ` + strings.TrimSpace(string(body)) + ` }`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "a.jsonl"), []byte("[1]\n[2]\n\n[3]\n"), 0644)).To(gomega.Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "b.jsonl"), []byte("[4]\n[5]\n"), 0644)).To(gomega.Succeed())
+
+	outputs := []string{}
+	for shard := 0; shard < 2; shard++ {
+		worker := &Worker{
+			PredictURL: server.URL,
+			InputPath:  dir,
+			Shard:      shard,
+			Shards:     2,
+			Retries:    1,
+			Client:     server.Client(),
+			Log:        zap.NewNop().Sugar(),
+		}
+		out := &bytes.Buffer{}
+		scored, err := worker.Run(context.Background(), out)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(scored).To(gomega.Equal(strings.Count(out.String(), "\n")))
+		outputs = append(outputs, out.String())
+	}
+	g.Expect(strings.Join(outputs, "")).To(gomega.Equal(
+		`{"predictions":[1]}` + "\n" + `{"predictions":[2]}` + "\n" + `{"predictions":[3]}` + "\n" +
+			`{"predictions":[4]}` + "\n" + `{"predictions":[5]}` + "\n"))
+}
+
+func TestWorkerRunFails(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	input := filepath.Join(t.TempDir(), "records.jsonl")
+	g.Expect(os.WriteFile(input, []byte("[1]\n"), 0644)).To(gomega.Succeed())
+	worker := &Worker{
+		PredictURL: server.URL,
+		InputPath:  input,
+		Shards:     1,
+		Retries:    3,
+		Client:     server.Client(),
+		Log:        zap.NewNop().Sugar(),
+	}
+	_, err := worker.Run(context.Background(), &bytes.Buffer{})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestOutputObject(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(OutputObject("s3://scores/2022-10/", 3)).To(gomega.Equal("s3://scores/2022-10/part-00003.jsonl"))
+	_, ok := OutputProtocol("https://scores")
+	g.Expect(ok).To(gomega.BeFalse())
+}
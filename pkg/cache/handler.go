@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultTTLSeconds = 300
+	DefaultMaxEntries = 1000
+	// MaxResponseBytes is the size of the largest response which is cached
+	MaxResponseBytes = 4 << 20
+	// StatusHeader tells the client whether the response was served from the cache
+	StatusHeader = "X-Kserve-Cache"
+	StatusHit    = "hit"
+	StatusMiss   = "miss"
+)
+
+// CacheHandler answers the repeated inference requests with the cached response of the first request. The requests
+// are keyed by the hash of their path, content type and body, and only the successful responses are cached. The
+// requests with a Cache-Control: no-cache header are always forwarded.
+type CacheHandler struct {
+	log       *zap.SugaredLogger
+	store     Store
+	ttl       time.Duration
+	keyPrefix string
+	next      http.Handler
+}
+
+func New(store Store, ttl time.Duration, keyPrefix string, next http.Handler, log *zap.SugaredLogger) *CacheHandler {
+	return &CacheHandler{
+		log:       log,
+		store:     store,
+		ttl:       ttl,
+		keyPrefix: keyPrefix,
+		next:      next,
+	}
+}
+
+// key hashes the request, the key prefix keeps the responses of the InferenceServices sharing a store apart
+func (h *CacheHandler) key(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	for _, part := range []string{r.URL.Path, r.URL.RawQuery, r.Header.Get("Content-Type")} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	hash.Write(body)
+	return "kserve:" + h.keyPrefix + ":" + hex.EncodeToString(hash.Sum(nil))
+}
+
+func (h *CacheHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || strings.Contains(r.Header.Get("Cache-Control"), "no-cache") {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read the request body", http.StatusBadRequest)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	key := h.key(r, body)
+
+	// The requests are forwarded when the store fails, the cache must not fail the inference
+	if value, ok, err := h.store.Get(r.Context(), key); err != nil {
+		h.log.Errorw("Failed to get the cached response", zap.Error(err))
+	} else if ok {
+		// The cached value is the content type of the response and its body separated by a new line
+		contentType, response, _ := bytes.Cut(value, []byte("\n"))
+		if len(contentType) > 0 {
+			w.Header().Set("Content-Type", string(contentType))
+		}
+		w.Header().Set(StatusHeader, StatusHit)
+		if _, err := w.Write(response); err != nil {
+			h.log.Errorw("Failed to write the cached response", zap.Error(err))
+		}
+		return
+	}
+
+	w.Header().Set(StatusHeader, StatusMiss)
+	rr := &responseRecorder{ResponseWriter: w}
+	h.next.ServeHTTP(rr, r)
+	contentType := w.Header().Get("Content-Type")
+	// The server-sent events are streamed to the client as they are generated and are not cached
+	if rr.statusCode() != http.StatusOK || rr.body.Len() > MaxResponseBytes ||
+		strings.HasPrefix(contentType, "text/event-stream") {
+		return
+	}
+	value := append([]byte(contentType+"\n"), rr.body.Bytes()...)
+	if err := h.store.Set(r.Context(), key, value, h.ttl); err != nil {
+		h.log.Errorw("Failed to cache the response", zap.Error(err))
+	}
+}
+
+// responseRecorder passes the response through to the client while recording it for the cache, the flushes are
+// passed through as well so that the streamed responses reach the client as they are written
+type responseRecorder struct {
+	http.ResponseWriter
+	code int
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	if r.body.Len() <= MaxResponseBytes {
+		r.body.Write(b)
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// statusCode returns the status code of the response, the response is OK when the handler wrote nothing
+func (r *responseRecorder) statusCode() int {
+	if r.code == 0 {
+		return http.StatusOK
+	}
+	return r.code
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	pkglogging "knative.dev/pkg/logging"
+)
+
+func TestCacheHandler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	logger, _ := pkglogging.NewLogger("", "INFO")
+
+	calls := 0
+	predictor := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) == "invalid" {
+			http.Error(w, "invalid instances", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"predictions": %s, "call": %d}`, body, calls)
+	})
+	handler := New(NewMemoryStore(DefaultMaxEntries), time.Minute, "default/sklearn", predictor, logger)
+
+	serve := func(body string, header http.Header) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", strings.NewReader(body))
+		for name, values := range header {
+			r.Header[name] = values
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve("[[1]]", nil)
+	g.Expect(w.Body.String()).To(gomega.Equal(`{"predictions": [[1]], "call": 1}`))
+	g.Expect(w.Header().Get(StatusHeader)).To(gomega.Equal(StatusMiss))
+
+	// The repeated request is answered from the cache
+	w = serve("[[1]]", nil)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK))
+	g.Expect(w.Body.String()).To(gomega.Equal(`{"predictions": [[1]], "call": 1}`))
+	g.Expect(w.Header().Get("Content-Type")).To(gomega.Equal("application/json"))
+	g.Expect(w.Header().Get(StatusHeader)).To(gomega.Equal(StatusHit))
+	g.Expect(calls).To(gomega.Equal(1))
+
+	// Another body, a request opting out of the cache and the failed requests are forwarded
+	w = serve("[[2]]", nil)
+	g.Expect(w.Body.String()).To(gomega.Equal(`{"predictions": [[2]], "call": 2}`))
+	w = serve("[[1]]", http.Header{"Cache-Control": []string{"no-cache"}})
+	g.Expect(w.Body.String()).To(gomega.Equal(`{"predictions": [[1]], "call": 3}`))
+	serve("invalid", nil)
+	w = serve("invalid", nil)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusBadRequest))
+	g.Expect(calls).To(gomega.Equal(5))
+}
+
+func TestMemoryStore(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.Background()
+	now := time.Date(2022, 10, 17, 8, 30, 0, 0, time.UTC)
+	store := NewMemoryStore(2)
+	store.now = func() time.Time { return now }
+
+	g.Expect(store.Set(ctx, "a", []byte("1"), time.Minute)).To(gomega.Succeed())
+	g.Expect(store.Set(ctx, "b", []byte("2"), time.Hour)).To(gomega.Succeed())
+	value, ok, err := store.Get(ctx, "a")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(value).To(gomega.Equal([]byte("1")))
+
+	// The least recently used key is evicted
+	g.Expect(store.Set(ctx, "c", []byte("3"), time.Hour)).To(gomega.Succeed())
+	_, ok, _ = store.Get(ctx, "b")
+	g.Expect(ok).To(gomega.BeFalse())
+
+	// The expired keys are not returned
+	now = now.Add(2 * time.Minute)
+	_, ok, _ = store.Get(ctx, "a")
+	g.Expect(ok).To(gomega.BeFalse())
+	_, ok, _ = store.Get(ctx, "c")
+	g.Expect(ok).To(gomega.BeTrue())
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Store holds the cached responses until their TTL expires
+type Store interface {
+	// Get returns false when the key is not cached or expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// MemoryStore caches up to maxEntries responses in memory and evicts the least recently used ones first
+type MemoryStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    *list.List
+	keys       map[string]*list.Element
+	now        func() time.Time
+}
+
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{
+		maxEntries: maxEntries,
+		entries:    list.New(),
+		keys:       map[string]*list.Element{},
+		now:        time.Now,
+	}
+}
+
+func (m *MemoryStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	element, ok := m.keys[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*memoryEntry)
+	if !m.now().Before(entry.expires) {
+		m.entries.Remove(element)
+		delete(m.keys, key)
+		return nil, false, nil
+	}
+	m.entries.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+func (m *MemoryStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	expires := m.now().Add(ttl)
+	if element, ok := m.keys[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		entry.value = value
+		entry.expires = expires
+		m.entries.MoveToFront(element)
+		return nil
+	}
+	m.keys[key] = m.entries.PushFront(&memoryEntry{key: key, value: value, expires: expires})
+	for m.entries.Len() > m.maxEntries {
+		oldest := m.entries.Back()
+		m.entries.Remove(oldest)
+		delete(m.keys, oldest.Value.(*memoryEntry).key)
+	}
+	return nil
+}
+
+// RedisStore caches the responses in a Redis server shared by the replicas, Redis expires the keys
+type RedisStore struct {
+	Client redis.Cmdable
+}
+
+func NewRedisStore(address string, password string) *RedisStore {
+	return &RedisStore{
+		Client: redis.NewClient(&redis.Options{Addr: address, Password: password}),
+	}
+}
+
+func (r *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.Client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (r *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.Client.Set(ctx, key, value, ttl).Err()
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"knative.dev/serving/pkg/apis/autoscaling"
 
@@ -45,6 +46,10 @@ var (
 	InferenceServiceAPIName       = "inferenceservices"
 	InferenceServicePodLabelKey   = KServeAPIGroupName + "/" + InferenceServiceName
 	InferenceServiceConfigMapName = "inferenceservice-config"
+	// InferenceServiceNamespaceLabelKey labels the objects of an InferenceService which live outside of its namespace
+	InferenceServiceNamespaceLabelKey = KServeAPIGroupName + "/" + InferenceServiceName + "-namespace"
+	// ExperimentParentLabelKey labels the InferenceServices serving the variants of the experiments of an InferenceService
+	ExperimentParentLabelKey = KServeAPIGroupName + "/experiment-parent"
 )
 
 // TrainedModel Constants
@@ -71,12 +76,25 @@ var (
 	InferenceServiceGKEAcceleratorAnnotationKey = KServeAPIGroupName + "/gke-accelerator"
 	DeploymentMode                              = KServeAPIGroupName + "/deploymentMode"
 	EnableRoutingTagAnnotationKey               = KServeAPIGroupName + "/enable-tag-routing"
+	ApprovedCanaryRevisionAnnotationKey         = KServeAPIGroupName + "/approved-canary-revision"
+	CanaryDrainGracePeriodAnnotationKey         = KServeAPIGroupName + "/canary-drain-grace-period"
+	CanaryAnalysisWindowAnnotationKey           = KServeAPIGroupName + "/canary-analysis-window"
+	CanaryMaxErrorRateAnnotationKey             = KServeAPIGroupName + "/canary-max-error-rate"
+	CanaryMaxLatencyAnnotationKey               = KServeAPIGroupName + "/canary-max-latency"
+	CanaryMinRequestCountAnnotationKey          = KServeAPIGroupName + "/canary-min-request-count"
+	CanaryAnalysisWebhookAnnotationKey          = KServeAPIGroupName + "/canary-analysis-webhook"
+	RollbackAnnotationKey                       = KServeAPIGroupName + "/rollback"
+	BlueGreenRetentionAnnotationKey             = KServeAPIGroupName + "/blue-green-retention"
+	UserPortNameAnnotationKey                   = KServeAPIGroupName + "/user-port-name"
+	ModelReadinessProbeAnnotationKey            = KServeAPIGroupName + "/model-readiness-probe"
+	IngressDomainAnnotationKey                  = KServeAPIGroupName + "/ingress-domain"
 	AutoscalerClass                             = KServeAPIGroupName + "/autoscalerClass"
 	AutoscalerMetrics                           = KServeAPIGroupName + "/metrics"
 	TargetUtilizationPercentage                 = KServeAPIGroupName + "/targetUtilizationPercentage"
 	MinScaleAnnotationKey                       = KnativeAutoscalingAPIGroupName + "/minScale"
 	MaxScaleAnnotationKey                       = KnativeAutoscalingAPIGroupName + "/maxScale"
 	RollOutDurationAnnotationKey                = KnativeServingAPIGroupName + "/rollout-duration"
+	ProgressDeadlineAnnotationKey               = KnativeServingAPIGroupName + "/progress-deadline"
 	EnableMetricAggregation                     = KServeAPIGroupName + "/enable-metric-aggregation"
 	SetPrometheusAggregateAnnotation            = KServeAPIGroupName + "/enable-prometheus-aggregate-scraping"
 	KserveContainerPrometheusPortKey            = "prometheus.kserve.io/port"
@@ -91,22 +109,66 @@ var (
 var (
 	InferenceServiceInternalAnnotationsPrefix        = "internal." + KServeAPIGroupName
 	StorageInitializerSourceUriInternalAnnotationKey = InferenceServiceInternalAnnotationsPrefix + "/storage-initializer-sourceuri"
+	StorageInitializerSha256InternalAnnotationKey    = InferenceServiceInternalAnnotationsPrefix + "/storage-initializer-sha256"
+	StorageVerificationInternalAnnotationKey         = InferenceServiceInternalAnnotationsPrefix + "/storage-initializer-verification"
+	CanaryDrainStartInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/canary-drain-start"
+	CanaryAnalysisRevisionInternalAnnotationKey      = InferenceServiceInternalAnnotationsPrefix + "/canary-analysis-revision"
+	CanaryAnalysisStartInternalAnnotationKey         = InferenceServiceInternalAnnotationsPrefix + "/canary-analysis-start"
+	CanaryAnalysisResultInternalAnnotationKey        = InferenceServiceInternalAnnotationsPrefix + "/canary-analysis-result"
+	RetainedRevisionInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/retained-revision"
+	RetainStartInternalAnnotationKey                 = InferenceServiceInternalAnnotationsPrefix + "/retain-start"
+	RollbackRevisionInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/rollback-revision"
+	RollbackConfigHashInternalAnnotationKey          = InferenceServiceInternalAnnotationsPrefix + "/rollback-config-hash"
+	ModelMountPathInternalAnnotationKey              = InferenceServiceInternalAnnotationsPrefix + "/model-mount-path"
+	GPUResourceNameInternalAnnotationKey             = InferenceServiceInternalAnnotationsPrefix + "/gpu-resource-name"
 	StorageSpecAnnotationKey                         = InferenceServiceInternalAnnotationsPrefix + "/storage-spec"
 	StorageSpecParamAnnotationKey                    = InferenceServiceInternalAnnotationsPrefix + "/storage-spec-param"
 	StorageSpecKeyAnnotationKey                      = InferenceServiceInternalAnnotationsPrefix + "/storage-spec-key"
 	LoggerInternalAnnotationKey                      = InferenceServiceInternalAnnotationsPrefix + "/logger"
 	LoggerSinkUrlInternalAnnotationKey               = InferenceServiceInternalAnnotationsPrefix + "/logger-sink-url"
 	LoggerModeInternalAnnotationKey                  = InferenceServiceInternalAnnotationsPrefix + "/logger-mode"
+	LoggerSamplingPercentInternalAnnotationKey       = InferenceServiceInternalAnnotationsPrefix + "/logger-sampling-percent"
+	LoggerRedactInternalAnnotationKey                = InferenceServiceInternalAnnotationsPrefix + "/logger-redact"
+	LoggerKafkaBrokersInternalAnnotationKey          = InferenceServiceInternalAnnotationsPrefix + "/logger-kafka-brokers"
+	LoggerKafkaTopicInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/logger-kafka-topic"
+	LoggerKafkaSecretInternalAnnotationKey           = InferenceServiceInternalAnnotationsPrefix + "/logger-kafka-secret"
+	LoggerStorageUriInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/logger-storage-uri"
+	LoggerStorageBatchSizeInternalAnnotationKey      = InferenceServiceInternalAnnotationsPrefix + "/logger-storage-batch-size"
+	LoggerStorageFlushIntervalInternalAnnotationKey  = InferenceServiceInternalAnnotationsPrefix + "/logger-storage-flush-interval"
+	OutlierDetectorUrlInternalAnnotationKey          = InferenceServiceInternalAnnotationsPrefix + "/outlier-detector-url"
 	BatcherInternalAnnotationKey                     = InferenceServiceInternalAnnotationsPrefix + "/batcher"
 	BatcherMaxBatchSizeInternalAnnotationKey         = InferenceServiceInternalAnnotationsPrefix + "/batcher-max-batchsize"
 	BatcherMaxLatencyInternalAnnotationKey           = InferenceServiceInternalAnnotationsPrefix + "/batcher-max-latency"
 	BatcherTimeoutInternalAnnotationKey              = InferenceServiceInternalAnnotationsPrefix + "/batcher-timeout"
+	RequestQueueInternalAnnotationKey                = InferenceServiceInternalAnnotationsPrefix + "/request-queue"
+	RequestQueueMaxInFlightInternalAnnotationKey     = InferenceServiceInternalAnnotationsPrefix + "/request-queue-max-in-flight"
+	RequestQueueMaxDepthInternalAnnotationKey        = InferenceServiceInternalAnnotationsPrefix + "/request-queue-max-depth"
+	RequestQueueRetryAfterInternalAnnotationKey      = InferenceServiceInternalAnnotationsPrefix + "/request-queue-retry-after"
+	OpenAIInternalAnnotationKey                      = InferenceServiceInternalAnnotationsPrefix + "/openai"
+	OpenAIInputNameInternalAnnotationKey             = InferenceServiceInternalAnnotationsPrefix + "/openai-input-name"
+	OpenAIOutputNameInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/openai-output-name"
+	ResponseCacheInternalAnnotationKey               = InferenceServiceInternalAnnotationsPrefix + "/response-cache"
+	ResponseCacheTTLInternalAnnotationKey            = InferenceServiceInternalAnnotationsPrefix + "/response-cache-ttl"
+	ResponseCacheMaxEntriesInternalAnnotationKey     = InferenceServiceInternalAnnotationsPrefix + "/response-cache-max-entries"
+	ResponseCacheRedisAddressInternalAnnotationKey   = InferenceServiceInternalAnnotationsPrefix + "/response-cache-redis-address"
+	ResponseCacheRedisSecretInternalAnnotationKey    = InferenceServiceInternalAnnotationsPrefix + "/response-cache-redis-secret"
+	TracingEndpointInternalAnnotationKey             = InferenceServiceInternalAnnotationsPrefix + "/tracing-endpoint"
+	TracingSamplingPercentInternalAnnotationKey      = InferenceServiceInternalAnnotationsPrefix + "/tracing-sampling-percent"
+	AuthenticationAudiencesInternalAnnotationKey     = InferenceServiceInternalAnnotationsPrefix + "/authentication-audiences"
+	AuthenticationIssuerInternalAnnotationKey        = InferenceServiceInternalAnnotationsPrefix + "/authentication-issuer"
 	AgentShouldInjectAnnotationKey                   = InferenceServiceInternalAnnotationsPrefix + "/agent"
 	AgentModelConfigVolumeNameAnnotationKey          = InferenceServiceInternalAnnotationsPrefix + "/configVolumeName"
 	AgentModelConfigMountPathAnnotationKey           = InferenceServiceInternalAnnotationsPrefix + "/configMountPath"
 	AgentModelDirAnnotationKey                       = InferenceServiceInternalAnnotationsPrefix + "/modelDir"
 	PredictorHostAnnotationKey                       = InferenceServiceInternalAnnotationsPrefix + "/predictor-host"
 	PredictorProtocolAnnotationKey                   = InferenceServiceInternalAnnotationsPrefix + "/predictor-protocol"
+	// The status annotations record the Istio policies created for the InferenceService, so that they are only looked
+	// up for deletion when they may exist
+	PeerAuthenticationStatusAnnotationKey  = InferenceServiceInternalAnnotationsPrefix + "/peer-authentication"
+	AuthorizationPolicyStatusAnnotationKey = InferenceServiceInternalAnnotationsPrefix + "/authorization-policies"
+	// ExperimentSpecHashAnnotationKey records the hash of the desired variant InferenceService, the spec of the variant
+	// is defaulted by the webhook so it cannot be compared with the desired spec
+	ExperimentSpecHashAnnotationKey = InferenceServiceInternalAnnotationsPrefix + "/experiment-spec-hash"
 )
 
 // StorageSpec Constants
@@ -117,8 +179,12 @@ var (
 
 // Controller Constants
 var (
-	ControllerLabelName = KServeName + "-controller-manager"
-	DefaultMinReplicas  = 1
+	ControllerLabelName           = KServeName + "-controller-manager"
+	DefaultMinReplicas            = 1
+	DefaultCanaryDrainGracePeriod = 30 * time.Second
+	DefaultCanaryMinRequestCount  = 10
+	DefaultBlueGreenRetention     = 10 * time.Minute
+	RevisionHistoryLimit          = 10
 )
 
 type AutoscalerClassType string
@@ -137,7 +203,8 @@ var (
 
 // Autoscaler Class
 var (
-	AutoscalerClassHPA AutoscalerClassType = "hpa"
+	AutoscalerClassHPA  AutoscalerClassType = "hpa"
+	AutoscalerClassKEDA AutoscalerClassType = "keda"
 )
 
 // Autoscaler Metrics
@@ -150,15 +217,39 @@ var (
 	AutoScalerMetricsMemory AutoscalerMetricsType = "memory"
 )
 
+// Autoscaler GPU metrics, scaled on the DCGM metrics served by a Prometheus adapter
+var (
+	AutoScalerMetricsGPU       AutoscalerMetricsType = "gpu"
+	AutoScalerMetricsGPUMemory AutoscalerMetricsType = "gpu-memory"
+)
+
+// Autoscaler queue depth metric, scaled on the request queue depth exported by the agent
+var (
+	AutoScalerMetricsQueueDepth AutoscalerMetricsType = "queue-depth"
+)
+
+// GPU custom metric names exposed per pod by the Prometheus adapter rules in config/prometheus-adapter
+const (
+	GPUUtilizationMetricName       = "kserve_gpu_utilization"
+	GPUMemoryUtilizationMetricName = "kserve_gpu_memory_utilization"
+)
+
+// QueueDepthMetricName is the request queue depth exported by the agent and exposed per pod by the Prometheus adapter
+const QueueDepthMetricName = "kserve_request_queue_depth"
+
 // Autoscaler Class Allowed List
 var AutoscalerAllowedClassList = []AutoscalerClassType{
 	AutoscalerClassHPA,
+	AutoscalerClassKEDA,
 }
 
 // Autoscaler Metrics Allowed List
 var AutoscalerAllowedMetricsList = []AutoscalerMetricsType{
 	AutoScalerMetricsCPU,
 	AutoScalerMetricsMemory,
+	AutoScalerMetricsGPU,
+	AutoScalerMetricsGPUMemory,
+	AutoScalerMetricsQueueDepth,
 }
 
 // Autoscaler KPA Metrics Allowed List
@@ -170,6 +261,8 @@ var AutoScalerKPAMetricsAllowedList = []AutoScalerKPAMetricsType{
 // Autoscaler Default Metrics Value
 var (
 	DefaultCPUUtilization int32 = 80
+	DefaultGPUUtilization int32 = 80
+	DefaultQueueDepth     int32 = 10
 )
 
 // Webhook Constants
@@ -185,6 +278,8 @@ const (
 // InferenceService Environment Variables
 const (
 	CustomSpecStorageUriEnvVarKey                     = "STORAGE_URI"
+	StorageSha256EnvVarKey                            = "STORAGE_SHA256"
+	StorageVerificationEnvVarKey                      = "STORAGE_VERIFICATION"
 	CustomSpecProtocolEnvVarKey                       = "PROTOCOL"
 	CustomSpecMultiModelServerEnvVarKey               = "MULTI_MODEL_SERVER"
 	KServeContainerPrometheusMetricsPortEnvVarKey     = "KSERVE_CONTAINER_PROMETHEUS_METRICS_PORT"
@@ -203,17 +298,26 @@ const (
 	KnativeLocalGateway   = "knative-serving/knative-local-gateway"
 	KnativeIngressGateway = "knative-serving/knative-ingress-gateway"
 	VisibilityLabel       = "serving.knative.dev/visibility"
+	ClusterLocal          = "cluster-local"
+	KnativeHTTP1PortName  = "http1"
+	KnativeH2CPortName    = "h2c"
 )
 
+// GRPCAppProtocol is the application protocol of the raw deployment service ports serving gRPC, Istio routes them as
+// HTTP/2 instead of sniffing the protocol
+const GRPCAppProtocol = "grpc"
+
 var (
 	LocalGatewayHost = "knative-local-gateway.istio-system.svc." + network.GetClusterDomainName()
 )
 
 // InferenceService Component enums
 const (
-	Predictor   InferenceServiceComponent = "predictor"
-	Explainer   InferenceServiceComponent = "explainer"
-	Transformer InferenceServiceComponent = "transformer"
+	Predictor       InferenceServiceComponent = "predictor"
+	Explainer       InferenceServiceComponent = "explainer"
+	Transformer     InferenceServiceComponent = "transformer"
+	OutlierDetector InferenceServiceComponent = "outlier-detector"
+	DriftDetector   InferenceServiceComponent = "drift-detector"
 )
 
 // InferenceService verb enums
@@ -236,6 +340,7 @@ const (
 	InferenceServiceDefaultHttpPort     = "8080"
 	InferenceServiceDefaultAgentPortStr = "9081"
 	InferenceServiceDefaultAgentPort    = 9081
+	InferenceServiceAgentMetricsPort    = 9089
 	CommonDefaultHttpPort               = 80
 )
 
@@ -266,6 +371,9 @@ const (
 	ArgumentPredictorHost  = "--predictor_host"
 	ArgumentHttpPort       = "--http_port"
 	ArgumentWorkers        = "--workers"
+	ArgumentStorageUri     = "--storage_uri"
+	ArgumentDriftBatchSize = "--drift_batch_size"
+	ArgumentEventSink      = "--event_sink"
 )
 
 // InferenceService container name
@@ -285,11 +393,25 @@ const (
 	ModelDir              = DefaultModelLocalMountPath
 )
 
+// The secret of the Kafka sink of the payload logger is mounted into the agent
+const (
+	LoggerKafkaSecretVolumeName = "logger-kafka-secret"
+	LoggerKafkaSecretDir        = "/mnt/logger-kafka-secret"
+)
+
+// The password of the Redis server of the response cache is read by the agent from the password key of the secret
+const (
+	ResponseCacheRedisPasswordEnvVar    = "RESPONSE_CACHE_REDIS_PASSWORD"
+	ResponseCacheRedisPasswordSecretKey = "password"
+)
+
 var (
 	ServiceAnnotationDisallowedList = []string{
 		autoscaling.MinScaleAnnotationKey,
 		autoscaling.MaxScaleAnnotationKey,
 		StorageInitializerSourceUriInternalAnnotationKey,
+		StorageInitializerSha256InternalAnnotationKey,
+		StorageVerificationInternalAnnotationKey,
 		"kubectl.kubernetes.io/last-applied-configuration",
 	}
 
@@ -318,15 +440,16 @@ const (
 
 // built-in runtime servers
 const (
-	SKLearnServer = "kserve-sklearnserver"
-	MLServer      = "kserve-mlserver"
-	TFServing     = "kserve-tensorflow-serving"
-	XGBServer     = "kserve-xgbserver"
-	TorchServe    = "kserve-torchserve"
-	TritonServer  = "kserve-tritonserver"
-	PMMLServer    = "kserve-pmmlserver"
-	LGBServer     = "kserve-lgbserver"
-	PaddleServer  = "kserve-paddleserver"
+	SKLearnServer     = "kserve-sklearnserver"
+	MLServer          = "kserve-mlserver"
+	TFServing         = "kserve-tensorflow-serving"
+	XGBServer         = "kserve-xgbserver"
+	TorchServe        = "kserve-torchserve"
+	TritonServer      = "kserve-tritonserver"
+	PMMLServer        = "kserve-pmmlserver"
+	LGBServer         = "kserve-lgbserver"
+	PaddleServer      = "kserve-paddleserver"
+	HuggingFaceServer = "kserve-huggingfaceserver"
 )
 
 const (
@@ -350,16 +473,17 @@ const (
 
 // supported model type
 const (
-	SupportedModelSKLearn    = "sklearn"
-	SupportedModelTensorflow = "tensorflow"
-	SupportedModelXGBoost    = "xgboost"
-	SupportedModelPyTorch    = "pytorch"
-	SupportedModelONNX       = "onnx"
-	SupportedModelPMML       = "pmml"
-	SupportedModelLightGBM   = "lightgbm"
-	SupportedModelPaddle     = "paddle"
-	SupportedModelTriton     = "triton"
-	SupportedModelMLFlow     = "mlflow"
+	SupportedModelSKLearn     = "sklearn"
+	SupportedModelTensorflow  = "tensorflow"
+	SupportedModelXGBoost     = "xgboost"
+	SupportedModelPyTorch     = "pytorch"
+	SupportedModelONNX        = "onnx"
+	SupportedModelPMML        = "pmml"
+	SupportedModelLightGBM    = "lightgbm"
+	SupportedModelPaddle      = "paddle"
+	SupportedModelTriton      = "triton"
+	SupportedModelMLFlow      = "mlflow"
+	SupportedModelHuggingFace = "huggingface"
 )
 
 type ProtocolVersion int
@@ -379,12 +503,24 @@ const (
 	RawDeploymentAppLabel = "app"
 )
 
+// warm pool labels, an activated warm pool pod is released from the warm pool and selected by the component service
+var (
+	WarmPoolLabel          = KServeAPIGroupName + "/warm-pool"
+	WarmPoolActivatedLabel = KServeAPIGroupName + "/warm-pool-activated"
+)
+
 // container state reason
 const (
 	StateReasonRunning          = "Running"
 	StateReasonCompleted        = "Completed"
 	StateReasonError            = "Error"
 	StateReasonCrashLoopBackOff = "CrashLoopBackOff"
+	// The container cannot start
+	StateReasonErrImagePull               = "ErrImagePull"
+	StateReasonImagePullBackOff           = "ImagePullBackOff"
+	StateReasonInvalidImageName           = "InvalidImageName"
+	StateReasonCreateContainerConfigError = "CreateContainerConfigError"
+	StateReasonCreateContainerError       = "CreateContainerError"
 )
 
 // GetRawServiceLabel generate native service label
@@ -392,6 +528,11 @@ func GetRawServiceLabel(service string) string {
 	return "isvc." + service
 }
 
+// WarmPoolName returns the name of the warm pool deployment of a raw deployment component
+func WarmPoolName(name string) string {
+	return name + "-warm"
+}
+
 func (e InferenceServiceComponent) String() string {
 	return string(e)
 }
@@ -443,6 +584,14 @@ func CanaryTransformerServiceName(name string) string {
 	return name + "-" + string(Transformer) + "-" + InferenceServiceCanary
 }
 
+func DefaultOutlierDetectorServiceName(name string) string {
+	return name + "-" + string(OutlierDetector) + "-" + InferenceServiceDefault
+}
+
+func DefaultDriftDetectorServiceName(name string) string {
+	return name + "-" + string(DriftDetector) + "-" + InferenceServiceDefault
+}
+
 func DefaultServiceName(name string, component InferenceServiceComponent) string {
 	return name + "-" + component.String() + "-" + InferenceServiceDefault
 }
@@ -469,6 +618,20 @@ func PredictPath(name string, protocol InferenceServiceProtocol) string {
 	return path
 }
 
+// V2HealthReadyPath is the server readiness endpoint of the open inference protocol
+const V2HealthReadyPath = "/v2/health/ready"
+
+// ModelReadyPath is the endpoint of the protocol which only succeeds once the model is loaded
+func ModelReadyPath(name string, protocol InferenceServiceProtocol) string {
+	path := ""
+	if protocol == ProtocolV1 {
+		path = fmt.Sprintf("/v1/models/%s", name)
+	} else if protocol == ProtocolV2 {
+		path = fmt.Sprintf("/v2/models/%s/ready", name)
+	}
+	return path
+}
+
 func ExplainPath(name string) string {
 	return fmt.Sprintf("/v1/models/%s:explain", name)
 }
@@ -481,6 +644,11 @@ func ExplainPrefix() string {
 	return fmt.Sprintf("^/v1/models/[\\w-]+:explain$")
 }
 
+// ExplainOrAdversarialPrefix matches the verbs served by the ART explainer
+func ExplainOrAdversarialPrefix() string {
+	return fmt.Sprintf("^/v1/models/[\\w-]+:(explain|adversarial)$")
+}
+
 func VirtualServiceHostname(name string, predictorHostName string) string {
 	index := strings.Index(predictorHostName, ".")
 	return name + predictorHostName[index:]
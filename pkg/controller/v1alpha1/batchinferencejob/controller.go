@@ -0,0 +1,305 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=batchinferencejobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=batchinferencejobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+package batchinferencejob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1alpha1api "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/batchworker"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/credentials"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// BatchInferenceJobLabel is set on the worker pods to the name of their BatchInferenceJob
+var BatchInferenceJobLabel = constants.KServeAPIGroupName + "/batchinferencejob"
+
+const (
+	// BatchWorkerConfigMapKeyName is the key of the worker config in the inferenceservice configmap
+	BatchWorkerConfigMapKeyName = "batchWorker"
+	// InvalidSpec is the reason of a BatchInferenceJob whose workers cannot be started
+	InvalidSpec = "InvalidSpec"
+	// WaitingForInferenceService is the reason of a BatchInferenceJob whose InferenceService is not ready
+	WaitingForInferenceService = "WaitingForInferenceService"
+	// Running is the reason of a BatchInferenceJob whose workers are scoring the records
+	Running = "Running"
+	// WorkersFailed is the reason of a BatchInferenceJob whose workers failed more often than they are retried
+	WorkersFailed = "WorkersFailed"
+	// the records are downloaded to and the responses are written to the emptyDir volume of the worker
+	workerContainerName = "batch-worker"
+	workDirVolumeName   = "batch-work-dir"
+	workDirMountPath    = "/mnt/batch"
+	// the workers are started once the InferenceService is ready, it is checked again after the interval
+	inferenceServiceRequeueInterval = 30 * time.Second
+)
+
+type BatchWorkerConfig struct {
+	Image         string `json:"image"`
+	CpuRequest    string `json:"cpuRequest"`
+	CpuLimit      string `json:"cpuLimit"`
+	MemoryRequest string `json:"memoryRequest"`
+	MemoryLimit   string `json:"memoryLimit"`
+}
+
+// BatchInferenceJobReconciler reconciles a BatchInferenceJob object
+type BatchInferenceJobReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+func (r *BatchInferenceJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	job := &v1alpha1api.BatchInferenceJob{}
+	if err := r.Get(ctx, req.NamespacedName, job); err != nil {
+		if apierr.IsNotFound(err) {
+			// The workers are garbage collected with their owner
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	existing := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: job.Namespace, Name: workerJobName(job)}, existing)
+	if err == nil {
+		propagateStatus(&job.Status, &existing.Status)
+		return reconcile.Result{}, r.updateStatus(job)
+	}
+	if !apierr.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+	// The workers of a finished job are not started again when they are deleted
+	if condition := job.Status.GetCondition(apis.ConditionSucceeded); condition != nil && condition.Status != v1.ConditionUnknown {
+		return reconcile.Result{}, nil
+	}
+
+	r.Log.Info("Reconciling batch inference job", "job", job.Name, "inferenceService", job.Spec.InferenceService)
+	if err := validateSpec(job); err != nil {
+		setSucceededCondition(&job.Status, v1.ConditionFalse, InvalidSpec, err.Error())
+		return reconcile.Result{}, r.updateStatus(job)
+	}
+	isvc := &v1beta1.InferenceService{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: job.Namespace, Name: job.Spec.InferenceService}, isvc)
+	if err != nil && !apierr.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+	if err != nil || !isvc.Status.IsReady() || isvc.Status.Address == nil || isvc.Status.Address.URL == nil {
+		setSucceededCondition(&job.Status, v1.ConditionUnknown, WaitingForInferenceService,
+			fmt.Sprintf("InferenceService %s is not ready", job.Spec.InferenceService))
+		return reconcile.Result{RequeueAfter: inferenceServiceRequeueInterval}, r.updateStatus(job)
+	}
+
+	configMap := &v1.ConfigMap{}
+	err = r.Get(ctx, types.NamespacedName{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace}, configMap)
+	if err != nil {
+		r.Log.Error(err, "Failed to find config map", "name", constants.InferenceServiceConfigMapName)
+		return reconcile.Result{}, err
+	}
+	workerConfig, err := getBatchWorkerConfig(configMap)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	predictURL := isvc.Status.Address.URL.String() +
+		constants.PredictPath(isvc.Name, isvc.Spec.Predictor.GetImplementation().GetProtocol())
+	desired := createWorkerJob(job, predictURL, workerConfig)
+	credentialBuilder := credentials.NewCredentialBulder(r.Client, configMap)
+	if err := credentialBuilder.CreateSecretVolumeAndEnv(job.Namespace, job.Spec.ServiceAccountName,
+		&desired.Spec.Template.Spec.Containers[0], &desired.Spec.Template.Spec.Volumes); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := controllerutil.SetControllerReference(job, desired, r.Scheme); err != nil {
+		return reconcile.Result{}, err
+	}
+	r.Log.Info("Creating batch workers", "namespace", desired.Namespace, "name", desired.Name, "url", predictURL)
+	if err := r.Create(ctx, desired); err != nil {
+		return reconcile.Result{}, err
+	}
+	propagateStatus(&job.Status, &desired.Status)
+	return reconcile.Result{}, r.updateStatus(job)
+}
+
+func validateSpec(job *v1alpha1api.BatchInferenceJob) error {
+	if job.Spec.InputUri == "" || job.Spec.InferenceService == "" {
+		return fmt.Errorf("inputUri and inferenceService are required")
+	}
+	if _, ok := batchworker.OutputProtocol(job.Spec.OutputUri); !ok {
+		return fmt.Errorf("outputUri %q is not supported, supported protocols are %v", job.Spec.OutputUri,
+			batchworker.OutputProtocols)
+	}
+	if job.GetParallelism() < 1 {
+		return fmt.Errorf("parallelism must be positive")
+	}
+	return nil
+}
+
+func getBatchWorkerConfig(configMap *v1.ConfigMap) (*BatchWorkerConfig, error) {
+	config := &BatchWorkerConfig{}
+	if value, ok := configMap.Data[BatchWorkerConfigMapKeyName]; ok {
+		if err := json.Unmarshal([]byte(value), config); err != nil {
+			return nil, fmt.Errorf("Unable to unmarshall %v json string due to %v ", BatchWorkerConfigMapKeyName, err)
+		}
+	}
+	if config.Image == "" {
+		return nil, fmt.Errorf("the image of the %v config is not set", BatchWorkerConfigMapKeyName)
+	}
+	for _, key := range []string{config.CpuRequest, config.CpuLimit, config.MemoryRequest, config.MemoryLimit} {
+		if _, err := resource.ParseQuantity(key); err != nil {
+			return nil, fmt.Errorf("Failed to parse resource configuration for %q: %q", BatchWorkerConfigMapKeyName, err.Error())
+		}
+	}
+	return config, nil
+}
+
+func workerJobName(job *v1alpha1api.BatchInferenceJob) string {
+	return job.Name + "-worker"
+}
+
+// createWorkerJob creates an indexed job running a worker per index, every worker scores the range of the records of
+// its index and is retried by the job when it fails
+func createWorkerJob(job *v1alpha1api.BatchInferenceJob, predictURL string, config *BatchWorkerConfig) *batchv1.Job {
+	parallelism := job.GetParallelism()
+	completionMode := batchv1.IndexedCompletion
+	labels := map[string]string{BatchInferenceJobLabel: job.Name}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workerJobName(job),
+			Namespace: job.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			Parallelism:    &parallelism,
+			Completions:    &parallelism,
+			CompletionMode: &completionMode,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					RestartPolicy:      v1.RestartPolicyNever,
+					ServiceAccountName: job.Spec.ServiceAccountName,
+					Containers: []v1.Container{{
+						Name:  workerContainerName,
+						Image: config.Image,
+						Args: []string{
+							"--input-uri", job.Spec.InputUri,
+							"--output-uri", job.Spec.OutputUri,
+							"--predict-url", predictURL,
+							"--shards", strconv.Itoa(int(parallelism)),
+							"--work-dir", workDirMountPath,
+						},
+						Resources: v1.ResourceRequirements{
+							Limits: v1.ResourceList{
+								v1.ResourceCPU:    resource.MustParse(config.CpuLimit),
+								v1.ResourceMemory: resource.MustParse(config.MemoryLimit),
+							},
+							Requests: v1.ResourceList{
+								v1.ResourceCPU:    resource.MustParse(config.CpuRequest),
+								v1.ResourceMemory: resource.MustParse(config.MemoryRequest),
+							},
+						},
+						TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+						VolumeMounts:             []v1.VolumeMount{{Name: workDirVolumeName, MountPath: workDirMountPath}},
+					}},
+					Volumes: []v1.Volume{{
+						Name:         workDirVolumeName,
+						VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// propagateStatus reports the progress of the workers, the job succeeds once every worker wrote its responses
+func propagateStatus(status *v1alpha1api.BatchInferenceJobStatus, jobStatus *batchv1.JobStatus) {
+	status.ActiveWorkers = jobStatus.Active
+	status.SucceededWorkers = jobStatus.Succeeded
+	status.FailedWorkers = jobStatus.Failed
+	status.StartTime = jobStatus.StartTime
+	status.CompletionTime = jobStatus.CompletionTime
+	for _, condition := range jobStatus.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			setSucceededCondition(status, v1.ConditionTrue, "", "")
+			return
+		case batchv1.JobFailed:
+			setSucceededCondition(status, v1.ConditionFalse, WorkersFailed, condition.Message)
+			return
+		}
+	}
+	setSucceededCondition(status, v1.ConditionUnknown, Running,
+		fmt.Sprintf("%d workers are running, %d workers succeeded", jobStatus.Active, jobStatus.Succeeded))
+}
+
+func setSucceededCondition(status *v1alpha1api.BatchInferenceJobStatus, conditionStatus v1.ConditionStatus,
+	reason string, message string) {
+	status.SetConditions(apis.Conditions{{
+		Type:    apis.ConditionSucceeded,
+		Status:  conditionStatus,
+		Reason:  reason,
+		Message: message,
+	}})
+}
+
+func (r *BatchInferenceJobReconciler) updateStatus(desired *v1alpha1api.BatchInferenceJob) error {
+	existing := &v1alpha1api.BatchInferenceJob{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing); err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(existing.Status, desired.Status) {
+		return nil
+	}
+	if err := r.Status().Update(context.TODO(), desired); err != nil {
+		r.Log.Error(err, "Failed to update BatchInferenceJob status", "BatchInferenceJob", desired.Name)
+		r.Recorder.Eventf(desired, v1.EventTypeWarning, "UpdateFailed",
+			"Failed to update status for BatchInferenceJob %q: %v", desired.Name, err)
+		return errors.Wrapf(err, "fails to update BatchInferenceJob status")
+	}
+	return nil
+}
+
+func (r *BatchInferenceJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1api.BatchInferenceJob{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
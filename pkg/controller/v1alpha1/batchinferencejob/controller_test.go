@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchinferencejob
+
+import (
+	"testing"
+
+	v1alpha1api "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestCreateWorkerJob(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &BatchWorkerConfig{
+		Image:         "kserve/batch-worker:v0.9.0",
+		CpuRequest:    "100m",
+		CpuLimit:      "1",
+		MemoryRequest: "100Mi",
+		MemoryLimit:   "1Gi",
+	}
+	parallelism := int32(4)
+	job := &v1alpha1api.BatchInferenceJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "scores", Namespace: "default"},
+		Spec: v1alpha1api.BatchInferenceJobSpec{
+			InputUri:           "s3://records/2022-10",
+			OutputUri:          "s3://scores/2022-10",
+			InferenceService:   "sklearn-iris",
+			Parallelism:        &parallelism,
+			ServiceAccountName: "records",
+		},
+	}
+	predictURL := "http://sklearn-iris.default/v1/models/sklearn-iris:predict"
+
+	workers := createWorkerJob(job, predictURL, config)
+	g.Expect(workers.Name).To(gomega.Equal("scores-worker"))
+	g.Expect(*workers.Spec.CompletionMode).To(gomega.Equal(batchv1.IndexedCompletion))
+	g.Expect(*workers.Spec.Parallelism).To(gomega.Equal(int32(4)))
+	g.Expect(*workers.Spec.Completions).To(gomega.Equal(int32(4)))
+	podSpec := workers.Spec.Template.Spec
+	g.Expect(workers.Spec.Template.Labels).To(gomega.HaveKeyWithValue(BatchInferenceJobLabel, "scores"))
+	g.Expect(podSpec.RestartPolicy).To(gomega.Equal(v1.RestartPolicyNever))
+	g.Expect(podSpec.ServiceAccountName).To(gomega.Equal("records"))
+	g.Expect(podSpec.Containers).To(gomega.HaveLen(1))
+	container := podSpec.Containers[0]
+	g.Expect(container.Image).To(gomega.Equal(config.Image))
+	g.Expect(container.Args).To(gomega.Equal([]string{
+		"--input-uri", "s3://records/2022-10",
+		"--output-uri", "s3://scores/2022-10",
+		"--predict-url", predictURL,
+		"--shards", "4",
+		"--work-dir", workDirMountPath,
+	}))
+	g.Expect(podSpec.Volumes[0].EmptyDir).NotTo(gomega.BeNil())
+}
+
+func TestValidateSpec(t *testing.T) {
+	zero := int32(0)
+	scenarios := map[string]struct {
+		spec    v1alpha1api.BatchInferenceJobSpec
+		matcher gomega.OmegaMatcher
+	}{
+		"Valid": {
+			spec:    v1alpha1api.BatchInferenceJobSpec{InputUri: "gs://records", OutputUri: "gs://scores", InferenceService: "iris"},
+			matcher: gomega.BeNil(),
+		},
+		"UnsupportedOutputUri": {
+			spec:    v1alpha1api.BatchInferenceJobSpec{InputUri: "gs://records", OutputUri: "pvc://scores", InferenceService: "iris"},
+			matcher: gomega.HaveOccurred(),
+		},
+		"ZeroParallelism": {
+			spec: v1alpha1api.BatchInferenceJobSpec{InputUri: "gs://records", OutputUri: "gs://scores", InferenceService: "iris",
+				Parallelism: &zero},
+			matcher: gomega.HaveOccurred(),
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			err := validateSpec(&v1alpha1api.BatchInferenceJob{Spec: scenario.spec})
+			g.Expect(err).To(scenario.matcher)
+		})
+	}
+}
+
+func TestPropagateStatus(t *testing.T) {
+	scenarios := map[string]struct {
+		jobStatus         batchv1.JobStatus
+		expectedSucceeded v1.ConditionStatus
+	}{
+		"Running": {
+			jobStatus:         batchv1.JobStatus{Active: 3, Succeeded: 1},
+			expectedSucceeded: v1.ConditionUnknown,
+		},
+		"Complete": {
+			jobStatus: batchv1.JobStatus{Succeeded: 4, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: v1.ConditionTrue},
+			}},
+			expectedSucceeded: v1.ConditionTrue,
+		},
+		"Failed": {
+			jobStatus: batchv1.JobStatus{Succeeded: 3, Failed: 7, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Message: "Job has reached the specified backoff limit"},
+			}},
+			expectedSucceeded: v1.ConditionFalse,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			status := &v1alpha1api.BatchInferenceJobStatus{}
+			propagateStatus(status, &scenario.jobStatus)
+			g.Expect(status.ActiveWorkers).To(gomega.Equal(scenario.jobStatus.Active))
+			g.Expect(status.SucceededWorkers).To(gomega.Equal(scenario.jobStatus.Succeeded))
+			g.Expect(status.FailedWorkers).To(gomega.Equal(scenario.jobStatus.Failed))
+			g.Expect(status.GetCondition(apis.ConditionSucceeded).Status).To(gomega.Equal(scenario.expectedSucceeded))
+		})
+	}
+}
@@ -146,6 +146,17 @@ func (r *InferenceGraphReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 				}
 			}
 		}
+		// the custom aggregation service of an ensemble node is resolved like the steps
+		if aggregation := router.Aggregation; aggregation != nil && aggregation.ServiceName != "" && aggregation.ServiceURL == "" {
+			isvc := v1beta1.InferenceService{}
+			err := r.Client.Get(ctx, types.NamespacedName{Namespace: graph.Namespace, Name: aggregation.ServiceName}, &isvc)
+			if err == nil && isvc.Status.Address != nil && isvc.Status.Address.URL != nil {
+				aggregation.ServiceURL = isvc.Status.Address.URL.String()
+			} else {
+				r.Log.Info("aggregation service is not ready", "name", aggregation.ServiceName)
+				return reconcile.Result{Requeue: true}, errors.Wrapf(err, "aggregation service %s is not ready", aggregation.ServiceName)
+			}
+		}
 	}
 	deployConfig, err := v1beta1api.NewDeployConfig(r.Client)
 	if err != nil {
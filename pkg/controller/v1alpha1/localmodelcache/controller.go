@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=localmodelcaches,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=localmodelcaches/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+package localmodelcache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1alpha1api "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/credentials"
+	"github.com/kserve/kserve/pkg/webhook/admission/pod"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// LocalModelCacheLabel is set on the cache pods to the name of their LocalModelCache
+var LocalModelCacheLabel = constants.KServeAPIGroupName + "/localmodelcache"
+
+const (
+	// CacheNotConfigured is the reason of a LocalModelCache which is not ready because the node cache is disabled
+	CacheNotConfigured = "CacheNotConfigured"
+	// CachingModel is the reason of a LocalModelCache which is not ready because nodes are still downloading the model
+	CachingModel = "CachingModel"
+	// the download is finished by the storage initializer, the cache container only keeps the pod running
+	cacheContainerName = "model-cache"
+)
+
+// LocalModelCacheReconciler reconciles a LocalModelCache object
+type LocalModelCacheReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+func (r *LocalModelCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	cache := &v1alpha1api.LocalModelCache{}
+	if err := r.Get(ctx, req.NamespacedName, cache); err != nil {
+		if apierr.IsNotFound(err) {
+			// The daemon set is garbage collected with its owner
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	r.Log.Info("Reconciling local model cache", "cache", cache.Name, "uri", cache.Spec.SourceModelUri)
+	configMap := &v1.ConfigMap{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace}, configMap)
+	if err != nil {
+		r.Log.Error(err, "Failed to find config map", "name", constants.InferenceServiceConfigMapName)
+		return reconcile.Result{}, err
+	}
+	storageInitializerConfig, err := getStorageInitializerConfig(configMap)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if storageInitializerConfig.CacheHostPath == "" {
+		cache.Status.SetConditions(apis.Conditions{{
+			Type:    apis.ConditionReady,
+			Status:  v1.ConditionFalse,
+			Reason:  CacheNotConfigured,
+			Message: "cacheHostPath is not set in the storageInitializer config",
+		}})
+		return reconcile.Result{}, r.updateStatus(cache)
+	}
+
+	desired, err := createDaemonSet(cache, storageInitializerConfig)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	credentialBuilder := credentials.NewCredentialBulder(r.Client, configMap)
+	if err := credentialBuilder.CreateSecretVolumeAndEnv(cache.Namespace, cache.Spec.ServiceAccountName,
+		&desired.Spec.Template.Spec.InitContainers[0], &desired.Spec.Template.Spec.Volumes); err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := controllerutil.SetControllerReference(cache, desired, r.Scheme); err != nil {
+		return reconcile.Result{}, err
+	}
+	existing := &appsv1.DaemonSet{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, existing)
+	if apierr.IsNotFound(err) {
+		r.Log.Info("Creating model cache daemon set", "namespace", desired.Namespace, "name", desired.Name)
+		if err := r.Create(ctx, desired); err != nil {
+			return reconcile.Result{}, err
+		}
+		existing = desired
+	} else if err != nil {
+		return reconcile.Result{}, err
+	} else if !equality.Semantic.DeepDerivative(desired.Spec, existing.Spec) {
+		r.Log.Info("Updating model cache daemon set", "namespace", desired.Namespace, "name", desired.Name)
+		existing.Spec = desired.Spec
+		if err := r.Update(ctx, existing); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	propagateStatus(&cache.Status, &existing.Status)
+	return reconcile.Result{}, r.updateStatus(cache)
+}
+
+func getStorageInitializerConfig(configMap *v1.ConfigMap) (*pod.StorageInitializerConfig, error) {
+	config := &pod.StorageInitializerConfig{}
+	if value, ok := configMap.Data[pod.StorageInitializerConfigMapKeyName]; ok {
+		if err := json.Unmarshal([]byte(value), config); err != nil {
+			return nil, fmt.Errorf("Unable to unmarshall %v json string due to %v ", pod.StorageInitializerConfigMapKeyName, err)
+		}
+	}
+	for _, key := range []string{config.CpuRequest, config.CpuLimit, config.MemoryRequest, config.MemoryLimit} {
+		if _, err := resource.ParseQuantity(key); err != nil {
+			return nil, fmt.Errorf("Failed to parse resource configuration for %q: %q", pod.StorageInitializerConfigMapKeyName, err.Error())
+		}
+	}
+	if config.CacheSizeLimit != "" {
+		if _, err := resource.ParseQuantity(config.CacheSizeLimit); err != nil {
+			return nil, fmt.Errorf("Failed to parse cache size limit for %q: %q", pod.StorageInitializerConfigMapKeyName, err.Error())
+		}
+	}
+	if config.DownloadChunkSize != "" {
+		if _, err := resource.ParseQuantity(config.DownloadChunkSize); err != nil {
+			return nil, fmt.Errorf("Failed to parse download chunk size for %q: %q", pod.StorageInitializerConfigMapKeyName, err.Error())
+		}
+	}
+	return config, nil
+}
+
+// createDaemonSet creates a daemon set which runs the storage initializer on every selected node. The storage
+// initializer downloads the model into the node cache, from where the storage initializer of an InferenceService pod
+// on the same node links it instead of downloading it again.
+func createDaemonSet(cache *v1alpha1api.LocalModelCache, config *pod.StorageInitializerConfig) (*appsv1.DaemonSet, error) {
+	if cache.Spec.SourceModelUri == "" {
+		return nil, fmt.Errorf("sourceModelUri is required for local model cache %s", cache.Name)
+	}
+	image := pod.StorageInitializerContainerImage + ":" + pod.StorageInitializerContainerImageVersion
+	if config.Image != "" {
+		image = config.Image
+	}
+	resources := v1.ResourceRequirements{
+		Limits: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(config.CpuLimit),
+			v1.ResourceMemory: resource.MustParse(config.MemoryLimit),
+		},
+		Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(config.CpuRequest),
+			v1.ResourceMemory: resource.MustParse(config.MemoryRequest),
+		},
+	}
+	env := []v1.EnvVar{{Name: pod.ModelCacheDirEnvVarKey, Value: pod.ModelCacheMountPath}}
+	if config.CacheSizeLimit != "" {
+		sizeLimit := resource.MustParse(config.CacheSizeLimit)
+		env = append(env, v1.EnvVar{Name: pod.ModelCacheSizeLimitEnvVarKey, Value: fmt.Sprint(sizeLimit.Value())})
+	}
+	env = append(env, pod.DownloadEnvs(config)...)
+	hostPathType := v1.HostPathDirectoryOrCreate
+	labels := map[string]string{LocalModelCacheLabel: cache.Name}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cache.Name + "-" + cacheContainerName,
+			Namespace: cache.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					NodeSelector:       cache.Spec.NodeSelector,
+					Tolerations:        cache.Spec.Tolerations,
+					ServiceAccountName: cache.Spec.ServiceAccountName,
+					InitContainers: []v1.Container{{
+						Name:                     pod.StorageInitializerContainerName,
+						Image:                    image,
+						Args:                     []string{cache.Spec.SourceModelUri, constants.DefaultModelLocalMountPath},
+						Env:                      env,
+						Resources:                resources,
+						TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+						VolumeMounts: []v1.VolumeMount{
+							{Name: pod.ModelCacheVolumeName, MountPath: pod.ModelCacheMountPath},
+							{Name: pod.StorageInitializerVolumeName, MountPath: constants.DefaultModelLocalMountPath},
+						},
+					}},
+					Containers: []v1.Container{{
+						Name:    cacheContainerName,
+						Image:   image,
+						Command: []string{"sleep", "infinity"},
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceCPU:    resource.MustParse("10m"),
+								v1.ResourceMemory: resource.MustParse("16Mi"),
+							},
+						},
+					}},
+					Volumes: []v1.Volume{
+						{
+							Name: pod.ModelCacheVolumeName,
+							VolumeSource: v1.VolumeSource{
+								HostPath: &v1.HostPathVolumeSource{Path: config.CacheHostPath, Type: &hostPathType},
+							},
+						},
+						{
+							Name:         pod.StorageInitializerVolumeName,
+							VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// propagateStatus reports the nodes whose cache pod finished the download as cached
+func propagateStatus(status *v1alpha1api.LocalModelCacheStatus, daemonSetStatus *appsv1.DaemonSetStatus) {
+	status.DesiredNodes = daemonSetStatus.DesiredNumberScheduled
+	status.CachedNodes = daemonSetStatus.NumberReady
+	condition := apis.Condition{Type: apis.ConditionReady, Status: v1.ConditionTrue}
+	if status.CachedNodes < status.DesiredNodes || status.DesiredNodes == 0 {
+		condition.Status = v1.ConditionFalse
+		condition.Reason = CachingModel
+		condition.Message = fmt.Sprintf("model is cached on %d of %d nodes", status.CachedNodes, status.DesiredNodes)
+	}
+	status.SetConditions(apis.Conditions{condition})
+}
+
+func (r *LocalModelCacheReconciler) updateStatus(desired *v1alpha1api.LocalModelCache) error {
+	existing := &v1alpha1api.LocalModelCache{}
+	if err := r.Get(context.TODO(), types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing); err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(existing.Status, desired.Status) {
+		return nil
+	}
+	if err := r.Status().Update(context.TODO(), desired); err != nil {
+		r.Log.Error(err, "Failed to update LocalModelCache status", "LocalModelCache", desired.Name)
+		r.Recorder.Eventf(desired, v1.EventTypeWarning, "UpdateFailed",
+			"Failed to update status for LocalModelCache %q: %v", desired.Name, err)
+		return errors.Wrapf(err, "fails to update LocalModelCache status")
+	}
+	return nil
+}
+
+func (r *LocalModelCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1api.LocalModelCache{}).
+		Owns(&appsv1.DaemonSet{}).
+		Complete(r)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localmodelcache
+
+import (
+	"testing"
+
+	v1alpha1api "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/webhook/admission/pod"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestCreateDaemonSet(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &pod.StorageInitializerConfig{
+		Image:          "kserve/storage-initializer:v0.9.0",
+		CpuRequest:     "100m",
+		CpuLimit:       "1",
+		MemoryRequest:  "200Mi",
+		MemoryLimit:    "1Gi",
+		CacheHostPath:  "/var/cache/kserve",
+		CacheSizeLimit: "1Gi",
+	}
+	cache := &v1alpha1api.LocalModelCache{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: v1alpha1api.LocalModelCacheSpec{
+			SourceModelUri: "s3://models/llama",
+			NodeSelector:   map[string]string{"node.kubernetes.io/instance-type": "gpu"},
+			Tolerations: []v1.Toleration{
+				{Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+			},
+			ServiceAccountName: "models",
+		},
+	}
+
+	daemonSet, err := createDaemonSet(cache, config)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(daemonSet.Name).To(gomega.Equal("llama-model-cache"))
+	g.Expect(daemonSet.Spec.Selector.MatchLabels).To(gomega.Equal(daemonSet.Spec.Template.Labels))
+	podSpec := daemonSet.Spec.Template.Spec
+	g.Expect(podSpec.NodeSelector).To(gomega.Equal(cache.Spec.NodeSelector))
+	g.Expect(podSpec.Tolerations).To(gomega.Equal(cache.Spec.Tolerations))
+	g.Expect(podSpec.ServiceAccountName).To(gomega.Equal("models"))
+	g.Expect(podSpec.InitContainers).To(gomega.HaveLen(1))
+	initContainer := podSpec.InitContainers[0]
+	g.Expect(initContainer.Image).To(gomega.Equal(config.Image))
+	g.Expect(initContainer.Args).To(gomega.Equal([]string{"s3://models/llama", constants.DefaultModelLocalMountPath}))
+	g.Expect(initContainer.Env).To(gomega.ConsistOf(
+		v1.EnvVar{Name: pod.ModelCacheDirEnvVarKey, Value: pod.ModelCacheMountPath},
+		v1.EnvVar{Name: pod.ModelCacheSizeLimitEnvVarKey, Value: "1073741824"},
+	))
+	g.Expect(podSpec.Volumes[0].HostPath.Path).To(gomega.Equal("/var/cache/kserve"))
+
+	cache.Spec.SourceModelUri = ""
+	_, err = createDaemonSet(cache, config)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestPropagateStatus(t *testing.T) {
+	scenarios := map[string]struct {
+		daemonSetStatus appsv1.DaemonSetStatus
+		expectedReady   v1.ConditionStatus
+	}{
+		"Caching": {
+			daemonSetStatus: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 1},
+			expectedReady:   v1.ConditionFalse,
+		},
+		"Cached": {
+			daemonSetStatus: appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 3},
+			expectedReady:   v1.ConditionTrue,
+		},
+		"NoNodesSelected": {
+			daemonSetStatus: appsv1.DaemonSetStatus{},
+			expectedReady:   v1.ConditionFalse,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			status := &v1alpha1api.LocalModelCacheStatus{}
+			propagateStatus(status, &scenario.daemonSetStatus)
+			g.Expect(status.DesiredNodes).To(gomega.Equal(scenario.daemonSetStatus.DesiredNumberScheduled))
+			g.Expect(status.CachedNodes).To(gomega.Equal(scenario.daemonSetStatus.NumberReady))
+			g.Expect(status.GetCondition(apis.ConditionReady).Status).To(gomega.Equal(scenario.expectedReady))
+		})
+	}
+}
@@ -27,7 +27,10 @@ import (
 	"github.com/kserve/kserve/pkg/controller/v1alpha1/trainedmodel/sharding/memory"
 	v1beta1utils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	"github.com/kserve/kserve/pkg/credentials"
+	v1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/network"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // Component can be reconciled to create underlying resources for an InferenceService
@@ -63,6 +66,28 @@ func addLoggerAnnotations(logger *v1beta1.LoggerSpec, annotations map[string]str
 			annotations[constants.LoggerSinkUrlInternalAnnotationKey] = *logger.URL
 		}
 		annotations[constants.LoggerModeInternalAnnotationKey] = string(logger.Mode)
+		if logger.SamplingPercent != nil {
+			annotations[constants.LoggerSamplingPercentInternalAnnotationKey] = strconv.FormatInt(*logger.SamplingPercent, 10)
+		}
+		if len(logger.Redact) > 0 {
+			annotations[constants.LoggerRedactInternalAnnotationKey] = strings.Join(logger.Redact, ",")
+		}
+		if logger.Kafka != nil {
+			annotations[constants.LoggerKafkaBrokersInternalAnnotationKey] = strings.Join(logger.Kafka.Brokers, ",")
+			annotations[constants.LoggerKafkaTopicInternalAnnotationKey] = logger.Kafka.Topic
+			if logger.Kafka.SecretName != "" {
+				annotations[constants.LoggerKafkaSecretInternalAnnotationKey] = logger.Kafka.SecretName
+			}
+		}
+		if logger.Storage != nil {
+			annotations[constants.LoggerStorageUriInternalAnnotationKey] = logger.Storage.StorageUri
+			if logger.Storage.BatchSize != nil {
+				annotations[constants.LoggerStorageBatchSizeInternalAnnotationKey] = strconv.FormatInt(*logger.Storage.BatchSize, 10)
+			}
+			if logger.Storage.FlushIntervalSeconds != nil {
+				annotations[constants.LoggerStorageFlushIntervalInternalAnnotationKey] = strconv.FormatInt(*logger.Storage.FlushIntervalSeconds, 10)
+			}
+		}
 		return true
 	}
 	return false
@@ -89,6 +114,115 @@ func addBatcherAnnotations(batcher *v1beta1.Batcher, annotations map[string]stri
 	return false
 }
 
+func addRequestQueueAnnotations(requestQueue *v1beta1.RequestQueue, annotations map[string]string) bool {
+	if requestQueue == nil {
+		return false
+	}
+	annotations[constants.RequestQueueInternalAnnotationKey] = "true"
+	annotations[constants.RequestQueueMaxInFlightInternalAnnotationKey] = strconv.Itoa(requestQueue.MaxInFlight)
+	if requestQueue.MaxQueueDepth != nil {
+		annotations[constants.RequestQueueMaxDepthInternalAnnotationKey] = strconv.Itoa(*requestQueue.MaxQueueDepth)
+	}
+	if requestQueue.RetryAfterSeconds != nil {
+		annotations[constants.RequestQueueRetryAfterInternalAnnotationKey] = strconv.Itoa(*requestQueue.RetryAfterSeconds)
+	}
+	return true
+}
+
+func addOpenAIAnnotations(openAI *v1beta1.OpenAISpec, annotations map[string]string) bool {
+	if openAI == nil {
+		return false
+	}
+	annotations[constants.OpenAIInternalAnnotationKey] = "true"
+	if openAI.InputName != "" {
+		annotations[constants.OpenAIInputNameInternalAnnotationKey] = openAI.InputName
+	}
+	if openAI.OutputName != "" {
+		annotations[constants.OpenAIOutputNameInternalAnnotationKey] = openAI.OutputName
+	}
+	return true
+}
+
+func addResponseCacheAnnotations(responseCache *v1beta1.ResponseCacheSpec, annotations map[string]string) bool {
+	if responseCache == nil {
+		return false
+	}
+	annotations[constants.ResponseCacheInternalAnnotationKey] = "true"
+	if responseCache.TTLSeconds != nil {
+		annotations[constants.ResponseCacheTTLInternalAnnotationKey] = strconv.Itoa(*responseCache.TTLSeconds)
+	}
+	if responseCache.MaxEntries != nil {
+		annotations[constants.ResponseCacheMaxEntriesInternalAnnotationKey] = strconv.Itoa(*responseCache.MaxEntries)
+	}
+	if responseCache.Redis != nil {
+		annotations[constants.ResponseCacheRedisAddressInternalAnnotationKey] = responseCache.Redis.Address
+		if responseCache.Redis.SecretName != "" {
+			annotations[constants.ResponseCacheRedisSecretInternalAnnotationKey] = responseCache.Redis.SecretName
+		}
+	}
+	return true
+}
+
+// addTracingAnnotations configures the tracing of the agent, the tracing is set for all the components of the
+// InferenceService so that the spans of a request are exported from each hop
+func addTracingAnnotations(tracing *v1beta1.TracingSpec, annotations map[string]string) bool {
+	if tracing == nil {
+		return false
+	}
+	annotations[constants.TracingEndpointInternalAnnotationKey] = tracing.Endpoint
+	if tracing.SamplingPercent != nil {
+		annotations[constants.TracingSamplingPercentInternalAnnotationKey] = strconv.Itoa(*tracing.SamplingPercent)
+	}
+	return true
+}
+
+// addAuthenticationAnnotations configures the agent to verify the tokens of the inference requests, the tokens are
+// issued for <name>.<namespace> of the InferenceService unless the audiences are set
+func addAuthenticationAnnotations(isvc *v1beta1.InferenceService, annotations map[string]string) bool {
+	authentication := isvc.Spec.Authentication
+	if authentication == nil {
+		return false
+	}
+	audiences := authentication.Audiences
+	if len(audiences) == 0 {
+		audiences = []string{isvc.Name + "." + isvc.Namespace}
+	}
+	annotations[constants.AuthenticationAudiencesInternalAnnotationKey] = strings.Join(audiences, ",")
+	if authentication.OIDC != nil {
+		annotations[constants.AuthenticationIssuerInternalAnnotationKey] = authentication.OIDC.IssuerURL
+	}
+	return true
+}
+
+// addOutlierDetectorAnnotations points the predictor at the outlier detector. In async mode the request payloads are
+// sent to the detector by the payload logger, in inline mode the agent scores each request before responding.
+func addOutlierDetectorAnnotations(isvc *v1beta1.InferenceService, annotations map[string]string) bool {
+	if isvc.Spec.OutlierDetector == nil {
+		return false
+	}
+	detectorURL := "http://" + network.GetServiceHostname(constants.DefaultOutlierDetectorServiceName(isvc.Name), isvc.Namespace)
+	if isvc.Spec.OutlierDetector.GetMode() == v1beta1.OutlierDetectionInline {
+		annotations[constants.OutlierDetectorUrlInternalAnnotationKey] = detectorURL
+		return true
+	}
+	return addLoggerAnnotations(&v1beta1.LoggerSpec{
+		URL:  &detectorURL,
+		Mode: v1beta1.LogRequest,
+	}, annotations)
+}
+
+// addDriftDetectorAnnotations streams the predictor requests to the drift detector through the payload logger
+func addDriftDetectorAnnotations(isvc *v1beta1.InferenceService, annotations map[string]string) bool {
+	if isvc.Spec.DriftDetector == nil {
+		return false
+	}
+	detectorURL := "http://" + network.GetServiceHostname(constants.DefaultDriftDetectorServiceName(isvc.Name), isvc.Namespace)
+	return addLoggerAnnotations(&v1beta1.LoggerSpec{
+		URL:  &detectorURL,
+		Mode: v1beta1.LogRequest,
+	}, annotations)
+}
+
 func addAgentAnnotations(isvc *v1beta1.InferenceService, annotations map[string]string) bool {
 	if v1beta1utils.IsMMSPredictor(&isvc.Spec.Predictor) {
 		annotations[constants.AgentShouldInjectAnnotationKey] = "true"
@@ -103,3 +237,27 @@ func addAgentAnnotations(isvc *v1beta1.InferenceService, annotations map[string]
 	}
 	return false
 }
+
+// propagateRevisionReplicas counts the ready pods of the default and canary revisions of the component in Serverless
+// mode, the revisions are set on the component status by PropagateStatus
+func propagateRevisionReplicas(cl client.Client, isvc *v1beta1.InferenceService, component v1beta1.ComponentType) error {
+	statusSpec := isvc.Status.Components[component]
+	for _, revision := range []*v1beta1.RevisionStatusSpec{statusSpec.Default, statusSpec.Canary} {
+		if revision == nil {
+			continue
+		}
+		podList, err := v1beta1utils.ListPodsByLabel(cl, isvc.Namespace, constants.RevisionLabel, revision.Name)
+		if err != nil {
+			return err
+		}
+		revision.ReadyReplicas = 0
+		for _, pod := range podList.Items {
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == v1.PodReady && condition.Status == v1.ConditionTrue {
+					revision.ReadyReplicas++
+				}
+			}
+		}
+	}
+	return nil
+}
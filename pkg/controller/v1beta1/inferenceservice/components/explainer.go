@@ -17,6 +17,8 @@ limitations under the License.
 package components
 
 import (
+	"time"
+
 	"github.com/go-logr/logr"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/knative"
@@ -68,6 +70,11 @@ func (e *Explainer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 		annotations[constants.StorageInitializerSourceUriInternalAnnotationKey] = *sourceURI
 	}
 	addLoggerAnnotations(isvc.Spec.Explainer.Logger, annotations)
+	addRequestQueueAnnotations(isvc.Spec.Explainer.RequestQueue, annotations)
+	addTracingAnnotations(isvc.Spec.Tracing, annotations)
+	addAuthenticationAnnotations(isvc, annotations)
+	isvc.Spec.Explainer.SetAutoscalerClassAnnotations(annotations)
+	isvc.Spec.Explainer.SetDeploymentModeAnnotations(annotations)
 	// Add StorageSpec annotations so mutator will mount storage credentials to InferenceService's explainer
 	addStorageSpecAnnotations(explainer.GetStorageSpec(), annotations)
 	objectMeta := metav1.ObjectMeta{
@@ -89,6 +96,13 @@ func (e *Explainer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 	}
 
 	podSpec := v1.PodSpec(isvc.Spec.Explainer.PodSpec)
+	if err := isvcutils.SetHealthCheckPort(&podSpec.Containers[0], isvc.Spec.Explainer.HealthCheckPort); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to set health check port for explainer")
+	}
+	gpuResourceName := isvc.Spec.Explainer.GetGPUResourceName()
+	isvcutils.SetGPUResourceRequirements(&podSpec, gpuResourceName)
+	isvcutils.SetGPUResourceNameAnnotation(annotations, gpuResourceName)
+	componentExt := isvcutils.ApplyScalingSchedule(&isvc.Spec.Explainer.ComponentExtensionSpec, time.Now())
 	deployConfig, err := v1beta1.NewDeployConfig(e.client)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -96,8 +110,7 @@ func (e *Explainer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 
 	// Here we allow switch between knative and vanilla deployment
 	if isvcutils.GetDeploymentMode(annotations, deployConfig) == constants.RawDeployment {
-		r, err := raw.NewRawKubeReconciler(e.client, e.scheme, objectMeta, &isvc.Spec.Explainer.ComponentExtensionSpec,
-			&podSpec)
+		r, err := raw.NewRawKubeReconciler(e.client, e.scheme, objectMeta, componentExt, &podSpec)
 		if err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to create NewRawKubeReconciler for explainer")
 		}
@@ -109,11 +122,19 @@ func (e *Explainer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 		if err := controllerutil.SetControllerReference(isvc, r.Service.Service, e.scheme); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to set service owner reference for explainer")
 		}
+		//set warm pool Deployment Controller
+		if err := controllerutil.SetControllerReference(isvc, r.WarmPool.Deployment, e.scheme); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to set warm pool owner reference for explainer")
+		}
 		//set autoscaler Controller
 		if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassHPA {
 			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.HPA.HPA, e.scheme); err != nil {
 				return ctrl.Result{}, errors.Wrapf(err, "fails to set HPA owner reference for explainer")
 			}
+		} else if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassKEDA {
+			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.KEDA.ScaledObject, e.scheme); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "fails to set ScaledObject owner reference for explainer")
+			}
 		}
 
 		deployment, err := r.Reconcile()
@@ -122,8 +143,8 @@ func (e *Explainer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 		}
 		isvc.Status.PropagateRawStatus(v1beta1.ExplainerComponent, deployment, r.URL)
 	} else {
-		r := knative.NewKsvcReconciler(e.client, e.scheme, objectMeta, &isvc.Spec.Explainer.ComponentExtensionSpec,
-			&podSpec, isvc.Status.Components[v1beta1.ExplainerComponent])
+		r := knative.NewKsvcReconciler(e.client, e.scheme, objectMeta, componentExt, &podSpec,
+			isvc.Status.Components[v1beta1.ExplainerComponent])
 
 		if err := controllerutil.SetControllerReference(isvc, r.Service, e.scheme); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to set owner reference for explainer")
@@ -133,6 +154,12 @@ func (e *Explainer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 			return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile explainer")
 		}
 		isvc.Status.PropagateStatus(v1beta1.ExplainerComponent, status)
+		if err := propagateRevisionReplicas(e.client, isvc, v1beta1.ExplainerComponent); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to count ready replicas of explainer revisions")
+		}
+		isvc.Status.SetRollbackRevision(v1beta1.ExplainerComponent, r.RollbackRevision)
+		isvc.Status.RecordRolledoutRevision(v1beta1.ExplainerComponent, r.ConfigHash, explainer.GetStorageUri())
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 	return ctrl.Result{}, nil
 }
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/knative"
+	raw "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/raw"
+	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
+	"github.com/kserve/kserve/pkg/utils"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+)
+
+var _ Component = &OutlierDetector{}
+
+// OutlierDetector reconciles resources for this component.
+type OutlierDetector struct {
+	client                 client.Client
+	scheme                 *runtime.Scheme
+	inferenceServiceConfig *v1beta1.InferenceServicesConfig
+	Log                    logr.Logger
+}
+
+func NewOutlierDetector(client client.Client, scheme *runtime.Scheme, inferenceServiceConfig *v1beta1.InferenceServicesConfig) Component {
+	return &OutlierDetector{
+		client:                 client,
+		scheme:                 scheme,
+		inferenceServiceConfig: inferenceServiceConfig,
+		Log:                    ctrl.Log.WithName("OutlierDetectorReconciler"),
+	}
+}
+
+// Reconcile observes the world and attempts to drive the status towards the desired state.
+func (p *OutlierDetector) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, error) {
+	p.Log.Info("Reconciling OutlierDetector", "OutlierDetectorSpec", isvc.Spec.OutlierDetector)
+	detector := isvc.Spec.OutlierDetector.GetImplementation()
+	annotations := utils.Filter(isvc.Annotations, func(key string) bool {
+		return !utils.Includes(constants.ServiceAnnotationDisallowedList, key)
+	})
+	// the reference distribution of the detector is downloaded by the storage initializer
+	if sourceURI := detector.GetStorageUri(); sourceURI != nil {
+		annotations[constants.StorageInitializerSourceUriInternalAnnotationKey] = *sourceURI
+	}
+	addLoggerAnnotations(isvc.Spec.OutlierDetector.Logger, annotations)
+	isvc.Spec.OutlierDetector.SetAutoscalerClassAnnotations(annotations)
+	isvc.Spec.OutlierDetector.SetDeploymentModeAnnotations(annotations)
+	addBatcherAnnotations(isvc.Spec.OutlierDetector.Batcher, annotations)
+	addRequestQueueAnnotations(isvc.Spec.OutlierDetector.RequestQueue, annotations)
+	addTracingAnnotations(isvc.Spec.Tracing, annotations)
+
+	deployConfig, err := v1beta1.NewDeployConfig(p.client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      constants.DefaultOutlierDetectorServiceName(isvc.Name),
+		Namespace: isvc.Namespace,
+		Labels: utils.Union(isvc.Labels, map[string]string{
+			constants.InferenceServicePodLabelKey: isvc.Name,
+			constants.KServiceComponentLabel:      string(v1beta1.OutlierDetectorComponent),
+		}),
+		Annotations: annotations,
+	}
+
+	container := detector.GetContainer(isvc.ObjectMeta, isvc.Spec.OutlierDetector.GetExtensions(), p.inferenceServiceConfig)
+	if len(isvc.Spec.OutlierDetector.PodSpec.Containers) == 0 {
+		isvc.Spec.OutlierDetector.PodSpec = v1beta1.PodSpec{
+			Containers: []corev1.Container{
+				*container,
+			},
+		}
+	} else {
+		isvc.Spec.OutlierDetector.PodSpec.Containers[0] = *container
+	}
+
+	podSpec := corev1.PodSpec(isvc.Spec.OutlierDetector.PodSpec)
+	if err := isvcutils.SetHealthCheckPort(&podSpec.Containers[0], isvc.Spec.OutlierDetector.HealthCheckPort); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to set health check port for outlier detector")
+	}
+	gpuResourceName := isvc.Spec.OutlierDetector.GetGPUResourceName()
+	isvcutils.SetGPUResourceRequirements(&podSpec, gpuResourceName)
+	isvcutils.SetGPUResourceNameAnnotation(annotations, gpuResourceName)
+	componentExt := isvcutils.ApplyScalingSchedule(&isvc.Spec.OutlierDetector.ComponentExtensionSpec, time.Now())
+
+	if isvcutils.GetDeploymentMode(annotations, deployConfig) == constants.RawDeployment {
+		r, err := raw.NewRawKubeReconciler(p.client, p.scheme, objectMeta, componentExt, &podSpec)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to create NewRawKubeReconciler for outlier detector")
+		}
+		if err := controllerutil.SetControllerReference(isvc, r.Deployment.Deployment, p.scheme); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to set deployment owner reference for outlier detector")
+		}
+		if err := controllerutil.SetControllerReference(isvc, r.Service.Service, p.scheme); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to set service owner reference for outlier detector")
+		}
+		//set warm pool Deployment Controller
+		if err := controllerutil.SetControllerReference(isvc, r.WarmPool.Deployment, p.scheme); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to set warm pool owner reference for outlier detector")
+		}
+		if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassHPA {
+			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.HPA.HPA, p.scheme); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "fails to set HPA owner reference for outlier detector")
+			}
+		} else if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassKEDA {
+			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.KEDA.ScaledObject, p.scheme); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "fails to set ScaledObject owner reference for outlier detector")
+			}
+		}
+
+		deployment, err := r.Reconcile()
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile outlier detector")
+		}
+		isvc.Status.PropagateRawStatus(v1beta1.OutlierDetectorComponent, deployment, r.URL)
+		return ctrl.Result{}, nil
+	}
+
+	r := knative.NewKsvcReconciler(p.client, p.scheme, objectMeta, componentExt, &podSpec,
+		isvc.Status.Components[v1beta1.OutlierDetectorComponent])
+	if err := controllerutil.SetControllerReference(isvc, r.Service, p.scheme); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to set owner reference for outlier detector")
+	}
+	status, err := r.Reconcile()
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile outlier detector")
+	}
+	isvc.Status.PropagateStatus(v1beta1.OutlierDetectorComponent, status)
+	if err := propagateRevisionReplicas(p.client, isvc, v1beta1.OutlierDetectorComponent); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to count ready replicas of outlier detector revisions")
+	}
+	isvc.Status.SetRollbackRevision(v1beta1.OutlierDetectorComponent, r.RollbackRevision)
+	isvc.Status.RecordRolledoutRevision(v1beta1.OutlierDetectorComponent, r.ConfigHash, detector.GetStorageUri())
+	return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
+}
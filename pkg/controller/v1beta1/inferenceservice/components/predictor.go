@@ -17,7 +17,9 @@ limitations under the License.
 package components
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/kserve/kserve/pkg/constants"
@@ -71,7 +73,16 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 	})
 
 	addLoggerAnnotations(isvc.Spec.Predictor.Logger, annotations)
+	isvc.Spec.Predictor.SetAutoscalerClassAnnotations(annotations)
+	isvc.Spec.Predictor.SetDeploymentModeAnnotations(annotations)
 	addBatcherAnnotations(isvc.Spec.Predictor.Batcher, annotations)
+	addRequestQueueAnnotations(isvc.Spec.Predictor.RequestQueue, annotations)
+	addTracingAnnotations(isvc.Spec.Tracing, annotations)
+	addAuthenticationAnnotations(isvc, annotations)
+	addOpenAIAnnotations(isvc.Spec.Predictor.OpenAI, annotations)
+	addResponseCacheAnnotations(isvc.Spec.Predictor.ResponseCache, annotations)
+	addOutlierDetectorAnnotations(isvc, annotations)
+	addDriftDetectorAnnotations(isvc, annotations)
 	// Add StorageSpec annotations so mutator will mount storage credentials to InferenceService's predictor
 	addStorageSpecAnnotations(isvc.Spec.Predictor.GetImplementation().GetStorageSpec(), annotations)
 	// Add agent annotations so mutator will mount model agent to multi-model InferenceService's predictor
@@ -196,8 +207,20 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 			return ctrl.Result{}, errors.Wrapf(err, "failed to replace placeholders in serving runtime Container")
 		}
 
+		// Mount the model where the runtime of the model format expects it
+		modelMountPath := p.inferenceServiceConfig.GetModelMountPath(isvc.Spec.Predictor.Model.ModelFormat.Name)
+		if modelMountPath != constants.DefaultModelLocalMountPath {
+			isvcutils.SetModelMountPath(container, modelMountPath)
+			annotations[constants.ModelMountPathInternalAnnotationKey] = modelMountPath
+		}
+
 		// Update image tag if GPU is enabled or runtime version is provided
-		isvcutils.UpdateImageTag(container, isvc.Spec.Predictor.Model.RuntimeVersion, isvc.Spec.Predictor.Model.Runtime)
+		isvcutils.UpdateImageTag(container, isvc.Spec.Predictor.Model.RuntimeVersion, isvc.Spec.Predictor.Model.Runtime,
+			isvc.Spec.Predictor.GetGPUResourceName())
+
+		// Hold traffic back from the replica until the model is loaded when the runtime serves the model readiness endpoint
+		isvcutils.SetModelReadinessProbe(container, predictor.GetProtocol(), isvc.Name, sRuntime.Annotations,
+			isvc.Spec.Predictor.HealthCheckPort)
 
 		podSpec = *mergedPodSpec
 		podSpec.Containers = []v1.Container{
@@ -223,6 +246,23 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 		}
 	}
 
+	// Name the user port after the predictor protocol so that gRPC traffic is not routed as HTTP/1
+	isvcutils.SetUserPortName(&podSpec.Containers[0], predictor.GetProtocol(), annotations)
+	isvcutils.SetV2ReadinessProbe(&podSpec.Containers[0], predictor.GetProtocol(), isvc.Spec.Predictor.HealthCheckPort)
+	if err := isvcutils.SetHealthCheckPort(&podSpec.Containers[0], isvc.Spec.Predictor.HealthCheckPort); err != nil {
+		isvc.Status.UpdateModelTransitionStatus(v1beta1.InvalidSpec, &v1beta1.FailureInfo{
+			Reason:  v1beta1.InvalidPredictorSpec,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, err
+	}
+	// Extended resources cannot be overcommitted so the GPU request has to match the limit
+	gpuResourceName := isvc.Spec.Predictor.GetGPUResourceName()
+	isvcutils.SetGPUResourceRequirements(&podSpec, gpuResourceName)
+	isvcutils.SetGPUResourceNameAnnotation(annotations, gpuResourceName)
+	// Scale within the replica bounds of the active scaling window, the controller requeues at the next window transition
+	componentExt := isvcutils.ApplyScalingSchedule(&isvc.Spec.Predictor.ComponentExtensionSpec, time.Now())
+
 	// Knative does not support INIT containers or mounting, so we add annotations that trigger the
 	// StorageInitializer injector to mutate the underlying deployment to provision model data
 	if sourceURI := predictor.GetStorageUri(); sourceURI != nil {
@@ -230,6 +270,16 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 			return ctrl.Result{}, errors.New("must provide only one of storageUri and storage.path")
 		}
 		annotations[constants.StorageInitializerSourceUriInternalAnnotationKey] = *sourceURI
+		if isvc.Spec.Predictor.Model != nil && isvc.Spec.Predictor.Model.StorageSha256 != nil {
+			annotations[constants.StorageInitializerSha256InternalAnnotationKey] = *isvc.Spec.Predictor.Model.StorageSha256
+		}
+		if isvc.Spec.Predictor.Model != nil && isvc.Spec.Predictor.Model.Verification != nil {
+			verification, err := json.Marshal(isvc.Spec.Predictor.Model.Verification)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "fails to marshal the model verification")
+			}
+			annotations[constants.StorageVerificationInternalAnnotationKey] = string(verification)
+		}
 	}
 
 	// Labels and annotations from isvc will overwrite labels and annotations from ServingRuntimePodSpec
@@ -253,13 +303,16 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 	var rawDeployment bool
 	var podLabelKey string
 	var podLabelValue string
+	var requeueAfter time.Duration
 
 	// Here we allow switch between knative and vanilla deployment
 	if isvcutils.GetDeploymentMode(annotations, deployConfig) == constants.RawDeployment {
 		rawDeployment = true
 		podLabelKey = constants.RawDeploymentAppLabel
-		r, err := raw.NewRawKubeReconciler(p.client, p.scheme, objectMeta, &isvc.Spec.Predictor.ComponentExtensionSpec,
-			&podSpec)
+		if isvc.Spec.Predictor.Model != nil && isvc.Spec.Predictor.Model.ModelLoadTimeoutSeconds != nil {
+			isvcutils.SetStartupProbe(&podSpec.Containers[0], *isvc.Spec.Predictor.Model.ModelLoadTimeoutSeconds)
+		}
+		r, err := raw.NewRawKubeReconciler(p.client, p.scheme, objectMeta, componentExt, &podSpec)
 		if err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to create NewRawKubeReconciler for predictor")
 		}
@@ -271,11 +324,19 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 		if err := controllerutil.SetControllerReference(isvc, r.Service.Service, p.scheme); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to set service owner reference for predictor")
 		}
+		//set warm pool Deployment Controller
+		if err := controllerutil.SetControllerReference(isvc, r.WarmPool.Deployment, p.scheme); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to set warm pool owner reference for predictor")
+		}
 		//set autoscaler Controller
 		if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassHPA {
 			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.HPA.HPA, p.scheme); err != nil {
 				return ctrl.Result{}, errors.Wrapf(err, "fails to set HPA owner reference for predictor")
 			}
+		} else if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassKEDA {
+			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.KEDA.ScaledObject, p.scheme); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "fails to set ScaledObject owner reference for predictor")
+			}
 		}
 
 		deployment, err := r.Reconcile()
@@ -285,16 +346,35 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 		isvc.Status.PropagateRawStatus(v1beta1.PredictorComponent, deployment, r.URL)
 	} else {
 		podLabelKey = constants.RevisionLabel
-		r := knative.NewKsvcReconciler(p.client, p.scheme, objectMeta, &isvc.Spec.Predictor.ComponentExtensionSpec,
-			&podSpec, isvc.Status.Components[v1beta1.PredictorComponent])
+		// Knative does not support startup probes, the revision is given the model load timeout to become ready instead
+		if isvc.Spec.Predictor.Model != nil && isvc.Spec.Predictor.Model.ModelLoadTimeoutSeconds != nil {
+			if _, ok := objectMeta.Annotations[constants.ProgressDeadlineAnnotationKey]; !ok {
+				objectMeta.Annotations[constants.ProgressDeadlineAnnotationKey] =
+					fmt.Sprintf("%ds", *isvc.Spec.Predictor.Model.ModelLoadTimeoutSeconds)
+			}
+		}
+		r := knative.NewKsvcReconciler(p.client, p.scheme, objectMeta, componentExt, &podSpec,
+			isvc.Status.Components[v1beta1.PredictorComponent])
 		if err := controllerutil.SetControllerReference(isvc, r.Service, p.scheme); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to set owner reference for predictor")
 		}
+		if prometheusURL := p.inferenceServiceConfig.CanaryAnalysis.PrometheusURL; prometheusURL != "" {
+			if r.MetricsProvider, err = knative.NewPrometheusMetricsProvider(prometheusURL); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "fails to create canary metrics provider for predictor")
+			}
+		}
+		r.AnalysisWebhooks = p.inferenceServiceConfig.CanaryAnalysis.Webhooks
 		status, err := r.Reconcile()
 		if err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile predictor")
 		}
 		isvc.Status.PropagateStatus(v1beta1.PredictorComponent, status)
+		if err := propagateRevisionReplicas(p.client, isvc, v1beta1.PredictorComponent); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to count ready replicas of predictor revisions")
+		}
+		isvc.Status.SetRollbackRevision(v1beta1.PredictorComponent, r.RollbackRevision)
+		isvc.Status.RecordRolledoutRevision(v1beta1.PredictorComponent, r.ConfigHash, predictor.GetStorageUri())
+		requeueAfter = r.RequeueAfter
 	}
 	statusSpec, _ := isvc.Status.Components[v1beta1.PredictorComponent]
 	if rawDeployment {
@@ -308,5 +388,5 @@ func (p *Predictor) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, erro
 	}
 	isvc.Status.PropagateModelStatus(statusSpec, podList, rawDeployment)
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
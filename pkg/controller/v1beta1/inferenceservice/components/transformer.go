@@ -72,7 +72,12 @@ func (p *Transformer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, er
 		annotations[constants.StorageInitializerSourceUriInternalAnnotationKey] = *sourceURI
 	}
 	addLoggerAnnotations(isvc.Spec.Transformer.Logger, annotations)
+	isvc.Spec.Transformer.SetAutoscalerClassAnnotations(annotations)
+	isvc.Spec.Transformer.SetDeploymentModeAnnotations(annotations)
 	addBatcherAnnotations(isvc.Spec.Transformer.Batcher, annotations)
+	addRequestQueueAnnotations(isvc.Spec.Transformer.RequestQueue, annotations)
+	addTracingAnnotations(isvc.Spec.Tracing, annotations)
+	addAuthenticationAnnotations(isvc, annotations)
 
 	deployConfig, err := v1beta1.NewDeployConfig(p.client)
 	if err != nil {
@@ -124,11 +129,17 @@ func (p *Transformer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, er
 	}
 
 	podSpec := corev1.PodSpec(isvc.Spec.Transformer.PodSpec)
+	if err := isvcutils.SetHealthCheckPort(&podSpec.Containers[0], isvc.Spec.Transformer.HealthCheckPort); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "fails to set health check port for transformer")
+	}
+	gpuResourceName := isvc.Spec.Transformer.GetGPUResourceName()
+	isvcutils.SetGPUResourceRequirements(&podSpec, gpuResourceName)
+	isvcutils.SetGPUResourceNameAnnotation(annotations, gpuResourceName)
+	componentExt := isvcutils.ApplyScalingSchedule(&isvc.Spec.Transformer.ComponentExtensionSpec, time.Now())
 
 	// Here we allow switch between knative and vanilla deployment
 	if isvcutils.GetDeploymentMode(annotations, deployConfig) == constants.RawDeployment {
-		r, err := raw.NewRawKubeReconciler(p.client, p.scheme, objectMeta, &isvc.Spec.Transformer.ComponentExtensionSpec,
-			&podSpec)
+		r, err := raw.NewRawKubeReconciler(p.client, p.scheme, objectMeta, componentExt, &podSpec)
 		if err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to create NewRawKubeReconciler for transformer")
 		}
@@ -140,11 +151,19 @@ func (p *Transformer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, er
 		if err := controllerutil.SetControllerReference(isvc, r.Service.Service, p.scheme); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to set service owner reference for transformer")
 		}
+		//set warm pool Deployment Controller
+		if err := controllerutil.SetControllerReference(isvc, r.WarmPool.Deployment, p.scheme); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to set warm pool owner reference for transformer")
+		}
 		//set autoscaler Controller
 		if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassHPA {
 			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.HPA.HPA, p.scheme); err != nil {
 				return ctrl.Result{}, errors.Wrapf(err, "fails to set HPA owner reference for transformer")
 			}
+		} else if r.Scaler.Autoscaler.AutoscalerClass == constants.AutoscalerClassKEDA {
+			if err := controllerutil.SetControllerReference(isvc, r.Scaler.Autoscaler.KEDA.ScaledObject, p.scheme); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "fails to set ScaledObject owner reference for transformer")
+			}
 		}
 
 		deployment, err := r.Reconcile()
@@ -154,8 +173,8 @@ func (p *Transformer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, er
 		isvc.Status.PropagateRawStatus(v1beta1.TransformerComponent, deployment, r.URL)
 
 	} else {
-		r := knative.NewKsvcReconciler(p.client, p.scheme, objectMeta, &isvc.Spec.Transformer.ComponentExtensionSpec,
-			&podSpec, isvc.Status.Components[v1beta1.TransformerComponent])
+		r := knative.NewKsvcReconciler(p.client, p.scheme, objectMeta, componentExt, &podSpec,
+			isvc.Status.Components[v1beta1.TransformerComponent])
 		if err := controllerutil.SetControllerReference(isvc, r.Service, p.scheme); err != nil {
 			return ctrl.Result{}, errors.Wrapf(err, "fails to set owner reference for predictor")
 		}
@@ -164,6 +183,12 @@ func (p *Transformer) Reconcile(isvc *v1beta1.InferenceService) (ctrl.Result, er
 			return ctrl.Result{}, errors.Wrapf(err, "fails to reconcile predictor")
 		}
 		isvc.Status.PropagateStatus(v1beta1.TransformerComponent, status)
+		if err := propagateRevisionReplicas(p.client, isvc, v1beta1.TransformerComponent); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "fails to count ready replicas of transformer revisions")
+		}
+		isvc.Status.SetRollbackRevision(v1beta1.TransformerComponent, r.RollbackRevision)
+		isvc.Status.RecordRolledoutRevision(v1beta1.TransformerComponent, r.ConfigHash, transformer.GetStorageUri())
+		return ctrl.Result{RequeueAfter: r.RequeueAfter}, nil
 	}
 
 	return ctrl.Result{}, nil
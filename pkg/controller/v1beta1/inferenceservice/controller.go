@@ -20,14 +20,19 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	v1alpha1api "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/components"
+	isvcmetrics "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/metrics"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/experiment"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/ingress"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/knative"
 	modelconfig "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/modelconfig"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/security"
 	isvcutils "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/utils"
 	"github.com/kserve/kserve/pkg/utils"
 	"github.com/pkg/errors"
@@ -52,6 +57,8 @@ import (
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingruntimes;clusterservingruntimes/finalizers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingruntimes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterstoragecontainers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=clusterservingpolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices/status,verbs=get;update;patch
@@ -61,15 +68,20 @@ import (
 // +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices/finalizers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.istio.io,resources=virtualservices/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.istio.io,resources=gateways,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=security.istio.io,resources=peerauthentications;authorizationpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations;validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;patch;delete
 
 // InferenceState describes the Readiness of the InferenceService
 type InferenceServiceState string
@@ -86,6 +98,9 @@ type InferenceServiceReconciler struct {
 	Log      logr.Logger
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// DryRun skips recording the metrics of the InferenceServices, it is set when reconciling against an in-memory
+	// client so that the metrics only describe the InferenceServices of the cluster
+	DryRun bool
 }
 
 func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -97,10 +112,23 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if apierr.IsNotFound(err) {
 			// Object not found, return.  Created objects are automatically garbage collected.
 			// For additional cleanup logic use finalizers.
+			if !r.DryRun {
+				isvcmetrics.Delete(req.Namespace, req.Name)
+				knative.DeleteAnalysisWebhookCalls(req.Namespace, req.Name)
+			}
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
 	}
+	start := time.Now()
+	result, err := r.reconcileInferenceService(isvc)
+	if !r.DryRun {
+		isvcmetrics.ObserveReconcile(isvc.Namespace, isvc.Name, start, err)
+	}
+	return result, err
+}
+
+func (r *InferenceServiceReconciler) reconcileInferenceService(isvc *v1beta1api.InferenceService) (ctrl.Result, error) {
 	//get annotations from isvc
 	annotations := utils.Filter(isvc.Annotations, func(key string) bool {
 		return !utils.Includes(constants.ServiceAnnotationDisallowedList, key)
@@ -165,6 +193,21 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	if err != nil {
 		return reconcile.Result{}, errors.Wrapf(err, "fails to create InferenceServicesConfig")
 	}
+	// The policies may have changed since the InferenceService was admitted
+	policies, err := v1alpha1api.GetServingPolicies(r.Client, isvc.Namespace)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "fails to get ClusterServingPolicies")
+	}
+	if err := v1beta1api.ValidateServingPolicies(isvc, isvcConfig, policies); err != nil {
+		r.Log.Info("Skipping reconciliation for InferenceService violating a serving policy", "isvc", isvc.Name,
+			"error", err.Error())
+		r.Recorder.Eventf(isvc, v1.EventTypeWarning, string(v1beta1api.PolicyViolation), err.Error())
+		isvc.Status.UpdateModelTransitionStatus(v1beta1api.InvalidSpec, &v1beta1api.FailureInfo{
+			Reason:  v1beta1api.PolicyViolation,
+			Message: err.Error(),
+		})
+		return reconcile.Result{}, r.updateStatus(isvc, deploymentMode)
+	}
 	reconcilers := []components.Component{}
 	if deploymentMode != constants.ModelMeshDeployment {
 		reconcilers = append(reconcilers, components.NewPredictor(r.Client, r.Scheme, isvcConfig))
@@ -175,6 +218,15 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	if isvc.Spec.Explainer != nil {
 		reconcilers = append(reconcilers, components.NewExplainer(r.Client, r.Scheme, isvcConfig))
 	}
+	if isvc.Spec.OutlierDetector != nil {
+		reconcilers = append(reconcilers, components.NewOutlierDetector(r.Client, r.Scheme, isvcConfig))
+	}
+	if isvc.Spec.DriftDetector != nil {
+		reconcilers = append(reconcilers, components.NewDriftDetector(r.Client, r.Scheme, isvcConfig))
+	}
+	// The components waiting for a change, e.g. a draining or analyzed canary, ask to be requeued. The rest of the
+	// reconcile still runs so that the ingress and the other components are not held back by the wait.
+	componentResult := ctrl.Result{}
 	for _, reconciler := range reconcilers {
 		result, err := reconciler.Reconcile(isvc)
 		if err != nil {
@@ -183,10 +235,19 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 			r.updateStatus(isvc, deploymentMode)
 			return reconcile.Result{}, errors.Wrapf(err, "fails to reconcile component")
 		}
-		if result.Requeue || result.RequeueAfter > 0 {
-			return result, nil
-		}
+		componentResult.Requeue = componentResult.Requeue || result.Requeue
+		componentResult.RequeueAfter = minRequeueAfter(componentResult.RequeueAfter, result.RequeueAfter)
+	}
+	if err := r.clearRollbackAnnotation(isvc, deploymentMode); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Reconcile the variants of the experiments before the ingress, which splits the traffic between the ready ones
+	if err := experiment.NewExperimentReconciler(r.Client, r.Scheme, deploymentMode).Reconcile(isvc); err != nil {
+		r.Recorder.Eventf(isvc, v1.EventTypeWarning, "InternalError", err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "fails to reconcile experiments")
 	}
+
 	//Reconcile ingress
 	ingressConfig, err := v1beta1api.NewIngressConfig(r.Client)
 	if err != nil {
@@ -194,7 +255,15 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	}
 
 	//check raw deployment
-	if deploymentMode == constants.RawDeployment {
+	if deploymentMode == constants.RawDeployment && ingressConfig.EnableGatewayApi {
+		reconciler, err := ingress.NewRawHTTPRouteReconciler(r.Client, r.Scheme, ingressConfig)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "fails to reconcile http route")
+		}
+		if err := reconciler.Reconcile(isvc); err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "fails to reconcile http route")
+		}
+	} else if deploymentMode == constants.RawDeployment {
 		reconciler, err := ingress.NewRawIngressReconciler(r.Client, r.Scheme, ingressConfig)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrapf(err, "fails to reconcile ingress")
@@ -210,6 +279,15 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 	}
 
+	// Reconcile the Istio policies securing the traffic between the components
+	securityConfig, err := v1beta1api.NewSecurityConfig(r.Client)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "fails to create SecurityConfig")
+	}
+	if err := security.NewSecurityReconciler(r.Client, r.Scheme, securityConfig, deploymentMode).Reconcile(isvc); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "fails to reconcile security policies")
+	}
+
 	// Reconcile modelConfig
 	configMapReconciler := modelconfig.NewModelConfigReconciler(r.Client, r.Scheme)
 	if err := configMapReconciler.Reconcile(isvc); err != nil {
@@ -221,7 +299,68 @@ func (r *InferenceServiceReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return reconcile.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	componentResult.RequeueAfter = minRequeueAfter(componentResult.RequeueAfter, scalingScheduleRequeueAfter(isvc, time.Now()))
+	return componentResult, nil
+}
+
+// minRequeueAfter returns the smaller of the two durations, a zero duration means no requeue is needed
+func minRequeueAfter(a, b time.Duration) time.Duration {
+	if a == 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// clearRollbackAnnotation removes the rollback annotation once the knative services of the components have recorded
+// the rollback, they keep the traffic on the rolled back revisions until the spec changes so the annotation is not
+// needed anymore and a later rollback has to be requested again. A Rollback event names the revisions rolled back to,
+// the RolledBack condition reports them until the spec changes.
+func (r *InferenceServiceReconciler) clearRollbackAnnotation(isvc *v1beta1api.InferenceService, deploymentMode constants.DeploymentModeType) error {
+	if deploymentMode != constants.Serverless || isvc.Annotations[constants.RollbackAnnotationKey] != "true" {
+		return nil
+	}
+	// The status computed by the reconcile is kept on isvc, only its metadata is replaced by the patched one
+	patched := isvc.DeepCopy()
+	delete(patched.Annotations, constants.RollbackAnnotationKey)
+	if err := r.Patch(context.TODO(), patched, client.MergeFrom(isvc)); err != nil {
+		return errors.Wrapf(err, "fails to clear the rollback annotation")
+	}
+	isvc.ObjectMeta = patched.ObjectMeta
+	for _, component := range []v1beta1api.ComponentType{v1beta1api.PredictorComponent, v1beta1api.TransformerComponent,
+		v1beta1api.ExplainerComponent, v1beta1api.OutlierDetectorComponent, v1beta1api.DriftDetectorComponent} {
+		if revision := isvc.Status.Components[component].RollbackRevision; revision != "" {
+			r.Recorder.Eventf(isvc, v1.EventTypeNormal, RollbackReason,
+				"InferenceService [%v] %s is rolled back to revision %s, the revision serves the traffic until the spec changes",
+				isvc.Name, component, revision)
+		}
+	}
+	return nil
+}
+
+// scalingScheduleRequeueAfter returns the duration until the next scaling window of any component starts or ends,
+// so that the replica bounds of the window are applied without waiting for another change.
+func scalingScheduleRequeueAfter(isvc *v1beta1api.InferenceService, now time.Time) time.Duration {
+	componentExts := []*v1beta1api.ComponentExtensionSpec{&isvc.Spec.Predictor.ComponentExtensionSpec}
+	if isvc.Spec.Transformer != nil {
+		componentExts = append(componentExts, &isvc.Spec.Transformer.ComponentExtensionSpec)
+	}
+	if isvc.Spec.Explainer != nil {
+		componentExts = append(componentExts, &isvc.Spec.Explainer.ComponentExtensionSpec)
+	}
+	if isvc.Spec.OutlierDetector != nil {
+		componentExts = append(componentExts, &isvc.Spec.OutlierDetector.ComponentExtensionSpec)
+	}
+	if isvc.Spec.DriftDetector != nil {
+		componentExts = append(componentExts, &isvc.Spec.DriftDetector.ComponentExtensionSpec)
+	}
+	var requeueAfter time.Duration
+	for _, componentExt := range componentExts {
+		next := isvcutils.NextScalingScheduleTransition(componentExt, now)
+		if next > 0 && (requeueAfter == 0 || next < requeueAfter) {
+			requeueAfter = next
+		}
+	}
+	return requeueAfter
 }
 
 func (r *InferenceServiceReconciler) updateStatus(desiredService *v1beta1api.InferenceService, deploymentMode constants.DeploymentModeType) error {
@@ -230,6 +369,11 @@ func (r *InferenceServiceReconciler) updateStatus(desiredService *v1beta1api.Inf
 	if err := r.Get(context.TODO(), namespacedName, existingService); err != nil {
 		return err
 	}
+	// The status reflects the spec of the generation reconciled, so that clients waiting for a rollout can tell the
+	// progress of the latest spec from the progress of the previous one
+	desiredService.Status.ObservedGeneration = desiredService.Generation
+	desiredService.Status.PropagateRolloutStatus()
+	desiredService.Status.PropagateRollbackStatus()
 	wasReady := inferenceServiceReadiness(existingService.Status)
 	if inferenceServiceStatusEqual(existingService.Status, desiredService.Status, deploymentMode) {
 		// If we didn't change anything then don't call updateStatus.
@@ -251,6 +395,12 @@ func (r *InferenceServiceReconciler) updateStatus(desiredService *v1beta1api.Inf
 			r.Recorder.Eventf(desiredService, v1.EventTypeNormal, string(InferenceServiceReadyState),
 				fmt.Sprintf("InferenceService [%v] is Ready", desiredService.GetName()))
 		}
+		for _, event := range rolloutEvents(existingService, desiredService) {
+			r.Recorder.Event(desiredService, event.eventType, event.reason, event.message)
+		}
+	}
+	if !r.DryRun {
+		isvcmetrics.RecordStatus(desiredService)
 	}
 	return nil
 }
@@ -268,21 +418,30 @@ func inferenceServiceStatusEqual(s1, s2 v1beta1api.InferenceServiceStatus, deplo
 		return equality.Semantic.DeepEqual(s1.Address, s2.Address) &&
 			equality.Semantic.DeepEqual(s1.URL, s2.URL) &&
 			equality.Semantic.DeepEqual(s1.Status, s2.Status) &&
+			equality.Semantic.DeepEqual(s1.RolloutStatus, s2.RolloutStatus) &&
 			equality.Semantic.DeepEqual(s1.Components[v1beta1api.TransformerComponent], s2.Components[v1beta1api.TransformerComponent]) &&
-			equality.Semantic.DeepEqual(s1.Components[v1beta1api.ExplainerComponent], s2.Components[v1beta1api.ExplainerComponent])
+			equality.Semantic.DeepEqual(s1.Components[v1beta1api.ExplainerComponent], s2.Components[v1beta1api.ExplainerComponent]) &&
+			equality.Semantic.DeepEqual(s1.Components[v1beta1api.OutlierDetectorComponent], s2.Components[v1beta1api.OutlierDetectorComponent]) &&
+			equality.Semantic.DeepEqual(s1.Components[v1beta1api.DriftDetectorComponent], s2.Components[v1beta1api.DriftDetectorComponent])
 	}
 	return equality.Semantic.DeepEqual(s1, s2)
 }
 
 func (r *InferenceServiceReconciler) SetupWithManager(mgr ctrl.Manager, deployConfig *v1beta1api.DeployConfig, disableIstioVirtualHost bool) error {
 	if deployConfig.DefaultDeploymentMode == string(constants.RawDeployment) {
-		return ctrl.NewControllerManagedBy(mgr).
+		builder := ctrl.NewControllerManagedBy(mgr).
 			For(&v1beta1api.InferenceService{}).
-			Owns(&appsv1.Deployment{}).
-			Complete(r)
+			Owns(&v1beta1api.InferenceService{}).
+			Owns(&appsv1.Deployment{})
+		// Watch the Knative services of the serverless components when the Knative scheme is set up
+		if mgr.GetScheme().Recognizes(knservingv1.SchemeGroupVersion.WithKind("Service")) {
+			builder = builder.Owns(&knservingv1.Service{})
+		}
+		return builder.Complete(r)
 	} else if disableIstioVirtualHost == false {
 		return ctrl.NewControllerManagedBy(mgr).
 			For(&v1beta1api.InferenceService{}).
+			Owns(&v1beta1api.InferenceService{}).
 			Owns(&knservingv1.Service{}).
 			Owns(&v1alpha3.VirtualService{}).
 			Owns(&appsv1.Deployment{}).
@@ -290,6 +449,7 @@ func (r *InferenceServiceReconciler) SetupWithManager(mgr ctrl.Manager, deployCo
 	} else {
 		return ctrl.NewControllerManagedBy(mgr).
 			For(&v1beta1api.InferenceService{}).
+			Owns(&v1beta1api.InferenceService{}).
 			Owns(&knservingv1.Service{}).
 			Owns(&appsv1.Deployment{}).
 			Complete(r)
@@ -315,5 +475,11 @@ func (r *InferenceServiceReconciler) deleteExternalResources(isvc *v1beta1api.In
 			r.Log.Error(err, "unable to delete trainedmodel", "trainedmodel", v)
 		}
 	}
-	return nil
+
+	// The certificate of the ingress gateway lives in the namespace of the gateway
+	ingressConfig, err := v1beta1api.NewIngressConfig(r.Client)
+	if err != nil {
+		return err
+	}
+	return ingress.DeleteCertificate(r.Client, isvc, ingressConfig)
 }
@@ -536,6 +536,7 @@ var _ = Describe("v1beta1 inference service controller", func() {
 			// verify if InferenceService status is updated
 			expectedIsvcStatus := v1beta1.InferenceServiceStatus{
 				Status: duckv1.Status{
+					ObservedGeneration: 1,
 					Conditions: duckv1.Conditions{
 						{
 							Type:   v1beta1.IngressReady,
@@ -572,17 +573,33 @@ var _ = Describe("v1beta1 inference service controller", func() {
 						LatestReadyRevision:   "revision-v1",
 						LatestCreatedRevision: "revision-v1",
 						URL:                   predictorUrl,
+						Conditions: duckv1.Conditions{
+							{
+								Type:   apis.ConditionReady,
+								Status: "True",
+							},
+						},
 					},
 					v1beta1.TransformerComponent: {
 						LatestReadyRevision:   "t-revision-v1",
 						LatestCreatedRevision: "t-revision-v1",
 						URL:                   transformerUrl,
+						Conditions: duckv1.Conditions{
+							{
+								Type:   apis.ConditionReady,
+								Status: "True",
+							},
+						},
 					},
 				},
 				ModelStatus: v1beta1.ModelStatus{
 					TransitionStatus:    "InProgress",
 					ModelRevisionStates: &v1beta1.ModelRevisionStates{TargetModelState: "Pending"},
 				},
+				RolloutStatus: &v1beta1.RolloutStatus{
+					Phase:  v1beta1.RolloutComplete,
+					Reason: v1beta1.RolloutReasonComplete,
+				},
 			}
 			Eventually(func() string {
 				isvc := &v1beta1.InferenceService{}
@@ -760,6 +777,7 @@ var _ = Describe("v1beta1 inference service controller", func() {
 			// verify if InferenceService status is updated
 			expectedIsvcStatus := v1beta1.InferenceServiceStatus{
 				Status: duckv1.Status{
+					ObservedGeneration: 1,
 					Conditions: duckv1.Conditions{
 						{
 							Type:     v1beta1.ExplainerReady,
@@ -796,17 +814,33 @@ var _ = Describe("v1beta1 inference service controller", func() {
 						LatestReadyRevision:   "revision-v1",
 						LatestCreatedRevision: "revision-v1",
 						URL:                   predictorUrl,
+						Conditions: duckv1.Conditions{
+							{
+								Type:   apis.ConditionReady,
+								Status: "True",
+							},
+						},
 					},
 					v1beta1.ExplainerComponent: {
 						LatestReadyRevision:   "exp-revision-v1",
 						LatestCreatedRevision: "exp-revision-v1",
 						URL:                   explainerUrl,
+						Conditions: duckv1.Conditions{
+							{
+								Type:   apis.ConditionReady,
+								Status: "True",
+							},
+						},
 					},
 				},
 				ModelStatus: v1beta1.ModelStatus{
 					TransitionStatus:    "InProgress",
 					ModelRevisionStates: &v1beta1.ModelRevisionStates{TargetModelState: "Pending"},
 				},
+				RolloutStatus: &v1beta1.RolloutStatus{
+					Phase:  v1beta1.RolloutComplete,
+					Reason: v1beta1.RolloutReasonComplete,
+				},
 			}
 			Eventually(func() string {
 				isvc := &v1beta1.InferenceService{}
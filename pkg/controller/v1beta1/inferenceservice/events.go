@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"fmt"
+	"sort"
+
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// Reasons of the events recorded on the InferenceService along a rollout
+const (
+	ModelDownloadStartedReason   = "ModelDownloadStarted"
+	ModelDownloadCompletedReason = "ModelDownloadCompleted"
+	RevisionReadyReason          = "RevisionReady"
+	CanaryPromotedReason         = "CanaryPromoted"
+	RollbackTriggeredReason      = "RollbackTriggered"
+	RollbackReason               = "Rollback"
+	AutoscaleBlockedReason       = "AutoscaleBlocked"
+)
+
+type rolloutEvent struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+// rolloutEvents compares the status of the InferenceService before and after a reconcile and returns the events of
+// the rollout steps which happened in between, so that `kubectl describe` lists the steps in order.
+func rolloutEvents(existing *v1beta1api.InferenceService, desired *v1beta1api.InferenceService) []rolloutEvent {
+	var events []rolloutEvent
+	previousState := targetModelState(existing.Status)
+	state := targetModelState(desired.Status)
+	if state == v1beta1api.Loading && previousState != v1beta1api.Loading {
+		events = append(events, rolloutEvent{v1.EventTypeNormal, ModelDownloadStartedReason,
+			fmt.Sprintf("Downloading the model of InferenceService [%v]%s", desired.Name, storageUriSuffix(desired))})
+	} else if previousState == v1beta1api.Loading && state != v1beta1api.Loading && state != v1beta1api.FailedToLoad {
+		events = append(events, rolloutEvent{v1.EventTypeNormal, ModelDownloadCompletedReason,
+			fmt.Sprintf("Downloaded the model of InferenceService [%v]", desired.Name)})
+	}
+
+	components := make([]string, 0, len(desired.Status.Components))
+	for component := range desired.Status.Components {
+		components = append(components, string(component))
+	}
+	sort.Strings(components)
+	for _, name := range components {
+		component := v1beta1api.ComponentType(name)
+		previous := existing.Status.Components[component]
+		current := desired.Status.Components[component]
+
+		if revision := readyRevision(desired.Status, component); revision != "" &&
+			revision != readyRevision(existing.Status, component) {
+			events = append(events, rolloutEvent{v1.EventTypeNormal, RevisionReadyReason,
+				fmt.Sprintf("InferenceService [%v] %s revision %s is ready", desired.Name, component, revision)})
+		}
+
+		canary := canaryTarget(previous.Traffic)
+		if canary != nil && current.LatestRolledoutRevision != "" &&
+			current.LatestRolledoutRevision != previous.LatestRolledoutRevision {
+			events = append(events, rolloutEvent{v1.EventTypeNormal, CanaryPromotedReason,
+				fmt.Sprintf("InferenceService [%v] %s canary revision %s is promoted to 100%% of the traffic",
+					desired.Name, component, current.LatestRolledoutRevision)})
+		}
+
+		// The rollback is either requested or the result of a failed canary analysis which sets the canary to 0%
+		if current.RollbackRevision != "" && current.RollbackRevision != previous.RollbackRevision {
+			events = append(events, rolloutEvent{v1.EventTypeNormal, RollbackTriggeredReason,
+				fmt.Sprintf("InferenceService [%v] %s traffic is rolled back to revision %s", desired.Name, component,
+					current.RollbackRevision)})
+		} else if latest := latestTarget(current.Traffic); canary != nil && latest != nil && latest.Percent != nil &&
+			*latest.Percent == 0 && current.LatestReadyRevision == previous.LatestReadyRevision {
+			events = append(events, rolloutEvent{v1.EventTypeWarning, RollbackTriggeredReason,
+				fmt.Sprintf("InferenceService [%v] %s canary revision %s is rolled back to revision %s", desired.Name,
+					component, current.LatestReadyRevision, current.LatestRolledoutRevision)})
+		}
+
+		if condition := desired.Status.GetScalingCondition(component); condition != nil && condition.IsFalse() {
+			if previousCondition := existing.Status.GetScalingCondition(component); previousCondition == nil ||
+				!previousCondition.IsFalse() {
+				events = append(events, rolloutEvent{v1.EventTypeWarning, AutoscaleBlockedReason,
+					fmt.Sprintf("InferenceService [%v] %s cannot be scaled: %s", desired.Name, component, condition.Message)})
+			}
+		}
+	}
+	return events
+}
+
+func targetModelState(status v1beta1api.InferenceServiceStatus) v1beta1api.ModelState {
+	if status.ModelStatus.ModelRevisionStates == nil {
+		return ""
+	}
+	return status.ModelStatus.ModelRevisionStates.TargetModelState
+}
+
+func storageUriSuffix(isvc *v1beta1api.InferenceService) string {
+	if implementation := isvc.Spec.Predictor.GetImplementation(); implementation != nil {
+		if storageUri := implementation.GetStorageUri(); storageUri != nil {
+			return " from " + *storageUri
+		}
+	}
+	return ""
+}
+
+// readyRevision returns the latest ready revision of the component, in RawDeployment mode it is the revision of the
+// deployment once the component is ready
+func readyRevision(status v1beta1api.InferenceServiceStatus, component v1beta1api.ComponentType) string {
+	statusSpec := status.Components[component]
+	if statusSpec.LatestReadyRevision != "" {
+		return statusSpec.LatestReadyRevision
+	}
+	if statusSpec.Traffic == nil && status.IsComponentReady(component) {
+		return statusSpec.LatestCreatedRevision
+	}
+	return ""
+}
+
+// canaryTarget returns the traffic target of the latest revision when it serves part of the traffic
+func canaryTarget(traffic []knservingv1.TrafficTarget) *knservingv1.TrafficTarget {
+	if target := latestTarget(traffic); target != nil && target.Percent != nil && *target.Percent > 0 &&
+		*target.Percent < 100 {
+		return target
+	}
+	return nil
+}
+
+func latestTarget(traffic []knservingv1.TrafficTarget) *knservingv1.TrafficTarget {
+	for i := range traffic {
+		if traffic[i].LatestRevision != nil && *traffic[i].LatestRevision {
+			return &traffic[i]
+		}
+	}
+	return nil
+}
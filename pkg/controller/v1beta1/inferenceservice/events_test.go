@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestRolloutEvents(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	newIsvc := func(state v1beta1api.ModelState, predictor v1beta1api.ComponentStatusSpec,
+		conditions ...apis.Condition) *v1beta1api.InferenceService {
+		return &v1beta1api.InferenceService{
+			ObjectMeta: metav1.ObjectMeta{Name: "sklearn", Namespace: "default"},
+			Spec: v1beta1api.InferenceServiceSpec{
+				Predictor: v1beta1api.PredictorSpec{
+					SKLearn: &v1beta1api.SKLearnSpec{
+						PredictorExtensionSpec: v1beta1api.PredictorExtensionSpec{StorageURI: proto.String("gs://models/sklearn")},
+					},
+				},
+			},
+			Status: v1beta1api.InferenceServiceStatus{
+				Status:      duckv1.Status{Conditions: conditions},
+				Components:  map[v1beta1api.ComponentType]v1beta1api.ComponentStatusSpec{v1beta1api.PredictorComponent: predictor},
+				ModelStatus: v1beta1api.ModelStatus{ModelRevisionStates: &v1beta1api.ModelRevisionStates{TargetModelState: state}},
+			},
+		}
+	}
+	stable := v1beta1api.ComponentStatusSpec{
+		LatestReadyRevision:     "sklearn-predictor-default-00001",
+		LatestCreatedRevision:   "sklearn-predictor-default-00001",
+		LatestRolledoutRevision: "sklearn-predictor-default-00001",
+		Traffic: []knservingv1.TrafficTarget{
+			{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+		},
+	}
+	canary := v1beta1api.ComponentStatusSpec{
+		LatestReadyRevision:     "sklearn-predictor-default-00002",
+		LatestCreatedRevision:   "sklearn-predictor-default-00002",
+		LatestRolledoutRevision: "sklearn-predictor-default-00001",
+		Traffic: []knservingv1.TrafficTarget{
+			{RevisionName: "sklearn-predictor-default-00002", LatestRevision: proto.Bool(true), Percent: proto.Int64(10)},
+			{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(90)},
+		},
+	}
+	promoted := v1beta1api.ComponentStatusSpec{
+		LatestReadyRevision:       "sklearn-predictor-default-00002",
+		LatestCreatedRevision:     "sklearn-predictor-default-00002",
+		LatestRolledoutRevision:   "sklearn-predictor-default-00002",
+		PreviousRolledoutRevision: "sklearn-predictor-default-00001",
+		Traffic: []knservingv1.TrafficTarget{
+			{RevisionName: "sklearn-predictor-default-00002", LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+		},
+	}
+	analysisRolledBack := *canary.DeepCopy()
+	analysisRolledBack.Traffic[0].Percent = proto.Int64(0)
+	analysisRolledBack.Traffic[1].Percent = proto.Int64(100)
+	requestedRollback := *promoted.DeepCopy()
+	requestedRollback.RollbackRevision = "sklearn-predictor-default-00001"
+	raw := v1beta1api.ComponentStatusSpec{LatestCreatedRevision: "2"}
+	predictorReady := apis.Condition{Type: v1beta1api.PredictorReady, Status: v1.ConditionTrue}
+	scalingBlocked := apis.Condition{Type: v1beta1api.PredictorScalingReady, Status: v1.ConditionFalse,
+		Message: "exceeded quota: compute"}
+
+	scenarios := map[string]struct {
+		existing *v1beta1api.InferenceService
+		desired  *v1beta1api.InferenceService
+		expected []rolloutEvent
+	}{
+		"ModelDownloadStarted": {
+			existing: newIsvc(v1beta1api.Pending, v1beta1api.ComponentStatusSpec{}),
+			desired:  newIsvc(v1beta1api.Loading, v1beta1api.ComponentStatusSpec{}),
+			expected: []rolloutEvent{{v1.EventTypeNormal, ModelDownloadStartedReason,
+				"Downloading the model of InferenceService [sklearn] from gs://models/sklearn"}},
+		},
+		"ModelDownloadCompletedAndRevisionReady": {
+			existing: newIsvc(v1beta1api.Loading, v1beta1api.ComponentStatusSpec{}),
+			desired:  newIsvc(v1beta1api.Loaded, stable),
+			expected: []rolloutEvent{
+				{v1.EventTypeNormal, ModelDownloadCompletedReason, "Downloaded the model of InferenceService [sklearn]"},
+				{v1.EventTypeNormal, RevisionReadyReason, "InferenceService [sklearn] predictor revision sklearn-predictor-default-00001 is ready"},
+			},
+		},
+		"ModelDownloadFailed": {
+			existing: newIsvc(v1beta1api.Loading, v1beta1api.ComponentStatusSpec{}),
+			desired:  newIsvc(v1beta1api.FailedToLoad, v1beta1api.ComponentStatusSpec{}),
+		},
+		"CanaryPromoted": {
+			existing: newIsvc(v1beta1api.Loaded, canary),
+			desired:  newIsvc(v1beta1api.Loaded, promoted),
+			expected: []rolloutEvent{{v1.EventTypeNormal, CanaryPromotedReason,
+				"InferenceService [sklearn] predictor canary revision sklearn-predictor-default-00002 is promoted to 100% of the traffic"}},
+		},
+		"NotACanary": {
+			existing: newIsvc(v1beta1api.Loaded, stable),
+			desired:  newIsvc(v1beta1api.Loaded, promoted),
+			expected: []rolloutEvent{{v1.EventTypeNormal, RevisionReadyReason,
+				"InferenceService [sklearn] predictor revision sklearn-predictor-default-00002 is ready"}},
+		},
+		"CanaryRolledBackByAnalysis": {
+			existing: newIsvc(v1beta1api.Loaded, canary),
+			desired:  newIsvc(v1beta1api.Loaded, analysisRolledBack),
+			expected: []rolloutEvent{{v1.EventTypeWarning, RollbackTriggeredReason,
+				"InferenceService [sklearn] predictor canary revision sklearn-predictor-default-00002 is rolled back to revision sklearn-predictor-default-00001"}},
+		},
+		"RollbackRequested": {
+			existing: newIsvc(v1beta1api.Loaded, promoted),
+			desired:  newIsvc(v1beta1api.Loaded, requestedRollback),
+			expected: []rolloutEvent{{v1.EventTypeNormal, RollbackTriggeredReason,
+				"InferenceService [sklearn] predictor traffic is rolled back to revision sklearn-predictor-default-00001"}},
+		},
+		"RawDeploymentRevisionReady": {
+			existing: newIsvc(v1beta1api.Pending, raw),
+			desired:  newIsvc(v1beta1api.Loaded, raw, predictorReady),
+			expected: []rolloutEvent{{v1.EventTypeNormal, RevisionReadyReason,
+				"InferenceService [sklearn] predictor revision 2 is ready"}},
+		},
+		"AutoscaleBlocked": {
+			existing: newIsvc(v1beta1api.Loaded, raw, predictorReady),
+			desired:  newIsvc(v1beta1api.Loaded, raw, predictorReady, scalingBlocked),
+			expected: []rolloutEvent{{v1.EventTypeWarning, AutoscaleBlockedReason,
+				"InferenceService [sklearn] predictor cannot be scaled: exceeded quota: compute"}},
+		},
+		"AutoscaleStillBlocked": {
+			existing: newIsvc(v1beta1api.Loaded, raw, predictorReady, scalingBlocked),
+			desired:  newIsvc(v1beta1api.Loaded, raw, predictorReady, scalingBlocked),
+		},
+	}
+	for name, scenario := range scenarios {
+		g.Expect(rolloutEvents(scenario.existing, scenario.desired)).To(gomega.Equal(scenario.expected), name)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileProbesOnHealthCheckPort(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1beta1api.AddToScheme(s)).To(gomega.Succeed())
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace},
+		Data: map[string]string{
+			"ingress": `{
+				"ingressGateway": "knative-serving/knative-ingress-gateway",
+				"ingressService": "test-destination",
+				"ingressDomain": "example.com"
+			}`,
+		},
+	}
+	isvc := &v1beta1api.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "custom",
+			Namespace:   "default",
+			UID:         "isvc-uid",
+			Annotations: map[string]string{constants.DeploymentMode: string(constants.RawDeployment)},
+		},
+		Spec: v1beta1api.InferenceServiceSpec{
+			Predictor: v1beta1api.PredictorSpec{
+				ComponentExtensionSpec: v1beta1api.ComponentExtensionSpec{HealthCheckPort: proto.Int32(8081)},
+				PodSpec: v1beta1api.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name:  constants.InferenceServiceContainerName,
+							Image: "custom/server:latest",
+							Ports: []v1.ContainerPort{
+								{Name: "http", ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+								{Name: "management", ContainerPort: 8081, Protocol: v1.ProtocolTCP},
+							},
+							ReadinessProbe: &v1.Probe{
+								ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/health"}},
+							},
+							LivenessProbe: &v1.Probe{
+								ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(configMap, isvc).Build()
+	r := &InferenceServiceReconciler{
+		Client:   cli,
+		Scheme:   s,
+		Log:      ctrl.Log.WithName("V1beta1InferenceServiceController"),
+		Recorder: record.NewFakeRecorder(10),
+		DryRun:   true,
+	}
+
+	_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: isvc.Name,
+		Namespace: isvc.Namespace}})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: constants.DefaultPredictorServiceName(isvc.Name),
+		Namespace: isvc.Namespace}, deployment)).To(gomega.Succeed())
+	container := deployment.Spec.Template.Spec.Containers[0]
+	g.Expect(container.ReadinessProbe.HTTPGet.Port).To(gomega.Equal(intstr.FromInt(8081)))
+	g.Expect(container.LivenessProbe.TCPSocket.Port).To(gomega.Equal(intstr.FromInt(8081)))
+	// the requests are still served on the first container port
+	g.Expect(container.Ports[0].ContainerPort).To(gomega.Equal(int32(8080)))
+}
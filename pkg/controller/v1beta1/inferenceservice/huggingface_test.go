@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+func TestReconcileHuggingFaceRuntime(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1beta1api.AddToScheme(s)).To(gomega.Succeed())
+
+	data, err := os.ReadFile("../../../../config/runtimes/" + constants.HuggingFaceServer + ".yaml")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	servingRuntime := &v1alpha1.ClusterServingRuntime{}
+	g.Expect(yaml.Unmarshal(data, servingRuntime)).To(gomega.Succeed())
+	g.Expect(servingRuntime.Name).To(gomega.Equal(constants.HuggingFaceServer))
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace},
+		Data: map[string]string{
+			"ingress": `{
+				"ingressGateway": "knative-serving/knative-ingress-gateway",
+				"ingressService": "test-destination",
+				"ingressDomain": "example.com"
+			}`,
+		},
+	}
+	isvc := &v1beta1api.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "bert",
+			Namespace:   "default",
+			UID:         "isvc-uid",
+			Annotations: map[string]string{constants.DeploymentMode: string(constants.RawDeployment)},
+		},
+		Spec: v1beta1api.InferenceServiceSpec{
+			Predictor: v1beta1api.PredictorSpec{
+				Model: &v1beta1api.ModelSpec{
+					ModelFormat: v1beta1api.ModelFormat{Name: constants.SupportedModelHuggingFace},
+					PredictorExtensionSpec: v1beta1api.PredictorExtensionSpec{
+						StorageURI: proto.String("gs://models/bert-base-uncased"),
+						Container: v1.Container{
+							Args: []string{"--task=fill-mask", "--dtype=float16"},
+						},
+					},
+				},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(configMap, servingRuntime, isvc).Build()
+	r := &InferenceServiceReconciler{
+		Client:   cli,
+		Scheme:   s,
+		Log:      ctrl.Log.WithName("V1beta1InferenceServiceController"),
+		Recorder: record.NewFakeRecorder(10),
+		DryRun:   true,
+	}
+
+	_, err = r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: isvc.Name,
+		Namespace: isvc.Namespace}})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: constants.DefaultPredictorServiceName(isvc.Name),
+		Namespace: isvc.Namespace}, deployment)).To(gomega.Succeed())
+	podSpec := deployment.Spec.Template.Spec
+	container := podSpec.Containers[0]
+	g.Expect(container.Image).To(gomega.Equal("kserve-huggingfaceserver:replace"))
+	// the task and the quantization of the predictor are appended to the runtime args
+	g.Expect(container.Args).To(gomega.Equal([]string{"--model_name=bert", "--model_dir=/mnt/models",
+		"--http_port=8080", "--task=fill-mask", "--dtype=float16"}))
+	g.Expect(container.StartupProbe).NotTo(gomega.BeNil())
+	g.Expect(container.StartupProbe.FailureThreshold).To(gomega.Equal(int32(60)))
+	g.Expect(container.VolumeMounts).To(gomega.ContainElement(v1.VolumeMount{Name: "devshm", MountPath: "/dev/shm"}))
+	g.Expect(podSpec.Volumes).To(gomega.ContainElement(gomega.HaveField("EmptyDir.Medium", v1.StorageMediumMemory)))
+}
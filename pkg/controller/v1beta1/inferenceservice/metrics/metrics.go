@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+var (
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kserve_inferenceservice_reconcile_duration_seconds",
+		Help:    "The duration of the InferenceService reconciliations",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"namespace", "inferenceservice", "result"})
+	ready = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kserve_inferenceservice_ready",
+		Help: "Whether the InferenceService is ready",
+	}, []string{"namespace", "inferenceservice"})
+	transitionStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kserve_inferenceservice_transition_status",
+		Help: "The rollout state of the InferenceService model, 1 for the current state",
+	}, []string{"namespace", "inferenceservice", "status"})
+	latestRevisionTraffic = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kserve_inferenceservice_component_latest_revision_traffic_percent",
+		Help: "The traffic percent routed to the latest ready revision of the component",
+	}, []string{"namespace", "inferenceservice", "component"})
+	desiredReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kserve_inferenceservice_component_desired_replicas",
+		Help: "The number of replicas the component is scaled to in RawDeployment mode",
+	}, []string{"namespace", "inferenceservice", "component"})
+	readyReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kserve_inferenceservice_component_ready_replicas",
+		Help: "The number of ready replicas of the component in RawDeployment mode",
+	}, []string{"namespace", "inferenceservice", "component"})
+
+	transitionStatuses = []v1beta1.TransitionStatus{v1beta1.UpToDate, v1beta1.InProgress, v1beta1.BlockedByFailedLoad,
+		v1beta1.InvalidSpec}
+	components = []v1beta1.ComponentType{v1beta1.PredictorComponent, v1beta1.TransformerComponent,
+		v1beta1.ExplainerComponent, v1beta1.OutlierDetectorComponent, v1beta1.DriftDetectorComponent}
+)
+
+func init() {
+	// The metrics are served by the controller manager together with the controller-runtime metrics
+	metrics.Registry.MustRegister(reconcileDuration, ready, transitionStatus, latestRevisionTraffic, desiredReplicas,
+		readyReplicas)
+}
+
+// ObserveReconcile records the duration of a reconciliation started at start
+func ObserveReconcile(namespace string, name string, start time.Time, err error) {
+	result := ResultSuccess
+	if err != nil {
+		result = ResultError
+	}
+	reconcileDuration.WithLabelValues(namespace, name, result).Observe(time.Since(start).Seconds())
+}
+
+// RecordStatus exports the readiness, the rollout state and the replicas of the InferenceService components. The
+// replicas are only exported in RawDeployment mode, Knative exports the replicas of the revisions in Serverless mode.
+func RecordStatus(isvc *v1beta1.InferenceService) {
+	namespace, name := isvc.Namespace, isvc.Name
+	ready.WithLabelValues(namespace, name).Set(boolValue(isvc.Status.IsConditionReady(apis.ConditionReady)))
+	for _, status := range transitionStatuses {
+		transitionStatus.WithLabelValues(namespace, name, string(status)).
+			Set(boolValue(isvc.Status.ModelStatus.TransitionStatus == status))
+	}
+	for _, component := range components {
+		statusSpec, ok := isvc.Status.Components[component]
+		if !ok {
+			deleteComponent(namespace, name, component)
+			continue
+		}
+		for _, traffic := range statusSpec.Traffic {
+			if traffic.LatestRevision != nil && *traffic.LatestRevision && traffic.Percent != nil {
+				latestRevisionTraffic.WithLabelValues(namespace, name, string(component)).Set(float64(*traffic.Percent))
+			}
+		}
+		if statusSpec.DesiredReplicas > 0 || statusSpec.CurrentReplicas > 0 {
+			desiredReplicas.WithLabelValues(namespace, name, string(component)).Set(float64(statusSpec.DesiredReplicas))
+			readyReplicas.WithLabelValues(namespace, name, string(component)).Set(float64(statusSpec.ReadyReplicas))
+		}
+	}
+}
+
+// Delete removes the metrics of a deleted InferenceService
+func Delete(namespace string, name string) {
+	reconcileDuration.DeleteLabelValues(namespace, name, ResultSuccess)
+	reconcileDuration.DeleteLabelValues(namespace, name, ResultError)
+	ready.DeleteLabelValues(namespace, name)
+	for _, status := range transitionStatuses {
+		transitionStatus.DeleteLabelValues(namespace, name, string(status))
+	}
+	for _, component := range components {
+		deleteComponent(namespace, name, component)
+	}
+}
+
+func deleteComponent(namespace string, name string, component v1beta1.ComponentType) {
+	latestRevisionTraffic.DeleteLabelValues(namespace, name, string(component))
+	desiredReplicas.DeleteLabelValues(namespace, name, string(component))
+	readyReplicas.DeleteLabelValues(namespace, name, string(component))
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestRecordStatus(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn", Namespace: "default"},
+		Status: v1beta1.InferenceServiceStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{Type: apis.ConditionReady, Status: v1.ConditionTrue}},
+			},
+			Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+				v1beta1.PredictorComponent: {
+					Traffic: []knservingv1.TrafficTarget{
+						{RevisionName: "sklearn-predictor-default-00002", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(20)},
+						{RevisionName: "sklearn-predictor-default-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(80)},
+					},
+				},
+				v1beta1.TransformerComponent: {
+					CurrentReplicas: 3,
+					ReadyReplicas:   2,
+					DesiredReplicas: 3,
+				},
+			},
+			ModelStatus: v1beta1.ModelStatus{TransitionStatus: v1beta1.InProgress},
+		},
+	}
+
+	RecordStatus(isvc)
+	g.Expect(testutil.ToFloat64(ready.WithLabelValues("default", "sklearn"))).To(gomega.Equal(1.0))
+	g.Expect(testutil.ToFloat64(transitionStatus.WithLabelValues("default", "sklearn", "InProgress"))).To(gomega.Equal(1.0))
+	g.Expect(testutil.ToFloat64(transitionStatus.WithLabelValues("default", "sklearn", "UpToDate"))).To(gomega.Equal(0.0))
+	g.Expect(testutil.ToFloat64(latestRevisionTraffic.WithLabelValues("default", "sklearn", "predictor"))).To(gomega.Equal(20.0))
+	g.Expect(testutil.ToFloat64(desiredReplicas.WithLabelValues("default", "sklearn", "transformer"))).To(gomega.Equal(3.0))
+	g.Expect(testutil.ToFloat64(readyReplicas.WithLabelValues("default", "sklearn", "transformer"))).To(gomega.Equal(2.0))
+	// The replicas are not exported in Serverless mode
+	g.Expect(testutil.CollectAndCount(desiredReplicas)).To(gomega.Equal(1))
+
+	ObserveReconcile("default", "sklearn", time.Now(), nil)
+	g.Expect(testutil.CollectAndCount(reconcileDuration)).To(gomega.Equal(1))
+
+	// The metrics of a deleted InferenceService are removed
+	Delete("default", "sklearn")
+	g.Expect(testutil.CollectAndCount(ready)).To(gomega.BeZero())
+	g.Expect(testutil.CollectAndCount(transitionStatus)).To(gomega.BeZero())
+	g.Expect(testutil.CollectAndCount(latestRevisionTraffic)).To(gomega.BeZero())
+	g.Expect(testutil.CollectAndCount(desiredReplicas)).To(gomega.BeZero())
+	g.Expect(testutil.CollectAndCount(readyReplicas)).To(gomega.BeZero())
+	g.Expect(testutil.CollectAndCount(reconcileDuration)).To(gomega.BeZero())
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	"istio.io/client-go/pkg/apis/networking/v1alpha3"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileUserPortName(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1beta1api.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(knservingv1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha3.AddToScheme(s)).To(gomega.Succeed())
+
+	scenarios := map[string]struct {
+		protocol     constants.InferenceServiceProtocol
+		annotations  map[string]string
+		portName     string
+		expectedName string
+	}{
+		"HTTPProtocol": {
+			protocol:     constants.ProtocolV2,
+			expectedName: constants.KnativeHTTP1PortName,
+		},
+		"GRPCProtocol": {
+			protocol:     constants.ProtocolGRPCV2,
+			expectedName: constants.KnativeH2CPortName,
+		},
+		"NamedPortIsKept": {
+			protocol:     constants.ProtocolGRPCV1,
+			portName:     constants.KnativeHTTP1PortName,
+			expectedName: constants.KnativeHTTP1PortName,
+		},
+		"AnnotationOverridesProtocol": {
+			protocol:     constants.ProtocolV1,
+			annotations:  map[string]string{constants.UserPortNameAnnotationKey: constants.KnativeH2CPortName},
+			expectedName: constants.KnativeH2CPortName,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			configMap := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace},
+				Data: map[string]string{
+					"ingress": `{
+						"ingressGateway": "knative-serving/knative-ingress-gateway",
+						"ingressService": "test-destination",
+						"localGateway": "knative-serving/knative-local-gateway",
+						"localGatewayService": "knative-local-gateway.istio-system.svc.cluster.local"
+					}`,
+				},
+			}
+			isvc := &v1beta1api.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "custom",
+					Namespace:   "default",
+					UID:         "isvc-uid",
+					Annotations: scenario.annotations,
+				},
+				Spec: v1beta1api.InferenceServiceSpec{
+					Predictor: v1beta1api.PredictorSpec{
+						PodSpec: v1beta1api.PodSpec{
+							Containers: []v1.Container{
+								{
+									Name:  constants.InferenceServiceContainerName,
+									Image: "custom/server:latest",
+									Env: []v1.EnvVar{
+										{Name: constants.CustomSpecProtocolEnvVarKey, Value: string(scenario.protocol)},
+									},
+									Ports: []v1.ContainerPort{
+										{Name: scenario.portName, ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(configMap, isvc).Build()
+			r := &InferenceServiceReconciler{
+				Client:   cli,
+				Scheme:   s,
+				Log:      ctrl.Log.WithName("V1beta1InferenceServiceController"),
+				Recorder: record.NewFakeRecorder(10),
+				DryRun:   true,
+			}
+
+			_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: isvc.Name,
+				Namespace: isvc.Namespace}})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			service := &knservingv1.Service{}
+			g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: constants.DefaultPredictorServiceName(isvc.Name),
+				Namespace: isvc.Namespace}, service)).To(gomega.Succeed())
+			g.Expect(service.Spec.Template.Spec.Containers[0].Ports[0].Name).To(gomega.Equal(scenario.expectedName))
+		})
+	}
+}
@@ -383,6 +383,7 @@ var _ = Describe("v1beta1 inference service controller", func() {
 			// verify if InferenceService status is updated
 			expectedIsvcStatus := v1beta1.InferenceServiceStatus{
 				Status: duckv1.Status{
+					ObservedGeneration: 1,
 					Conditions: duckv1.Conditions{
 						{
 							Type:   v1beta1.IngressReady,
@@ -415,12 +416,31 @@ var _ = Describe("v1beta1 inference service controller", func() {
 							Scheme: "http",
 							Host:   "raw-foo-predictor-default-default.example.com",
 						},
+						Address: &duckv1.Addressable{
+							URL: &apis.URL{
+								Scheme: "http",
+								Host:   network.GetServiceHostname(constants.DefaultPredictorServiceName(serviceKey.Name), serviceKey.Namespace),
+							},
+						},
+						Conditions: duckv1.Conditions{
+							{
+								Type:   apis.ConditionReady,
+								Status: "True",
+							},
+						},
+						Default: &v1beta1.RevisionStatusSpec{
+							TrafficPercent: 100,
+						},
 					},
 				},
 				ModelStatus: v1beta1.ModelStatus{
 					TransitionStatus:    "InProgress",
 					ModelRevisionStates: &v1beta1.ModelRevisionStates{TargetModelState: "Pending"},
 				},
+				RolloutStatus: &v1beta1.RolloutStatus{
+					Phase:  v1beta1.RolloutComplete,
+					Reason: v1beta1.RolloutReasonComplete,
+				},
 			}
 			Eventually(func() string {
 				isvc := &v1beta1.InferenceService{}
@@ -815,6 +835,7 @@ var _ = Describe("v1beta1 inference service controller", func() {
 			// verify if InferenceService status is updated
 			expectedIsvcStatus := v1beta1.InferenceServiceStatus{
 				Status: duckv1.Status{
+					ObservedGeneration: 1,
 					Conditions: duckv1.Conditions{
 						{
 							Type:   v1beta1.IngressReady,
@@ -847,12 +868,31 @@ var _ = Describe("v1beta1 inference service controller", func() {
 							Scheme: "http",
 							Host:   fmt.Sprintf("%s-predictor-default-default.example.com", serviceName),
 						},
+						Address: &duckv1.Addressable{
+							URL: &apis.URL{
+								Scheme: "http",
+								Host:   network.GetServiceHostname(constants.DefaultPredictorServiceName(serviceKey.Name), serviceKey.Namespace),
+							},
+						},
+						Conditions: duckv1.Conditions{
+							{
+								Type:   apis.ConditionReady,
+								Status: "True",
+							},
+						},
+						Default: &v1beta1.RevisionStatusSpec{
+							TrafficPercent: 100,
+						},
 					},
 				},
 				ModelStatus: v1beta1.ModelStatus{
 					TransitionStatus:    "InProgress",
 					ModelRevisionStates: &v1beta1.ModelRevisionStates{TargetModelState: "Pending"},
 				},
+				RolloutStatus: &v1beta1.RolloutStatus{
+					Phase:  v1beta1.RolloutComplete,
+					Reason: v1beta1.RolloutReasonComplete,
+				},
 			}
 			Eventually(func() string {
 				isvc := &v1beta1.InferenceService{}
@@ -1248,6 +1288,7 @@ var _ = Describe("v1beta1 inference service controller", func() {
 			// verify if InferenceService status is updated
 			expectedIsvcStatus := v1beta1.InferenceServiceStatus{
 				Status: duckv1.Status{
+					ObservedGeneration: 1,
 					Conditions: duckv1.Conditions{
 						{
 							Type:   v1beta1.IngressReady,
@@ -1280,12 +1321,31 @@ var _ = Describe("v1beta1 inference service controller", func() {
 							Scheme: "http",
 							Host:   fmt.Sprintf("%s-predictor-default.%s.%s", serviceName, serviceKey.Namespace, domain),
 						},
+						Address: &duckv1.Addressable{
+							URL: &apis.URL{
+								Scheme: "http",
+								Host:   network.GetServiceHostname(constants.DefaultPredictorServiceName(serviceKey.Name), serviceKey.Namespace),
+							},
+						},
+						Conditions: duckv1.Conditions{
+							{
+								Type:   apis.ConditionReady,
+								Status: "True",
+							},
+						},
+						Default: &v1beta1.RevisionStatusSpec{
+							TrafficPercent: 100,
+						},
 					},
 				},
 				ModelStatus: v1beta1.ModelStatus{
 					TransitionStatus:    "InProgress",
 					ModelRevisionStates: &v1beta1.ModelRevisionStates{TargetModelState: "Pending"},
 				},
+				RolloutStatus: &v1beta1.RolloutStatus{
+					Phase:  v1beta1.RolloutComplete,
+					Reason: v1beta1.RolloutReasonComplete,
+				},
 			}
 			Eventually(func() string {
 				isvc := &v1beta1.InferenceService{}
@@ -22,6 +22,7 @@ import (
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
 	hpa "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/hpa"
+	keda "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/keda"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +34,7 @@ var log = logf.Log.WithName("AutoscalerReconciler")
 type Autoscaler struct {
 	AutoscalerClass constants.AutoscalerClassType
 	HPA             *hpa.HPAReconciler
+	KEDA            *keda.KedaReconciler
 }
 
 // AutoscalerReconciler is the struct of Raw K8S Object
@@ -77,6 +79,12 @@ func createAutoscaler(client client.Client,
 	switch ac {
 	case constants.AutoscalerClassHPA:
 		as.HPA = hpa.NewHPAReconciler(client, scheme, componentMeta, componentExt)
+	case constants.AutoscalerClassKEDA:
+		kedaReconciler, err := keda.NewKedaReconciler(client, scheme, componentMeta, componentExt)
+		if err != nil {
+			return nil, err
+		}
+		as.KEDA = kedaReconciler
 	default:
 		return nil, errors.New("unknown autoscaler class type.")
 	}
@@ -91,6 +99,11 @@ func (r *AutoscalerReconciler) Reconcile() (*Autoscaler, error) {
 		if err != nil {
 			return nil, err
 		}
+	} else if r.Autoscaler.AutoscalerClass == constants.AutoscalerClassKEDA {
+		_, err := r.Autoscaler.KEDA.Reconcile()
+		if err != nil {
+			return nil, err
+		}
 	}
 	return r.Autoscaler, nil
 }
@@ -18,6 +18,7 @@ package deployment
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
@@ -62,6 +63,7 @@ func createRawDeployment(componentMeta metav1.ObjectMeta,
 	podSpec *corev1.PodSpec) *appsv1.Deployment {
 	podMetadata := componentMeta
 	podMetadata.Labels["app"] = constants.GetRawServiceLabel(componentMeta.Name)
+	setDrainHook(podSpec, componentExt.DrainSeconds)
 	setDefaultPodSpec(podSpec)
 	deployment := &appsv1.Deployment{
 		ObjectMeta: componentMeta,
@@ -107,6 +109,31 @@ func (r *DeploymentReconciler) checkDeploymentExist(client client.Client) (const
 	return constants.CheckResultExisted, existingDeployment, nil
 }
 
+// setDrainHook delays the termination of the containers until the pod is removed from the service endpoints and the
+// in-flight requests are drained, the containers with their own pre-stop hook are left untouched
+func setDrainHook(podSpec *corev1.PodSpec, drainSeconds *int64) {
+	if drainSeconds == nil || *drainSeconds == 0 {
+		return
+	}
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		if container.Lifecycle == nil {
+			container.Lifecycle = &corev1.Lifecycle{}
+		}
+		if container.Lifecycle.PreStop == nil {
+			container.Lifecycle.PreStop = &corev1.LifecycleHandler{
+				Exec: &corev1.ExecAction{
+					Command: []string{"/bin/sh", "-c", fmt.Sprintf("sleep %d", *drainSeconds)},
+				},
+			}
+		}
+	}
+	if podSpec.TerminationGracePeriodSeconds == nil {
+		terminationGracePeriodSeconds := *drainSeconds + corev1.DefaultTerminationGracePeriodSeconds
+		podSpec.TerminationGracePeriodSeconds = &terminationGracePeriodSeconds
+	}
+}
+
 func setDefaultPodSpec(podSpec *corev1.PodSpec) {
 	if podSpec.DNSPolicy == "" {
 		podSpec.DNSPolicy = corev1.DNSClusterFirst
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateRawDeploymentDrain(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentMeta := metav1.ObjectMeta{Name: "llama-predictor-default", Namespace: "default", Labels: map[string]string{}}
+	customPreStop := &corev1.LifecycleHandler{Exec: &corev1.ExecAction{Command: []string{"/drain"}}}
+	podSpec := &corev1.PodSpec{
+		Containers: []corev1.Container{
+			{Name: constants.InferenceServiceContainerName},
+			{Name: "sidecar", Lifecycle: &corev1.Lifecycle{PreStop: customPreStop}},
+		},
+	}
+
+	deployment := createRawDeployment(componentMeta, &v1beta1.ComponentExtensionSpec{DrainSeconds: proto.Int64(300)}, podSpec)
+	spec := deployment.Spec.Template.Spec
+	g.Expect(*spec.TerminationGracePeriodSeconds).To(gomega.Equal(int64(330)))
+	g.Expect(spec.Containers[0].Lifecycle.PreStop.Exec.Command).To(gomega.Equal([]string{"/bin/sh", "-c", "sleep 300"}))
+	g.Expect(spec.Containers[1].Lifecycle.PreStop).To(gomega.Equal(customPreStop))
+
+	// an explicit termination grace period is kept
+	podSpec = &corev1.PodSpec{
+		Containers:                    []corev1.Container{{Name: constants.InferenceServiceContainerName}},
+		TerminationGracePeriodSeconds: proto.Int64(600),
+	}
+	deployment = createRawDeployment(componentMeta, &v1beta1.ComponentExtensionSpec{DrainSeconds: proto.Int64(300)}, podSpec)
+	g.Expect(*deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(gomega.Equal(int64(600)))
+
+	podSpec = &corev1.PodSpec{Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName}}}
+	deployment = createRawDeployment(componentMeta, &v1beta1.ComponentExtensionSpec{}, podSpec)
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Lifecycle).To(gomega.BeNil())
+	g.Expect(*deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).
+		To(gomega.Equal(int64(corev1.DefaultTerminationGracePeriodSeconds)))
+}
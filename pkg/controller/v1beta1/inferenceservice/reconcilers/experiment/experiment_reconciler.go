@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("ExperimentReconciler")
+
+// DefaultVariantName is the name the predictor of the InferenceService is reported with in the experiments status
+const DefaultVariantName = "default"
+
+// ExperimentReconciler deploys the variants of the experiments of an InferenceService as InferenceServices with the
+// predictor of the variant, the ingress of the InferenceService splits the predictor traffic between them
+type ExperimentReconciler struct {
+	client         client.Client
+	scheme         *runtime.Scheme
+	deploymentMode constants.DeploymentModeType
+}
+
+func NewExperimentReconciler(client client.Client, scheme *runtime.Scheme,
+	deploymentMode constants.DeploymentModeType) *ExperimentReconciler {
+	return &ExperimentReconciler{
+		client:         client,
+		scheme:         scheme,
+		deploymentMode: deploymentMode,
+	}
+}
+
+// VariantServiceName returns the name of the InferenceService serving a variant
+func VariantServiceName(isvc *v1beta1.InferenceService, variant string) string {
+	return isvc.Name + "-" + variant
+}
+
+// Reconcile creates or updates the InferenceServices of the variants, deletes the ones of the removed variants and
+// reports the readiness and the traffic of the variants in the status. The variants are ignored outside of the
+// Serverless mode as the raw ingress does not split the traffic.
+func (r *ExperimentReconciler) Reconcile(isvc *v1beta1.InferenceService) error {
+	experiments := isvc.Spec.Experiments
+	if r.deploymentMode != constants.Serverless {
+		if len(experiments) > 0 {
+			log.Info("Ignoring the experiments of the InferenceService", "isvc", isvc.Name,
+				constants.DeploymentMode, r.deploymentMode)
+		}
+		experiments = nil
+	}
+	desired := map[string]bool{}
+	statuses := []v1beta1.ExperimentStatus{}
+	variantTraffic := int64(0)
+	for i := range experiments {
+		variant, err := r.reconcileVariant(isvc, &experiments[i])
+		if err != nil {
+			return err
+		}
+		desired[variant.Name] = true
+		ready := variant.Status.IsReady()
+		traffic := int64(0)
+		if ready {
+			traffic = experiments[i].TrafficPercent
+		}
+		variantTraffic += traffic
+		statuses = append(statuses, v1beta1.ExperimentStatus{
+			Name:           experiments[i].Name,
+			ServiceName:    variant.Name,
+			TrafficPercent: traffic,
+			Ready:          ready,
+		})
+	}
+	if err := r.deleteRemovedVariants(isvc, desired); err != nil {
+		return err
+	}
+	if len(statuses) == 0 {
+		isvc.Status.Experiments = nil
+		return nil
+	}
+	isvc.Status.Experiments = append([]v1beta1.ExperimentStatus{{
+		Name:           DefaultVariantName,
+		ServiceName:    isvc.Name,
+		TrafficPercent: 100 - variantTraffic,
+		Ready:          isvc.Status.IsConditionReady(v1beta1.PredictorReady),
+	}}, statuses...)
+	return nil
+}
+
+// desiredVariant returns the InferenceService of a variant, it has the metadata of the InferenceService and is only
+// reachable inside the cluster as its traffic comes through the ingress of the InferenceService
+func (r *ExperimentReconciler) desiredVariant(isvc *v1beta1.InferenceService, experiment *v1beta1.ExperimentSpec) (*v1beta1.InferenceService, error) {
+	annotations := utils.Filter(isvc.Annotations, func(key string) bool {
+		return !utils.Includes(constants.ServiceAnnotationDisallowedList, key) &&
+			!strings.HasPrefix(key, constants.InferenceServiceInternalAnnotationsPrefix)
+	})
+	labels := utils.Union(isvc.Labels, map[string]string{
+		constants.ExperimentParentLabelKey: isvc.Name,
+		constants.VisibilityLabel:          constants.ClusterLocal,
+	})
+	variant := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        VariantServiceName(isvc, experiment.Name),
+			Namespace:   isvc.Namespace,
+			Annotations: annotations,
+			Labels:      labels,
+		},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: *experiment.Predictor.DeepCopy(),
+		},
+	}
+	hash, err := variantHash(variant)
+	if err != nil {
+		return nil, err
+	}
+	variant.Annotations[constants.ExperimentSpecHashAnnotationKey] = hash
+	if err := controllerutil.SetControllerReference(isvc, variant, r.scheme); err != nil {
+		return nil, err
+	}
+	return variant, nil
+}
+
+// variantHash hashes the metadata and the spec set on the InferenceService of a variant
+func variantHash(variant *v1beta1.InferenceService) (string, error) {
+	data, err := json.Marshal(struct {
+		Annotations map[string]string
+		Labels      map[string]string
+		Spec        v1beta1.InferenceServiceSpec
+	}{variant.Annotations, variant.Labels, variant.Spec})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (r *ExperimentReconciler) reconcileVariant(isvc *v1beta1.InferenceService, experiment *v1beta1.ExperimentSpec) (*v1beta1.InferenceService, error) {
+	desired, err := r.desiredVariant(isvc, experiment)
+	if err != nil {
+		return nil, err
+	}
+	existing := &v1beta1.InferenceService{}
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
+	if apierr.IsNotFound(err) {
+		log.Info("Creating experiment variant", "isvc", isvc.Name, "variant", desired.Name)
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return nil, fmt.Errorf("fails to create the InferenceService of the variant %s: %w", experiment.Name, err)
+		}
+		return desired, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(existing, isvc) {
+		return nil, fmt.Errorf("the InferenceService %s of the variant %s already exists and is not controlled by the InferenceService",
+			existing.Name, experiment.Name)
+	}
+	if existing.Annotations[constants.ExperimentSpecHashAnnotationKey] == desired.Annotations[constants.ExperimentSpecHashAnnotationKey] {
+		return existing, nil
+	}
+	existing.Spec = desired.Spec
+	existing.Annotations = desired.Annotations
+	existing.Labels = desired.Labels
+	log.Info("Updating experiment variant", "isvc", isvc.Name, "variant", desired.Name)
+	if err := r.client.Update(context.TODO(), existing); err != nil {
+		return nil, fmt.Errorf("fails to update the InferenceService of the variant %s: %w", experiment.Name, err)
+	}
+	return existing, nil
+}
+
+// deleteRemovedVariants deletes the InferenceServices of the variants which were removed from the experiments
+func (r *ExperimentReconciler) deleteRemovedVariants(isvc *v1beta1.InferenceService, desired map[string]bool) error {
+	variants := &v1beta1.InferenceServiceList{}
+	if err := r.client.List(context.TODO(), variants, client.InNamespace(isvc.Namespace),
+		client.MatchingLabels{constants.ExperimentParentLabelKey: isvc.Name}); err != nil {
+		return err
+	}
+	for i := range variants.Items {
+		variant := &variants.Items[i]
+		if desired[variant.Name] || !metav1.IsControlledBy(variant, isvc) {
+			continue
+		}
+		log.Info("Deleting experiment variant", "isvc", isvc.Name, "variant", variant.Name)
+		if err := r.client.Delete(context.TODO(), variant); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("fails to delete the InferenceService %s of a removed variant: %w", variant.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newExperimentTestIsvc(experiments ...v1beta1.ExperimentSpec) *v1beta1.InferenceService {
+	return &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-model",
+			Namespace:   "test",
+			UID:         "my-model-uid",
+			Annotations: map[string]string{"team": "ml", constants.LoggerInternalAnnotationKey: "true"},
+			Labels:      map[string]string{"app": "my-model"},
+		},
+		Spec: v1beta1.InferenceServiceSpec{
+			Experiments: experiments,
+		},
+		Status: v1beta1.InferenceServiceStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{Type: v1beta1.PredictorReady, Status: corev1.ConditionTrue}},
+			},
+		},
+	}
+}
+
+func newVariant(name, storageURI string, traffic int64) v1beta1.ExperimentSpec {
+	return v1beta1.ExperimentSpec{
+		Name:           name,
+		TrafficPercent: traffic,
+		Predictor: v1beta1.PredictorSpec{
+			Model: &v1beta1.ModelSpec{
+				ModelFormat: v1beta1.ModelFormat{Name: "sklearn"},
+				PredictorExtensionSpec: v1beta1.PredictorExtensionSpec{
+					StorageURI: proto.String(storageURI),
+				},
+			},
+		},
+	}
+}
+
+func getVariant(c client.Client, name string) (*v1beta1.InferenceService, error) {
+	variant := &v1beta1.InferenceService{}
+	err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "test"}, variant)
+	return variant, err
+}
+
+func setReady(g *gomega.WithT, c client.Client, name string) {
+	variant, err := getVariant(c, name)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	variant.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}}
+	g.Expect(c.Update(context.TODO(), variant)).To(gomega.Succeed())
+}
+
+func TestExperimentReconciler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(s).Build()
+	reconciler := NewExperimentReconciler(c, s, constants.Serverless)
+
+	// the variants are created with the metadata of the InferenceService and receive no traffic until they are ready
+	isvc := newExperimentTestIsvc(newVariant("b", "gs://bucket/b", 30), newVariant("c", "gs://bucket/c", 20))
+	g.Expect(reconciler.Reconcile(isvc)).To(gomega.Succeed())
+	variant, err := getVariant(c, "my-model-b")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(metav1.IsControlledBy(variant, isvc)).To(gomega.BeTrue())
+	g.Expect(*variant.Spec.Predictor.Model.StorageURI).To(gomega.Equal("gs://bucket/b"))
+	g.Expect(variant.Annotations).To(gomega.HaveKeyWithValue("team", "ml"))
+	g.Expect(variant.Annotations).NotTo(gomega.HaveKey(constants.LoggerInternalAnnotationKey))
+	g.Expect(variant.Labels).To(gomega.Equal(map[string]string{
+		"app":                              "my-model",
+		constants.ExperimentParentLabelKey: "my-model",
+		constants.VisibilityLabel:          constants.ClusterLocal,
+	}))
+	g.Expect(isvc.Status.Experiments).To(gomega.Equal([]v1beta1.ExperimentStatus{
+		{Name: DefaultVariantName, ServiceName: "my-model", TrafficPercent: 100, Ready: true},
+		{Name: "b", ServiceName: "my-model-b", TrafficPercent: 0, Ready: false},
+		{Name: "c", ServiceName: "my-model-c", TrafficPercent: 0, Ready: false},
+	}))
+
+	// the ready variants receive their traffic, the predictor receives the rest
+	setReady(g, c, "my-model-b")
+	g.Expect(reconciler.Reconcile(isvc)).To(gomega.Succeed())
+	g.Expect(isvc.Status.Experiments).To(gomega.Equal([]v1beta1.ExperimentStatus{
+		{Name: DefaultVariantName, ServiceName: "my-model", TrafficPercent: 70, Ready: true},
+		{Name: "b", ServiceName: "my-model-b", TrafficPercent: 30, Ready: true},
+		{Name: "c", ServiceName: "my-model-c", TrafficPercent: 0, Ready: false},
+	}))
+
+	// the defaults set on the variants do not trigger updates, a change of the variant does
+	variant, err = getVariant(c, "my-model-b")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	variant.Spec.Predictor.Model.Runtime = proto.String("kserve-sklearnserver")
+	g.Expect(c.Update(context.TODO(), variant)).To(gomega.Succeed())
+	g.Expect(reconciler.Reconcile(isvc)).To(gomega.Succeed())
+	variant, err = getVariant(c, "my-model-b")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(variant.Spec.Predictor.Model.Runtime).NotTo(gomega.BeNil())
+	isvc.Spec.Experiments[0].Predictor.Model.StorageURI = proto.String("gs://bucket/b2")
+	g.Expect(reconciler.Reconcile(isvc)).To(gomega.Succeed())
+	variant, err = getVariant(c, "my-model-b")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(*variant.Spec.Predictor.Model.StorageURI).To(gomega.Equal("gs://bucket/b2"))
+	g.Expect(variant.Spec.Predictor.Model.Runtime).To(gomega.BeNil())
+
+	// the InferenceServices of the removed variants are deleted, the ones not controlled by the InferenceService are kept
+	unowned := &v1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{
+		Name:      "my-model-d",
+		Namespace: "test",
+		Labels:    map[string]string{constants.ExperimentParentLabelKey: "my-model"},
+	}}
+	g.Expect(c.Create(context.TODO(), unowned)).To(gomega.Succeed())
+	isvc.Spec.Experiments = isvc.Spec.Experiments[:1]
+	g.Expect(reconciler.Reconcile(isvc)).To(gomega.Succeed())
+	_, err = getVariant(c, "my-model-c")
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	_, err = getVariant(c, "my-model-d")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// a variant cannot take over an InferenceService it does not control
+	isvc.Spec.Experiments = append(isvc.Spec.Experiments, newVariant("d", "gs://bucket/d", 10))
+	g.Expect(reconciler.Reconcile(isvc)).To(gomega.MatchError(gomega.ContainSubstring("not controlled by the InferenceService")))
+
+	// the experiments are removed outside of the Serverless mode
+	isvc.Spec.Experiments = isvc.Spec.Experiments[:1]
+	g.Expect(NewExperimentReconciler(c, s, constants.RawDeployment).Reconcile(isvc)).To(gomega.Succeed())
+	_, err = getVariant(c, "my-model-b")
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(isvc.Status.Experiments).To(gomega.BeNil())
+}
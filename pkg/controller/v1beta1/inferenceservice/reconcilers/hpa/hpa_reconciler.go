@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -71,6 +72,10 @@ func getHPAMetrics(metadata metav1.ObjectMeta, componentExt *v1beta1.ComponentEx
 	}
 
 	if componentExt.ScaleMetric != nil {
+		switch *componentExt.ScaleMetric {
+		case v1beta1.MetricGPU, v1beta1.MetricGPUMemory, v1beta1.MetricQueueDepth:
+			return append(metrics, getPodsMetric(*componentExt.ScaleMetric, componentExt.ScaleTarget))
+		}
 		resourceName = corev1.ResourceName(*componentExt.ScaleMetric)
 	}
 
@@ -91,6 +96,37 @@ func getHPAMetrics(metadata metav1.ObjectMeta, componentExt *v1beta1.ComponentEx
 	return metrics
 }
 
+// getPodsMetric scales on the average value of a custom metric of the pods, the GPU utilization percentage or the
+// request queue depth are not resource metrics so they are read from the custom metrics API served by the
+// Prometheus adapter.
+func getPodsMetric(metric v1beta1.ScaleMetric, scaleTarget *int) v2beta2.MetricSpec {
+	var metricName string
+	var target int64
+	switch metric {
+	case v1beta1.MetricGPUMemory:
+		metricName, target = constants.GPUMemoryUtilizationMetricName, int64(constants.DefaultGPUUtilization)
+	case v1beta1.MetricQueueDepth:
+		metricName, target = constants.QueueDepthMetricName, int64(constants.DefaultQueueDepth)
+	default:
+		metricName, target = constants.GPUUtilizationMetricName, int64(constants.DefaultGPUUtilization)
+	}
+	if scaleTarget != nil {
+		target = int64(*scaleTarget)
+	}
+	return v2beta2.MetricSpec{
+		Type: v2beta2.PodsMetricSourceType,
+		Pods: &v2beta2.PodsMetricSource{
+			Metric: v2beta2.MetricIdentifier{
+				Name: metricName,
+			},
+			Target: v2beta2.MetricTarget{
+				Type:         v2beta2.AverageValueMetricType,
+				AverageValue: resource.NewQuantity(target, resource.DecimalSI),
+			},
+		},
+	}
+}
+
 func createHPA(componentMeta metav1.ObjectMeta,
 	componentExt *v1beta1.ComponentExtensionSpec) *v2beta2.HorizontalPodAutoscaler {
 	var minReplicas int32
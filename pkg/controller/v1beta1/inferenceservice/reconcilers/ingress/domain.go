@@ -19,9 +19,12 @@ package ingress
 import (
 	"bytes"
 	"fmt"
+	"net/url"
+	"strings"
 	"text/template"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -40,7 +43,7 @@ func GenerateDomainName(name string, obj metav1.ObjectMeta, ingressConfig *v1bet
 	values := DomainTemplateValues{
 		Name:          name,
 		Namespace:     obj.Namespace,
-		IngressDomain: ingressConfig.IngressDomain,
+		IngressDomain: GetIngressDomain(obj, ingressConfig),
 		Annotations:   obj.Annotations,
 		Labels:        obj.Labels,
 	}
@@ -62,3 +65,52 @@ func GenerateDomainName(name string, obj metav1.ObjectMeta, ingressConfig *v1bet
 
 	return buf.String(), nil
 }
+
+// IsClusterLocal returns true when the object is labelled to be only reachable from inside the cluster
+func IsClusterLocal(obj metav1.ObjectMeta) bool {
+	return obj.Labels[constants.VisibilityLabel] == constants.ClusterLocal
+}
+
+// GetIngressDomain returns the ingress domain of the object, which can be overridden per InferenceService with the
+// ingress domain annotation
+func GetIngressDomain(obj metav1.ObjectMeta, ingressConfig *v1beta1.IngressConfig) string {
+	if domain, ok := obj.Annotations[constants.IngressDomainAnnotationKey]; ok {
+		return domain
+	}
+	return ingressConfig.IngressDomain
+}
+
+// GenerateUrlPath generate the path prefix of path based routing using the template configured in IngressConfig,
+// it returns an empty path when path based routing is not configured
+func GenerateUrlPath(name string, obj metav1.ObjectMeta, ingressConfig *v1beta1.IngressConfig) (string, error) {
+	if ingressConfig.PathTemplate == "" {
+		return "", nil
+	}
+	values := DomainTemplateValues{
+		Name:          name,
+		Namespace:     obj.Namespace,
+		IngressDomain: GetIngressDomain(obj, ingressConfig),
+		Annotations:   obj.Annotations,
+		Labels:        obj.Labels,
+	}
+
+	tpl, err := template.New("path-template").Parse(ingressConfig.PathTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	buf := bytes.Buffer{}
+	if err := tpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("error rendering the path template: %w", err)
+	}
+
+	path := buf.String()
+	if !strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+		return "", fmt.Errorf("invalid url path %q: it must start and must not end with a slash", path)
+	}
+	if _, err := url.ParseRequestURI(path); err != nil {
+		return "", fmt.Errorf("invalid url path %q: %w", path, err)
+	}
+
+	return path, nil
+}
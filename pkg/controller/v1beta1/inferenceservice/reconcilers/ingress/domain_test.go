@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -96,6 +97,24 @@ func TestGenerateDomainName(t *testing.T) {
 			},
 			want: "model.test.label-value.example.com",
 		},
+		{
+			name: "ingress domain annotation",
+			args: args{
+				name: "model",
+				obj: v1.ObjectMeta{
+					Name:      "model",
+					Namespace: "test",
+					Annotations: map[string]string{
+						constants.IngressDomainAnnotationKey: "models.corp.internal",
+					},
+				},
+				ingressConfig: &v1beta1.IngressConfig{
+					IngressDomain:  v1beta1.DefaultIngressDomain,
+					DomainTemplate: v1beta1.DefaultDomainTemplate,
+				},
+			},
+			want: "model-test.models.corp.internal",
+		},
 		{
 			name: "unknown variable",
 			args: args{
@@ -134,3 +153,55 @@ func TestGenerateDomainName(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateUrlPath(t *testing.T) {
+	obj := v1.ObjectMeta{
+		Name:      "model",
+		Namespace: "test",
+	}
+
+	tests := []struct {
+		name         string
+		pathTemplate string
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:         "path based routing disabled",
+			pathTemplate: "",
+			want:         "",
+		},
+		{
+			name:         "namespace and name",
+			pathTemplate: "/serving/{{ .Namespace }}/{{ .Name }}",
+			want:         "/serving/test/model",
+		},
+		{
+			name:         "missing leading slash",
+			pathTemplate: "serving/{{ .Namespace }}/{{ .Name }}",
+			wantErr:      true,
+		},
+		{
+			name:         "trailing slash",
+			pathTemplate: "/serving/{{ .Namespace }}/{{ .Name }}/",
+			wantErr:      true,
+		},
+		{
+			name:         "unknown variable",
+			pathTemplate: "/serving/{{ .ModelName }}",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateUrlPath("model", obj, &v1beta1.IngressConfig{PathTemplate: tt.pathTemplate})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GenerateUrlPath() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Test %q unexpected path (-want +got): %v", tt.name, diff)
+			}
+		})
+	}
+}
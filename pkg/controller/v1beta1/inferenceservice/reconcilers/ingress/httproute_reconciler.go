@@ -0,0 +1,319 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"fmt"
+	"strings"
+
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/network"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// HTTPRouteGVK is the Gateway API route which exposes an InferenceService when enableGatewayApi is set,
+// it is handled as unstructured so that the Gateway API CRDs are only required when the flag is on
+var HTTPRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1beta1", Kind: "HTTPRoute"}
+
+// The route fields which the API server defaults are set explicitly so that an unchanged route is not updated
+// on every reconcile
+type httpRouteSpec struct {
+	ParentRefs []parentReference `json:"parentRefs"`
+	Hostnames  []string          `json:"hostnames"`
+	Rules      []httpRouteRule   `json:"rules"`
+}
+
+type parentReference struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type httpRouteRule struct {
+	Matches     []httpRouteMatch  `json:"matches"`
+	Filters     []httpRouteFilter `json:"filters,omitempty"`
+	BackendRefs []httpBackendRef  `json:"backendRefs"`
+}
+
+type httpRouteMatch struct {
+	Path httpPathMatch `json:"path"`
+}
+
+type httpPathMatch struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type httpRouteFilter struct {
+	Type       string          `json:"type"`
+	URLRewrite *httpURLRewrite `json:"urlRewrite,omitempty"`
+}
+
+type httpURLRewrite struct {
+	Path httpPathModifier `json:"path"`
+}
+
+type httpPathModifier struct {
+	Type               string `json:"type"`
+	ReplacePrefixMatch string `json:"replacePrefixMatch"`
+}
+
+type httpBackendRef struct {
+	Group  string `json:"group"`
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Port   int32  `json:"port"`
+	Weight int32  `json:"weight"`
+}
+
+// RawHTTPRouteReconciler reconciles the Gateway API HTTPRoutes of an InferenceService
+type RawHTTPRouteReconciler struct {
+	client        client.Client
+	scheme        *runtime.Scheme
+	ingressConfig *v1beta1api.IngressConfig
+}
+
+func NewRawHTTPRouteReconciler(client client.Client,
+	scheme *runtime.Scheme,
+	ingressConfig *v1beta1api.IngressConfig) (*RawHTTPRouteReconciler, error) {
+	return &RawHTTPRouteReconciler{
+		client:        client,
+		scheme:        scheme,
+		ingressConfig: ingressConfig,
+	}, nil
+}
+
+// parseGateway returns the namespace and name of the Gateway configured in kserveIngressGateway
+func parseGateway(ingressConfig *v1beta1api.IngressConfig) (parentReference, error) {
+	parts := strings.Split(ingressConfig.KserveIngressGateway, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return parentReference{}, fmt.Errorf("invalid kserveIngressGateway %q, expected <namespace>/<name>",
+			ingressConfig.KserveIngressGateway)
+	}
+	return parentReference{
+		Group:     HTTPRouteGVK.Group,
+		Kind:      "Gateway",
+		Namespace: parts[0],
+		Name:      parts[1],
+	}, nil
+}
+
+// createHTTPRoute creates the route of a host, when a path is given only the requests under the path are routed and the
+// path prefix is stripped before the request reaches the service
+func createHTTPRoute(name string, host string, path string, serviceName string, gateway parentReference,
+	isvc *v1beta1api.InferenceService) (*unstructured.Unstructured, error) {
+	rule := httpRouteRule{
+		Matches: []httpRouteMatch{
+			{
+				Path: httpPathMatch{Type: "PathPrefix", Value: "/"},
+			},
+		},
+		BackendRefs: []httpBackendRef{
+			{
+				Kind:   "Service",
+				Name:   serviceName,
+				Port:   constants.CommonDefaultHttpPort,
+				Weight: 1,
+			},
+		},
+	}
+	if path != "" {
+		rule.Matches[0].Path.Value = path
+		rule.Filters = []httpRouteFilter{
+			{
+				Type: "URLRewrite",
+				URLRewrite: &httpURLRewrite{
+					Path: httpPathModifier{Type: "ReplacePrefixMatch", ReplacePrefixMatch: "/"},
+				},
+			},
+		}
+	}
+	spec := httpRouteSpec{
+		ParentRefs: []parentReference{gateway},
+		Hostnames:  []string{host},
+		Rules:      []httpRouteRule{rule},
+	}
+	unstructuredSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	if err != nil {
+		return nil, err
+	}
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(HTTPRouteGVK)
+	route.SetName(name)
+	route.SetNamespace(isvc.Namespace)
+	route.SetAnnotations(isvc.Annotations)
+	route.Object["spec"] = unstructuredSpec
+	return route, nil
+}
+
+// createHTTPRoutes returns the top level route of the InferenceService followed by one route per component,
+// following the same host to service mapping as the raw kubernetes ingress, and the path based route when configured
+func createHTTPRoutes(scheme *runtime.Scheme, isvc *v1beta1api.InferenceService,
+	ingressConfig *v1beta1api.IngressConfig) ([]*unstructured.Unstructured, error) {
+	if !isvc.Status.IsConditionReady(v1beta1api.PredictorReady) {
+		isvc.Status.SetCondition(v1beta1api.IngressReady, &apis.Condition{
+			Type:   v1beta1api.IngressReady,
+			Status: corev1.ConditionFalse,
+			Reason: "Predictor ingress not created",
+		})
+		return nil, nil
+	}
+	if isvc.Spec.Transformer != nil && !isvc.Status.IsConditionReady(v1beta1api.TransformerReady) {
+		isvc.Status.SetCondition(v1beta1api.IngressReady, &apis.Condition{
+			Type:   v1beta1api.IngressReady,
+			Status: corev1.ConditionFalse,
+			Reason: "Transformer ingress not created",
+		})
+		return nil, nil
+	}
+	if isvc.Spec.Transformer == nil && isvc.Spec.Explainer != nil && !isvc.Status.IsConditionReady(v1beta1api.ExplainerReady) {
+		isvc.Status.SetCondition(v1beta1api.IngressReady, &apis.Condition{
+			Type:   v1beta1api.IngressReady,
+			Status: corev1.ConditionFalse,
+			Reason: "Explainer ingress not created",
+		})
+		return nil, nil
+	}
+	gateway, err := parseGateway(ingressConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// :predict routes to the transformer when there is one, otherwise to the predictor
+	topLevelService := constants.DefaultPredictorServiceName(isvc.Name)
+	components := []constants.InferenceServiceComponent{constants.Predictor}
+	if isvc.Spec.Transformer != nil {
+		topLevelService = constants.DefaultTransformerServiceName(isvc.Name)
+		components = append(components, constants.Transformer)
+	}
+	if isvc.Spec.Explainer != nil {
+		components = append(components, constants.Explainer)
+	}
+
+	host, err := generateIngressHost(ingressConfig, isvc, string(constants.Predictor), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating top level http route host: %v", err)
+	}
+	route, err := createHTTPRoute(isvc.Name, host, "", topLevelService, gateway, isvc)
+	if err != nil {
+		return nil, err
+	}
+	routes := []*unstructured.Unstructured{route}
+	for _, component := range components {
+		serviceName := generateMetadata(isvc, component).Name
+		componentHost, err := generateIngressHost(ingressConfig, isvc, string(component), false)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating %s http route host: %v", component, err)
+		}
+		route, err := createHTTPRoute(serviceName, componentHost, "", serviceName, gateway, isvc)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	path, err := GenerateUrlPath(isvc.Name, isvc.ObjectMeta, ingressConfig)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		route, err := createHTTPRoute(pathRouteName(isvc.Name), GetIngressDomain(isvc.ObjectMeta, ingressConfig), path,
+			topLevelService, gateway, isvc)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	for _, route := range routes {
+		if err := controllerutil.SetControllerReference(isvc, route, scheme); err != nil {
+			return nil, err
+		}
+	}
+	return routes, nil
+}
+
+// pathRouteName returns the name of the path based route of the InferenceService
+func pathRouteName(name string) string {
+	return name + "-path"
+}
+
+func (r *RawHTTPRouteReconciler) Reconcile(isvc *v1beta1api.InferenceService) error {
+	if IsClusterLocal(isvc.ObjectMeta) {
+		for _, name := range []string{
+			isvc.Name,
+			pathRouteName(isvc.Name),
+			constants.DefaultPredictorServiceName(isvc.Name),
+			constants.DefaultTransformerServiceName(isvc.Name),
+			constants.DefaultExplainerServiceName(isvc.Name),
+		} {
+			route := &unstructured.Unstructured{}
+			route.SetGroupVersionKind(HTTPRouteGVK)
+			route.SetName(name)
+			route.SetNamespace(isvc.Namespace)
+			if err := deleteIfExists(r.client, route); err != nil {
+				return err
+			}
+		}
+		setClusterLocalStatus(isvc)
+		return nil
+	}
+	routes, err := createHTTPRoutes(r.scheme, isvc, r.ingressConfig)
+	if err != nil {
+		return err
+	}
+	if routes == nil {
+		return nil
+	}
+	for _, route := range routes {
+		if err := reconcileUnstructured(r.client, route); err != nil {
+			return err
+		}
+	}
+	isvc.Status.URL, err = createRawURL(isvc, r.ingressConfig)
+	if err != nil {
+		return err
+	}
+	path, err := GenerateUrlPath(isvc.Name, isvc.ObjectMeta, r.ingressConfig)
+	if err != nil {
+		return err
+	}
+	if path != "" {
+		isvc.Status.URL.Host = GetIngressDomain(isvc.ObjectMeta, r.ingressConfig)
+		isvc.Status.URL.Path = path
+	}
+	isvc.Status.Address = &duckv1.Addressable{
+		URL: &apis.URL{
+			Host:   network.GetServiceHostname(isvc.Name, isvc.Namespace),
+			Scheme: r.ingressConfig.UrlScheme,
+			Path:   "",
+		},
+	}
+	isvc.Status.SetCondition(v1beta1api.IngressReady, &apis.Condition{
+		Type:   v1beta1api.IngressReady,
+		Status: corev1.ConditionTrue,
+	})
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newHTTPRouteTestIsvc(conditions ...apis.Condition) *v1beta1.InferenceService {
+	return &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "test"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Transformer: &v1beta1.TransformerSpec{},
+		},
+		Status: v1beta1.InferenceServiceStatus{
+			Status: duckv1.Status{Conditions: conditions},
+		},
+	}
+}
+
+func getHTTPRouteSpec(g *gomega.WithT, route *unstructured.Unstructured) httpRouteSpec {
+	spec := httpRouteSpec{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(
+		route.Object["spec"].(map[string]interface{}), &spec)).To(gomega.Succeed())
+	return spec
+}
+
+func TestCreateHTTPRoutes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	ingressConfig := &v1beta1.IngressConfig{
+		IngressDomain:        "example.com",
+		DomainTemplate:       "{{ .Name }}-{{ .Namespace }}.{{ .IngressDomain }}",
+		EnableGatewayApi:     true,
+		KserveIngressGateway: "kserve/kserve-ingress-gateway",
+	}
+
+	isvc := newHTTPRouteTestIsvc(apis.Condition{Type: v1beta1.PredictorReady, Status: corev1.ConditionTrue})
+	routes, err := createHTTPRoutes(s, isvc, ingressConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(routes).To(gomega.BeNil())
+	g.Expect(isvc.Status.GetCondition(v1beta1.IngressReady).Reason).To(gomega.Equal("Transformer ingress not created"))
+
+	isvc = newHTTPRouteTestIsvc(
+		apis.Condition{Type: v1beta1.PredictorReady, Status: corev1.ConditionTrue},
+		apis.Condition{Type: v1beta1.TransformerReady, Status: corev1.ConditionTrue})
+	routes, err = createHTTPRoutes(s, isvc, ingressConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	expected := map[string][]string{
+		"my-model":                     {"my-model-test.example.com", constants.DefaultTransformerServiceName("my-model")},
+		"my-model-predictor-default":   {"my-model-predictor-default-test.example.com", constants.DefaultPredictorServiceName("my-model")},
+		"my-model-transformer-default": {"my-model-transformer-default-test.example.com", constants.DefaultTransformerServiceName("my-model")},
+	}
+	g.Expect(routes).To(gomega.HaveLen(len(expected)))
+	for _, route := range routes {
+		g.Expect(expected).To(gomega.HaveKey(route.GetName()))
+		g.Expect(route.GetOwnerReferences()).To(gomega.HaveLen(1))
+		spec := getHTTPRouteSpec(g, route)
+		g.Expect(spec.ParentRefs[0].Namespace).To(gomega.Equal("kserve"))
+		g.Expect(spec.ParentRefs[0].Name).To(gomega.Equal("kserve-ingress-gateway"))
+		g.Expect(spec.Hostnames).To(gomega.Equal([]string{expected[route.GetName()][0]}))
+		g.Expect(spec.Rules[0].BackendRefs[0].Name).To(gomega.Equal(expected[route.GetName()][1]))
+	}
+
+	// path based routing adds a route on the ingress domain which strips the path prefix
+	ingressConfig.PathTemplate = "/serving/{{ .Namespace }}/{{ .Name }}"
+	routes, err = createHTTPRoutes(s, isvc, ingressConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(routes).To(gomega.HaveLen(len(expected) + 1))
+	pathRoute := routes[len(routes)-1]
+	g.Expect(pathRoute.GetName()).To(gomega.Equal("my-model-path"))
+	spec := getHTTPRouteSpec(g, pathRoute)
+	g.Expect(spec.Hostnames).To(gomega.Equal([]string{"example.com"}))
+	g.Expect(spec.Rules[0].Matches[0].Path.Value).To(gomega.Equal("/serving/test/my-model"))
+	g.Expect(spec.Rules[0].Filters[0].URLRewrite.Path.ReplacePrefixMatch).To(gomega.Equal("/"))
+	g.Expect(spec.Rules[0].BackendRefs[0].Name).To(gomega.Equal(constants.DefaultTransformerServiceName("my-model")))
+
+	ingressConfig.KserveIngressGateway = "kserve-ingress-gateway"
+	_, err = createHTTPRoutes(s, isvc, ingressConfig)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestRawHTTPRouteReconciler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(s).Build()
+	ingressConfig := &v1beta1.IngressConfig{
+		IngressDomain:        "example.com",
+		DomainTemplate:       "{{ .Name }}.{{ .Namespace }}.{{ .IngressDomain }}",
+		UrlScheme:            "http",
+		EnableGatewayApi:     true,
+		KserveIngressGateway: "kserve/kserve-ingress-gateway",
+	}
+	r, err := NewRawHTTPRouteReconciler(c, s, ingressConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	isvc := newHTTPRouteTestIsvc(
+		apis.Condition{Type: v1beta1.PredictorReady, Status: corev1.ConditionTrue},
+		apis.Condition{Type: v1beta1.TransformerReady, Status: corev1.ConditionTrue})
+	g.Expect(r.Reconcile(isvc)).To(gomega.Succeed())
+	g.Expect(isvc.Status.IsConditionReady(v1beta1.IngressReady)).To(gomega.BeTrue())
+	g.Expect(isvc.Status.URL.String()).To(gomega.Equal("http://my-model.test.example.com"))
+
+	// removing the transformer routes the top level host to the predictor
+	isvc.Spec.Transformer = nil
+	g.Expect(r.Reconcile(isvc)).To(gomega.Succeed())
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(HTTPRouteGVK)
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: "my-model", Namespace: "test"}, existing)).
+		To(gomega.Succeed())
+	spec := getHTTPRouteSpec(g, existing)
+	g.Expect(spec.Rules[0].BackendRefs[0].Name).To(gomega.Equal(constants.DefaultPredictorServiceName("my-model")))
+
+	// a cluster local service is only reachable through the internal hostname of its predictor
+	isvc.Labels = map[string]string{constants.VisibilityLabel: constants.ClusterLocal}
+	g.Expect(r.Reconcile(isvc)).To(gomega.Succeed())
+	err = c.Get(context.TODO(), types.NamespacedName{Name: "my-model", Namespace: "test"}, existing)
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(isvc.Status.IsConditionReady(v1beta1.IngressReady)).To(gomega.BeTrue())
+	g.Expect(isvc.Status.URL.String()).To(gomega.Equal("http://my-model-predictor-default.test.svc.cluster.local"))
+}
@@ -19,6 +19,8 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
@@ -37,6 +39,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmp"
 	"knative.dev/pkg/network"
+	"knative.dev/serving/pkg/reconciler/serverlessservice/resources/names"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"strings"
@@ -188,6 +191,124 @@ func createHTTPRouteDestination(targetHost, namespace string, gatewayService str
 	return httpRouteDestination
 }
 
+// createExperimentHTTPRouteDestinations splits the predictor traffic between the predictor and the ready variants of
+// the experiments with the weights reported in the status, every destination sets the Host header of the predictor
+// serving it. It returns nil when the InferenceService has no experiments.
+func createExperimentHTTPRouteDestinations(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig) []*istiov1alpha3.HTTPRouteDestination {
+	if len(isvc.Spec.Experiments) == 0 || isvc.Spec.Transformer != nil || len(isvc.Status.Experiments) == 0 {
+		return nil
+	}
+	destinations := []*istiov1alpha3.HTTPRouteDestination{}
+	for _, experiment := range isvc.Status.Experiments {
+		if experiment.TrafficPercent <= 0 {
+			continue
+		}
+		destination := createHTTPRouteDestination(constants.DefaultPredictorServiceName(experiment.ServiceName),
+			isvc.Namespace, config.LocalGatewayServiceName)
+		destination.Weight = int32(experiment.TrafficPercent)
+		destination.Headers = &istiov1alpha3.Headers{
+			Request: &istiov1alpha3.Headers_HeaderOperations{
+				Set: map[string]string{
+					"Host": network.GetServiceHostname(constants.DefaultPredictorServiceName(experiment.ServiceName), isvc.Namespace),
+				},
+			},
+		}
+		destinations = append(destinations, destination)
+	}
+	return destinations
+}
+
+// setHTTPMirror mirrors the route to the candidate revision of the component while it is rolled out in the Mirror
+// traffic mode. The copy is sent straight to the private service of the revision because the mirrored request keeps
+// the Host header of the component, which would route it back to the rolled out revision.
+func setHTTPMirror(route *istiov1alpha3.HTTPRoute, isvc *v1beta1.InferenceService, component v1beta1.ComponentType,
+	componentExtension *v1beta1.ComponentExtensionSpec) {
+	if componentExtension == nil || componentExtension.TrafficMode != v1beta1.TrafficModeMirror {
+		return
+	}
+	canaryRevision := getCanaryRevision(isvc, component)
+	if canaryRevision == "" {
+		return
+	}
+	mirrorPercent := int64(100)
+	if componentExtension.CanaryTrafficPercent != nil {
+		mirrorPercent = *componentExtension.CanaryTrafficPercent
+	}
+	route.Mirror = &istiov1alpha3.Destination{
+		Host: network.GetServiceHostname(names.PrivateService(canaryRevision), isvc.Namespace),
+		Port: &istiov1alpha3.PortSelector{
+			Number: constants.CommonDefaultHttpPort,
+		},
+	}
+	route.MirrorPercentage = &istiov1alpha3.Percent{Value: float64(mirrorPercent)}
+}
+
+// createCanaryHTTPRoute creates the route which sends the requests matching the canary routing of the component to
+// its candidate revision, the route has to be placed before the given component route.
+func createCanaryHTTPRoute(route *istiov1alpha3.HTTPRoute, isvc *v1beta1.InferenceService, component v1beta1.ComponentType,
+	componentExtension *v1beta1.ComponentExtensionSpec, serviceName string) *istiov1alpha3.HTTPRoute {
+	if componentExtension == nil || componentExtension.CanaryRouting == nil || getCanaryRevision(isvc, component) == "" {
+		return nil
+	}
+	headerMatches := []map[string]*istiov1alpha3.StringMatch{}
+	for _, name := range sortedKeys(componentExtension.CanaryRouting.Headers) {
+		headerMatches = append(headerMatches, map[string]*istiov1alpha3.StringMatch{
+			strings.ToLower(name): {
+				MatchType: &istiov1alpha3.StringMatch_Exact{Exact: componentExtension.CanaryRouting.Headers[name]},
+			},
+		})
+	}
+	for _, name := range sortedKeys(componentExtension.CanaryRouting.Cookies) {
+		cookie := regexp.QuoteMeta(name + "=" + componentExtension.CanaryRouting.Cookies[name])
+		headerMatches = append(headerMatches, map[string]*istiov1alpha3.StringMatch{
+			"cookie": {
+				MatchType: &istiov1alpha3.StringMatch_Regex{Regex: "^(.*?;\\s*)?" + cookie + "(;.*)?$"},
+			},
+		})
+	}
+	matchRequests := []*istiov1alpha3.HTTPMatchRequest{}
+	for _, match := range route.Match {
+		for _, headers := range headerMatches {
+			matchRequests = append(matchRequests, &istiov1alpha3.HTTPMatchRequest{
+				Uri:       match.Uri,
+				Authority: match.Authority,
+				Headers:   headers,
+				Gateways:  match.Gateways,
+			})
+		}
+	}
+	return &istiov1alpha3.HTTPRoute{
+		Match: matchRequests,
+		Route: route.Route,
+		Headers: &istiov1alpha3.Headers{
+			Request: &istiov1alpha3.Headers_HeaderOperations{
+				Set: map[string]string{
+					"Host": network.GetServiceHostname("latest-"+serviceName, isvc.Namespace),
+				},
+			},
+		},
+	}
+}
+
+// getCanaryRevision returns the candidate revision of the component while it is being rolled out
+func getCanaryRevision(isvc *v1beta1.InferenceService, component v1beta1.ComponentType) string {
+	componentStatus := isvc.Status.Components[component]
+	if componentStatus.LatestReadyRevision == "" || componentStatus.LatestRolledoutRevision == "" ||
+		componentStatus.LatestReadyRevision == componentStatus.LatestRolledoutRevision {
+		return ""
+	}
+	return componentStatus.LatestReadyRevision
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func createHTTPMatchRequest(prefix, targetHost, internalHost string, isInternal bool, config *v1beta1.IngressConfig) []*istiov1alpha3.HTTPMatchRequest {
 	var uri *istiov1alpha3.StringMatch
 	if prefix != "" {
@@ -223,10 +344,48 @@ func createHTTPMatchRequest(prefix, targetHost, internalHost string, isInternal
 	return matchRequests
 }
 
-func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig) *v1alpha3.VirtualService {
+// getExternalHost returns the host of the InferenceService on the ingress gateway, it follows the knative domain unless
+// the ingress domain of the InferenceService is overridden
+func getExternalHost(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig) (string, error) {
+	if _, ok := isvc.Annotations[constants.IngressDomainAnnotationKey]; !ok {
+		return getServiceHost(isvc), nil
+	}
+	return GenerateDomainName(isvc.Name, isvc.ObjectMeta, config)
+}
+
+// isInternalService returns true if the service is labelled with cluster local or knative domain is configured as
+// internal
+func isInternalService(isvc *v1beta1.InferenceService, serviceHost string) bool {
+	return IsClusterLocal(isvc.ObjectMeta) || serviceHost == network.GetServiceHostname(isvc.Name, isvc.Namespace)
+}
+
+// setExternalURL points the url of the InferenceService at its path based route when path based routing is
+// configured, otherwise at its external host which is served with TLS when enableAutoTLS is set. The path based
+// route keeps the configured scheme as the ingress domain is shared by all the InferenceServices.
+func setExternalURL(url *apis.URL, isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig) error {
+	if isInternalService(isvc, getServiceHost(isvc)) {
+		return nil
+	}
+	path, err := GenerateUrlPath(isvc.Name, isvc.ObjectMeta, config)
+	if err != nil {
+		return err
+	}
+	if path != "" {
+		url.Host = GetIngressDomain(isvc.ObjectMeta, config)
+		url.Path = path
+		return nil
+	}
+	url.Host, err = getExternalHost(isvc, config)
+	if config.EnableAutoTLS {
+		url.Scheme = "https"
+	}
+	return err
+}
+
+func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig) (*v1alpha3.VirtualService, error) {
 	serviceHost := getServiceHost(isvc)
 	if serviceHost == "" {
-		return nil
+		return nil, nil
 	}
 
 	if !isvc.Status.IsConditionReady(v1beta1.PredictorReady) {
@@ -235,29 +394,33 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 			Status: corev1.ConditionFalse,
 			Reason: "Predictor ingress not created",
 		})
-		return nil
+		return nil, nil
 	}
 	backend := constants.DefaultPredictorServiceName(isvc.Name)
+	backendComponent := v1beta1.PredictorComponent
+	backendExtension := &isvc.Spec.Predictor.ComponentExtensionSpec
 
 	if isvc.Spec.Transformer != nil {
 		backend = constants.DefaultTransformerServiceName(isvc.Name)
+		backendComponent = v1beta1.TransformerComponent
+		backendExtension = &isvc.Spec.Transformer.ComponentExtensionSpec
 		if !isvc.Status.IsConditionReady(v1beta1.TransformerReady) {
 			isvc.Status.SetCondition(v1beta1.IngressReady, &apis.Condition{
 				Type:   v1beta1.IngressReady,
 				Status: corev1.ConditionFalse,
 				Reason: "Transformer ingress not created",
 			})
-			return nil
+			return nil, nil
 		}
 	}
-	isInternal := false
-	//if service is labelled with cluster local or knative domain is configured as internal
-	if val, ok := isvc.Labels[constants.VisibilityLabel]; ok && val == "cluster-local" {
-		isInternal = true
+	isInternal := isInternalService(isvc, serviceHost)
+	externalHost, err := getExternalHost(isvc, config)
+	if err != nil {
+		return nil, err
 	}
-	serviceInternalHostName := network.GetServiceHostname(isvc.Name, isvc.Namespace)
-	if serviceHost == serviceInternalHostName {
-		isInternal = true
+	path, err := GenerateUrlPath(isvc.Name, isvc.ObjectMeta, config)
+	if err != nil {
+		return nil, err
 	}
 	httpRoutes := []*istiov1alpha3.HTTPRoute{}
 	// Build explain route
@@ -268,10 +431,14 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 				Status: corev1.ConditionFalse,
 				Reason: "Explainer ingress not created",
 			})
-			return nil
+			return nil, nil
+		}
+		explainPrefix := constants.ExplainPrefix()
+		if isvc.Spec.Explainer.ART != nil {
+			explainPrefix = constants.ExplainOrAdversarialPrefix()
 		}
 		explainerRouter := istiov1alpha3.HTTPRoute{
-			Match: createHTTPMatchRequest(constants.ExplainPrefix(), serviceHost,
+			Match: createHTTPMatchRequest(explainPrefix, externalHost,
 				network.GetServiceHostname(isvc.Name, isvc.Namespace), isInternal, config),
 			Route: []*istiov1alpha3.HTTPRouteDestination{
 				createHTTPRouteDestination(constants.DefaultExplainerServiceName(isvc.Name), isvc.Namespace, config.LocalGatewayServiceName),
@@ -284,11 +451,16 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 				},
 			},
 		}
+		setHTTPMirror(&explainerRouter, isvc, v1beta1.ExplainerComponent, &isvc.Spec.Explainer.ComponentExtensionSpec)
+		if canaryRouter := createCanaryHTTPRoute(&explainerRouter, isvc, v1beta1.ExplainerComponent,
+			&isvc.Spec.Explainer.ComponentExtensionSpec, constants.DefaultExplainerServiceName(isvc.Name)); canaryRouter != nil {
+			httpRoutes = append(httpRoutes, canaryRouter)
+		}
 		httpRoutes = append(httpRoutes, &explainerRouter)
 	}
 	// Add predict route
-	httpRoutes = append(httpRoutes, &istiov1alpha3.HTTPRoute{
-		Match: createHTTPMatchRequest("", serviceHost,
+	predictRouter := istiov1alpha3.HTTPRoute{
+		Match: createHTTPMatchRequest("", externalHost,
 			network.GetServiceHostname(isvc.Name, isvc.Namespace), isInternal, config),
 		Route: []*istiov1alpha3.HTTPRouteDestination{
 			createHTTPRouteDestination(backend, isvc.Namespace, config.LocalGatewayServiceName),
@@ -300,7 +472,16 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 				},
 			},
 		},
-	})
+	}
+	if experimentRoute := createExperimentHTTPRouteDestinations(isvc, config); experimentRoute != nil {
+		predictRouter.Route = experimentRoute
+		predictRouter.Headers = nil
+	}
+	setHTTPMirror(&predictRouter, isvc, backendComponent, backendExtension)
+	if canaryRouter := createCanaryHTTPRoute(&predictRouter, isvc, backendComponent, backendExtension, backend); canaryRouter != nil {
+		httpRoutes = append(httpRoutes, canaryRouter)
+	}
+	httpRoutes = append(httpRoutes, &predictRouter)
 	hosts := []string{
 		network.GetServiceHostname(isvc.Name, isvc.Namespace),
 	}
@@ -308,9 +489,44 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 		config.LocalGateway,
 	}
 	if !isInternal {
-		hosts = append(hosts, serviceHost)
+		hosts = append(hosts, externalHost)
 		gateways = append(gateways, config.IngressGateway)
 	}
+	// Add path based route, the path prefix is stripped before the request reaches the backend
+	if path != "" && !isInternal {
+		ingressDomain := GetIngressDomain(isvc.ObjectMeta, config)
+		pathRouter := istiov1alpha3.HTTPRoute{
+			Match: []*istiov1alpha3.HTTPMatchRequest{
+				{
+					Uri: &istiov1alpha3.StringMatch{
+						MatchType: &istiov1alpha3.StringMatch_Prefix{
+							Prefix: path + "/",
+						},
+					},
+					Authority: &istiov1alpha3.StringMatch{
+						MatchType: &istiov1alpha3.StringMatch_Regex{
+							Regex: constants.HostRegExp(ingressDomain),
+						},
+					},
+					Gateways: []string{config.IngressGateway},
+				},
+			},
+			Rewrite: &istiov1alpha3.HTTPRewrite{
+				Uri: "/",
+			},
+			Route:   predictRouter.Route,
+			Headers: predictRouter.Headers,
+		}
+		setHTTPMirror(&pathRouter, isvc, backendComponent, backendExtension)
+		if canaryRouter := createCanaryHTTPRoute(&pathRouter, isvc, backendComponent, backendExtension, backend); canaryRouter != nil {
+			canaryRouter.Rewrite = pathRouter.Rewrite
+			httpRoutes = append(httpRoutes, canaryRouter)
+		}
+		httpRoutes = append(httpRoutes, &pathRouter)
+		if ingressDomain != externalHost {
+			hosts = append(hosts, ingressDomain)
+		}
+	}
 
 	annotations := utils.Filter(isvc.Annotations, func(key string) bool {
 		return !utils.Includes(constants.ServiceAnnotationDisallowedList, key)
@@ -328,7 +544,7 @@ func createIngress(isvc *v1beta1.InferenceService, config *v1beta1.IngressConfig
 			Http:     httpRoutes,
 		},
 	}
-	return desiredIngress
+	return desiredIngress, nil
 }
 
 func (ir *IngressReconciler) Reconcile(isvc *v1beta1.InferenceService, disableIstioVirtualHost bool) error {
@@ -340,7 +556,10 @@ func (ir *IngressReconciler) Reconcile(isvc *v1beta1.InferenceService, disableIs
 	// When Istio virtual host is disabled, we return the underlying component url.
 	// When Istio virtual host is enabled. we return the url using inference service virtual host name and redirect to the corresponding transformer, predictor or explainer url.
 	if disableIstioVirtualHost == false {
-		desiredIngress := createIngress(isvc, ir.ingressConfig)
+		desiredIngress, err := createIngress(isvc, ir.ingressConfig)
+		if err != nil {
+			return errors.Wrapf(err, "fails to create ingress")
+		}
 		if desiredIngress == nil {
 			return nil
 		}
@@ -354,8 +573,15 @@ func (ir *IngressReconciler) Reconcile(isvc *v1beta1.InferenceService, disableIs
 			return errors.Wrapf(err, "fails to set owner reference for ingress")
 		}
 
+		// Serve the external host with a certificate provisioned by cert-manager
+		if ir.ingressConfig.EnableAutoTLS {
+			if err := ir.reconcileTLSGateway(isvc, desiredIngress); err != nil {
+				return errors.Wrapf(err, "fails to reconcile tls gateway")
+			}
+		}
+
 		existing := &v1alpha3.VirtualService{}
-		err := ir.client.Get(context.TODO(), types.NamespacedName{Name: desiredIngress.Name, Namespace: desiredIngress.Namespace}, existing)
+		err = ir.client.Get(context.TODO(), types.NamespacedName{Name: desiredIngress.Name, Namespace: desiredIngress.Namespace}, existing)
 		if err != nil {
 			if apierr.IsNotFound(err) {
 				log.Info("Creating Ingress for isvc", "namespace", desiredIngress.Namespace, "name", desiredIngress.Name)
@@ -376,6 +602,11 @@ func (ir *IngressReconciler) Reconcile(isvc *v1beta1.InferenceService, disableIs
 	}
 
 	if url, err := apis.ParseURL(serviceUrl); err == nil {
+		if disableIstioVirtualHost == false {
+			if err := setExternalURL(url, isvc, ir.ingressConfig); err != nil {
+				return errors.Wrapf(err, "fails to create ingress url")
+			}
+		}
 		isvc.Status.URL = url
 		path := ""
 		if isvc.Spec.Transformer != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package ingress
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
@@ -493,7 +494,10 @@ func TestCreateVirtualService(t *testing.T) {
 				LocalGatewayServiceName: "knative-local-gateway.istio-system.svc.cluster.local",
 			}
 
-			actualService := createIngress(testIsvc, ingressConfig)
+			actualService, err := createIngress(testIsvc, ingressConfig)
+			if err != nil {
+				t.Errorf("Test %q unexpected error: %v", tc.name, err)
+			}
 			if diff := cmp.Diff(tc.expectedService, actualService); diff != "" {
 				t.Errorf("Test %q unexpected status (-want +got): %v", tc.name, diff)
 			}
@@ -501,6 +505,473 @@ func TestCreateVirtualService(t *testing.T) {
 	}
 }
 
+func TestCreateVirtualServiceMirror(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	serviceName := "my-model"
+	namespace := "test"
+	ingressConfig := &v1beta1.IngressConfig{
+		IngressGateway:          constants.KnativeIngressGateway,
+		IngressServiceName:      "someIngressServiceName",
+		LocalGateway:            constants.KnativeLocalGateway,
+		LocalGatewayServiceName: "knative-local-gateway.istio-system.svc.cluster.local",
+	}
+	rolloutStatus := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:     "my-model-predictor-default-00002",
+		LatestRolledoutRevision: "my-model-predictor-default-00001",
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   constants.InferenceServiceHostName(constants.DefaultPredictorServiceName(serviceName), namespace, "example.com"),
+		},
+	}
+	mirror := &istiov1alpha3.Destination{
+		Host: network.GetServiceHostname("my-model-predictor-default-00002-private", namespace),
+		Port: &istiov1alpha3.PortSelector{Number: constants.CommonDefaultHttpPort},
+	}
+
+	scenarios := map[string]struct {
+		componentExt             v1beta1.ComponentExtensionSpec
+		componentStatus          v1beta1.ComponentStatusSpec
+		expectedMirror           *istiov1alpha3.Destination
+		expectedMirrorPercentage *istiov1alpha3.Percent
+	}{
+		"MirrorCanaryPercent": {
+			componentExt: v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				TrafficMode:          v1beta1.TrafficModeMirror,
+			},
+			componentStatus:          rolloutStatus,
+			expectedMirror:           mirror,
+			expectedMirrorPercentage: &istiov1alpha3.Percent{Value: 20},
+		},
+		"MirrorAllTraffic": {
+			componentExt: v1beta1.ComponentExtensionSpec{
+				TrafficMode: v1beta1.TrafficModeMirror,
+			},
+			componentStatus:          rolloutStatus,
+			expectedMirror:           mirror,
+			expectedMirrorPercentage: &istiov1alpha3.Percent{Value: 100},
+		},
+		"SplitTrafficIsNotMirrored": {
+			componentExt: v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+			},
+			componentStatus: rolloutStatus,
+		},
+		"RolledoutRevisionIsNotMirrored": {
+			componentExt: v1beta1.ComponentExtensionSpec{
+				TrafficMode: v1beta1.TrafficModeMirror,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "my-model-predictor-default-00001",
+				LatestRolledoutRevision: "my-model-predictor-default-00001",
+				URL:                     rolloutStatus.URL,
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			isvc := &v1beta1.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      serviceName,
+					Namespace: namespace,
+				},
+				Spec: v1beta1.InferenceServiceSpec{
+					Predictor: v1beta1.PredictorSpec{ComponentExtensionSpec: scenario.componentExt},
+				},
+				Status: v1beta1.InferenceServiceStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   v1beta1.PredictorReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+						v1beta1.PredictorComponent: scenario.componentStatus,
+					},
+				},
+			}
+			actual, err := createIngress(isvc, ingressConfig)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(actual).NotTo(gomega.BeNil())
+			g.Expect(actual.Spec.Http).To(gomega.HaveLen(1))
+			g.Expect(actual.Spec.Http[0].Mirror).To(gomega.Equal(scenario.expectedMirror))
+			g.Expect(actual.Spec.Http[0].MirrorPercentage).To(gomega.Equal(scenario.expectedMirrorPercentage))
+		})
+	}
+}
+
+func TestCreateVirtualServiceExperiments(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	serviceName := "my-model"
+	namespace := "test"
+	ingressConfig := &v1beta1.IngressConfig{
+		IngressGateway:          constants.KnativeIngressGateway,
+		IngressServiceName:      "someIngressServiceName",
+		LocalGateway:            constants.KnativeLocalGateway,
+		LocalGatewayServiceName: "knative-local-gateway.istio-system.svc.cluster.local",
+	}
+	destination := func(service string, weight int32) *istiov1alpha3.HTTPRouteDestination {
+		return &istiov1alpha3.HTTPRouteDestination{
+			Destination: &istiov1alpha3.Destination{
+				Host: ingressConfig.LocalGatewayServiceName,
+				Port: &istiov1alpha3.PortSelector{Number: constants.CommonDefaultHttpPort},
+			},
+			Weight: weight,
+			Headers: &istiov1alpha3.Headers{
+				Request: &istiov1alpha3.Headers_HeaderOperations{
+					Set: map[string]string{
+						"Host": network.GetServiceHostname(constants.DefaultPredictorServiceName(service), namespace),
+					},
+				},
+			},
+		}
+	}
+
+	scenarios := map[string]struct {
+		experiments   []v1beta1.ExperimentStatus
+		expectedRoute []*istiov1alpha3.HTTPRouteDestination
+	}{
+		"ReadyVariants": {
+			experiments: []v1beta1.ExperimentStatus{
+				{Name: "default", ServiceName: serviceName, TrafficPercent: 50, Ready: true},
+				{Name: "b", ServiceName: serviceName + "-b", TrafficPercent: 30, Ready: true},
+				{Name: "c", ServiceName: serviceName + "-c", TrafficPercent: 20, Ready: true},
+			},
+			expectedRoute: []*istiov1alpha3.HTTPRouteDestination{
+				destination(serviceName, 50),
+				destination(serviceName+"-b", 30),
+				destination(serviceName+"-c", 20),
+			},
+		},
+		"UnreadyVariant": {
+			experiments: []v1beta1.ExperimentStatus{
+				{Name: "default", ServiceName: serviceName, TrafficPercent: 70, Ready: true},
+				{Name: "b", ServiceName: serviceName + "-b", TrafficPercent: 30, Ready: true},
+				{Name: "c", ServiceName: serviceName + "-c", TrafficPercent: 0},
+			},
+			expectedRoute: []*istiov1alpha3.HTTPRouteDestination{
+				destination(serviceName, 70),
+				destination(serviceName+"-b", 30),
+			},
+		},
+		"AllTrafficToVariant": {
+			experiments: []v1beta1.ExperimentStatus{
+				{Name: "default", ServiceName: serviceName, TrafficPercent: 0, Ready: true},
+				{Name: "b", ServiceName: serviceName + "-b", TrafficPercent: 100, Ready: true},
+			},
+			expectedRoute: []*istiov1alpha3.HTTPRouteDestination{
+				destination(serviceName+"-b", 100),
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			isvc := &v1beta1.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      serviceName,
+					Namespace: namespace,
+				},
+				Spec: v1beta1.InferenceServiceSpec{
+					Experiments: []v1beta1.ExperimentSpec{{Name: "b"}, {Name: "c"}},
+				},
+				Status: v1beta1.InferenceServiceStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   v1beta1.PredictorReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+						v1beta1.PredictorComponent: {
+							URL: &apis.URL{
+								Scheme: "http",
+								Host:   constants.InferenceServiceHostName(constants.DefaultPredictorServiceName(serviceName), namespace, "example.com"),
+							},
+						},
+					},
+					Experiments: scenario.experiments,
+				},
+			}
+			actual, err := createIngress(isvc, ingressConfig)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(actual).NotTo(gomega.BeNil())
+			g.Expect(actual.Spec.Http).To(gomega.HaveLen(1))
+			g.Expect(actual.Spec.Http[0].Route).To(gomega.Equal(scenario.expectedRoute))
+			g.Expect(actual.Spec.Http[0].Headers).To(gomega.BeNil())
+		})
+	}
+}
+
+func TestCreateVirtualServiceARTExplainer(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	serviceName := "my-model"
+	namespace := "test"
+	ingressConfig := &v1beta1.IngressConfig{
+		IngressGateway:          constants.KnativeIngressGateway,
+		IngressServiceName:      "someIngressServiceName",
+		LocalGateway:            constants.KnativeLocalGateway,
+		LocalGatewayServiceName: "knative-local-gateway.istio-system.svc.cluster.local",
+	}
+	componentStatus := func(name string) v1beta1.ComponentStatusSpec {
+		return v1beta1.ComponentStatusSpec{
+			URL: &apis.URL{
+				Scheme: "http",
+				Host:   constants.InferenceServiceHostName(name, namespace, "example.com"),
+			},
+		}
+	}
+
+	scenarios := map[string]struct {
+		explainer      v1beta1.ExplainerSpec
+		expectedPrefix string
+	}{
+		"ARTExplainerServesAdversarialVerb": {
+			explainer:      v1beta1.ExplainerSpec{ART: &v1beta1.ARTExplainerSpec{Type: v1beta1.ARTFeatureSqueezingDetector}},
+			expectedPrefix: constants.ExplainOrAdversarialPrefix(),
+		},
+		"AlibiExplainerServesExplainVerb": {
+			explainer:      v1beta1.ExplainerSpec{Alibi: &v1beta1.AlibiExplainerSpec{Type: v1beta1.AlibiAnchorsTextExplainer}},
+			expectedPrefix: constants.ExplainPrefix(),
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			explainer := scenario.explainer
+			isvc := &v1beta1.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      serviceName,
+					Namespace: namespace,
+				},
+				Spec: v1beta1.InferenceServiceSpec{
+					Explainer: &explainer,
+				},
+				Status: v1beta1.InferenceServiceStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   v1beta1.PredictorReady,
+								Status: corev1.ConditionTrue,
+							},
+							{
+								Type:   v1beta1.ExplainerReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+						v1beta1.PredictorComponent: componentStatus(constants.DefaultPredictorServiceName(serviceName)),
+						v1beta1.ExplainerComponent: componentStatus(constants.DefaultExplainerServiceName(serviceName)),
+					},
+				},
+			}
+			actual, err := createIngress(isvc, ingressConfig)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(actual).NotTo(gomega.BeNil())
+			g.Expect(actual.Spec.Http).To(gomega.HaveLen(2))
+			for _, match := range actual.Spec.Http[0].Match {
+				g.Expect(match.Uri.GetRegex()).To(gomega.Equal(scenario.expectedPrefix))
+			}
+		})
+	}
+}
+
+func TestCreateVirtualServiceCanaryRouting(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	serviceName := "my-model"
+	namespace := "test"
+	ingressConfig := &v1beta1.IngressConfig{
+		IngressGateway:          constants.KnativeIngressGateway,
+		IngressServiceName:      "someIngressServiceName",
+		LocalGateway:            constants.KnativeLocalGateway,
+		LocalGatewayServiceName: "knative-local-gateway.istio-system.svc.cluster.local",
+	}
+	rolloutStatus := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:     "my-model-predictor-default-00002",
+		LatestRolledoutRevision: "my-model-predictor-default-00001",
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   constants.InferenceServiceHostName(constants.DefaultPredictorServiceName(serviceName), namespace, "example.com"),
+		},
+	}
+	canaryHost := network.GetServiceHostname("latest-"+constants.DefaultPredictorServiceName(serviceName), namespace)
+
+	scenarios := map[string]struct {
+		canaryRouting   *v1beta1.CanaryRoutingSpec
+		componentStatus v1beta1.ComponentStatusSpec
+		expectedHeaders []map[string]*istiov1alpha3.StringMatch
+	}{
+		"RouteHeader": {
+			canaryRouting:   &v1beta1.CanaryRoutingSpec{Headers: map[string]string{"X-Model-Version": "canary"}},
+			componentStatus: rolloutStatus,
+			expectedHeaders: []map[string]*istiov1alpha3.StringMatch{
+				{"x-model-version": {MatchType: &istiov1alpha3.StringMatch_Exact{Exact: "canary"}}},
+			},
+		},
+		"RouteHeaderOrCookie": {
+			canaryRouting: &v1beta1.CanaryRoutingSpec{
+				Headers: map[string]string{"x-model-version": "canary"},
+				Cookies: map[string]string{"canary": "true"},
+			},
+			componentStatus: rolloutStatus,
+			expectedHeaders: []map[string]*istiov1alpha3.StringMatch{
+				{"x-model-version": {MatchType: &istiov1alpha3.StringMatch_Exact{Exact: "canary"}}},
+				{"cookie": {MatchType: &istiov1alpha3.StringMatch_Regex{Regex: `^(.*?;\s*)?canary=true(;.*)?$`}}},
+			},
+		},
+		"RolledoutRevisionIsNotRouted": {
+			canaryRouting: &v1beta1.CanaryRoutingSpec{Headers: map[string]string{"x-model-version": "canary"}},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "my-model-predictor-default-00001",
+				LatestRolledoutRevision: "my-model-predictor-default-00001",
+				URL:                     rolloutStatus.URL,
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			isvc := &v1beta1.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      serviceName,
+					Namespace: namespace,
+				},
+				Spec: v1beta1.InferenceServiceSpec{
+					Predictor: v1beta1.PredictorSpec{
+						ComponentExtensionSpec: v1beta1.ComponentExtensionSpec{CanaryRouting: scenario.canaryRouting},
+					},
+				},
+				Status: v1beta1.InferenceServiceStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   v1beta1.PredictorReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+						v1beta1.PredictorComponent: scenario.componentStatus,
+					},
+				},
+			}
+			actual, err := createIngress(isvc, ingressConfig)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(actual).NotTo(gomega.BeNil())
+			if scenario.expectedHeaders == nil {
+				g.Expect(actual.Spec.Http).To(gomega.HaveLen(1))
+				return
+			}
+			g.Expect(actual.Spec.Http).To(gomega.HaveLen(2))
+			canaryRoute, predictRoute := actual.Spec.Http[0], actual.Spec.Http[1]
+			g.Expect(canaryRoute.Route).To(gomega.Equal(predictRoute.Route))
+			g.Expect(canaryRoute.Headers.Request.Set).To(gomega.Equal(map[string]string{"Host": canaryHost}))
+			// every gateway match of the predict route is combined with every header match
+			g.Expect(canaryRoute.Match).To(gomega.HaveLen(len(predictRoute.Match) * len(scenario.expectedHeaders)))
+			for i, match := range canaryRoute.Match {
+				predictMatch := predictRoute.Match[i/len(scenario.expectedHeaders)]
+				g.Expect(match.Authority).To(gomega.Equal(predictMatch.Authority))
+				g.Expect(match.Gateways).To(gomega.Equal(predictMatch.Gateways))
+				g.Expect(match.Headers).To(gomega.Equal(scenario.expectedHeaders[i%len(scenario.expectedHeaders)]))
+			}
+		})
+	}
+}
+
+func TestCreateVirtualServicePathRouting(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	serviceName := "my-model"
+	namespace := "test"
+	predictorHost := network.GetServiceHostname(constants.DefaultPredictorServiceName(serviceName), namespace)
+
+	scenarios := map[string]struct {
+		annotations      map[string]string
+		pathTemplate     string
+		expectedHosts    []string
+		expectedPathHost string
+	}{
+		"KnativeDomain": {
+			expectedHosts: []string{network.GetServiceHostname(serviceName, namespace), "my-model.test.example.com"},
+		},
+		"IngressDomainAnnotation": {
+			annotations:   map[string]string{constants.IngressDomainAnnotationKey: "models.corp.internal"},
+			expectedHosts: []string{network.GetServiceHostname(serviceName, namespace), "my-model-test.models.corp.internal"},
+		},
+		"PathTemplate": {
+			pathTemplate: "/serving/{{ .Namespace }}/{{ .Name }}",
+			expectedHosts: []string{network.GetServiceHostname(serviceName, namespace), "my-model.test.example.com",
+				"models.example.com"},
+			expectedPathHost: "models.example.com",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			ingressConfig := &v1beta1.IngressConfig{
+				IngressGateway:          constants.KnativeIngressGateway,
+				IngressServiceName:      "someIngressServiceName",
+				LocalGateway:            constants.KnativeLocalGateway,
+				LocalGatewayServiceName: "knative-local-gateway.istio-system.svc.cluster.local",
+				IngressDomain:           "models.example.com",
+				DomainTemplate:          v1beta1.DefaultDomainTemplate,
+				UrlScheme:               "http",
+				PathTemplate:            scenario.pathTemplate,
+			}
+			isvc := &v1beta1.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        serviceName,
+					Namespace:   namespace,
+					Annotations: scenario.annotations,
+				},
+				Status: v1beta1.InferenceServiceStatus{
+					Status: duckv1.Status{
+						Conditions: duckv1.Conditions{
+							{
+								Type:   v1beta1.PredictorReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+						v1beta1.PredictorComponent: {
+							URL: &apis.URL{
+								Scheme: "http",
+								Host:   constants.InferenceServiceHostName(constants.DefaultPredictorServiceName(serviceName), namespace, "example.com"),
+							},
+						},
+					},
+				},
+			}
+			actual, err := createIngress(isvc, ingressConfig)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(actual.Spec.Hosts).To(gomega.Equal(scenario.expectedHosts))
+			g.Expect(actual.Spec.Http[0].Match[1].Authority.GetRegex()).To(gomega.Equal(constants.HostRegExp(scenario.expectedHosts[1])))
+			if scenario.expectedPathHost == "" {
+				g.Expect(actual.Spec.Http).To(gomega.HaveLen(1))
+				return
+			}
+			g.Expect(actual.Spec.Http).To(gomega.HaveLen(2))
+			pathRoute := actual.Spec.Http[1]
+			g.Expect(pathRoute.Match).To(gomega.HaveLen(1))
+			g.Expect(pathRoute.Match[0].Uri.GetPrefix()).To(gomega.Equal("/serving/test/my-model/"))
+			g.Expect(pathRoute.Match[0].Authority.GetRegex()).To(gomega.Equal(constants.HostRegExp(scenario.expectedPathHost)))
+			g.Expect(pathRoute.Match[0].Gateways).To(gomega.Equal([]string{constants.KnativeIngressGateway}))
+			g.Expect(pathRoute.Rewrite.Uri).To(gomega.Equal("/"))
+			g.Expect(pathRoute.Headers.Request.Set["Host"]).To(gomega.Equal(predictorHost))
+
+			url := &apis.URL{Scheme: "http", Host: "my-model.test.example.com"}
+			g.Expect(setExternalURL(url, isvc, ingressConfig)).To(gomega.Succeed())
+			g.Expect(url.String()).To(gomega.Equal("http://models.example.com/serving/test/my-model"))
+		})
+	}
+}
+
 func TestGetServiceHostname(t *testing.T) {
 
 	testCases := []struct {
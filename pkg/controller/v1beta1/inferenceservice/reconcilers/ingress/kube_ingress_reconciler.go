@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/pkg/apis"
@@ -225,11 +226,74 @@ func createRawIngress(scheme *runtime.Scheme, isvc *v1beta1api.InferenceService,
 	return ingress, nil
 }
 
+// setClusterLocalStatus points the urls of a cluster local InferenceService at the service of the component which
+// serves :predict, as nothing is exposed on the ingress
+func setClusterLocalStatus(isvc *v1beta1api.InferenceService) {
+	backend := constants.DefaultPredictorServiceName(isvc.Name)
+	if isvc.Spec.Transformer != nil {
+		backend = constants.DefaultTransformerServiceName(isvc.Name)
+	}
+	url := &apis.URL{
+		Scheme: "http",
+		Host:   network.GetServiceHostname(backend, isvc.Namespace),
+	}
+	isvc.Status.URL = url
+	isvc.Status.Address = &duckv1.Addressable{URL: url}
+	isvc.Status.SetCondition(v1beta1api.IngressReady, &apis.Condition{
+		Type:   v1beta1api.IngressReady,
+		Status: corev1.ConditionTrue,
+	})
+}
+
+// deleteIfExists removes an object which is no longer desired, e.g. the ingress of a service which became cluster local
+func deleteIfExists(cl client.Client, obj client.Object) error {
+	if err := cl.Delete(context.TODO(), obj); err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// reconcileUnstructured creates or updates the spec of an object of an optional CRD
+func reconcileUnstructured(cl client.Client, desired *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(desired.GroupVersionKind())
+	err := cl.Get(context.TODO(), types.NamespacedName{
+		Namespace: desired.GetNamespace(),
+		Name:      desired.GetName(),
+	}, existing)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			err = cl.Create(context.TODO(), desired)
+			log.Info("creating "+desired.GetKind(), "name", desired.GetName(), "err", err)
+		}
+		return err
+	}
+	if !equality.Semantic.DeepEqual(desired.Object["spec"], existing.Object["spec"]) {
+		existing.Object["spec"] = desired.Object["spec"]
+		err = cl.Update(context.TODO(), existing)
+		log.Info("updating "+desired.GetKind(), "name", desired.GetName(), "err", err)
+	}
+	return err
+}
+
 func semanticIngressEquals(desired, existing *netv1.Ingress) bool {
 	return equality.Semantic.DeepEqual(desired.Spec, existing.Spec)
 }
 
 func (r *RawIngressReconciler) Reconcile(isvc *v1beta1api.InferenceService) error {
+	if IsClusterLocal(isvc.ObjectMeta) {
+		if err := deleteIfExists(r.client, &netv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+			Name:      isvc.Name,
+			Namespace: isvc.Namespace,
+		}}); err != nil {
+			return err
+		}
+		if err := DeleteCertificate(r.client, isvc, r.ingressConfig); err != nil {
+			return err
+		}
+		setClusterLocalStatus(isvc)
+		return nil
+	}
 	ingress, err := createRawIngress(r.scheme, isvc, r.ingressConfig)
 	if ingress == nil {
 		return nil
@@ -237,6 +301,11 @@ func (r *RawIngressReconciler) Reconcile(isvc *v1beta1api.InferenceService) erro
 	if err != nil {
 		return err
 	}
+	if r.ingressConfig.EnableAutoTLS {
+		if err := r.reconcileIngressTLS(isvc, ingress); err != nil {
+			return err
+		}
+	}
 	//reconcile ingress
 	existingIngress := &netv1.Ingress{}
 	err = r.client.Get(context.TODO(), types.NamespacedName{
@@ -263,6 +332,9 @@ func (r *RawIngressReconciler) Reconcile(isvc *v1beta1api.InferenceService) erro
 	if err != nil {
 		return err
 	}
+	if r.ingressConfig.EnableAutoTLS {
+		isvc.Status.URL.Scheme = "https"
+	}
 	isvc.Status.Address = &duckv1.Addressable{
 		URL: &apis.URL{
 			Host:   network.GetServiceHostname(isvc.Name, isvc.Namespace),
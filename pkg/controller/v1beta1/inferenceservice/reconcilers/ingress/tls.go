@@ -0,0 +1,345 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
+	istiov1alpha3 "istio.io/api/networking/v1alpha3"
+	"istio.io/client-go/pkg/apis/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// CertificateGVK is the cert-manager resource which provisions the TLS certificate of an InferenceService host when
+// enableAutoTLS is set, it is handled as unstructured so that cert-manager is only required when the flag is on
+var CertificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// HTTPSPort is the port of the ingress gateway serving the InferenceService hosts with TLS
+const HTTPSPort = 443
+
+// certManagerCertificateNameAnnotationKey is set by cert-manager on the secrets it issues
+const certManagerCertificateNameAnnotationKey = "cert-manager.io/certificate-name"
+
+type certificateSpec struct {
+	SecretName     string          `json:"secretName"`
+	SecretTemplate secretTemplate  `json:"secretTemplate"`
+	DNSNames       []string        `json:"dnsNames"`
+	IssuerRef      issuerReference `json:"issuerRef"`
+}
+
+type secretTemplate struct {
+	Labels map[string]string `json:"labels"`
+}
+
+type issuerReference struct {
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+}
+
+// parseIssuer returns the cert-manager issuer configured in certManagerIssuer
+func parseIssuer(ingressConfig *v1beta1.IngressConfig) (issuerReference, error) {
+	parts := strings.Split(ingressConfig.CertManagerIssuer, "/")
+	if len(parts) != 2 || (parts[0] != "Issuer" && parts[0] != "ClusterIssuer") || parts[1] == "" {
+		return issuerReference{}, fmt.Errorf("invalid certManagerIssuer %q, expected Issuer/<name> or ClusterIssuer/<name>",
+			ingressConfig.CertManagerIssuer)
+	}
+	return issuerReference{
+		Group: CertificateGVK.Group,
+		Kind:  parts[0],
+		Name:  parts[1],
+	}, nil
+}
+
+// tlsSecretName returns the name of the certificate and its secret. The certificates of the Istio ingress gateway all
+// live in the namespace of the gateway, the name carries a hash of the namespace and the name of the InferenceService
+// as concatenating them is ambiguous, e.g. for a-b/c and a/b-c.
+func tlsSecretName(isvc *v1beta1.InferenceService) string {
+	hash := sha256.Sum256([]byte(isvc.Namespace + "/" + isvc.Name))
+	return fmt.Sprintf("%s-%s-tls", isvc.Name, hex.EncodeToString(hash[:8]))
+}
+
+// tlsLabels identify the certificates and the secrets of the InferenceService
+func tlsLabels(isvc *v1beta1.InferenceService) map[string]string {
+	return map[string]string{
+		constants.InferenceServicePodLabelKey:       isvc.Name,
+		constants.InferenceServiceNamespaceLabelKey: isvc.Namespace,
+	}
+}
+
+// isTLSObjectOf checks the labels of a certificate or a secret, the objects of the gateway namespace are not owned by
+// the InferenceService
+func isTLSObjectOf(obj metav1.Object, isvc *v1beta1.InferenceService) bool {
+	labels := obj.GetLabels()
+	return labels[constants.InferenceServicePodLabelKey] == isvc.Name &&
+		labels[constants.InferenceServiceNamespaceLabelKey] == isvc.Namespace
+}
+
+// gatewayNamespace returns the namespace of the Istio ingress gateway workload, which is where the gateway looks up
+// the TLS secrets
+func gatewayNamespace(ingressConfig *v1beta1.IngressConfig) (string, error) {
+	parts := strings.Split(ingressConfig.IngressServiceName, ".")
+	if len(parts) < 2 || parts[1] == "" {
+		return "", fmt.Errorf("invalid ingressService %q, expected <name>.<namespace>.svc.<cluster domain>",
+			ingressConfig.IngressServiceName)
+	}
+	return parts[1], nil
+}
+
+func createCertificate(isvc *v1beta1.InferenceService, namespace string, hosts []string,
+	ingressConfig *v1beta1.IngressConfig) (*unstructured.Unstructured, error) {
+	issuer, err := parseIssuer(ingressConfig)
+	if err != nil {
+		return nil, err
+	}
+	spec := certificateSpec{
+		SecretName:     tlsSecretName(isvc),
+		SecretTemplate: secretTemplate{Labels: tlsLabels(isvc)},
+		DNSNames:       hosts,
+		IssuerRef:      issuer,
+	}
+	unstructuredSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(CertificateGVK)
+	certificate.SetName(tlsSecretName(isvc))
+	certificate.SetNamespace(namespace)
+	certificate.SetLabels(tlsLabels(isvc))
+	certificate.Object["spec"] = unstructuredSpec
+	return certificate, nil
+}
+
+// reconcileCertificate creates or updates the certificate of the InferenceService, a certificate of the same name
+// which is not labeled for the InferenceService is not taken over
+func reconcileCertificate(cl client.Client, isvc *v1beta1.InferenceService, certificate *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(CertificateGVK)
+	err := cl.Get(context.TODO(), types.NamespacedName{Namespace: certificate.GetNamespace(), Name: certificate.GetName()},
+		existing)
+	if err == nil && !isTLSObjectOf(existing, isvc) {
+		return fmt.Errorf("certificate %s/%s already exists and does not belong to the InferenceService",
+			certificate.GetNamespace(), certificate.GetName())
+	}
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return reconcileUnstructured(cl, certificate)
+}
+
+// createTLSGateway creates the Istio gateway which terminates TLS for the external host of the InferenceService, it
+// selects the same gateway workload as the configured ingress gateway
+func createTLSGateway(isvc *v1beta1.InferenceService, host string, selector map[string]string) *v1alpha3.Gateway {
+	return &v1alpha3.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      isvc.Name,
+			Namespace: isvc.Namespace,
+			Labels:    isvc.Labels,
+		},
+		Spec: istiov1alpha3.Gateway{
+			Selector: selector,
+			Servers: []*istiov1alpha3.Server{
+				{
+					Port: &istiov1alpha3.Port{
+						Number:   HTTPSPort,
+						Name:     "https",
+						Protocol: "HTTPS",
+					},
+					Hosts: []string{host},
+					Tls: &istiov1alpha3.ServerTLSSettings{
+						Mode:           istiov1alpha3.ServerTLSSettings_SIMPLE,
+						CredentialName: tlsSecretName(isvc),
+					},
+				},
+			},
+		},
+	}
+}
+
+// addTLSGateway serves the external routes of the virtual service on the TLS gateway as well
+func addTLSGateway(vs *v1alpha3.VirtualService, ingressGateway string, tlsGateway string) {
+	vs.Spec.Gateways = append(vs.Spec.Gateways, tlsGateway)
+	for _, route := range vs.Spec.Http {
+		for _, match := range route.Match {
+			for _, gateway := range match.Gateways {
+				if gateway == ingressGateway {
+					match.Gateways = append(match.Gateways, tlsGateway)
+					break
+				}
+			}
+		}
+	}
+}
+
+// reconcileTLSGateway provisions the certificate of the external host and binds the virtual service to the gateway
+// which serves the host with it
+func (ir *IngressReconciler) reconcileTLSGateway(isvc *v1beta1.InferenceService, vs *v1alpha3.VirtualService) error {
+	if isInternalService(isvc, getServiceHost(isvc)) {
+		return ir.deleteTLSGateway(isvc)
+	}
+	host, err := getExternalHost(isvc, ir.ingressConfig)
+	if err != nil {
+		return err
+	}
+	namespace, err := gatewayNamespace(ir.ingressConfig)
+	if err != nil {
+		return err
+	}
+	certificate, err := createCertificate(isvc, namespace, []string{host}, ir.ingressConfig)
+	if err != nil {
+		return err
+	}
+	if err := reconcileCertificate(ir.client, isvc, certificate); err != nil {
+		return err
+	}
+
+	ingressGateway := strings.Split(ir.ingressConfig.IngressGateway, "/")
+	if len(ingressGateway) != 2 {
+		return fmt.Errorf("invalid ingressGateway %q, expected <namespace>/<name>", ir.ingressConfig.IngressGateway)
+	}
+	existingIngressGateway := &v1alpha3.Gateway{}
+	if err := ir.client.Get(context.TODO(), types.NamespacedName{Namespace: ingressGateway[0], Name: ingressGateway[1]},
+		existingIngressGateway); err != nil {
+		return err
+	}
+	desired := createTLSGateway(isvc, host, existingIngressGateway.Spec.Selector)
+	if err := controllerutil.SetControllerReference(isvc, desired, ir.scheme); err != nil {
+		return err
+	}
+	existing := &v1alpha3.Gateway{}
+	err = ir.client.Get(context.TODO(), types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}, existing)
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		log.Info("Creating TLS gateway for isvc", "namespace", desired.Namespace, "name", desired.Name)
+		err = ir.client.Create(context.TODO(), desired)
+	} else if !equality.Semantic.DeepEqual(desired.Spec, existing.Spec) {
+		existing.Spec = desired.Spec
+		log.Info("Updating TLS gateway for isvc", "namespace", desired.Namespace, "name", desired.Name)
+		err = ir.client.Update(context.TODO(), existing)
+	}
+	if err != nil {
+		return err
+	}
+	addTLSGateway(vs, ir.ingressConfig.IngressGateway, desired.Namespace+"/"+desired.Name)
+	return nil
+}
+
+// reconcileIngressTLS provisions the certificate of the hosts of the kubernetes ingress, the certificate lives next to
+// the ingress as the ingress controller reads the TLS secret from the namespace of the ingress
+func (r *RawIngressReconciler) reconcileIngressTLS(isvc *v1beta1.InferenceService, ingress *netv1.Ingress) error {
+	hosts := []string{}
+	for _, rule := range ingress.Spec.Rules {
+		if !utils.Includes(hosts, rule.Host) {
+			hosts = append(hosts, rule.Host)
+		}
+	}
+	certificate, err := createCertificate(isvc, isvc.Namespace, hosts, r.ingressConfig)
+	if err != nil {
+		return err
+	}
+	if err := controllerutil.SetControllerReference(isvc, certificate, r.scheme); err != nil {
+		return err
+	}
+	if err := reconcileCertificate(r.client, isvc, certificate); err != nil {
+		return err
+	}
+	ingress.Spec.TLS = []netv1.IngressTLS{
+		{
+			Hosts:      hosts,
+			SecretName: tlsSecretName(isvc),
+		},
+	}
+	return nil
+}
+
+// deleteTLSGateway removes the certificate and the gateway of an InferenceService which is no longer served with TLS
+func (ir *IngressReconciler) deleteTLSGateway(isvc *v1beta1.InferenceService) error {
+	if err := deleteIfExists(ir.client, &v1alpha3.Gateway{ObjectMeta: metav1.ObjectMeta{
+		Name:      isvc.Name,
+		Namespace: isvc.Namespace,
+	}}); err != nil {
+		return err
+	}
+	return DeleteCertificate(ir.client, isvc, ir.ingressConfig)
+}
+
+// DeleteCertificate removes the certificate of the InferenceService and its secret. They are not garbage collected
+// through an owner reference, the certificate of the Istio ingress gateway lives in the namespace of the gateway and
+// cert-manager does not set an owner reference on the secrets it issues. Only the certificates labeled for the
+// InferenceService and the secrets issued for them are deleted.
+func DeleteCertificate(cl client.Client, isvc *v1beta1.InferenceService, ingressConfig *v1beta1.IngressConfig) error {
+	if !ingressConfig.EnableAutoTLS {
+		return nil
+	}
+	namespaces := []string{isvc.Namespace}
+	if namespace, err := gatewayNamespace(ingressConfig); err == nil && namespace != isvc.Namespace {
+		namespaces = append(namespaces, namespace)
+	}
+	name := tlsSecretName(isvc)
+	for _, namespace := range namespaces {
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(CertificateGVK)
+		if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, certificate); err != nil {
+			if apierr.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return err
+		}
+		if !isTLSObjectOf(certificate, isvc) {
+			continue
+		}
+		if err := deleteIfExists(cl, certificate); err != nil {
+			return err
+		}
+		secret := &corev1.Secret{}
+		if err := cl.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+			if apierr.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if secret.Annotations[certManagerCertificateNameAnnotationKey] != name || !isTLSObjectOf(secret, isvc) {
+			continue
+		}
+		if err := deleteIfExists(cl, secret); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	istiov1alpha3 "istio.io/api/networking/v1alpha3"
+	"istio.io/client-go/pkg/apis/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTLSTestIngressConfig() *v1beta1.IngressConfig {
+	return &v1beta1.IngressConfig{
+		IngressGateway:          constants.KnativeIngressGateway,
+		IngressServiceName:      "istio-ingressgateway.istio-system.svc.cluster.local",
+		LocalGateway:            constants.KnativeLocalGateway,
+		LocalGatewayServiceName: "knative-local-gateway.istio-system.svc.cluster.local",
+		IngressDomain:           "example.com",
+		DomainTemplate:          "{{ .Name }}.{{ .Namespace }}.{{ .IngressDomain }}",
+		UrlScheme:               "http",
+		EnableAutoTLS:           true,
+		CertManagerIssuer:       "ClusterIssuer/letsencrypt",
+	}
+}
+
+func newTLSTestIsvc() *v1beta1.InferenceService {
+	return &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "test"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Predictor: v1beta1.PredictorSpec{SKLearn: &v1beta1.SKLearnSpec{}},
+		},
+		Status: v1beta1.InferenceServiceStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{
+					{
+						Type:   v1beta1.PredictorReady,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+			Components: map[v1beta1.ComponentType]v1beta1.ComponentStatusSpec{
+				v1beta1.PredictorComponent: {
+					URL: &apis.URL{
+						Scheme: "http",
+						Host:   "my-model-predictor-default.test.example.com",
+					},
+				},
+			},
+		},
+	}
+}
+
+func getCertificateSpec(g *gomega.WithT, c *unstructured.Unstructured) certificateSpec {
+	spec := certificateSpec{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(
+		c.Object["spec"].(map[string]interface{}), &spec)).To(gomega.Succeed())
+	return spec
+}
+
+func TestParseIssuer(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	issuer, err := parseIssuer(&v1beta1.IngressConfig{CertManagerIssuer: "Issuer/self-signed"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(issuer).To(gomega.Equal(issuerReference{Group: "cert-manager.io", Kind: "Issuer", Name: "self-signed"}))
+
+	for _, invalid := range []string{"", "letsencrypt", "Certificate/letsencrypt", "ClusterIssuer/"} {
+		_, err := parseIssuer(&v1beta1.IngressConfig{CertManagerIssuer: invalid})
+		g.Expect(err).To(gomega.HaveOccurred())
+	}
+}
+
+func TestIngressReconcilerAutoTLS(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha3.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(corev1.AddToScheme(s)).To(gomega.Succeed())
+	selector := map[string]string{"istio": "ingressgateway"}
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(&v1alpha3.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "knative-ingress-gateway", Namespace: "knative-serving"},
+		Spec:       istiov1alpha3.Gateway{Selector: selector},
+	}).Build()
+	ingressConfig := newTLSTestIngressConfig()
+
+	isvc := newTLSTestIsvc()
+	g.Expect(NewIngressReconciler(c, s, ingressConfig).Reconcile(isvc, false)).To(gomega.Succeed())
+	g.Expect(isvc.Status.URL.String()).To(gomega.Equal("https://my-model.test.example.com"))
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(CertificateGVK)
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: tlsSecretName(isvc), Namespace: "istio-system"},
+		certificate)).To(gomega.Succeed())
+	spec := getCertificateSpec(g, certificate)
+	g.Expect(spec.DNSNames).To(gomega.Equal([]string{"my-model.test.example.com"}))
+	g.Expect(spec.SecretName).To(gomega.Equal(tlsSecretName(isvc)))
+	g.Expect(spec.IssuerRef.Name).To(gomega.Equal("letsencrypt"))
+	g.Expect(spec.SecretTemplate.Labels).To(gomega.Equal(map[string]string{
+		constants.InferenceServicePodLabelKey:       "my-model",
+		constants.InferenceServiceNamespaceLabelKey: "test",
+	}))
+
+	gateway := &v1alpha3.Gateway{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: "my-model", Namespace: "test"}, gateway)).To(gomega.Succeed())
+	g.Expect(gateway.Spec.Selector).To(gomega.Equal(selector))
+	g.Expect(gateway.Spec.Servers[0].Hosts).To(gomega.Equal([]string{"my-model.test.example.com"}))
+	g.Expect(gateway.Spec.Servers[0].Tls.CredentialName).To(gomega.Equal(tlsSecretName(isvc)))
+
+	vs := &v1alpha3.VirtualService{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: "my-model", Namespace: "test"}, vs)).To(gomega.Succeed())
+	g.Expect(vs.Spec.Gateways).To(gomega.ContainElement("test/my-model"))
+	g.Expect(vs.Spec.Http[0].Match[0].Gateways).To(gomega.Equal([]string{constants.KnativeLocalGateway}))
+	g.Expect(vs.Spec.Http[0].Match[1].Gateways).To(gomega.Equal([]string{constants.KnativeIngressGateway, "test/my-model"}))
+
+	// the certificate and its secret are removed with the InferenceService, they are not owned as they live in the
+	// gateway namespace
+	issued := func(namespace string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:        tlsSecretName(isvc),
+			Namespace:   namespace,
+			Labels:      labels,
+			Annotations: map[string]string{certManagerCertificateNameAnnotationKey: tlsSecretName(isvc)},
+		}}
+	}
+	g.Expect(c.Create(context.TODO(), issued("istio-system", tlsLabels(isvc)))).To(gomega.Succeed())
+	// the secret of the same name in the namespace of the InferenceService was not issued for the gateway
+	g.Expect(c.Create(context.TODO(), issued("test", nil))).To(gomega.Succeed())
+	g.Expect(DeleteCertificate(c, isvc, ingressConfig)).To(gomega.Succeed())
+	err := c.Get(context.TODO(), types.NamespacedName{Name: tlsSecretName(isvc), Namespace: "istio-system"}, certificate)
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	err = c.Get(context.TODO(), types.NamespacedName{Name: tlsSecretName(isvc), Namespace: "istio-system"}, &corev1.Secret{})
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: tlsSecretName(isvc), Namespace: "test"},
+		&corev1.Secret{})).To(gomega.Succeed())
+}
+
+func TestTLSSecretName(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	isvc := func(namespace string, name string) *v1beta1.InferenceService {
+		return &v1beta1.InferenceService{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+	g.Expect(tlsSecretName(isvc("a-b", "c"))).NotTo(gomega.Equal(tlsSecretName(isvc("a", "b-c"))))
+	g.Expect(tlsSecretName(isvc("a", "b-c"))).To(gomega.HavePrefix("b-c-"))
+}
+
+func TestCertificateOwnership(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(corev1.AddToScheme(s)).To(gomega.Succeed())
+	ingressConfig := newTLSTestIngressConfig()
+	isvc := newTLSTestIsvc()
+
+	// a certificate of the gateway namespace which is not labeled for the InferenceService is neither updated nor
+	// deleted
+	other, err := createCertificate(isvc, "istio-system", []string{"other.example.com"}, ingressConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	other.SetLabels(nil)
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(other).Build()
+	desired, err := createCertificate(isvc, "istio-system", []string{"my-model.test.example.com"}, ingressConfig)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(reconcileCertificate(c, isvc, desired)).To(gomega.MatchError(gomega.ContainSubstring("does not belong")))
+	g.Expect(DeleteCertificate(c, isvc, ingressConfig)).To(gomega.Succeed())
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(CertificateGVK)
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: tlsSecretName(isvc), Namespace: "istio-system"},
+		existing)).To(gomega.Succeed())
+	g.Expect(getCertificateSpec(g, existing).DNSNames).To(gomega.Equal([]string{"other.example.com"}))
+}
+
+func TestRawIngressReconcilerAutoTLS(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(netv1.AddToScheme(s)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(s).Build()
+	r, err := NewRawIngressReconciler(c, s, newTLSTestIngressConfig())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	isvc := newTLSTestIsvc()
+	g.Expect(r.Reconcile(isvc)).To(gomega.Succeed())
+	g.Expect(isvc.Status.URL.String()).To(gomega.Equal("https://my-model.test.example.com"))
+
+	ingress := &netv1.Ingress{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: "my-model", Namespace: "test"}, ingress)).To(gomega.Succeed())
+	hosts := []string{"my-model.test.example.com", "my-model-predictor-default.test.example.com"}
+	g.Expect(ingress.Spec.TLS).To(gomega.Equal([]netv1.IngressTLS{{Hosts: hosts, SecretName: tlsSecretName(isvc)}}))
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(CertificateGVK)
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: tlsSecretName(isvc), Namespace: "test"},
+		certificate)).To(gomega.Succeed())
+	g.Expect(getCertificateSpec(g, certificate).DNSNames).To(gomega.Equal(hosts))
+	g.Expect(certificate.GetOwnerReferences()).To(gomega.HaveLen(1))
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("KedaReconciler")
+
+// ScaledObjectGVK is the KEDA resource which scales the deployment of a component,
+// it is handled as unstructured so that KEDA is only required when the keda autoscaler class is used
+var ScaledObjectGVK = schema.GroupVersionKind{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"}
+
+// DefaultKafkaLagThreshold is the consumer group lag per replica when the lag threshold is not set
+const DefaultKafkaLagThreshold = 10
+
+type scaledObjectSpec struct {
+	ScaleTargetRef  scaleTargetRef `json:"scaleTargetRef"`
+	MinReplicaCount *int32         `json:"minReplicaCount,omitempty"`
+	MaxReplicaCount *int32         `json:"maxReplicaCount,omitempty"`
+	Triggers        []scaleTrigger `json:"triggers"`
+}
+
+type scaleTargetRef struct {
+	Name string `json:"name"`
+}
+
+type scaleTrigger struct {
+	Type     string            `json:"type"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// KedaReconciler reconciles the KEDA ScaledObject of a component
+type KedaReconciler struct {
+	client       client.Client
+	scheme       *runtime.Scheme
+	ScaledObject *unstructured.Unstructured
+	componentExt *v1beta1.ComponentExtensionSpec
+}
+
+func NewKedaReconciler(client client.Client,
+	scheme *runtime.Scheme,
+	componentMeta metav1.ObjectMeta,
+	componentExt *v1beta1.ComponentExtensionSpec) (*KedaReconciler, error) {
+	scaledObject, err := createScaledObject(componentMeta, componentExt)
+	if err != nil {
+		return nil, err
+	}
+	return &KedaReconciler{
+		client:       client,
+		scheme:       scheme,
+		ScaledObject: scaledObject,
+		componentExt: componentExt,
+	}, nil
+}
+
+func getTriggers(componentExt *v1beta1.ComponentExtensionSpec) []scaleTrigger {
+	var triggers []scaleTrigger
+	if componentExt.AutoScaling == nil {
+		return triggers
+	}
+	for _, metric := range componentExt.AutoScaling.Metrics {
+		if metric.Prometheus != nil {
+			triggers = append(triggers, scaleTrigger{
+				Type: "prometheus",
+				Metadata: map[string]string{
+					"serverAddress": metric.Prometheus.ServerAddress,
+					"query":         metric.Prometheus.Query,
+					"threshold":     metric.Prometheus.Threshold,
+				},
+			})
+		}
+		if metric.Kafka != nil {
+			lagThreshold := int64(DefaultKafkaLagThreshold)
+			if metric.Kafka.LagThreshold != nil {
+				lagThreshold = *metric.Kafka.LagThreshold
+			}
+			triggers = append(triggers, scaleTrigger{
+				Type: "kafka",
+				Metadata: map[string]string{
+					"bootstrapServers": metric.Kafka.BootstrapServers,
+					"consumerGroup":    metric.Kafka.ConsumerGroup,
+					"topic":            metric.Kafka.Topic,
+					"lagThreshold":     fmt.Sprint(lagThreshold),
+				},
+			})
+		}
+	}
+	return triggers
+}
+
+func createScaledObject(componentMeta metav1.ObjectMeta,
+	componentExt *v1beta1.ComponentExtensionSpec) (*unstructured.Unstructured, error) {
+	// Unlike the HPA, KEDA scales the deployment to zero replicas when minReplicas is 0
+	minReplicas := int32(constants.DefaultMinReplicas)
+	if componentExt.MinReplicas != nil {
+		minReplicas = int32(*componentExt.MinReplicas)
+	} else if componentExt.ScaleToZero != nil && *componentExt.ScaleToZero {
+		minReplicas = 0
+	}
+	spec := scaledObjectSpec{
+		ScaleTargetRef:  scaleTargetRef{Name: componentMeta.Name},
+		MinReplicaCount: &minReplicas,
+		Triggers:        getTriggers(componentExt),
+	}
+	if componentExt.MaxReplicas != 0 {
+		maxReplicas := int32(componentExt.MaxReplicas)
+		if maxReplicas < minReplicas {
+			maxReplicas = minReplicas
+		}
+		spec.MaxReplicaCount = &maxReplicas
+	}
+	unstructuredSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	if err != nil {
+		return nil, err
+	}
+
+	scaledObject := &unstructured.Unstructured{}
+	scaledObject.SetGroupVersionKind(ScaledObjectGVK)
+	scaledObject.SetName(componentMeta.Name)
+	scaledObject.SetNamespace(componentMeta.Namespace)
+	scaledObject.SetLabels(componentMeta.Labels)
+	scaledObject.SetAnnotations(componentMeta.Annotations)
+	scaledObject.Object["spec"] = unstructuredSpec
+	return scaledObject, nil
+}
+
+// checkScaledObjectExist checks if the ScaledObject exists and is equivalent to the desired one
+func (r *KedaReconciler) checkScaledObjectExist(client client.Client) (constants.CheckResultType, *unstructured.Unstructured, error) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(ScaledObjectGVK)
+	err := client.Get(context.TODO(), types.NamespacedName{
+		Namespace: r.ScaledObject.GetNamespace(),
+		Name:      r.ScaledObject.GetName(),
+	}, existing)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return constants.CheckResultCreate, nil, nil
+		}
+		return constants.CheckResultUnknown, nil, err
+	}
+
+	if equality.Semantic.DeepEqual(r.ScaledObject.Object["spec"], existing.Object["spec"]) {
+		return constants.CheckResultExisted, existing, nil
+	}
+	return constants.CheckResultUpdate, existing, nil
+}
+
+// Reconcile ...
+func (r *KedaReconciler) Reconcile() (*unstructured.Unstructured, error) {
+	checkResult, existing, err := r.checkScaledObjectExist(r.client)
+	log.Info("ScaledObject reconcile", "checkResult", checkResult, "err", err)
+	if err != nil {
+		return nil, err
+	}
+
+	switch checkResult {
+	case constants.CheckResultCreate:
+		if err := r.client.Create(context.TODO(), r.ScaledObject); err != nil {
+			return nil, err
+		}
+		return r.ScaledObject, nil
+	case constants.CheckResultUpdate:
+		existing.Object["spec"] = r.ScaledObject.Object["spec"]
+		if err := r.client.Update(context.TODO(), existing); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	default:
+		return existing, nil
+	}
+}
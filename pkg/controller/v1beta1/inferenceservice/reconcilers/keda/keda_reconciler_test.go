@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keda
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestComponentExt() *v1beta1.ComponentExtensionSpec {
+	return &v1beta1.ComponentExtensionSpec{
+		MaxReplicas: 4,
+		AutoScaling: &v1beta1.AutoScalingSpec{
+			Metrics: []v1beta1.ExternalMetricSpec{
+				{
+					Prometheus: &v1beta1.PrometheusMetricSource{
+						ServerAddress: "http://prometheus.monitoring:9090",
+						Query:         "avg(DCGM_FI_DEV_GPU_UTIL)",
+						Threshold:     "80",
+					},
+				},
+				{
+					Kafka: &v1beta1.KafkaMetricSource{
+						BootstrapServers: "kafka:9092",
+						ConsumerGroup:    "llama",
+						Topic:            "requests",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCreateScaledObject(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentMeta := metav1.ObjectMeta{Name: "llama-predictor-default", Namespace: "default"}
+
+	scaledObject, err := createScaledObject(componentMeta, newTestComponentExt())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(scaledObject.GroupVersionKind()).To(gomega.Equal(ScaledObjectGVK))
+	g.Expect(scaledObject.Object["spec"]).To(gomega.Equal(map[string]interface{}{
+		"scaleTargetRef":  map[string]interface{}{"name": "llama-predictor-default"},
+		"minReplicaCount": int64(1),
+		"maxReplicaCount": int64(4),
+		"triggers": []interface{}{
+			map[string]interface{}{
+				"type": "prometheus",
+				"metadata": map[string]interface{}{
+					"serverAddress": "http://prometheus.monitoring:9090",
+					"query":         "avg(DCGM_FI_DEV_GPU_UTIL)",
+					"threshold":     "80",
+				},
+			},
+			map[string]interface{}{
+				"type": "kafka",
+				"metadata": map[string]interface{}{
+					"bootstrapServers": "kafka:9092",
+					"consumerGroup":    "llama",
+					"topic":            "requests",
+					"lagThreshold":     "10",
+				},
+			},
+		},
+	}))
+
+	componentExt := newTestComponentExt()
+	componentExt.ScaleToZero = proto.Bool(true)
+	componentExt.MaxReplicas = 0
+	scaledObject, err = createScaledObject(componentMeta, componentExt)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	spec := scaledObject.Object["spec"].(map[string]interface{})
+	g.Expect(spec["minReplicaCount"]).To(gomega.Equal(int64(0)))
+	g.Expect(spec).NotTo(gomega.HaveKey("maxReplicaCount"))
+}
+
+func TestKedaReconciler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentMeta := metav1.ObjectMeta{Name: "llama-predictor-default", Namespace: "default"}
+	c := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+	r, err := NewKedaReconciler(c, runtime.NewScheme(), componentMeta, newTestComponentExt())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	_, err = r.Reconcile()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	componentExt := newTestComponentExt()
+	componentExt.MaxReplicas = 8
+	r, err = NewKedaReconciler(c, runtime.NewScheme(), componentMeta, componentExt)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	_, err = r.Reconcile()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(ScaledObjectGVK)
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: componentMeta.Name, Namespace: "default"}, existing)).
+		To(gomega.Succeed())
+	maxReplicas, _, _ := unstructured.NestedInt64(existing.Object, "spec", "maxReplicaCount")
+	g.Expect(maxReplicas).To(gomega.Equal(int64(8)))
+}
@@ -0,0 +1,422 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knative
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/constants"
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// Canary analysis results
+const (
+	CanaryAnalysisPromoted   = "Promoted"
+	CanaryAnalysisRolledBack = "RolledBack"
+)
+
+const (
+	errorRateQuery = `sum(rate(revision_app_request_count{namespace_name="%[1]s",revision_name="%[2]s",response_code_class="5xx"}[%[3]s]))` +
+		` / sum(rate(revision_app_request_count{namespace_name="%[1]s",revision_name="%[2]s"}[%[3]s]))`
+	requestCountQuery = `sum(increase(revision_app_request_count{namespace_name="%[1]s",revision_name="%[2]s"}[%[3]s]))`
+	latencyQuery      = `histogram_quantile(0.99, sum(rate(revision_app_request_latencies_bucket{namespace_name="%[1]s",revision_name="%[2]s"}[%[3]s])) by (le))`
+	// canaryAnalysisRetryInterval is how long to wait before querying the metrics or calling the webhook again
+	// after a failed attempt or while the webhook is still evaluating the canary
+	canaryAnalysisRetryInterval = 30 * time.Second
+	// analysisWebhookTimeout bounds a call of the analysis webhook, the reconcile is requeued after it to pick up the
+	// result of the call
+	analysisWebhookTimeout = 5 * time.Second
+)
+
+var analysisWebhookClient = &http.Client{Timeout: analysisWebhookTimeout}
+
+// analysisWebhookCalls tracks the calls of the analysis webhooks across reconciles, the knative service reconcilers
+// are created for every reconcile of the InferenceService. A knative service has at most one call, the call is dropped
+// when the canary is superseded or no longer analyzed and when the InferenceService is deleted. A call in flight
+// during a leader change is started again by the new leader.
+var analysisWebhookCalls = &webhookCalls{calls: map[string]*webhookCall{}}
+
+type webhookCall struct {
+	// namespace and inferenceService own the knative service the call is made for
+	namespace        string
+	inferenceService string
+	// revision and url identify the call, the call is dropped when the service polls for another revision or webhook
+	revision string
+	url      string
+	// done is closed once the result of the call is set
+	done   chan struct{}
+	result string
+	err    error
+}
+
+// webhookCalls runs the calls of the analysis webhooks in the background so that a slow webhook does not block the
+// reconcile loop
+type webhookCalls struct {
+	mu sync.Mutex
+	// calls are keyed by the namespace and name of the knative service
+	calls map[string]*webhookCall
+}
+
+// poll returns the result of the finished call of the webhook for the revision and forgets it. It starts the call when
+// there is none for the revision, finished is false while the call is in flight. The call of a superseded revision
+// or webhook is dropped, its result is ignored when it finishes.
+func (c *webhookCalls) poll(key string, next webhookCall, call func() (string, error)) (result string, finished bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if pending, ok := c.calls[key]; ok && pending.revision == next.revision && pending.url == next.url {
+		select {
+		case <-pending.done:
+			delete(c.calls, key)
+			return pending.result, true, pending.err
+		default:
+			return "", false, nil
+		}
+	}
+	pending := &next
+	pending.done = make(chan struct{})
+	c.calls[key] = pending
+	go func() {
+		pending.result, pending.err = call()
+		close(pending.done)
+	}()
+	return "", false, nil
+}
+
+// forget drops the call of the knative service
+func (c *webhookCalls) forget(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.calls, key)
+}
+
+// forgetInferenceService drops the calls of the knative services of the InferenceService
+func (c *webhookCalls) forgetInferenceService(namespace string, inferenceService string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, call := range c.calls {
+		if call.namespace == namespace && call.inferenceService == inferenceService {
+			delete(c.calls, key)
+		}
+	}
+}
+
+// DeleteAnalysisWebhookCalls drops the pending analysis webhook calls of a deleted InferenceService
+func DeleteAnalysisWebhookCalls(namespace string, inferenceService string) {
+	analysisWebhookCalls.forgetInferenceService(namespace, inferenceService)
+}
+
+// CanaryMetrics are the request metrics of a revision over the analysis window
+type CanaryMetrics struct {
+	// RequestCount is the number of requests served by the revision, the other metrics are inconclusive below the
+	// minimum request count
+	RequestCount float64
+	// ErrorRate is the ratio of the requests which returned a 5xx response
+	ErrorRate float64
+	// Latency is the 99th percentile of the request latencies
+	Latency time.Duration
+}
+
+// CanaryMetricsProvider queries the request metrics of a revision
+type CanaryMetricsProvider interface {
+	GetMetrics(namespace string, revision string, window time.Duration) (*CanaryMetrics, error)
+}
+
+// CanaryAnalysisRequest is the payload posted to the analysis webhook before a canary revision is promoted
+type CanaryAnalysisRequest struct {
+	Namespace string `json:"namespace"`
+	// Service is the name of the knative service of the component
+	Service  string `json:"service"`
+	Revision string `json:"revision"`
+	// PreviousRevision is the revision which is serving the rest of the traffic
+	PreviousRevision string `json:"previousRevision,omitempty"`
+	// ErrorRate and Latency are only reported when the metrics are analyzed
+	ErrorRate *float64 `json:"errorRate,omitempty"`
+	Latency   string   `json:"latency,omitempty"`
+}
+
+type prometheusMetricsProvider struct {
+	api promv1.API
+}
+
+// NewPrometheusMetricsProvider creates a metrics provider which queries the knative queue proxy metrics from Prometheus
+func NewPrometheusMetricsProvider(address string) (CanaryMetricsProvider, error) {
+	client, err := promapi.NewClient(promapi.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &prometheusMetricsProvider{api: promv1.NewAPI(client)}, nil
+}
+
+func (p *prometheusMetricsProvider) GetMetrics(namespace string, revision string, window time.Duration) (*CanaryMetrics, error) {
+	promWindow := model.Duration(window).String()
+	requestCount, err := p.query(fmt.Sprintf(requestCountQuery, namespace, revision, promWindow))
+	if err != nil {
+		return nil, err
+	}
+	errorRate, err := p.query(fmt.Sprintf(errorRateQuery, namespace, revision, promWindow))
+	if err != nil {
+		return nil, err
+	}
+	latency, err := p.query(fmt.Sprintf(latencyQuery, namespace, revision, promWindow))
+	if err != nil {
+		return nil, err
+	}
+	return &CanaryMetrics{
+		RequestCount: requestCount,
+		ErrorRate:    errorRate,
+		Latency:      time.Duration(latency * float64(time.Millisecond)),
+	}, nil
+}
+
+// query returns the first sample of the query result, a revision without requests has no samples and reports 0
+func (p *prometheusMetricsProvider) query(query string) (float64, error) {
+	result, _, err := p.api.Query(context.TODO(), query, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return 0, fmt.Errorf("unexpected result type %s for query %s", result.Type(), query)
+	}
+	if len(vector) == 0 || math.IsNaN(float64(vector[0].Value)) {
+		return 0, nil
+	}
+	return float64(vector[0].Value), nil
+}
+
+// analyzeCanary compares the metrics of the canary revision with the thresholds once the analysis window has passed.
+// A canary which stays within the thresholds for the whole window is promoted to 100 percent of the traffic, a canary
+// which exceeds them is rolled back to 0 percent. When an analysis webhook is configured the promotion additionally
+// waits for the webhook to accept the canary. The result is recorded on the knative service so that it sticks until
+// a new revision is rolled out. It returns the time until the canary should be analyzed again.
+//
+// A canary which receives no traffic, e.g. while its manual traffic shift is not approved, is not analyzed. The
+// metrics of a canary which has served fewer requests than the minimum request count are inconclusive, the canary is
+// kept until it has served enough requests.
+//
+// The webhook is selected by name from the webhooks configured by the cluster admin and is called in the background,
+// the result is picked up by a later reconcile.
+func (r *KsvcReconciler) analyzeCanary(desired, existing *knservingv1.Service) time.Duration {
+	// the pending webhook call of a canary which is no longer analyzed is dropped
+	webhookCallKey := analysisWebhookCallKey(desired)
+	window, err := time.ParseDuration(desired.Annotations[constants.CanaryAnalysisWindowAnnotationKey])
+	if err != nil || window <= 0 || r.RollbackRevision != "" {
+		analysisWebhookCalls.forget(webhookCallKey)
+		return 0
+	}
+	webhookName := desired.Annotations[constants.CanaryAnalysisWebhookAnnotationKey]
+	if r.MetricsProvider == nil && webhookName == "" {
+		analysisWebhookCalls.forget(webhookCallKey)
+		return 0
+	}
+	revision := r.componentStatus.LatestReadyRevision
+	if revision == r.componentStatus.LatestRolledoutRevision || !isTrafficSplit(desired.Spec.Traffic) {
+		analysisWebhookCalls.forget(webhookCallKey)
+		return 0
+	}
+	if r.componentExt.ManualTrafficShift && !r.isCanaryApproved(desired, existing) {
+		analysisWebhookCalls.forget(webhookCallKey)
+		return 0
+	}
+
+	analysisStart := time.Now()
+	result := ""
+	if existing.Annotations[constants.CanaryAnalysisRevisionInternalAnnotationKey] == revision {
+		if start, err := time.Parse(time.RFC3339, existing.Annotations[constants.CanaryAnalysisStartInternalAnnotationKey]); err == nil {
+			analysisStart = start
+		}
+		result = existing.Annotations[constants.CanaryAnalysisResultInternalAnnotationKey]
+	}
+
+	requeueAfter := time.Duration(0)
+	if result == "" {
+		var metrics *CanaryMetrics
+		if r.MetricsProvider != nil {
+			metrics, err = r.MetricsProvider.GetMetrics(desired.Namespace, revision, window)
+		}
+		switch {
+		case err != nil:
+			log.Error(err, "Failed to get canary metrics", "namespace", desired.Namespace, "revision", revision)
+			requeueAfter = canaryAnalysisRetryInterval
+		case metrics != nil && !isConclusive(desired.Annotations, metrics):
+			log.Info("Canary metrics are inconclusive", "namespace", desired.Namespace, "revision", revision,
+				"requestCount", metrics.RequestCount)
+			requeueAfter = canaryAnalysisRetryInterval
+			if remaining := window - time.Since(analysisStart); remaining > 0 {
+				requeueAfter = remaining
+			}
+		case metrics != nil && exceedsCanaryThresholds(desired.Annotations, metrics):
+			result = CanaryAnalysisRolledBack
+		case time.Since(analysisStart) < window:
+			requeueAfter = window - time.Since(analysisStart)
+		case webhookName != "":
+			result, requeueAfter = r.pollAnalysisWebhook(desired, webhookName, CanaryAnalysisRequest{
+				Namespace:        desired.Namespace,
+				Service:          desired.Name,
+				Revision:         revision,
+				PreviousRevision: r.componentStatus.LatestRolledoutRevision,
+			}, metrics)
+		default:
+			result = CanaryAnalysisPromoted
+		}
+		log.Info("Analyzed canary revision", "namespace", desired.Namespace, "revision", revision,
+			"metrics", metrics, "result", result)
+	}
+
+	desired.Annotations[constants.CanaryAnalysisRevisionInternalAnnotationKey] = revision
+	desired.Annotations[constants.CanaryAnalysisStartInternalAnnotationKey] = analysisStart.Format(time.RFC3339)
+	switch result {
+	case CanaryAnalysisPromoted:
+		desired.Annotations[constants.CanaryAnalysisResultInternalAnnotationKey] = result
+		desired.Spec.Traffic = []knservingv1.TrafficTarget{promoteLatestTarget(desired.Spec.Traffic)}
+	case CanaryAnalysisRolledBack:
+		desired.Annotations[constants.CanaryAnalysisResultInternalAnnotationKey] = result
+		for i := range desired.Spec.Traffic {
+			if isLatestTarget(desired.Spec.Traffic[i]) {
+				desired.Spec.Traffic[i].Percent = proto.Int64(0)
+			} else {
+				desired.Spec.Traffic[i].Percent = proto.Int64(100)
+			}
+		}
+	}
+	return requeueAfter
+}
+
+// pollAnalysisWebhook starts the call of the named analysis webhook for the canary or picks up its result. The canary is
+// kept while the call is in flight, when the call failed and when the webhook is not configured.
+func (r *KsvcReconciler) pollAnalysisWebhook(desired *knservingv1.Service, name string, request CanaryAnalysisRequest, metrics *CanaryMetrics) (string, time.Duration) {
+	url, ok := r.AnalysisWebhooks[name]
+	if !ok {
+		log.Error(fmt.Errorf("canary analysis webhook %s is not configured", name), "Failed to call canary analysis webhook",
+			"namespace", request.Namespace, "revision", request.Revision)
+		return "", canaryAnalysisRetryInterval
+	}
+	result, finished, err := analysisWebhookCalls.poll(analysisWebhookCallKey(desired), webhookCall{
+		namespace:        request.Namespace,
+		inferenceService: desired.Labels[constants.InferenceServicePodLabelKey],
+		revision:         request.Revision,
+		url:              url,
+	}, func() (string, error) {
+		return callAnalysisWebhook(url, request, metrics)
+	})
+	switch {
+	case !finished:
+		return "", analysisWebhookTimeout
+	case err != nil:
+		log.Error(err, "Failed to call canary analysis webhook", "namespace", request.Namespace,
+			"revision", request.Revision, "webhook", name)
+	}
+	if result == "" {
+		return "", canaryAnalysisRetryInterval
+	}
+	return result, 0
+}
+
+func analysisWebhookCallKey(service *knservingv1.Service) string {
+	return service.Namespace + "/" + service.Name
+}
+
+// callAnalysisWebhook posts the canary to the analysis webhook. A 200 response promotes the canary, a 202 response
+// means the evaluation is still in progress and a server error is retried, any other response rolls the canary back.
+// It returns an empty result when the canary should be analyzed again.
+func callAnalysisWebhook(url string, request CanaryAnalysisRequest, metrics *CanaryMetrics) (string, error) {
+	if metrics != nil {
+		request.ErrorRate = &metrics.ErrorRate
+		request.Latency = metrics.Latency.String()
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	resp, err := analysisWebhookClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return CanaryAnalysisPromoted, nil
+	case http.StatusAccepted:
+		return "", nil
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return "", fmt.Errorf("analysis webhook returned %s", resp.Status)
+	}
+	return CanaryAnalysisRolledBack, nil
+}
+
+// exceedsCanaryThresholds checks the metrics against the thresholds set on the knative service annotations
+func exceedsCanaryThresholds(annotations map[string]string, metrics *CanaryMetrics) bool {
+	if value, ok := annotations[constants.CanaryMaxErrorRateAnnotationKey]; ok {
+		if maxErrorRate, err := strconv.ParseFloat(value, 64); err == nil && metrics.ErrorRate > maxErrorRate {
+			return true
+		}
+	}
+	if value, ok := annotations[constants.CanaryMaxLatencyAnnotationKey]; ok {
+		if maxLatency, err := time.ParseDuration(value); err == nil && metrics.Latency > maxLatency {
+			return true
+		}
+	}
+	return false
+}
+
+// isConclusive checks whether the canary has served the minimum request count set on the knative service annotations
+func isConclusive(annotations map[string]string, metrics *CanaryMetrics) bool {
+	minRequestCount := constants.DefaultCanaryMinRequestCount
+	if value, ok := annotations[constants.CanaryMinRequestCountAnnotationKey]; ok {
+		if count, err := strconv.Atoi(value); err == nil && count >= 0 {
+			minRequestCount = count
+		}
+	}
+	return metrics.RequestCount > 0 && metrics.RequestCount >= float64(minRequestCount)
+}
+
+// isTrafficSplit checks whether the latest revision serves a share of the traffic without serving all of it
+func isTrafficSplit(traffic []knservingv1.TrafficTarget) bool {
+	for _, target := range traffic {
+		if isLatestTarget(target) && target.Percent != nil && *target.Percent > 0 && *target.Percent < 100 {
+			return true
+		}
+	}
+	return false
+}
+
+func isLatestTarget(target knservingv1.TrafficTarget) bool {
+	return target.LatestRevision != nil && *target.LatestRevision
+}
+
+func promoteLatestTarget(traffic []knservingv1.TrafficTarget) knservingv1.TrafficTarget {
+	for _, target := range traffic {
+		if isLatestTarget(target) {
+			target.Percent = proto.Int64(100)
+			return target
+		}
+	}
+	return knservingv1.TrafficTarget{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)}
+}
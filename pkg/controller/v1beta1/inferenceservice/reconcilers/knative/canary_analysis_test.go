@@ -0,0 +1,495 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knative
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeMetricsProvider struct {
+	metrics *CanaryMetrics
+	err     error
+}
+
+func (f *fakeMetricsProvider) GetMetrics(namespace string, revision string, window time.Duration) (*CanaryMetrics, error) {
+	return f.metrics, f.err
+}
+
+func TestExceedsCanaryThresholds(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		annotations map[string]string
+		metrics     *CanaryMetrics
+		expected    bool
+	}{
+		"NoThresholds": {
+			annotations: map[string]string{},
+			metrics:     &CanaryMetrics{ErrorRate: 1, Latency: time.Minute},
+			expected:    false,
+		},
+		"WithinThresholds": {
+			annotations: map[string]string{
+				constants.CanaryMaxErrorRateAnnotationKey: "0.05",
+				constants.CanaryMaxLatencyAnnotationKey:   "500ms",
+			},
+			metrics:  &CanaryMetrics{ErrorRate: 0.01, Latency: 200 * time.Millisecond},
+			expected: false,
+		},
+		"ExceedsErrorRate": {
+			annotations: map[string]string{
+				constants.CanaryMaxErrorRateAnnotationKey: "0.05",
+			},
+			metrics:  &CanaryMetrics{ErrorRate: 0.1},
+			expected: true,
+		},
+		"ExceedsLatency": {
+			annotations: map[string]string{
+				constants.CanaryMaxLatencyAnnotationKey: "500ms",
+			},
+			metrics:  &CanaryMetrics{Latency: time.Second},
+			expected: true,
+		},
+		"InvalidThresholdIgnored": {
+			annotations: map[string]string{
+				constants.CanaryMaxErrorRateAnnotationKey: "five percent",
+			},
+			metrics:  &CanaryMetrics{ErrorRate: 0.1},
+			expected: false,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g.Expect(exceedsCanaryThresholds(scenario.annotations, scenario.metrics)).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestKsvcReconcilerCanaryAnalysis(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentStatus := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:     "sklearn-predictor-default-00002",
+		LatestCreatedRevision:   "sklearn-predictor-default-00002",
+		LatestRolledoutRevision: "sklearn-predictor-default-00001",
+	}
+	annotations := map[string]string{
+		constants.CanaryAnalysisWindowAnnotationKey: "5m",
+		constants.CanaryMaxErrorRateAnnotationKey:   "0.05",
+		constants.CanaryMaxLatencyAnnotationKey:     "500ms",
+	}
+	newReconciler := func(cli client.Client, provider CanaryMetricsProvider) *KsvcReconciler {
+		componentMeta := newTestComponentMeta()
+		for key, value := range annotations {
+			componentMeta.Annotations[key] = value
+		}
+		r := NewKsvcReconciler(cli, newTestScheme(), componentMeta,
+			&v1beta1.ComponentExtensionSpec{CanaryTrafficPercent: proto.Int64(20)}, newTestPodSpec(), componentStatus)
+		r.Service.OwnerReferences = []metav1.OwnerReference{isvcOwnerReference("sklearn", "isvc-uid")}
+		r.MetricsProvider = provider
+		return r
+	}
+	getService := func(cli client.Client) *knservingv1.Service {
+		actual := &knservingv1.Service{}
+		g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: "sklearn-predictor-default", Namespace: "default"},
+			actual)).To(gomega.Succeed())
+		return actual
+	}
+	canaryTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(20)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(80), Tag: "prev"},
+	}
+	promotedTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(0), Tag: "prev"},
+	}
+	rolledBackTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+	}
+	healthy := &fakeMetricsProvider{metrics: &CanaryMetrics{RequestCount: 100, ErrorRate: 0.01, Latency: 100 * time.Millisecond}}
+
+	scenarios := map[string]struct {
+		provider        CanaryMetricsProvider
+		analysisStart   time.Time
+		expectedTraffic []knservingv1.TrafficTarget
+		expectedResult  string
+		expectRequeue   bool
+	}{
+		"KeepCanaryWithinWindow": {
+			provider:        healthy,
+			analysisStart:   time.Now(),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectRequeue:   true,
+		},
+		"PromoteAfterWindow": {
+			provider:        healthy,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: promotedTraffic,
+			expectedResult:  CanaryAnalysisPromoted,
+			expectRequeue:   true,
+		},
+		"RollbackOnErrorRate": {
+			provider:        &fakeMetricsProvider{metrics: &CanaryMetrics{RequestCount: 100, ErrorRate: 0.5}},
+			analysisStart:   time.Now(),
+			expectedTraffic: rolledBackTraffic,
+			expectedResult:  CanaryAnalysisRolledBack,
+			expectRequeue:   false,
+		},
+		"RollbackOnLatency": {
+			provider:        &fakeMetricsProvider{metrics: &CanaryMetrics{RequestCount: 100, Latency: 2 * time.Second}},
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: rolledBackTraffic,
+			expectedResult:  CanaryAnalysisRolledBack,
+			expectRequeue:   false,
+		},
+		"RetryOnMetricsError": {
+			provider:        &fakeMetricsProvider{err: fmt.Errorf("prometheus unavailable")},
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectRequeue:   true,
+		},
+		"KeepCanaryWithoutRequests": {
+			provider:        &fakeMetricsProvider{metrics: &CanaryMetrics{}},
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectRequeue:   true,
+		},
+		"KeepCanaryBelowMinRequestCount": {
+			provider:        &fakeMetricsProvider{metrics: &CanaryMetrics{RequestCount: 5, ErrorRate: 0.5}},
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectRequeue:   true,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+			_, err := newReconciler(cli, scenario.provider).Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			existing := getService(cli)
+			existing.Annotations[constants.CanaryAnalysisRevisionInternalAnnotationKey] = componentStatus.LatestReadyRevision
+			existing.Annotations[constants.CanaryAnalysisStartInternalAnnotationKey] = scenario.analysisStart.Format(time.RFC3339)
+			g.Expect(cli.Update(context.TODO(), existing)).To(gomega.Succeed())
+
+			r := newReconciler(cli, scenario.provider)
+			_, err = r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			actual := getService(cli)
+			g.Expect(actual.Spec.Traffic).To(gomega.Equal(scenario.expectedTraffic))
+			g.Expect(actual.Annotations[constants.CanaryAnalysisResultInternalAnnotationKey]).To(gomega.Equal(scenario.expectedResult))
+			if scenario.expectRequeue {
+				g.Expect(r.RequeueAfter).To(gomega.BeNumerically(">", 0))
+			} else {
+				g.Expect(r.RequeueAfter).To(gomega.BeZero())
+			}
+		})
+	}
+
+	for name, componentExt := range map[string]*v1beta1.ComponentExtensionSpec{
+		"SkipCanaryWithoutTraffic":        {CanaryTrafficPercent: proto.Int64(0)},
+		"SkipCanaryPendingManualApproval": {CanaryTrafficPercent: proto.Int64(20), ManualTrafficShift: true},
+		"SkipCanaryMirroringTheTraffic":   {TrafficMode: v1beta1.TrafficModeMirror},
+	} {
+		t.Run(name, func(t *testing.T) {
+			newSkippedReconciler := func(cli client.Client) *KsvcReconciler {
+				componentMeta := newTestComponentMeta()
+				for key, value := range annotations {
+					componentMeta.Annotations[key] = value
+				}
+				r := NewKsvcReconciler(cli, newTestScheme(), componentMeta, componentExt, newTestPodSpec(), componentStatus)
+				r.Service.OwnerReferences = []metav1.OwnerReference{isvcOwnerReference("sklearn", "isvc-uid")}
+				r.MetricsProvider = healthy
+				return r
+			}
+			cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+			_, err := newSkippedReconciler(cli).Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			existing := getService(cli)
+			existing.Annotations[constants.CanaryAnalysisRevisionInternalAnnotationKey] = componentStatus.LatestReadyRevision
+			existing.Annotations[constants.CanaryAnalysisStartInternalAnnotationKey] = time.Now().Add(-10 * time.Minute).Format(time.RFC3339)
+			g.Expect(cli.Update(context.TODO(), existing)).To(gomega.Succeed())
+
+			// the canary without traffic has no metrics to analyze and is not promoted when the window has passed
+			r := newSkippedReconciler(cli)
+			_, err = r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			actual := getService(cli)
+			g.Expect(actual.Annotations).NotTo(gomega.HaveKey(constants.CanaryAnalysisResultInternalAnnotationKey))
+			for _, target := range actual.Spec.Traffic {
+				if isLatestTarget(target) {
+					g.Expect(*target.Percent).To(gomega.BeZero())
+				}
+			}
+			g.Expect(r.RequeueAfter).To(gomega.BeZero())
+		})
+	}
+
+	t.Run("StartAnalysisAndKeepRollback", func(t *testing.T) {
+		cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+		_, err := newReconciler(cli, healthy).Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		// the analysis of a new canary revision starts on the next reconcile
+		_, err = newReconciler(cli, healthy).Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		actual := getService(cli)
+		g.Expect(actual.Annotations[constants.CanaryAnalysisRevisionInternalAnnotationKey]).To(gomega.Equal(componentStatus.LatestReadyRevision))
+		g.Expect(actual.Annotations).To(gomega.HaveKey(constants.CanaryAnalysisStartInternalAnnotationKey))
+
+		_, err = newReconciler(cli, &fakeMetricsProvider{metrics: &CanaryMetrics{RequestCount: 100, ErrorRate: 0.5}}).Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(getService(cli).Spec.Traffic).To(gomega.Equal(rolledBackTraffic))
+
+		// a rolled back canary is not analyzed again even if its metrics recover
+		_, err = newReconciler(cli, healthy).Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(getService(cli).Spec.Traffic).To(gomega.Equal(rolledBackTraffic))
+	})
+}
+
+func TestKsvcReconcilerCanaryAnalysisWebhook(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentStatus := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:     "sklearn-predictor-default-00002",
+		LatestCreatedRevision:   "sklearn-predictor-default-00002",
+		LatestRolledoutRevision: "sklearn-predictor-default-00001",
+	}
+	canaryTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(20)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(80), Tag: "prev"},
+	}
+	promotedTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(0), Tag: "prev"},
+	}
+	rolledBackTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+	}
+	healthy := &fakeMetricsProvider{metrics: &CanaryMetrics{RequestCount: 100, ErrorRate: 0.01, Latency: 100 * time.Millisecond}}
+
+	scenarios := map[string]struct {
+		provider        CanaryMetricsProvider
+		webhookName     string
+		statusCode      int
+		analysisStart   time.Time
+		expectedTraffic []knservingv1.TrafficTarget
+		expectedResult  string
+		expectedCalls   int
+	}{
+		"NotCalledWithinWindow": {
+			provider:        healthy,
+			statusCode:      http.StatusOK,
+			analysisStart:   time.Now(),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectedCalls:   0,
+		},
+		"PromoteWhenAccepted": {
+			provider:        healthy,
+			statusCode:      http.StatusOK,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: promotedTraffic,
+			expectedResult:  CanaryAnalysisPromoted,
+			expectedCalls:   1,
+		},
+		"PromoteWithoutMetricsProvider": {
+			statusCode:      http.StatusOK,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: promotedTraffic,
+			expectedResult:  CanaryAnalysisPromoted,
+			expectedCalls:   1,
+		},
+		"KeepCanaryWhileEvaluating": {
+			provider:        healthy,
+			statusCode:      http.StatusAccepted,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectedCalls:   1,
+		},
+		"KeepCanaryOnWebhookError": {
+			provider:        healthy,
+			statusCode:      http.StatusServiceUnavailable,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectedCalls:   1,
+		},
+		"RollbackWhenRejected": {
+			provider:        healthy,
+			statusCode:      http.StatusForbidden,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: rolledBackTraffic,
+			expectedResult:  CanaryAnalysisRolledBack,
+			expectedCalls:   1,
+		},
+		"RollbackOnMetricsWithoutCall": {
+			provider:        &fakeMetricsProvider{metrics: &CanaryMetrics{RequestCount: 100, ErrorRate: 0.5}},
+			statusCode:      http.StatusOK,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: rolledBackTraffic,
+			expectedResult:  CanaryAnalysisRolledBack,
+			expectedCalls:   0,
+		},
+		"KeepCanaryWhenWebhookNotConfigured": {
+			provider:        healthy,
+			webhookName:     "unknown",
+			statusCode:      http.StatusOK,
+			analysisStart:   time.Now().Add(-10 * time.Minute),
+			expectedTraffic: canaryTraffic,
+			expectedResult:  "",
+			expectedCalls:   0,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests []CanaryAnalysisRequest
+			webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				request := CanaryAnalysisRequest{}
+				g.Expect(json.NewDecoder(req.Body).Decode(&request)).To(gomega.Succeed())
+				mu.Lock()
+				requests = append(requests, request)
+				mu.Unlock()
+				w.WriteHeader(scenario.statusCode)
+			}))
+			defer webhook.Close()
+			newReconciler := func(cli client.Client) *KsvcReconciler {
+				componentMeta := newTestComponentMeta()
+				componentMeta.Annotations[constants.CanaryAnalysisWindowAnnotationKey] = "5m"
+				componentMeta.Annotations[constants.CanaryMaxErrorRateAnnotationKey] = "0.05"
+				componentMeta.Annotations[constants.CanaryAnalysisWebhookAnnotationKey] = "analysis"
+				if scenario.webhookName != "" {
+					componentMeta.Annotations[constants.CanaryAnalysisWebhookAnnotationKey] = scenario.webhookName
+				}
+				r := NewKsvcReconciler(cli, newTestScheme(), componentMeta,
+					&v1beta1.ComponentExtensionSpec{CanaryTrafficPercent: proto.Int64(20)}, newTestPodSpec(), componentStatus)
+				r.Service.OwnerReferences = []metav1.OwnerReference{isvcOwnerReference("sklearn", "isvc-uid")}
+				r.MetricsProvider = scenario.provider
+				r.AnalysisWebhooks = map[string]string{"analysis": webhook.URL}
+				return r
+			}
+			getService := func(cli client.Client) *knservingv1.Service {
+				actual := &knservingv1.Service{}
+				g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: "sklearn-predictor-default", Namespace: "default"},
+					actual)).To(gomega.Succeed())
+				return actual
+			}
+
+			cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+			_, err := newReconciler(cli).Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			existing := &knservingv1.Service{}
+			g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: "sklearn-predictor-default", Namespace: "default"},
+				existing)).To(gomega.Succeed())
+			existing.Annotations[constants.CanaryAnalysisRevisionInternalAnnotationKey] = componentStatus.LatestReadyRevision
+			existing.Annotations[constants.CanaryAnalysisStartInternalAnnotationKey] = scenario.analysisStart.Format(time.RFC3339)
+			g.Expect(cli.Update(context.TODO(), existing)).To(gomega.Succeed())
+			mu.Lock()
+			requests = nil
+			mu.Unlock()
+
+			r := newReconciler(cli)
+			_, err = r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			if scenario.expectedCalls > 0 {
+				// the webhook is called in the background, the canary is kept until a later reconcile picks up the result
+				g.Expect(getService(cli).Spec.Traffic).To(gomega.Equal(canaryTraffic))
+				g.Expect(r.RequeueAfter).To(gomega.Equal(analysisWebhookTimeout))
+				analysisWebhookCalls.mu.Lock()
+				calls := make([]*webhookCall, 0, len(analysisWebhookCalls.calls))
+				for _, call := range analysisWebhookCalls.calls {
+					calls = append(calls, call)
+				}
+				analysisWebhookCalls.mu.Unlock()
+				for _, call := range calls {
+					<-call.done
+				}
+				_, err = newReconciler(cli).Reconcile()
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+			actual := getService(cli)
+			g.Expect(actual.Spec.Traffic).To(gomega.Equal(scenario.expectedTraffic))
+			g.Expect(actual.Annotations[constants.CanaryAnalysisResultInternalAnnotationKey]).To(gomega.Equal(scenario.expectedResult))
+			mu.Lock()
+			defer mu.Unlock()
+			g.Expect(requests).To(gomega.HaveLen(scenario.expectedCalls))
+			for _, request := range requests {
+				g.Expect(request.Namespace).To(gomega.Equal("default"))
+				g.Expect(request.Service).To(gomega.Equal("sklearn-predictor-default"))
+				g.Expect(request.Revision).To(gomega.Equal(componentStatus.LatestReadyRevision))
+				g.Expect(request.PreviousRevision).To(gomega.Equal(componentStatus.LatestRolledoutRevision))
+			}
+		})
+	}
+}
+
+func TestAnalysisWebhookCalls(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	calls := &webhookCalls{calls: map[string]*webhookCall{}}
+	release := make(chan struct{})
+	blocking := func() (string, error) {
+		<-release
+		return CanaryAnalysisPromoted, nil
+	}
+	defer close(release)
+
+	call := webhookCall{namespace: "default", inferenceService: "my-model", revision: "my-model-predictor-default-00002", url: "http://webhook"}
+	_, finished, err := calls.poll("default/my-model-predictor-default", call, blocking)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(finished).To(gomega.BeFalse())
+	first := calls.calls["default/my-model-predictor-default"]
+
+	// the call of the superseded revision is replaced by the call of the new revision
+	call.revision = "my-model-predictor-default-00003"
+	_, finished, _ = calls.poll("default/my-model-predictor-default", call, blocking)
+	g.Expect(finished).To(gomega.BeFalse())
+	g.Expect(calls.calls).To(gomega.HaveLen(1))
+	g.Expect(calls.calls["default/my-model-predictor-default"]).NotTo(gomega.BeIdenticalTo(first))
+	g.Expect(calls.calls["default/my-model-predictor-default"].revision).To(gomega.Equal("my-model-predictor-default-00003"))
+
+	// the calls of the other InferenceServices are kept when an InferenceService is deleted
+	other := webhookCall{namespace: "default", inferenceService: "other", revision: "other-predictor-default-00001", url: "http://webhook"}
+	calls.poll("default/other-predictor-default", other, blocking)
+	calls.forgetInferenceService("default", "my-model")
+	g.Expect(calls.calls).To(gomega.HaveLen(1))
+	g.Expect(calls.calls).To(gomega.HaveKey("default/other-predictor-default"))
+
+	calls.forget("default/other-predictor-default")
+	g.Expect(calls.calls).To(gomega.BeEmpty())
+}
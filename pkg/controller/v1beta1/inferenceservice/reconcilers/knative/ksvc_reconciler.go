@@ -18,7 +18,11 @@ package knative
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
@@ -42,12 +46,49 @@ import (
 
 var log = logf.Log.WithName("KsvcReconciler")
 
+// ksvcAnnotationKeys are put under the knative service metadata.annotations instead of the revision template,
+// rollout-duration must be set there and changing the canary settings must not create a new revision.
+var ksvcAnnotationKeys = []string{
+	constants.RollOutDurationAnnotationKey,
+	constants.CanaryDrainGracePeriodAnnotationKey,
+	constants.ApprovedCanaryRevisionAnnotationKey,
+	constants.CanaryAnalysisWindowAnnotationKey,
+	constants.CanaryMaxErrorRateAnnotationKey,
+	constants.CanaryMaxLatencyAnnotationKey,
+	constants.CanaryMinRequestCountAnnotationKey,
+	constants.CanaryAnalysisWebhookAnnotationKey,
+	constants.RollbackAnnotationKey,
+	constants.BlueGreenRetentionAnnotationKey,
+}
+
+// ksvcInternalAnnotationKeys record the canary state of the knative service across reconciles
+var ksvcInternalAnnotationKeys = []string{
+	constants.CanaryDrainStartInternalAnnotationKey,
+	constants.CanaryAnalysisRevisionInternalAnnotationKey,
+	constants.CanaryAnalysisStartInternalAnnotationKey,
+	constants.CanaryAnalysisResultInternalAnnotationKey,
+	constants.RetainedRevisionInternalAnnotationKey,
+	constants.RetainStartInternalAnnotationKey,
+	constants.RollbackRevisionInternalAnnotationKey,
+	constants.RollbackConfigHashInternalAnnotationKey,
+}
+
 type KsvcReconciler struct {
 	client          client.Client
 	scheme          *runtime.Scheme
 	Service         *knservingv1.Service
 	componentExt    *v1beta1.ComponentExtensionSpec
 	componentStatus v1beta1.ComponentStatusSpec
+	// RollbackRevision is the revision which serves all the traffic when a rollback is requested
+	RollbackRevision string
+	// ConfigHash identifies the revision template of the desired knative service
+	ConfigHash string
+	// MetricsProvider is used to analyze the canary revision when canary analysis is enabled
+	MetricsProvider CanaryMetricsProvider
+	// AnalysisWebhooks are the canary analysis webhook URLs configured by the cluster admin keyed by name
+	AnalysisWebhooks map[string]string
+	// RequeueAfter is set by Reconcile while the removed traffic targets are draining or the canary is analyzed
+	RequeueAfter time.Duration
 }
 
 func NewKsvcReconciler(client client.Client,
@@ -56,12 +97,17 @@ func NewKsvcReconciler(client client.Client,
 	componentExt *v1beta1.ComponentExtensionSpec,
 	podSpec *corev1.PodSpec,
 	componentStatus v1beta1.ComponentStatusSpec) *KsvcReconciler {
+	// createKnativeService moves the rollback annotation off the component annotations, so read it first
+	rollbackRevision := getRollbackRevision(componentMeta.Annotations, componentExt, componentStatus)
+	service := createKnativeService(componentMeta, componentExt, podSpec, componentStatus)
 	return &KsvcReconciler{
-		client:          client,
-		scheme:          scheme,
-		Service:         createKnativeService(componentMeta, componentExt, podSpec, componentStatus),
-		componentExt:    componentExt,
-		componentStatus: componentStatus,
+		client:           client,
+		scheme:           scheme,
+		Service:          service,
+		componentExt:     componentExt,
+		componentStatus:  componentStatus,
+		RollbackRevision: rollbackRevision,
+		ConfigHash:       configHash(service),
 	}
 }
 
@@ -72,7 +118,11 @@ func createKnativeService(componentMeta metav1.ObjectMeta,
 	annotations := componentMeta.GetAnnotations()
 
 	if componentExtension.MinReplicas == nil {
-		annotations[constants.MinScaleAnnotationKey] = fmt.Sprint(constants.DefaultMinReplicas)
+		minReplicas := constants.DefaultMinReplicas
+		if componentExtension.ScaleToZero != nil && *componentExtension.ScaleToZero {
+			minReplicas = 0
+		}
+		annotations[constants.MinScaleAnnotationKey] = fmt.Sprint(minReplicas)
 	} else {
 		annotations[constants.MinScaleAnnotationKey] = fmt.Sprint(*componentExtension.MinReplicas)
 	}
@@ -95,11 +145,12 @@ func createKnativeService(componentMeta metav1.ObjectMeta,
 	}
 
 	// ksvc metadata.annotations
-	// rollout-duration must be put under metadata.annotations
 	ksvcAnnotations := make(map[string]string)
-	if value, ok := annotations[constants.RollOutDurationAnnotationKey]; ok {
-		ksvcAnnotations[constants.RollOutDurationAnnotationKey] = value
-		delete(annotations, constants.RollOutDurationAnnotationKey)
+	for _, key := range ksvcAnnotationKeys {
+		if value, ok := annotations[key]; ok {
+			ksvcAnnotations[key] = value
+			delete(annotations, key)
+		}
 	}
 
 	lastRolledoutRevision := componentStatus.LatestRolledoutRevision
@@ -108,19 +159,38 @@ func createKnativeService(componentMeta metav1.ObjectMeta,
 	log.Info("revision status:", "LatestRolledoutRevision", componentStatus.LatestRolledoutRevision, "LatestReadyRevision", componentStatus.LatestReadyRevision, "LatestCreatedRevision", componentStatus.LatestCreatedRevision, "PreviousRolledoutRevision", componentStatus.PreviousRolledoutRevision, "CanaryTrafficPercent", componentExtension.CanaryTrafficPercent)
 
 	trafficTargets := []knservingv1.TrafficTarget{}
-	// Split traffic when canary traffic percent is specified
-	if componentExtension.CanaryTrafficPercent != nil && lastRolledoutRevision != "" {
+	if rollbackRevision := getRollbackRevision(ksvcAnnotations, componentExtension, componentStatus); rollbackRevision != "" {
+		// Zero the traffic of the latest revision and restore the previously rolled out revision
+		log.Info("Rolling back traffic", "namespace", componentMeta.Namespace, "name", componentMeta.Name,
+			"revision", rollbackRevision)
+		trafficTargets = rollbackTrafficTargets(rollbackRevision, annotations)
+	} else if (componentExtension.CanaryTrafficPercent != nil || componentExtension.ManualTrafficShift ||
+		componentExtension.TrafficMode == v1beta1.TrafficModeMirror || componentExtension.CanaryRouting != nil) &&
+		lastRolledoutRevision != "" {
+		canaryTrafficPercent := int64(100)
+		if componentExtension.CanaryTrafficPercent != nil {
+			canaryTrafficPercent = *componentExtension.CanaryTrafficPercent
+		} else if componentExtension.CanaryRouting != nil {
+			// Only the requests routed by the ingress reach the candidate revision
+			canaryTrafficPercent = 0
+		}
+		// The mirrored candidate revision only receives the copy of the traffic sent by the ingress
+		if componentExtension.TrafficMode == v1beta1.TrafficModeMirror {
+			canaryTrafficPercent = 0
+		}
 		latestTarget := knservingv1.TrafficTarget{
 			LatestRevision: proto.Bool(true),
-			Percent:        proto.Int64(*componentExtension.CanaryTrafficPercent),
+			Percent:        proto.Int64(canaryTrafficPercent),
 		}
-		if value, ok := annotations[constants.EnableRoutingTagAnnotationKey]; ok && value == "true" {
+		// Requests matching the canary routing reach the candidate revision through its tag
+		if value, ok := annotations[constants.EnableRoutingTagAnnotationKey]; (ok && value == "true") ||
+			componentExtension.CanaryRouting != nil {
 			latestTarget.Tag = "latest"
 		}
 		trafficTargets = append(trafficTargets, latestTarget)
 
-		if *componentExtension.CanaryTrafficPercent < 100 {
-			remainingTraffic := 100 - *componentExtension.CanaryTrafficPercent
+		if canaryTrafficPercent < 100 {
+			remainingTraffic := 100 - canaryTrafficPercent
 			canaryTarget := knservingv1.TrafficTarget{
 				RevisionName:   lastRolledoutRevision,
 				LatestRevision: proto.Bool(false),
@@ -176,6 +246,94 @@ func createKnativeService(componentMeta metav1.ObjectMeta,
 	return service
 }
 
+// rollbackTrafficTargets zeroes the traffic of the latest revision and restores the rolled back revision
+func rollbackTrafficTargets(rollbackRevision string, templateAnnotations map[string]string) []knservingv1.TrafficTarget {
+	latestTarget := knservingv1.TrafficTarget{
+		LatestRevision: proto.Bool(true),
+		Percent:        proto.Int64(0),
+	}
+	if value, ok := templateAnnotations[constants.EnableRoutingTagAnnotationKey]; ok && value == "true" {
+		latestTarget.Tag = "latest"
+	}
+	rollbackTarget := knservingv1.TrafficTarget{
+		RevisionName:   rollbackRevision,
+		LatestRevision: proto.Bool(false),
+		Percent:        proto.Int64(100),
+		Tag:            "prev",
+	}
+	return []knservingv1.TrafficTarget{latestTarget, rollbackTarget}
+}
+
+// getRollbackRevision returns the revision which serves all the traffic instead of the latest revision. It is the
+// revision referenced by the component when it is in the revision history, otherwise when the rollback annotation is
+// set it is the latest rolled out revision while a canary is in progress, or the previous rolled out revision.
+func getRollbackRevision(annotations map[string]string, componentExtension *v1beta1.ComponentExtensionSpec,
+	componentStatus v1beta1.ComponentStatusSpec) string {
+	if revisionRef := componentExtension.RevisionRef; revisionRef != nil && *revisionRef != componentStatus.LatestReadyRevision {
+		for _, revision := range componentStatus.RevisionHistory {
+			if revision.Name == *revisionRef {
+				return revision.Name
+			}
+		}
+		log.Info("Ignoring revision reference which is not in the revision history", "revision", *revisionRef)
+	}
+	if value, ok := annotations[constants.RollbackAnnotationKey]; !ok || value != "true" {
+		return ""
+	}
+	if componentStatus.LatestReadyRevision != componentStatus.LatestRolledoutRevision {
+		return componentStatus.LatestRolledoutRevision
+	}
+	return componentStatus.PreviousRolledoutRevision
+}
+
+// isCanaryApproved checks if the revision of the desired revision template is the latest rolled out revision or is
+// listed in the comma separated approved revisions. The revision is only known once knative has observed the template
+// of the existing knative service, a changed template is a new revision which is not approved yet.
+func (r *KsvcReconciler) isCanaryApproved(desired, existing *knservingv1.Service) bool {
+	if existing == nil || existing.Status.ObservedGeneration != existing.Generation ||
+		!equality.Semantic.DeepEqual(desired.Spec.ConfigurationSpec, existing.Spec.ConfigurationSpec) {
+		return false
+	}
+	revision := existing.Status.LatestCreatedRevisionName
+	if revision == "" {
+		return false
+	}
+	if revision == r.componentStatus.LatestRolledoutRevision {
+		return true
+	}
+	for _, approved := range strings.Split(desired.Annotations[constants.ApprovedCanaryRevisionAnnotationKey], ",") {
+		if strings.TrimSpace(approved) == revision {
+			return true
+		}
+	}
+	return false
+}
+
+// holdCanary keeps the latest revision at 0 percent and the latest rolled out revision at 100 percent while the manual
+// traffic shift of the desired revision is not approved, so that a new revision does not receive the canary traffic
+// of the latest revision target as soon as it becomes ready.
+func (r *KsvcReconciler) holdCanary(desired, existing *knservingv1.Service) {
+	rolledoutRevision := r.componentStatus.LatestRolledoutRevision
+	if !r.componentExt.ManualTrafficShift || rolledoutRevision == "" || r.RollbackRevision != "" ||
+		r.isCanaryApproved(desired, existing) {
+		return
+	}
+	log.Info("Holding canary revision until it is approved", "namespace", desired.Namespace, "name", desired.Name)
+	traffic := []knservingv1.TrafficTarget{}
+	for _, target := range desired.Spec.Traffic {
+		if isLatestTarget(target) {
+			target.Percent = proto.Int64(0)
+			traffic = append(traffic, target)
+		}
+	}
+	desired.Spec.Traffic = append(traffic, knservingv1.TrafficTarget{
+		RevisionName:   rolledoutRevision,
+		LatestRevision: proto.Bool(false),
+		Percent:        proto.Int64(100),
+		Tag:            "prev",
+	})
+}
+
 func (r *KsvcReconciler) Reconcile() (*knservingv1.ServiceStatus, error) {
 	// Create service if does not exist
 	desired := r.Service
@@ -183,17 +341,17 @@ func (r *KsvcReconciler) Reconcile() (*knservingv1.ServiceStatus, error) {
 	err := r.client.Get(context.TODO(), types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing)
 	if err != nil {
 		if apierr.IsNotFound(err) {
+			r.holdCanary(desired, nil)
 			log.Info("Creating knative service", "namespace", desired.Namespace, "name", desired.Name)
 			return &desired.Status, r.client.Create(context.TODO(), desired)
 		}
 		return nil, err
 	}
-	// Return if no differences to reconcile.
-	if semanticEquals(desired, existing) {
-		return &existing.Status, nil
+	// Adopt a knative service which was created outside of KServe, e.g. when migrating manually managed services
+	adopted, err := adoptService(desired, existing)
+	if err != nil {
+		return &existing.Status, err
 	}
-
-	// Reconcile differences and update
 	// knative mutator defaults the enableServiceLinks to false which would generate a diff despite no changes on desired knative service
 	// https://github.com/knative/serving/blob/main/pkg/apis/serving/v1/revision_defaults.go#L134
 	if desired.Spec.ConfigurationSpec.Template.Spec.EnableServiceLinks == nil &&
@@ -201,6 +359,26 @@ func (r *KsvcReconciler) Reconcile() (*knservingv1.ServiceStatus, error) {
 		*existing.Spec.ConfigurationSpec.Template.Spec.EnableServiceLinks == false {
 		desired.Spec.ConfigurationSpec.Template.Spec.EnableServiceLinks = proto.Bool(false)
 	}
+	r.holdRollback(desired, existing)
+	r.holdCanary(desired, existing)
+	// Promote or roll back the canary based on its metrics and retain the replaced blue green revision before
+	// draining the removed traffic targets
+	analysisRequeueAfter := r.analyzeCanary(desired, existing)
+	retentionRequeueAfter := r.retainPreviousRevision(desired, existing)
+	// Keep the removed traffic targets at 0 percent until the in-flight requests are drained
+	r.RequeueAfter = 0
+	for _, requeueAfter := range []time.Duration{analysisRequeueAfter, retentionRequeueAfter,
+		drainTraffic(desired, existing, getDrainGracePeriod(desired))} {
+		if requeueAfter > 0 && (r.RequeueAfter == 0 || requeueAfter < r.RequeueAfter) {
+			r.RequeueAfter = requeueAfter
+		}
+	}
+	// Return if no differences to reconcile.
+	if !adopted && semanticEquals(desired, existing) {
+		return &existing.Status, nil
+	}
+
+	// Reconcile differences and update
 	diff, err := kmp.SafeDiff(desired.Spec.ConfigurationSpec, existing.Spec.ConfigurationSpec)
 	if err != nil {
 		return &existing.Status, errors.Wrapf(err, "failed to diff knative service configuration spec")
@@ -209,6 +387,16 @@ func (r *KsvcReconciler) Reconcile() (*knservingv1.ServiceStatus, error) {
 	existing.Spec.ConfigurationSpec = desired.Spec.ConfigurationSpec
 	existing.ObjectMeta.Labels = desired.ObjectMeta.Labels
 	existing.Spec.Traffic = desired.Spec.Traffic
+	for _, key := range ksvcInternalAnnotationKeys {
+		if value, ok := desired.Annotations[key]; ok {
+			if existing.Annotations == nil {
+				existing.Annotations = map[string]string{}
+			}
+			existing.Annotations[key] = value
+		} else {
+			delete(existing.Annotations, key)
+		}
+	}
 	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		log.Info("Updating knative service", "namespace", desired.Namespace, "name", desired.Name)
 		return r.client.Update(context.TODO(), existing)
@@ -219,8 +407,177 @@ func (r *KsvcReconciler) Reconcile() (*knservingv1.ServiceStatus, error) {
 	return &existing.Status, nil
 }
 
+// holdRollback keeps the traffic on the revision rolled back to with the rollback annotation until the revision
+// template changes, so that the traffic does not go back to the rolled back revision once the annotation is removed.
+// The rollback is recorded on the knative service with the hash of the revision template it was requested for, the
+// recorded revision is kept while the annotation is set as the status reports the pinned revision as rolled out.
+func (r *KsvcReconciler) holdRollback(desired, existing *knservingv1.Service) {
+	recordedRevision := ""
+	if existing.Annotations[constants.RollbackConfigHashInternalAnnotationKey] == r.ConfigHash {
+		recordedRevision = existing.Annotations[constants.RollbackRevisionInternalAnnotationKey]
+	}
+	requested := desired.Annotations[constants.RollbackAnnotationKey] == "true" && r.RollbackRevision != ""
+	revision := recordedRevision
+	if revision == "" && requested {
+		revision = r.RollbackRevision
+	}
+	if revision == "" || (!requested && r.RollbackRevision != "") {
+		// the revision referenced by the component takes precedence over a recorded rollback
+		return
+	}
+	if revision != r.RollbackRevision {
+		log.Info("Holding rolled back traffic until the revision template changes", "namespace", desired.Namespace,
+			"name", desired.Name, "revision", revision)
+	}
+	if desired.Annotations == nil {
+		desired.Annotations = map[string]string{}
+	}
+	desired.Annotations[constants.RollbackRevisionInternalAnnotationKey] = revision
+	desired.Annotations[constants.RollbackConfigHashInternalAnnotationKey] = r.ConfigHash
+	desired.Spec.Traffic = rollbackTrafficTargets(revision, desired.Spec.Template.Annotations)
+	r.RollbackRevision = revision
+}
+
+// adoptService sets the controller reference of the desired knative service on an existing knative service without
+// a controller, it refuses to take over a knative service which is controlled by another resource.
+func adoptService(desired, existing *knservingv1.Service) (bool, error) {
+	desiredOwner := metav1.GetControllerOf(desired)
+	if desiredOwner == nil {
+		return false, nil
+	}
+	existingOwner := metav1.GetControllerOf(existing)
+	if existingOwner == nil {
+		log.Info("Adopting existing knative service", "namespace", existing.Namespace, "name", existing.Name)
+		existing.OwnerReferences = append(existing.OwnerReferences, *desiredOwner)
+		return true, nil
+	}
+	if existingOwner.UID != desiredOwner.UID {
+		return false, fmt.Errorf("knative service %s/%s is already controlled by %s %s",
+			existing.Namespace, existing.Name, existingOwner.Kind, existingOwner.Name)
+	}
+	return false, nil
+}
+
+// drainTraffic keeps the tagged traffic targets which are removed from the desired knative service at 0 percent
+// until the grace period has passed since the drain started, so that in-flight requests to the tag routes are not
+// dropped. It returns the remaining time of the drain.
+func drainTraffic(desired, existing *knservingv1.Service, gracePeriod time.Duration) time.Duration {
+	desiredTags := map[string]bool{}
+	for _, target := range desired.Spec.Traffic {
+		if target.Tag != "" {
+			desiredTags[target.Tag] = true
+		}
+	}
+	drainingTargets := []knservingv1.TrafficTarget{}
+	for _, target := range existing.Spec.Traffic {
+		if target.Tag != "" && !desiredTags[target.Tag] {
+			drainingTarget := target.DeepCopy()
+			drainingTarget.Percent = proto.Int64(0)
+			drainingTargets = append(drainingTargets, *drainingTarget)
+		}
+	}
+	if len(drainingTargets) == 0 {
+		return 0
+	}
+	drainStart, err := time.Parse(time.RFC3339, existing.Annotations[constants.CanaryDrainStartInternalAnnotationKey])
+	if err != nil {
+		drainStart = time.Now()
+	}
+	remaining := gracePeriod - time.Since(drainStart)
+	if remaining <= 0 {
+		log.Info("Removing drained traffic targets", "namespace", desired.Namespace, "name", desired.Name)
+		return 0
+	}
+	log.Info("Draining removed traffic targets", "namespace", desired.Namespace, "name", desired.Name,
+		"remaining", remaining)
+	if desired.Annotations == nil {
+		desired.Annotations = map[string]string{}
+	}
+	desired.Annotations[constants.CanaryDrainStartInternalAnnotationKey] = drainStart.Format(time.RFC3339)
+	desired.Spec.Traffic = append(desired.Spec.Traffic, drainingTargets...)
+	return remaining
+}
+
+// getDrainGracePeriod returns the drain grace period set on the knative service or the default grace period
+func getDrainGracePeriod(service *knservingv1.Service) time.Duration {
+	if value, ok := service.Annotations[constants.CanaryDrainGracePeriodAnnotationKey]; ok {
+		gracePeriod, err := time.ParseDuration(value)
+		if err == nil && gracePeriod >= 0 {
+			return gracePeriod
+		}
+		log.Info("Ignoring invalid canary drain grace period", "namespace", service.Namespace, "name", service.Name,
+			"gracePeriod", value)
+	}
+	return constants.DefaultCanaryDrainGracePeriod
+}
+
+// retainPreviousRevision keeps the revision replaced by a blue green deployment routable at 0 percent under the
+// prev tag until the retention period has passed, so that a rollback does not have to wait for it to be recreated.
+// It returns the remaining time of the retention.
+func (r *KsvcReconciler) retainPreviousRevision(desired, existing *knservingv1.Service) time.Duration {
+	previous := r.componentStatus.PreviousRolledoutRevision
+	if r.componentExt.DeploymentStrategy != v1beta1.DeploymentStrategyBlueGreen || previous == "" ||
+		r.RollbackRevision != "" {
+		return 0
+	}
+	retainStart := time.Now()
+	if existing.Annotations[constants.RetainedRevisionInternalAnnotationKey] == previous {
+		if start, err := time.Parse(time.RFC3339, existing.Annotations[constants.RetainStartInternalAnnotationKey]); err == nil {
+			retainStart = start
+		}
+	}
+	if desired.Annotations == nil {
+		desired.Annotations = map[string]string{}
+	}
+	desired.Annotations[constants.RetainedRevisionInternalAnnotationKey] = previous
+	desired.Annotations[constants.RetainStartInternalAnnotationKey] = retainStart.Format(time.RFC3339)
+	remaining := getBlueGreenRetention(desired) - time.Since(retainStart)
+	if remaining <= 0 {
+		return 0
+	}
+	desired.Spec.Traffic = append(desired.Spec.Traffic, knservingv1.TrafficTarget{
+		RevisionName:   previous,
+		LatestRevision: proto.Bool(false),
+		Percent:        proto.Int64(0),
+		Tag:            "prev",
+	})
+	return remaining
+}
+
+// getBlueGreenRetention returns the blue green retention set on the knative service or the default retention
+func getBlueGreenRetention(service *knservingv1.Service) time.Duration {
+	if value, ok := service.Annotations[constants.BlueGreenRetentionAnnotationKey]; ok {
+		retention, err := time.ParseDuration(value)
+		if err == nil && retention >= 0 {
+			return retention
+		}
+		log.Info("Ignoring invalid blue green retention", "namespace", service.Namespace, "name", service.Name,
+			"retention", value)
+	}
+	return constants.DefaultBlueGreenRetention
+}
+
+// configHash returns the hash of the revision template, which changes whenever knative creates a new revision
+func configHash(service *knservingv1.Service) string {
+	template, err := json.Marshal(service.Spec.ConfigurationSpec.Template)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(template))
+}
+
 func semanticEquals(desiredService, service *knservingv1.Service) bool {
 	return equality.Semantic.DeepEqual(desiredService.Spec.ConfigurationSpec, service.Spec.ConfigurationSpec) &&
 		equality.Semantic.DeepEqual(desiredService.ObjectMeta.Labels, service.ObjectMeta.Labels) &&
-		equality.Semantic.DeepEqual(desiredService.Spec.RouteSpec, service.Spec.RouteSpec)
+		equality.Semantic.DeepEqual(desiredService.Spec.RouteSpec, service.Spec.RouteSpec) &&
+		internalAnnotationsEqual(desiredService.Annotations, service.Annotations)
+}
+
+func internalAnnotationsEqual(desired, existing map[string]string) bool {
+	for _, key := range ksvcInternalAnnotationKeys {
+		if desired[key] != existing[key] {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,900 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package knative
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = knservingv1.AddToScheme(s)
+	return s
+}
+
+func newTestComponentMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        "sklearn-predictor-default",
+		Namespace:   "default",
+		Labels:      map[string]string{},
+		Annotations: map[string]string{},
+	}
+}
+
+func newTestPodSpec() *corev1.PodSpec {
+	return &corev1.PodSpec{
+		Containers: []corev1.Container{
+			{
+				Name:  "kserve-container",
+				Image: "kserve/sklearnserver:latest",
+			},
+		},
+	}
+}
+
+func isvcOwnerReference(name string, uid types.UID) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion:         v1beta1.SchemeGroupVersion.String(),
+		Kind:               "InferenceService",
+		Name:               name,
+		UID:                uid,
+		Controller:         proto.Bool(true),
+		BlockOwnerDeletion: proto.Bool(true),
+	}
+}
+
+func TestKsvcReconcilerAdoption(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	serviceKey := types.NamespacedName{Name: "sklearn-predictor-default", Namespace: "default"}
+
+	scenarios := map[string]struct {
+		existing        *knservingv1.Service
+		expectErr       bool
+		expectedOwnerID types.UID
+	}{
+		"CreateService": {
+			existing:        nil,
+			expectedOwnerID: "isvc-uid",
+		},
+		"AdoptUnownedService": {
+			existing: &knservingv1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      serviceKey.Name,
+					Namespace: serviceKey.Namespace,
+				},
+				Spec: knservingv1.ServiceSpec{
+					ConfigurationSpec: knservingv1.ConfigurationSpec{
+						Template: knservingv1.RevisionTemplateSpec{
+							Spec: knservingv1.RevisionSpec{
+								PodSpec: corev1.PodSpec{
+									Containers: []corev1.Container{{Image: "manually/created:latest"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedOwnerID: "isvc-uid",
+		},
+		"RejectServiceOwnedByOthers": {
+			existing: &knservingv1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            serviceKey.Name,
+					Namespace:       serviceKey.Namespace,
+					OwnerReferences: []metav1.OwnerReference{isvcOwnerReference("other", "other-uid")},
+				},
+			},
+			expectErr:       true,
+			expectedOwnerID: "other-uid",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(newTestScheme())
+			if scenario.existing != nil {
+				builder = builder.WithObjects(scenario.existing)
+			}
+			cli := builder.Build()
+			r := NewKsvcReconciler(cli, newTestScheme(), newTestComponentMeta(), &v1beta1.ComponentExtensionSpec{},
+				newTestPodSpec(), v1beta1.ComponentStatusSpec{})
+			r.Service.OwnerReferences = []metav1.OwnerReference{isvcOwnerReference("sklearn", "isvc-uid")}
+
+			_, err := r.Reconcile()
+			if scenario.expectErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+
+			actual := &knservingv1.Service{}
+			g.Expect(cli.Get(context.TODO(), client.ObjectKey(serviceKey), actual)).To(gomega.Succeed())
+			g.Expect(metav1.GetControllerOf(actual)).NotTo(gomega.BeNil())
+			g.Expect(metav1.GetControllerOf(actual).UID).To(gomega.Equal(scenario.expectedOwnerID))
+			if !scenario.expectErr {
+				g.Expect(actual.Spec.Template.Spec.Containers[0].Image).To(gomega.Equal("kserve/sklearnserver:latest"))
+			}
+		})
+	}
+}
+
+func TestKsvcReconcilerManualTrafficShift(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	rolloutStatus := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:     "sklearn-predictor-default-00002",
+		LatestCreatedRevision:   "sklearn-predictor-default-00002",
+		LatestRolledoutRevision: "sklearn-predictor-default-00001",
+	}
+
+	scenarios := map[string]struct {
+		componentExt    *v1beta1.ComponentExtensionSpec
+		componentStatus v1beta1.ComponentStatusSpec
+		annotations     map[string]string
+		// createdRevision is the latest created revision of the existing knative service
+		createdRevision string
+		// specChanged changes the revision template of the desired knative service after the existing one was created
+		specChanged bool
+		// notObserved marks the revision template of the existing knative service as not observed by knative yet
+		notObserved     bool
+		expectedTraffic []knservingv1.TrafficTarget
+	}{
+		"HoldCanaryUntilApproved": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: rolloutStatus,
+			createdRevision: "sklearn-predictor-default-00002",
+			annotations:     map[string]string{},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"HoldCanaryApprovedForOtherRevision": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: rolloutStatus,
+			createdRevision: "sklearn-predictor-default-00002",
+			annotations: map[string]string{
+				constants.ApprovedCanaryRevisionAnnotationKey: "sklearn-predictor-default-00001",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"ApplyCanaryPercentWhenApproved": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: rolloutStatus,
+			createdRevision: "sklearn-predictor-default-00002",
+			annotations: map[string]string{
+				constants.ApprovedCanaryRevisionAnnotationKey: "transformer-default-00003, sklearn-predictor-default-00002",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(20)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(80), Tag: "prev"},
+			},
+		},
+		"PromoteWhenApprovedWithoutCanaryPercent": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				ManualTrafficShift: true,
+			},
+			componentStatus: rolloutStatus,
+			createdRevision: "sklearn-predictor-default-00002",
+			annotations: map[string]string{
+				constants.ApprovedCanaryRevisionAnnotationKey: "sklearn-predictor-default-00002",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+			},
+		},
+		"HoldCreatedRevisionBeforeReady": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00001",
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			createdRevision: "sklearn-predictor-default-00002",
+			annotations:     map[string]string{},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"ApplyCanaryPercentWhenCreatedRevisionApprovedBeforeReady": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00001",
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			createdRevision: "sklearn-predictor-default-00002",
+			annotations: map[string]string{
+				constants.ApprovedCanaryRevisionAnnotationKey: "sklearn-predictor-default-00002",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(20)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(80), Tag: "prev"},
+			},
+		},
+		"RolledoutRevisionDoesNotNeedApproval": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00001",
+				LatestCreatedRevision:   "sklearn-predictor-default-00001",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			createdRevision: "sklearn-predictor-default-00001",
+			annotations:     map[string]string{},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(20)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(80), Tag: "prev"},
+			},
+		},
+		"HoldChangedSpecUntilApproved": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			// the status still reports the rolled out revision on the reconcile of the spec change
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00001",
+				LatestCreatedRevision:   "sklearn-predictor-default-00001",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			annotations: map[string]string{
+				constants.ApprovedCanaryRevisionAnnotationKey: "sklearn-predictor-default-00001",
+			},
+			createdRevision: "sklearn-predictor-default-00001",
+			specChanged:     true,
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"HoldRevisionNotObservedYet": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00001",
+				LatestCreatedRevision:   "sklearn-predictor-default-00001",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			annotations:     map[string]string{},
+			createdRevision: "sklearn-predictor-default-00001",
+			notObserved:     true,
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"HoldRecreatedService": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00001",
+				LatestCreatedRevision:   "sklearn-predictor-default-00001",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			annotations: map[string]string{},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"FirstRolloutIsNotHeld": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				ManualTrafficShift:   true,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{},
+			annotations:     map[string]string{},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+			componentMeta := newTestComponentMeta()
+			componentMeta.Annotations = scenario.annotations
+			r := NewKsvcReconciler(cli, newTestScheme(), componentMeta, scenario.componentExt,
+				newTestPodSpec(), scenario.componentStatus)
+			if scenario.createdRevision != "" {
+				existing := r.Service.DeepCopy()
+				existing.Generation = 2
+				existing.Status.ObservedGeneration = 2
+				if scenario.notObserved {
+					existing.Status.ObservedGeneration = 1
+				}
+				existing.Status.LatestCreatedRevisionName = scenario.createdRevision
+				g.Expect(cli.Create(context.TODO(), existing)).To(gomega.Succeed())
+			}
+			if scenario.specChanged {
+				r.Service.Spec.Template.Spec.Containers[0].Image = "kserve/sklearnserver:v0.9.0"
+			}
+			_, err := r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			actual := &knservingv1.Service{}
+			g.Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(r.Service), actual)).To(gomega.Succeed())
+			g.Expect(actual.Spec.Traffic).To(gomega.Equal(scenario.expectedTraffic))
+			// approving a revision must not roll out a new revision
+			g.Expect(actual.Spec.Template.Annotations).NotTo(gomega.HaveKey(constants.ApprovedCanaryRevisionAnnotationKey))
+		})
+	}
+}
+
+func TestKsvcReconcilerCanaryTrafficModes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		componentExt    *v1beta1.ComponentExtensionSpec
+		componentStatus v1beta1.ComponentStatusSpec
+		expectedTraffic []knservingv1.TrafficTarget
+	}{
+		"MirrorCanaryRevision": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				TrafficMode:          v1beta1.TrafficModeMirror,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00002",
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"MirrorWithoutCanaryPercent": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				TrafficMode: v1beta1.TrafficModeMirror,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00002",
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"SplitTrafficMode": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				TrafficMode:          v1beta1.TrafficModeSplit,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00002",
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(20)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(80), Tag: "prev"},
+			},
+		},
+		"RouteCanaryWithoutPercent": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryRouting: &v1beta1.CanaryRoutingSpec{Headers: map[string]string{"x-model-version": "canary"}},
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00002",
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0), Tag: "latest"},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"RouteCanaryWithPercent": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				CanaryTrafficPercent: proto.Int64(20),
+				CanaryRouting:        &v1beta1.CanaryRoutingSpec{Cookies: map[string]string{"canary": "true"}},
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00002",
+				LatestCreatedRevision:   "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(20), Tag: "latest"},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(80), Tag: "prev"},
+			},
+		},
+		"FirstRolloutIsNotMirrored": {
+			componentExt: &v1beta1.ComponentExtensionSpec{
+				TrafficMode: v1beta1.TrafficModeMirror,
+			},
+			componentStatus: v1beta1.ComponentStatusSpec{},
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+			r := NewKsvcReconciler(cli, newTestScheme(), newTestComponentMeta(), scenario.componentExt,
+				newTestPodSpec(), scenario.componentStatus)
+			_, err := r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			actual := &knservingv1.Service{}
+			g.Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(r.Service), actual)).To(gomega.Succeed())
+			g.Expect(actual.Spec.Traffic).To(gomega.Equal(scenario.expectedTraffic))
+		})
+	}
+}
+
+func TestKsvcReconcilerCanaryDrain(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentStatus := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:     "sklearn-predictor-default-00002",
+		LatestCreatedRevision:   "sklearn-predictor-default-00002",
+		LatestRolledoutRevision: "sklearn-predictor-default-00001",
+	}
+	newReconciler := func(cli client.Client, componentExt *v1beta1.ComponentExtensionSpec, annotations map[string]string) *KsvcReconciler {
+		componentMeta := newTestComponentMeta()
+		componentMeta.Annotations = annotations
+		r := NewKsvcReconciler(cli, newTestScheme(), componentMeta, componentExt, newTestPodSpec(), componentStatus)
+		r.Service.OwnerReferences = []metav1.OwnerReference{isvcOwnerReference("sklearn", "isvc-uid")}
+		return r
+	}
+	getService := func(cli client.Client) *knservingv1.Service {
+		actual := &knservingv1.Service{}
+		g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: "sklearn-predictor-default", Namespace: "default"},
+			actual)).To(gomega.Succeed())
+		return actual
+	}
+	drainedTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(0), Tag: "prev"},
+	}
+	promotedTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+	}
+
+	t.Run("DrainThenDelete", func(t *testing.T) {
+		cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+		canary := &v1beta1.ComponentExtensionSpec{CanaryTrafficPercent: proto.Int64(20)}
+		_, err := newReconciler(cli, canary, map[string]string{}).Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(getService(cli).Spec.Traffic).To(gomega.HaveLen(2))
+
+		// promoting the canary shifts the traffic of the previous revision to 0 percent first
+		r := newReconciler(cli, &v1beta1.ComponentExtensionSpec{}, map[string]string{})
+		_, err = r.Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(r.RequeueAfter).To(gomega.BeNumerically(">", 0))
+		g.Expect(r.RequeueAfter).To(gomega.BeNumerically("<=", constants.DefaultCanaryDrainGracePeriod))
+		actual := getService(cli)
+		g.Expect(actual.Spec.Traffic).To(gomega.Equal(drainedTraffic))
+		g.Expect(actual.Annotations).To(gomega.HaveKey(constants.CanaryDrainStartInternalAnnotationKey))
+
+		// the previous revision is kept while the grace period has not passed
+		r = newReconciler(cli, &v1beta1.ComponentExtensionSpec{}, map[string]string{})
+		_, err = r.Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(r.RequeueAfter).To(gomega.BeNumerically(">", 0))
+		g.Expect(getService(cli).Spec.Traffic).To(gomega.Equal(drainedTraffic))
+
+		// the previous revision is removed once the grace period has passed
+		actual = getService(cli)
+		actual.Annotations[constants.CanaryDrainStartInternalAnnotationKey] =
+			time.Now().Add(-2 * constants.DefaultCanaryDrainGracePeriod).Format(time.RFC3339)
+		g.Expect(cli.Update(context.TODO(), actual)).To(gomega.Succeed())
+		r = newReconciler(cli, &v1beta1.ComponentExtensionSpec{}, map[string]string{})
+		_, err = r.Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(r.RequeueAfter).To(gomega.BeZero())
+		actual = getService(cli)
+		g.Expect(actual.Spec.Traffic).To(gomega.Equal(promotedTraffic))
+		g.Expect(actual.Annotations).NotTo(gomega.HaveKey(constants.CanaryDrainStartInternalAnnotationKey))
+	})
+
+	t.Run("DeleteWithoutGracePeriod", func(t *testing.T) {
+		cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+		canary := &v1beta1.ComponentExtensionSpec{CanaryTrafficPercent: proto.Int64(20)}
+		_, err := newReconciler(cli, canary, map[string]string{}).Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+
+		r := newReconciler(cli, &v1beta1.ComponentExtensionSpec{}, map[string]string{
+			constants.CanaryDrainGracePeriodAnnotationKey: "0s",
+		})
+		_, err = r.Reconcile()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(r.RequeueAfter).To(gomega.BeZero())
+		g.Expect(getService(cli).Spec.Traffic).To(gomega.Equal(promotedTraffic))
+	})
+}
+
+func TestKsvcReconcilerRollback(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	rollbackAnnotations := map[string]string{
+		constants.RollbackAnnotationKey: "true",
+	}
+
+	scenarios := map[string]struct {
+		componentExt             *v1beta1.ComponentExtensionSpec
+		componentStatus          v1beta1.ComponentStatusSpec
+		annotations              map[string]string
+		expectedRollbackRevision string
+		expectedTraffic          []knservingv1.TrafficTarget
+	}{
+		"RollbackCanary": {
+			componentExt: &v1beta1.ComponentExtensionSpec{CanaryTrafficPercent: proto.Int64(20)},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			annotations:              rollbackAnnotations,
+			expectedRollbackRevision: "sklearn-predictor-default-00001",
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"RollbackRolledoutRevision": {
+			componentExt: &v1beta1.ComponentExtensionSpec{},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:       "sklearn-predictor-default-00002",
+				LatestRolledoutRevision:   "sklearn-predictor-default-00002",
+				PreviousRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			annotations:              rollbackAnnotations,
+			expectedRollbackRevision: "sklearn-predictor-default-00001",
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"NoPreviousRevision": {
+			componentExt: &v1beta1.ComponentExtensionSpec{},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00001",
+				LatestRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			annotations:              rollbackAnnotations,
+			expectedRollbackRevision: "",
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+			},
+		},
+		"PinRevisionRef": {
+			componentExt: &v1beta1.ComponentExtensionSpec{RevisionRef: proto.String("sklearn-predictor-default-00001")},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:       "sklearn-predictor-default-00003",
+				LatestRolledoutRevision:   "sklearn-predictor-default-00003",
+				PreviousRolledoutRevision: "sklearn-predictor-default-00002",
+				RevisionHistory: []v1beta1.RevisionHistoryEntry{
+					{Name: "sklearn-predictor-default-00003"},
+					{Name: "sklearn-predictor-default-00002"},
+					{Name: "sklearn-predictor-default-00001"},
+				},
+			},
+			annotations:              map[string]string{},
+			expectedRollbackRevision: "sklearn-predictor-default-00001",
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+				{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+			},
+		},
+		"IgnoreRevisionRefNotInHistory": {
+			componentExt: &v1beta1.ComponentExtensionSpec{RevisionRef: proto.String("sklearn-predictor-default-00001")},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:     "sklearn-predictor-default-00002",
+				LatestRolledoutRevision: "sklearn-predictor-default-00002",
+				RevisionHistory: []v1beta1.RevisionHistoryEntry{
+					{Name: "sklearn-predictor-default-00002"},
+				},
+			},
+			annotations:              map[string]string{},
+			expectedRollbackRevision: "",
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+			},
+		},
+		"RollbackNotRequested": {
+			componentExt: &v1beta1.ComponentExtensionSpec{},
+			componentStatus: v1beta1.ComponentStatusSpec{
+				LatestReadyRevision:       "sklearn-predictor-default-00002",
+				LatestRolledoutRevision:   "sklearn-predictor-default-00002",
+				PreviousRolledoutRevision: "sklearn-predictor-default-00001",
+			},
+			annotations: map[string]string{
+				constants.RollbackAnnotationKey: "false",
+			},
+			expectedRollbackRevision: "",
+			expectedTraffic: []knservingv1.TrafficTarget{
+				{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+			componentMeta := newTestComponentMeta()
+			for key, value := range scenario.annotations {
+				componentMeta.Annotations[key] = value
+			}
+			r := NewKsvcReconciler(cli, newTestScheme(), componentMeta, scenario.componentExt,
+				newTestPodSpec(), scenario.componentStatus)
+			g.Expect(r.RollbackRevision).To(gomega.Equal(scenario.expectedRollbackRevision))
+			g.Expect(r.ConfigHash).NotTo(gomega.BeEmpty())
+			_, err := r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			actual := &knservingv1.Service{}
+			g.Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(r.Service), actual)).To(gomega.Succeed())
+			g.Expect(actual.Spec.Traffic).To(gomega.Equal(scenario.expectedTraffic))
+			// requesting a rollback must not roll out a new revision
+			g.Expect(actual.Spec.Template.Annotations).NotTo(gomega.HaveKey(constants.RollbackAnnotationKey))
+		})
+	}
+}
+
+func TestKsvcReconcilerRollbackHold(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+	newReconciler := func(annotations map[string]string, podSpec *corev1.PodSpec, componentStatus v1beta1.ComponentStatusSpec) *KsvcReconciler {
+		componentMeta := newTestComponentMeta()
+		componentMeta.Annotations = annotations
+		return NewKsvcReconciler(cli, newTestScheme(), componentMeta, &v1beta1.ComponentExtensionSpec{}, podSpec, componentStatus)
+	}
+	getService := func() *knservingv1.Service {
+		actual := &knservingv1.Service{}
+		g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: "sklearn-predictor-default", Namespace: "default"},
+			actual)).To(gomega.Succeed())
+		return actual
+	}
+	rolledOut := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:       "sklearn-predictor-default-00002",
+		LatestRolledoutRevision:   "sklearn-predictor-default-00002",
+		PreviousRolledoutRevision: "sklearn-predictor-default-00001",
+	}
+	// the status reports the pinned revision as rolled out once the traffic is rolled back
+	rolledBack := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:       "sklearn-predictor-default-00002",
+		LatestRolledoutRevision:   "sklearn-predictor-default-00001",
+		PreviousRolledoutRevision: "sklearn-predictor-default-00002",
+	}
+	pinnedTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(0)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(100), Tag: "prev"},
+	}
+
+	_, err := newReconciler(map[string]string{}, newTestPodSpec(), rolledOut).Reconcile()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// the rollback is recorded with the hash of the revision template
+	r := newReconciler(map[string]string{constants.RollbackAnnotationKey: "true"}, newTestPodSpec(), rolledOut)
+	_, err = r.Reconcile()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	actual := getService()
+	g.Expect(actual.Spec.Traffic).To(gomega.Equal(pinnedTraffic))
+	g.Expect(actual.Annotations).To(gomega.HaveKeyWithValue(constants.RollbackRevisionInternalAnnotationKey,
+		"sklearn-predictor-default-00001"))
+	g.Expect(actual.Annotations).To(gomega.HaveKeyWithValue(constants.RollbackConfigHashInternalAnnotationKey, r.ConfigHash))
+
+	// the recorded revision is kept while the annotation is set
+	r = newReconciler(map[string]string{constants.RollbackAnnotationKey: "true"}, newTestPodSpec(), rolledBack)
+	_, err = r.Reconcile()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r.RollbackRevision).To(gomega.Equal("sklearn-predictor-default-00001"))
+	g.Expect(getService().Spec.Traffic).To(gomega.Equal(pinnedTraffic))
+
+	// the traffic stays rolled back once the annotation is removed
+	r = newReconciler(map[string]string{}, newTestPodSpec(), rolledBack)
+	_, err = r.Reconcile()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r.RollbackRevision).To(gomega.Equal("sklearn-predictor-default-00001"))
+	g.Expect(getService().Spec.Traffic).To(gomega.Equal(pinnedTraffic))
+
+	// a new revision template releases the rollback
+	podSpec := newTestPodSpec()
+	podSpec.Containers[0].Image = "kserve/sklearnserver:fixed"
+	r = newReconciler(map[string]string{}, podSpec, rolledBack)
+	_, err = r.Reconcile()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r.RollbackRevision).To(gomega.BeEmpty())
+	actual = getService()
+	g.Expect(actual.Spec.Traffic).To(gomega.ContainElement(
+		knservingv1.TrafficTarget{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)}))
+	g.Expect(actual.Annotations).NotTo(gomega.HaveKey(constants.RollbackRevisionInternalAnnotationKey))
+	g.Expect(actual.Annotations).NotTo(gomega.HaveKey(constants.RollbackConfigHashInternalAnnotationKey))
+}
+
+func TestKsvcReconcilerBlueGreen(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentStatus := v1beta1.ComponentStatusSpec{
+		LatestReadyRevision:       "sklearn-predictor-default-00002",
+		LatestCreatedRevision:     "sklearn-predictor-default-00002",
+		LatestRolledoutRevision:   "sklearn-predictor-default-00002",
+		PreviousRolledoutRevision: "sklearn-predictor-default-00001",
+	}
+	retainedTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+		{RevisionName: "sklearn-predictor-default-00001", LatestRevision: proto.Bool(false), Percent: proto.Int64(0), Tag: "prev"},
+	}
+	switchedTraffic := []knservingv1.TrafficTarget{
+		{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+	}
+
+	scenarios := map[string]struct {
+		strategy         v1beta1.DeploymentStrategy
+		annotations      map[string]string
+		retainedRevision string
+		retainStart      time.Time
+		expectedTraffic  []knservingv1.TrafficTarget
+		expectRequeue    bool
+	}{
+		"RetainPreviousRevision": {
+			strategy:        v1beta1.DeploymentStrategyBlueGreen,
+			annotations:     map[string]string{},
+			expectedTraffic: retainedTraffic,
+			expectRequeue:   true,
+		},
+		"RetainPreviousRevisionWithinRetention": {
+			strategy: v1beta1.DeploymentStrategyBlueGreen,
+			annotations: map[string]string{
+				constants.BlueGreenRetentionAnnotationKey: "1h",
+			},
+			retainedRevision: "sklearn-predictor-default-00001",
+			retainStart:      time.Now().Add(-20 * time.Minute),
+			expectedTraffic:  retainedTraffic,
+			expectRequeue:    true,
+		},
+		"RemovePreviousRevisionAfterRetention": {
+			strategy: v1beta1.DeploymentStrategyBlueGreen,
+			annotations: map[string]string{
+				constants.CanaryDrainGracePeriodAnnotationKey: "0s",
+			},
+			retainedRevision: "sklearn-predictor-default-00001",
+			retainStart:      time.Now().Add(-20 * time.Minute),
+			expectedTraffic:  switchedTraffic,
+			expectRequeue:    false,
+		},
+		"RestartRetentionForNewPreviousRevision": {
+			strategy:         v1beta1.DeploymentStrategyBlueGreen,
+			annotations:      map[string]string{},
+			retainedRevision: "sklearn-predictor-default-00000",
+			retainStart:      time.Now().Add(-20 * time.Minute),
+			expectedTraffic:  retainedTraffic,
+			expectRequeue:    true,
+		},
+		"CanaryStrategyDoesNotRetain": {
+			strategy:        v1beta1.DeploymentStrategyCanary,
+			annotations:     map[string]string{},
+			expectedTraffic: switchedTraffic,
+			expectRequeue:   false,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+			newReconciler := func() *KsvcReconciler {
+				componentMeta := newTestComponentMeta()
+				for key, value := range scenario.annotations {
+					componentMeta.Annotations[key] = value
+				}
+				return NewKsvcReconciler(cli, newTestScheme(), componentMeta,
+					&v1beta1.ComponentExtensionSpec{DeploymentStrategy: scenario.strategy}, newTestPodSpec(), componentStatus)
+			}
+			r := newReconciler()
+			_, err := r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			if scenario.retainedRevision != "" {
+				existing := &knservingv1.Service{}
+				g.Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(r.Service), existing)).To(gomega.Succeed())
+				existing.Annotations = map[string]string{
+					constants.RetainedRevisionInternalAnnotationKey: scenario.retainedRevision,
+					constants.RetainStartInternalAnnotationKey:      scenario.retainStart.Format(time.RFC3339),
+				}
+				existing.Spec.Traffic = retainedTraffic
+				g.Expect(cli.Update(context.TODO(), existing)).To(gomega.Succeed())
+			}
+
+			r = newReconciler()
+			_, err = r.Reconcile()
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			actual := &knservingv1.Service{}
+			g.Expect(cli.Get(context.TODO(), client.ObjectKeyFromObject(r.Service), actual)).To(gomega.Succeed())
+			g.Expect(actual.Spec.Traffic).To(gomega.Equal(scenario.expectedTraffic))
+			if scenario.expectRequeue {
+				g.Expect(r.RequeueAfter).To(gomega.BeNumerically(">", 0))
+				g.Expect(actual.Annotations[constants.RetainedRevisionInternalAnnotationKey]).To(gomega.Equal(componentStatus.PreviousRolledoutRevision))
+			} else {
+				g.Expect(r.RequeueAfter).To(gomega.BeZero())
+			}
+		})
+	}
+}
+
+func TestCreateKnativeServiceMinScale(t *testing.T) {
+	scenarios := map[string]struct {
+		componentExt *v1beta1.ComponentExtensionSpec
+		expected     string
+	}{
+		"Default": {
+			componentExt: &v1beta1.ComponentExtensionSpec{},
+			expected:     "1",
+		},
+		"ScaleToZero": {
+			componentExt: &v1beta1.ComponentExtensionSpec{ScaleToZero: proto.Bool(true)},
+			expected:     "0",
+		},
+		"ScaleToZeroDisabled": {
+			componentExt: &v1beta1.ComponentExtensionSpec{ScaleToZero: proto.Bool(false)},
+			expected:     "1",
+		},
+		"MinReplicas": {
+			componentExt: &v1beta1.ComponentExtensionSpec{MinReplicas: v1beta1.GetIntReference(3)},
+			expected:     "3",
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			ksvc := createKnativeService(newTestComponentMeta(), scenario.componentExt, newTestPodSpec(),
+				v1beta1.ComponentStatusSpec{})
+			g.Expect(ksvc.Spec.Template.Annotations[constants.MinScaleAnnotationKey]).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
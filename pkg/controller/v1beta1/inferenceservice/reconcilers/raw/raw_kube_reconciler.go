@@ -24,11 +24,13 @@ import (
 	deployment "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/deployment"
 	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/ingress"
 	service "github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/service"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice/reconcilers/warmpool"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	knapis "knative.dev/pkg/apis"
+	"knative.dev/pkg/network"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -39,6 +41,7 @@ type RawKubeReconciler struct {
 	Deployment *deployment.DeploymentReconciler
 	Service    *service.ServiceReconciler
 	Scaler     *autoscaler.AutoscalerReconciler
+	WarmPool   *warmpool.WarmPoolReconciler
 	URL        *knapis.URL
 }
 
@@ -64,18 +67,23 @@ func NewRawKubeReconciler(client client.Client,
 		Deployment: deployment.NewDeploymentReconciler(client, scheme, componentMeta, componentExt, podSpec),
 		Service:    service.NewServiceReconciler(client, scheme, componentMeta, componentExt, podSpec),
 		Scaler:     as,
+		WarmPool:   warmpool.NewWarmPoolReconciler(client, scheme, componentMeta, componentExt, podSpec),
 		URL:        url,
 	}, nil
 }
 
 func createRawURL(client client.Client, metadata metav1.ObjectMeta) (*knapis.URL, error) {
+	url := &knapis.URL{}
+	url.Scheme = "http"
+	if ingress.IsClusterLocal(metadata) {
+		url.Host = network.GetServiceHostname(metadata.Name, metadata.Namespace)
+		return url, nil
+	}
+
 	ingressConfig, err := v1beta1.NewIngressConfig(client)
 	if err != nil {
 		return nil, err
 	}
-
-	url := &knapis.URL{}
-	url.Scheme = "http"
 	url.Host, err = ingress.GenerateDomainName(metadata.Name, metadata, ingressConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating host name: %v", err)
@@ -101,5 +109,9 @@ func (r *RawKubeReconciler) Reconcile() (*appsv1.Deployment, error) {
 	if err != nil {
 		return nil, err
 	}
+	//reconcile warm pool
+	if err := r.WarmPool.Reconcile(deployment); err != nil {
+		return nil, err
+	}
 	return deployment, nil
 }
@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("SecurityReconciler")
+
+// PeerAuthenticationGVK and AuthorizationPolicyGVK are the Istio resources which secure the traffic between the
+// components, they are handled as unstructured so that Istio is only required when the security config enables them
+var (
+	PeerAuthenticationGVK  = schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: "PeerAuthentication"}
+	AuthorizationPolicyGVK = schema.GroupVersionKind{Group: "security.istio.io", Version: "v1beta1", Kind: "AuthorizationPolicy"}
+)
+
+// DefaultServiceAccountName is the service account of the component pods which do not set one
+const DefaultServiceAccountName = "default"
+
+type peerAuthenticationSpec struct {
+	Selector workloadSelector `json:"selector"`
+	Mtls     mutualTLS        `json:"mtls"`
+}
+
+type workloadSelector struct {
+	MatchLabels map[string]string `json:"matchLabels"`
+}
+
+type mutualTLS struct {
+	Mode string `json:"mode"`
+}
+
+type authorizationPolicySpec struct {
+	Selector workloadSelector    `json:"selector"`
+	Action   string              `json:"action"`
+	Rules    []authorizationRule `json:"rules,omitempty"`
+}
+
+type authorizationRule struct {
+	From []ruleFrom `json:"from"`
+}
+
+type ruleFrom struct {
+	Source source `json:"source"`
+}
+
+type source struct {
+	Principals []string `json:"principals,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// SecurityReconciler reconciles the Istio PeerAuthentication and AuthorizationPolicies of an InferenceService
+type SecurityReconciler struct {
+	client         client.Client
+	scheme         *runtime.Scheme
+	securityConfig *v1beta1.SecurityConfig
+	deploymentMode constants.DeploymentModeType
+}
+
+func NewSecurityReconciler(client client.Client,
+	scheme *runtime.Scheme,
+	securityConfig *v1beta1.SecurityConfig,
+	deploymentMode constants.DeploymentModeType) *SecurityReconciler {
+	return &SecurityReconciler{
+		client:         client,
+		scheme:         scheme,
+		securityConfig: securityConfig,
+		deploymentMode: deploymentMode,
+	}
+}
+
+// componentSelector selects the pods of a component, all the components of the InferenceService are selected when
+// the component is empty
+func componentSelector(isvc *v1beta1.InferenceService, component constants.InferenceServiceComponent) workloadSelector {
+	labels := map[string]string{constants.InferenceServicePodLabelKey: isvc.Name}
+	if component != "" {
+		labels[constants.KServiceComponentLabel] = string(component)
+	}
+	return workloadSelector{MatchLabels: labels}
+}
+
+// principal returns the mesh identity of the pods running with the given service account
+func principal(trustDomain string, namespace string, serviceAccountName string) string {
+	if serviceAccountName == "" {
+		serviceAccountName = DefaultServiceAccountName
+	}
+	return fmt.Sprintf("%s/ns/%s/sa/%s", trustDomain, namespace, serviceAccountName)
+}
+
+func newUnstructured(gvk schema.GroupVersionKind, name string, isvc *v1beta1.InferenceService,
+	spec interface{}) (*unstructured.Unstructured, error) {
+	unstructuredSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(spec)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(isvc.Namespace)
+	obj.SetLabels(map[string]string{constants.InferenceServicePodLabelKey: isvc.Name})
+	obj.Object["spec"] = unstructuredSpec
+	return obj, nil
+}
+
+func createPeerAuthentication(isvc *v1beta1.InferenceService) (*unstructured.Unstructured, error) {
+	return newUnstructured(PeerAuthenticationGVK, isvc.Name, isvc, &peerAuthenticationSpec{
+		Selector: componentSelector(isvc, ""),
+		Mtls:     mutualTLS{Mode: "STRICT"},
+	})
+}
+
+// createAuthorizationPolicies returns the policy of each component keyed by name. The transformer, or the predictor
+// when there is no transformer, and the explainer are exposed by the ingress and accept the requests of the gateways.
+// The transformer and the explainer call the predictor, in Serverless mode through the Knative local gateway.
+func createAuthorizationPolicies(isvc *v1beta1.InferenceService, securityConfig *v1beta1.SecurityConfig,
+	deploymentMode constants.DeploymentModeType) (map[string]*unstructured.Unstructured, error) {
+	callers := map[constants.InferenceServiceComponent][]authorizationRule{}
+	allow := func(component constants.InferenceServiceComponent, source source) {
+		callers[component] = append(callers[component], authorizationRule{From: []ruleFrom{{Source: source}}})
+	}
+	exposed := func(component constants.InferenceServiceComponent) {
+		if len(securityConfig.GatewayNamespaces) > 0 {
+			allow(component, source{Namespaces: securityConfig.GatewayNamespaces})
+		}
+	}
+
+	components := []constants.InferenceServiceComponent{constants.Predictor}
+	if isvc.Spec.Transformer != nil {
+		components = append(components, constants.Transformer)
+		exposed(constants.Transformer)
+		allow(constants.Predictor, source{Principals: []string{
+			principal(securityConfig.TrustDomain, isvc.Namespace, isvc.Spec.Transformer.ServiceAccountName),
+		}})
+	} else {
+		exposed(constants.Predictor)
+	}
+	if isvc.Spec.Explainer != nil {
+		components = append(components, constants.Explainer)
+		exposed(constants.Explainer)
+		allow(constants.Predictor, source{Principals: []string{
+			principal(securityConfig.TrustDomain, isvc.Namespace, isvc.Spec.Explainer.ServiceAccountName),
+		}})
+	}
+	// The Knative services are called by their host which routes through the local gateway, the predictor sees the
+	// identity of the gateway instead of the one of the calling component
+	if deploymentMode == constants.Serverless && (isvc.Spec.Transformer != nil || isvc.Spec.Explainer != nil) {
+		if len(securityConfig.LocalGatewayPrincipals) > 0 {
+			allow(constants.Predictor, source{Principals: securityConfig.LocalGatewayPrincipals})
+		} else if len(securityConfig.GatewayNamespaces) > 0 {
+			allow(constants.Predictor, source{Namespaces: securityConfig.GatewayNamespaces})
+		}
+	}
+
+	policies := map[string]*unstructured.Unstructured{}
+	for _, component := range components {
+		if len(securityConfig.SystemNamespaces) > 0 {
+			allow(component, source{Namespaces: securityConfig.SystemNamespaces})
+		}
+		// An ALLOW policy without rules denies every request
+		policy, err := newUnstructured(AuthorizationPolicyGVK, constants.DefaultServiceName(isvc.Name, component), isvc,
+			&authorizationPolicySpec{
+				Selector: componentSelector(isvc, component),
+				Action:   "ALLOW",
+				Rules:    callers[component],
+			})
+		if err != nil {
+			return nil, err
+		}
+		policies[policy.GetName()] = policy
+	}
+	return policies, nil
+}
+
+func (r *SecurityReconciler) reconcileUnstructured(isvc *v1beta1.InferenceService, desired *unstructured.Unstructured) error {
+	if err := controllerutil.SetControllerReference(isvc, desired, r.scheme); err != nil {
+		return err
+	}
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(desired.GroupVersionKind())
+	err := r.client.Get(context.TODO(), types.NamespacedName{
+		Namespace: desired.GetNamespace(),
+		Name:      desired.GetName(),
+	}, existing)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			err = r.client.Create(context.TODO(), desired)
+			log.Info("creating "+desired.GetKind(), "name", desired.GetName(), "err", err)
+		}
+		return err
+	}
+	// The policies of the namespace may already use the name, they are not taken over
+	if !metav1.IsControlledBy(existing, isvc) {
+		return fmt.Errorf("%s %s/%s already exists and is not controlled by the InferenceService", desired.GetKind(),
+			desired.GetNamespace(), desired.GetName())
+	}
+	if !equality.Semantic.DeepEqual(desired.Object["spec"], existing.Object["spec"]) {
+		existing.Object["spec"] = desired.Object["spec"]
+		err = r.client.Update(context.TODO(), existing)
+		log.Info("updating "+desired.GetKind(), "name", desired.GetName(), "err", err)
+	}
+	return err
+}
+
+// deleteIfExists removes an object of the InferenceService which is no longer desired, the objects of the same name
+// which are not controlled by the InferenceService are left alone
+func (r *SecurityReconciler) deleteIfExists(isvc *v1beta1.InferenceService, gvk schema.GroupVersionKind, name string) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(gvk)
+	err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: isvc.Namespace, Name: name}, existing)
+	if err != nil {
+		if apierr.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	if !metav1.IsControlledBy(existing, isvc) {
+		return nil
+	}
+	log.Info("deleting "+gvk.Kind, "name", name)
+	if err := r.client.Delete(context.TODO(), existing); err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// setStatusAnnotation records whether the policies of a kind are created for the InferenceService
+func setStatusAnnotation(isvc *v1beta1.InferenceService, key string, created bool) {
+	if !created {
+		delete(isvc.Status.Annotations, key)
+		return
+	}
+	if isvc.Status.Annotations == nil {
+		isvc.Status.Annotations = map[string]string{}
+	}
+	isvc.Status.Annotations[key] = "true"
+}
+
+// Reconcile creates the policies enabled by the security config. The policies are only looked up for deletion when
+// the status of the InferenceService records that they were created, so that the Istio API is not called when the
+// features are disabled.
+func (r *SecurityReconciler) Reconcile(isvc *v1beta1.InferenceService) error {
+	if r.securityConfig.EnableMTLS {
+		peerAuthentication, err := createPeerAuthentication(isvc)
+		if err != nil {
+			return err
+		}
+		if err := r.reconcileUnstructured(isvc, peerAuthentication); err != nil {
+			return err
+		}
+		setStatusAnnotation(isvc, constants.PeerAuthenticationStatusAnnotationKey, true)
+	} else if _, ok := isvc.Status.Annotations[constants.PeerAuthenticationStatusAnnotationKey]; ok {
+		if err := r.deleteIfExists(isvc, PeerAuthenticationGVK, isvc.Name); err != nil {
+			return err
+		}
+		setStatusAnnotation(isvc, constants.PeerAuthenticationStatusAnnotationKey, false)
+	}
+
+	_, created := isvc.Status.Annotations[constants.AuthorizationPolicyStatusAnnotationKey]
+	if !r.securityConfig.EnableAuthorizationPolicy && !created {
+		return nil
+	}
+	policies := map[string]*unstructured.Unstructured{}
+	if r.securityConfig.EnableAuthorizationPolicy {
+		var err error
+		if policies, err = createAuthorizationPolicies(isvc, r.securityConfig, r.deploymentMode); err != nil {
+			return err
+		}
+	}
+	for _, component := range []constants.InferenceServiceComponent{constants.Predictor, constants.Transformer,
+		constants.Explainer} {
+		name := constants.DefaultServiceName(isvc.Name, component)
+		if policy, ok := policies[name]; ok {
+			if err := r.reconcileUnstructured(isvc, policy); err != nil {
+				return err
+			}
+		} else if err := r.deleteIfExists(isvc, AuthorizationPolicyGVK, name); err != nil {
+			return err
+		}
+	}
+	setStatusAnnotation(isvc, constants.AuthorizationPolicyStatusAnnotationKey, r.securityConfig.EnableAuthorizationPolicy)
+	return nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newSecurityTestIsvc() *v1beta1.InferenceService {
+	return &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-model", Namespace: "test"},
+		Spec: v1beta1.InferenceServiceSpec{
+			Transformer: &v1beta1.TransformerSpec{PodSpec: v1beta1.PodSpec{ServiceAccountName: "transformer-sa"}},
+			Explainer:   &v1beta1.ExplainerSpec{},
+		},
+	}
+}
+
+func getObject(c client.Client, gvk schema.GroupVersionKind, name string) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "test"}, obj)
+	return obj, err
+}
+
+func getPolicySpec(g *gomega.WithT, policy *unstructured.Unstructured) authorizationPolicySpec {
+	spec := authorizationPolicySpec{}
+	g.Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(
+		policy.Object["spec"].(map[string]interface{}), &spec)).To(gomega.Succeed())
+	return spec
+}
+
+func TestCreateAuthorizationPolicies(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	securityConfig := &v1beta1.SecurityConfig{
+		EnableAuthorizationPolicy: true,
+		TrustDomain:               v1beta1.DefaultTrustDomain,
+		GatewayNamespaces:         []string{"istio-system"},
+		SystemNamespaces:          []string{"knative-serving"},
+	}
+	gateways := authorizationRule{From: []ruleFrom{{Source: source{Namespaces: []string{"istio-system"}}}}}
+	system := authorizationRule{From: []ruleFrom{{Source: source{Namespaces: []string{"knative-serving"}}}}}
+	principals := func(principals ...string) authorizationRule {
+		return authorizationRule{From: []ruleFrom{{Source: source{Principals: principals}}}}
+	}
+
+	policies, err := createAuthorizationPolicies(newSecurityTestIsvc(), securityConfig, constants.RawDeployment)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(policies).To(gomega.HaveLen(3))
+
+	// only the transformer and the explainer may call the predictor behind the transformer
+	predictor := getPolicySpec(g, policies["my-model-predictor-default"])
+	g.Expect(predictor.Selector.MatchLabels).To(gomega.Equal(map[string]string{
+		constants.InferenceServicePodLabelKey: "my-model",
+		constants.KServiceComponentLabel:      "predictor",
+	}))
+	g.Expect(predictor.Action).To(gomega.Equal("ALLOW"))
+	g.Expect(predictor.Rules).To(gomega.Equal([]authorizationRule{
+		principals("cluster.local/ns/test/sa/transformer-sa"),
+		principals("cluster.local/ns/test/sa/default"),
+		system,
+	}))
+	g.Expect(getPolicySpec(g, policies["my-model-transformer-default"]).Rules).To(gomega.Equal(
+		[]authorizationRule{gateways, system}))
+	g.Expect(getPolicySpec(g, policies["my-model-explainer-default"]).Rules).To(gomega.Equal(
+		[]authorizationRule{gateways, system}))
+
+	// the predictor is exposed by the ingress without a transformer
+	isvc := newSecurityTestIsvc()
+	isvc.Spec.Transformer = nil
+	isvc.Spec.Explainer = nil
+	policies, err = createAuthorizationPolicies(isvc, securityConfig, constants.RawDeployment)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(policies).To(gomega.HaveLen(1))
+	g.Expect(getPolicySpec(g, policies["my-model-predictor-default"]).Rules).To(gomega.Equal(
+		[]authorizationRule{gateways, system}))
+}
+
+func TestCreateAuthorizationPoliciesServerless(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	securityConfig := &v1beta1.SecurityConfig{
+		EnableAuthorizationPolicy: true,
+		TrustDomain:               v1beta1.DefaultTrustDomain,
+		GatewayNamespaces:         []string{"istio-system"},
+	}
+	transformer := authorizationRule{From: []ruleFrom{{Source: source{Principals: []string{
+		"cluster.local/ns/test/sa/transformer-sa"}}}}}
+	explainer := authorizationRule{From: []ruleFrom{{Source: source{Principals: []string{
+		"cluster.local/ns/test/sa/default"}}}}}
+
+	// the transformer reaches the predictor through the local gateway
+	policies, err := createAuthorizationPolicies(newSecurityTestIsvc(), securityConfig, constants.Serverless)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(getPolicySpec(g, policies["my-model-predictor-default"]).Rules).To(gomega.Equal([]authorizationRule{
+		transformer, explainer,
+		{From: []ruleFrom{{Source: source{Namespaces: []string{"istio-system"}}}}},
+	}))
+
+	// only the local gateway is allowed when its identity is configured
+	securityConfig.LocalGatewayPrincipals = []string{"cluster.local/ns/istio-system/sa/knative-local-gateway"}
+	policies, err = createAuthorizationPolicies(newSecurityTestIsvc(), securityConfig, constants.Serverless)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(getPolicySpec(g, policies["my-model-predictor-default"]).Rules).To(gomega.Equal([]authorizationRule{
+		transformer, explainer,
+		{From: []ruleFrom{{Source: source{Principals: []string{"cluster.local/ns/istio-system/sa/knative-local-gateway"}}}}},
+	}))
+}
+
+func TestSecurityReconciler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(s).Build()
+	securityConfig := &v1beta1.SecurityConfig{
+		EnableMTLS:                true,
+		EnableAuthorizationPolicy: true,
+		TrustDomain:               v1beta1.DefaultTrustDomain,
+	}
+
+	isvc := newSecurityTestIsvc()
+	g.Expect(NewSecurityReconciler(c, s, securityConfig, constants.RawDeployment).Reconcile(isvc)).To(gomega.Succeed())
+	peerAuthentication, err := getObject(c, PeerAuthenticationGVK, "my-model")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(peerAuthentication.GetOwnerReferences()).To(gomega.HaveLen(1))
+	mode, _, _ := unstructured.NestedString(peerAuthentication.Object, "spec", "mtls", "mode")
+	g.Expect(mode).To(gomega.Equal("STRICT"))
+	for _, name := range []string{"my-model-predictor-default", "my-model-transformer-default", "my-model-explainer-default"} {
+		_, err := getObject(c, AuthorizationPolicyGVK, name)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	// the policy of a removed component is deleted and the predictor is exposed again
+	isvc.Spec.Transformer = nil
+	g.Expect(NewSecurityReconciler(c, s, securityConfig, constants.RawDeployment).Reconcile(isvc)).To(gomega.Succeed())
+	_, err = getObject(c, AuthorizationPolicyGVK, "my-model-transformer-default")
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	predictor, err := getObject(c, AuthorizationPolicyGVK, "my-model-predictor-default")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(getPolicySpec(g, predictor).Rules).To(gomega.Equal([]authorizationRule{
+		{From: []ruleFrom{{Source: source{Principals: []string{"cluster.local/ns/test/sa/default"}}}}},
+	}))
+
+	// disabling the security config removes the policies
+	g.Expect(NewSecurityReconciler(c, s, &v1beta1.SecurityConfig{}, constants.RawDeployment).Reconcile(isvc)).To(gomega.Succeed())
+	_, err = getObject(c, PeerAuthenticationGVK, "my-model")
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	_, err = getObject(c, AuthorizationPolicyGVK, "my-model-predictor-default")
+	g.Expect(apierr.IsNotFound(err)).To(gomega.BeTrue())
+	g.Expect(isvc.Status.Annotations).To(gomega.BeEmpty())
+}
+
+func TestSecurityReconcilerOwnership(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1.AddToScheme(s)).To(gomega.Succeed())
+	securityConfig := &v1beta1.SecurityConfig{
+		EnableMTLS:                true,
+		EnableAuthorizationPolicy: true,
+		TrustDomain:               v1beta1.DefaultTrustDomain,
+	}
+
+	// the Istio API is not called while the features have never been enabled for the InferenceService
+	isvc := newSecurityTestIsvc()
+	g.Expect(NewSecurityReconciler(nil, s, &v1beta1.SecurityConfig{}, constants.RawDeployment).Reconcile(isvc)).To(gomega.Succeed())
+
+	// the policies of the namespace which use the same names are neither taken over nor deleted
+	unowned, err := newUnstructured(PeerAuthenticationGVK, "my-model", isvc, &peerAuthenticationSpec{
+		Mtls: mutualTLS{Mode: "PERMISSIVE"},
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(unowned).Build()
+	g.Expect(NewSecurityReconciler(c, s, securityConfig, constants.RawDeployment).Reconcile(isvc)).To(gomega.MatchError(
+		gomega.ContainSubstring("not controlled by the InferenceService")))
+
+	isvc.Status.Annotations = map[string]string{
+		constants.PeerAuthenticationStatusAnnotationKey:  "true",
+		constants.AuthorizationPolicyStatusAnnotationKey: "true",
+	}
+	g.Expect(NewSecurityReconciler(c, s, &v1beta1.SecurityConfig{}, constants.RawDeployment).Reconcile(isvc)).To(gomega.Succeed())
+	existing, err := getObject(c, PeerAuthenticationGVK, "my-model")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	mode, _, _ := unstructured.NestedString(existing.Object, "spec", "mtls", "mode")
+	g.Expect(mode).To(gomega.Equal("PERMISSIVE"))
+	g.Expect(isvc.Status.Annotations).To(gomega.BeEmpty())
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
 	corev1 "k8s.io/api/core/v1"
@@ -59,16 +60,45 @@ func NewServiceReconciler(client client.Client,
 func createService(componentMeta metav1.ObjectMeta, componentExt *v1beta1.ComponentExtensionSpec,
 	podSpec *corev1.PodSpec) *corev1.Service {
 	var port int
+	var appProtocol *string
 	if podSpec.Containers != nil && podSpec.Containers[0].Ports != nil {
 		port = int(podSpec.Containers[0].Ports[0].ContainerPort)
+		// The user port is named h2c when the model server speaks gRPC
+		if podSpec.Containers[0].Ports[0].Name == constants.KnativeH2CPortName {
+			appProtocol = proto.String(constants.GRPCAppProtocol)
+		}
 	} else {
 		port, _ = strconv.Atoi(constants.InferenceServiceDefaultHttpPort)
 	}
 	if componentExt.Batcher != nil {
 		port = int(constants.InferenceServiceDefaultAgentPort)
+		appProtocol = nil
 	}
 	if componentExt.Logger != nil {
 		port = int(constants.InferenceServiceDefaultAgentPort)
+		appProtocol = nil
+	}
+	if componentExt.OpenAI != nil {
+		port = int(constants.InferenceServiceDefaultAgentPort)
+		appProtocol = nil
+	}
+	if componentExt.ResponseCache != nil {
+		port = int(constants.InferenceServiceDefaultAgentPort)
+		appProtocol = nil
+	}
+	if componentExt.RequestQueue != nil {
+		port = int(constants.InferenceServiceDefaultAgentPort)
+		appProtocol = nil
+	}
+	// The tracing is set on the InferenceService rather than on the component, the agent is injected for all the
+	// components when the tracing annotations are set
+	if _, ok := componentMeta.Annotations[constants.TracingEndpointInternalAnnotationKey]; ok {
+		port = int(constants.InferenceServiceDefaultAgentPort)
+		appProtocol = nil
+	}
+	if _, ok := componentMeta.Annotations[constants.AuthenticationAudiencesInternalAnnotationKey]; ok {
+		port = int(constants.InferenceServiceDefaultAgentPort)
+		appProtocol = nil
 	}
 
 	service := &corev1.Service{
@@ -85,7 +115,8 @@ func createService(componentMeta metav1.ObjectMeta, componentExt *v1beta1.Compon
 						Type:   intstr.Int,
 						IntVal: int32(port),
 					},
-					Protocol: corev1.ProtocolTCP,
+					Protocol:    corev1.ProtocolTCP,
+					AppProtocol: appProtocol,
 				},
 			},
 		},
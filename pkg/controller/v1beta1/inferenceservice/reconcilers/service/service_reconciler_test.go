@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateServiceAppProtocol(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentMeta := metav1.ObjectMeta{Name: "triton-predictor-default", Namespace: "default"}
+	podSpec := &corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Name:  constants.InferenceServiceContainerName,
+			Ports: []corev1.ContainerPort{{Name: constants.KnativeH2CPortName, ContainerPort: 9000}},
+		}},
+	}
+
+	service := createService(componentMeta, &v1beta1.ComponentExtensionSpec{}, podSpec)
+	g.Expect(service.Spec.Ports[0].TargetPort.IntVal).To(gomega.Equal(int32(9000)))
+	g.Expect(service.Spec.Ports[0].AppProtocol).To(gomega.Equal(proto.String(constants.GRPCAppProtocol)))
+
+	// The agent in front of the model server serves HTTP
+	service = createService(componentMeta, &v1beta1.ComponentExtensionSpec{Logger: &v1beta1.LoggerSpec{}}, podSpec)
+	g.Expect(service.Spec.Ports[0].TargetPort.IntVal).To(gomega.Equal(int32(constants.InferenceServiceDefaultAgentPort)))
+	g.Expect(service.Spec.Ports[0].AppProtocol).To(gomega.BeNil())
+	service = createService(componentMeta, &v1beta1.ComponentExtensionSpec{OpenAI: &v1beta1.OpenAISpec{}}, podSpec)
+	g.Expect(service.Spec.Ports[0].TargetPort.IntVal).To(gomega.Equal(int32(constants.InferenceServiceDefaultAgentPort)))
+	service = createService(componentMeta, &v1beta1.ComponentExtensionSpec{ResponseCache: &v1beta1.ResponseCacheSpec{}}, podSpec)
+	g.Expect(service.Spec.Ports[0].TargetPort.IntVal).To(gomega.Equal(int32(constants.InferenceServiceDefaultAgentPort)))
+	service = createService(componentMeta, &v1beta1.ComponentExtensionSpec{RequestQueue: &v1beta1.RequestQueue{MaxInFlight: 4}}, podSpec)
+	g.Expect(service.Spec.Ports[0].TargetPort.IntVal).To(gomega.Equal(int32(constants.InferenceServiceDefaultAgentPort)))
+	tracedMeta := metav1.ObjectMeta{Name: componentMeta.Name, Namespace: componentMeta.Namespace,
+		Annotations: map[string]string{constants.TracingEndpointInternalAnnotationKey: "http://otel-collector:4318"}}
+	service = createService(tracedMeta, &v1beta1.ComponentExtensionSpec{}, podSpec)
+	g.Expect(service.Spec.Ports[0].TargetPort.IntVal).To(gomega.Equal(int32(constants.InferenceServiceDefaultAgentPort)))
+	authenticatedMeta := metav1.ObjectMeta{Name: componentMeta.Name, Namespace: componentMeta.Namespace,
+		Annotations: map[string]string{constants.AuthenticationAudiencesInternalAnnotationKey: "sklearn.default"}}
+	service = createService(authenticatedMeta, &v1beta1.ComponentExtensionSpec{}, podSpec)
+	g.Expect(service.Spec.Ports[0].TargetPort.IntVal).To(gomega.Equal(int32(constants.InferenceServiceDefaultAgentPort)))
+
+	podSpec.Containers[0].Ports[0].Name = constants.KnativeHTTP1PortName
+	service = createService(componentMeta, &v1beta1.ComponentExtensionSpec{}, podSpec)
+	g.Expect(service.Spec.Ports[0].AppProtocol).To(gomega.BeNil())
+}
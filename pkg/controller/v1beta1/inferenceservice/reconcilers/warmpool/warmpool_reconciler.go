@@ -0,0 +1,203 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warmpool
+
+import (
+	"context"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("WarmPoolReconciler")
+
+// WarmPoolReconciler keeps the idle replicas of a raw deployment component provisioned in a warm pool deployment
+// which is not selected by the component service. When the component deployment has less ready replicas than the
+// autoscaler requires, ready warm pool pods are relabeled to be selected by the component service, and they are
+// deleted again once the component deployment has caught up.
+type WarmPoolReconciler struct {
+	client        client.Client
+	scheme        *runtime.Scheme
+	Deployment    *appsv1.Deployment
+	componentName string
+	componentExt  *v1beta1.ComponentExtensionSpec
+}
+
+func NewWarmPoolReconciler(client client.Client,
+	scheme *runtime.Scheme,
+	componentMeta metav1.ObjectMeta,
+	componentExt *v1beta1.ComponentExtensionSpec,
+	podSpec *corev1.PodSpec) *WarmPoolReconciler {
+	return &WarmPoolReconciler{
+		client:        client,
+		scheme:        scheme,
+		Deployment:    createWarmPoolDeployment(componentMeta, componentExt, podSpec),
+		componentName: componentMeta.Name,
+		componentExt:  componentExt,
+	}
+}
+
+func createWarmPoolDeployment(componentMeta metav1.ObjectMeta,
+	componentExt *v1beta1.ComponentExtensionSpec,
+	podSpec *corev1.PodSpec) *appsv1.Deployment {
+	// The warm pool pods must not be selected by the component service until they are activated
+	labels := utils.Filter(componentMeta.Labels, func(key string) bool {
+		return key != constants.RawDeploymentAppLabel
+	})
+	labels[constants.WarmPoolLabel] = componentMeta.Name
+	replicas := int32(componentExt.IdleReplicas)
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        constants.WarmPoolName(componentMeta.Name),
+			Namespace:   componentMeta.Namespace,
+			Labels:      labels,
+			Annotations: componentMeta.Annotations,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					constants.WarmPoolLabel: componentMeta.Name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: componentMeta.Annotations,
+				},
+				Spec: *podSpec.DeepCopy(),
+			},
+		},
+	}
+}
+
+func (r *WarmPoolReconciler) reconcileDeployment() error {
+	existing := &appsv1.Deployment{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{
+		Namespace: r.Deployment.Namespace,
+		Name:      r.Deployment.Name,
+	}, existing)
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		if r.componentExt.IdleReplicas == 0 {
+			return nil
+		}
+		log.Info("Creating warm pool deployment", "namespace", r.Deployment.Namespace, "name", r.Deployment.Name)
+		return r.client.Create(context.TODO(), r.Deployment)
+	}
+	if r.componentExt.IdleReplicas == 0 {
+		log.Info("Deleting warm pool deployment", "namespace", existing.Namespace, "name", existing.Name)
+		return client.IgnoreNotFound(r.client.Delete(context.TODO(), existing))
+	}
+	if equality.Semantic.DeepDerivative(r.Deployment.Spec, existing.Spec) {
+		return nil
+	}
+	log.Info("Updating warm pool deployment", "namespace", existing.Namespace, "name", existing.Name)
+	existing.Spec = r.Deployment.Spec
+	return r.client.Update(context.TODO(), existing)
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// activate releases the pod from the warm pool and hands it over to the component deployment, the pod is selected by
+// the component service and garbage collected with the component deployment
+func (r *WarmPoolReconciler) activate(pod *corev1.Pod, deployment *appsv1.Deployment) error {
+	patch := client.MergeFrom(pod.DeepCopy())
+	delete(pod.Labels, constants.WarmPoolLabel)
+	pod.Labels[constants.WarmPoolActivatedLabel] = r.componentName
+	pod.Labels[constants.RawDeploymentAppLabel] = constants.GetRawServiceLabel(r.componentName)
+	pod.OwnerReferences = nil
+	if err := controllerutil.SetOwnerReference(deployment, pod, r.scheme); err != nil {
+		return err
+	}
+	log.Info("Activating warm pool pod", "namespace", pod.Namespace, "name", pod.Name)
+	return r.client.Patch(context.TODO(), pod, patch)
+}
+
+func (r *WarmPoolReconciler) reconcilePods(deployment *appsv1.Deployment) error {
+	// The replicas of a deployment which was just created or updated are not known yet
+	if deployment == nil || deployment.UID == "" || deployment.Spec.Replicas == nil {
+		return nil
+	}
+	activated := &corev1.PodList{}
+	if err := r.client.List(context.TODO(), activated, client.InNamespace(deployment.Namespace),
+		client.MatchingLabels{constants.WarmPoolActivatedLabel: r.componentName}); err != nil {
+		return err
+	}
+	// Retire the activated pods once the ready replicas of the component deployment have caught up
+	excess := int(deployment.Status.ReadyReplicas) + len(activated.Items) - int(*deployment.Spec.Replicas)
+	for i := 0; i < excess && i < len(activated.Items); i++ {
+		log.Info("Deleting activated warm pool pod", "namespace", activated.Items[i].Namespace, "name", activated.Items[i].Name)
+		if err := client.IgnoreNotFound(r.client.Delete(context.TODO(), &activated.Items[i])); err != nil {
+			return err
+		}
+	}
+	if excess >= 0 || r.componentExt.IdleReplicas == 0 {
+		return nil
+	}
+
+	warm := &corev1.PodList{}
+	if err := r.client.List(context.TODO(), warm, client.InNamespace(deployment.Namespace),
+		client.MatchingLabels{constants.WarmPoolLabel: r.componentName}); err != nil {
+		return err
+	}
+	shortfall := -excess
+	for i := range warm.Items {
+		if shortfall == 0 {
+			break
+		}
+		if !isPodReady(&warm.Items[i]) {
+			continue
+		}
+		if err := r.activate(&warm.Items[i], deployment); err != nil {
+			return err
+		}
+		shortfall--
+	}
+	return nil
+}
+
+// Reconcile reconciles the warm pool deployment and activates warm pool pods for the given component deployment
+func (r *WarmPoolReconciler) Reconcile(deployment *appsv1.Deployment) error {
+	if err := r.reconcileDeployment(); err != nil {
+		return err
+	}
+	return r.reconcilePods(deployment)
+}
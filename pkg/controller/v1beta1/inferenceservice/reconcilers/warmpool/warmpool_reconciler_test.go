@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warmpool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const componentName = "sklearn-predictor-default"
+
+func newWarmPod(name string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{constants.WarmPoolLabel: componentName},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func newComponentDeployment(replicas int32, readyReplicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: componentName, Namespace: "default", UID: "component-uid"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: readyReplicas},
+	}
+}
+
+func newReconciler(c client.Client, idleReplicas int) *WarmPoolReconciler {
+	componentMeta := metav1.ObjectMeta{
+		Name:      componentName,
+		Namespace: "default",
+		Labels: map[string]string{
+			constants.RawDeploymentAppLabel:       constants.GetRawServiceLabel(componentName),
+			constants.InferenceServicePodLabelKey: "sklearn",
+		},
+	}
+	podSpec := &corev1.PodSpec{Containers: []corev1.Container{{Name: constants.InferenceServiceContainerName}}}
+	return NewWarmPoolReconciler(c, clientgoscheme.Scheme, componentMeta,
+		&v1beta1.ComponentExtensionSpec{IdleReplicas: idleReplicas}, podSpec)
+}
+
+func listPods(g *gomega.WithT, c client.Client, label string) []corev1.Pod {
+	pods := &corev1.PodList{}
+	g.Expect(c.List(context.TODO(), pods, client.MatchingLabels{label: componentName})).To(gomega.Succeed())
+	return pods.Items
+}
+
+func TestCreateWarmPoolDeployment(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	r := newReconciler(fake.NewClientBuilder().Build(), 2)
+
+	g.Expect(r.Deployment.Name).To(gomega.Equal("sklearn-predictor-default-warm"))
+	g.Expect(*r.Deployment.Spec.Replicas).To(gomega.Equal(int32(2)))
+	g.Expect(r.Deployment.Spec.Template.Labels).To(gomega.Equal(map[string]string{
+		constants.InferenceServicePodLabelKey: "sklearn",
+		constants.WarmPoolLabel:               componentName,
+	}))
+}
+
+func TestWarmPoolReconciler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+		WithObjects(newWarmPod("warm-1", true), newWarmPod("warm-2", false), newWarmPod("warm-3", true)).Build()
+
+	// The autoscaler scales the component out from 1 to 3 replicas
+	r := newReconciler(c, 3)
+	g.Expect(r.Reconcile(newComponentDeployment(3, 1))).To(gomega.Succeed())
+	warmPool := &appsv1.Deployment{}
+	g.Expect(c.Get(context.TODO(), types.NamespacedName{Name: constants.WarmPoolName(componentName), Namespace: "default"},
+		warmPool)).To(gomega.Succeed())
+
+	activated := listPods(g, c, constants.WarmPoolActivatedLabel)
+	g.Expect(activated).To(gomega.HaveLen(2))
+	for _, pod := range activated {
+		g.Expect(pod.Labels).NotTo(gomega.HaveKey(constants.WarmPoolLabel))
+		g.Expect(pod.Labels[constants.RawDeploymentAppLabel]).To(gomega.Equal(constants.GetRawServiceLabel(componentName)))
+		g.Expect(pod.OwnerReferences).To(gomega.HaveLen(1))
+		g.Expect(pod.OwnerReferences[0].UID).To(gomega.Equal(types.UID("component-uid")))
+	}
+	g.Expect(listPods(g, c, constants.WarmPoolLabel)).To(gomega.HaveLen(1))
+
+	// Nothing changes while the new component replicas are starting
+	g.Expect(r.Reconcile(newComponentDeployment(3, 1))).To(gomega.Succeed())
+	g.Expect(listPods(g, c, constants.WarmPoolActivatedLabel)).To(gomega.HaveLen(2))
+
+	// The activated pods are retired as the component replicas become ready
+	g.Expect(r.Reconcile(newComponentDeployment(3, 2))).To(gomega.Succeed())
+	g.Expect(listPods(g, c, constants.WarmPoolActivatedLabel)).To(gomega.HaveLen(1))
+	g.Expect(r.Reconcile(newComponentDeployment(3, 3))).To(gomega.Succeed())
+	g.Expect(listPods(g, c, constants.WarmPoolActivatedLabel)).To(gomega.BeEmpty())
+
+	// The warm pool is deleted when the idle replicas are removed
+	r = newReconciler(c, 0)
+	g.Expect(r.Reconcile(newComponentDeployment(3, 3))).To(gomega.Succeed())
+	err := c.Get(context.TODO(), types.NamespacedName{Name: constants.WarmPoolName(componentName), Namespace: "default"},
+		warmPool)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	"istio.io/client-go/pkg/apis/networking/v1alpha3"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileIngressWhileCanaryDrains(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1beta1api.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(knservingv1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha3.AddToScheme(s)).To(gomega.Succeed())
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace},
+		Data: map[string]string{
+			"ingress": `{
+				"ingressGateway": "knative-serving/knative-ingress-gateway",
+				"ingressService": "test-destination",
+				"localGateway": "knative-serving/knative-local-gateway",
+				"localGatewayService": "knative-local-gateway.istio-system.svc.cluster.local"
+			}`,
+		},
+	}
+	isvc := &v1beta1api.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{Name: "sklearn", Namespace: "default", UID: "isvc-uid"},
+		Spec: v1beta1api.InferenceServiceSpec{
+			Predictor: v1beta1api.PredictorSpec{
+				SKLearn: &v1beta1api.SKLearnSpec{
+					PredictorExtensionSpec: v1beta1api.PredictorExtensionSpec{
+						StorageURI:     proto.String("gs://models/sklearn"),
+						RuntimeVersion: proto.String("latest"),
+					},
+				},
+			},
+		},
+	}
+	predictorName := constants.DefaultPredictorServiceName(isvc.Name)
+	predictorURL, _ := apis.ParseURL("http://" + constants.InferenceServiceHostName(predictorName, isvc.Namespace, "example.com"))
+	// The existing knative service still routes a tagged canary which is removed from the desired traffic
+	existing := &knservingv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: predictorName, Namespace: isvc.Namespace},
+		Spec: knservingv1.ServiceSpec{
+			RouteSpec: knservingv1.RouteSpec{
+				Traffic: []knservingv1.TrafficTarget{
+					{LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+					{RevisionName: "sklearn-predictor-default-00001", Tag: "prev", Percent: proto.Int64(0)},
+				},
+			},
+		},
+		Status: knservingv1.ServiceStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{Type: knservingv1.ServiceConditionReady, Status: v1.ConditionTrue}},
+			},
+			ConfigurationStatusFields: knservingv1.ConfigurationStatusFields{
+				LatestReadyRevisionName:   "sklearn-predictor-default-00002",
+				LatestCreatedRevisionName: "sklearn-predictor-default-00002",
+			},
+			RouteStatusFields: knservingv1.RouteStatusFields{
+				URL: predictorURL,
+				Address: &duckv1.Addressable{URL: &apis.URL{
+					Scheme: "http",
+					Host:   predictorName + "." + isvc.Namespace + ".svc.cluster.local",
+				}},
+				Traffic: []knservingv1.TrafficTarget{
+					{RevisionName: "sklearn-predictor-default-00002", LatestRevision: proto.Bool(true), Percent: proto.Int64(100)},
+				},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(configMap, isvc, existing).Build()
+	r := &InferenceServiceReconciler{
+		Client:   cli,
+		Scheme:   s,
+		Log:      ctrl.Log.WithName("V1beta1InferenceServiceController"),
+		Recorder: record.NewFakeRecorder(10),
+		DryRun:   true,
+	}
+
+	result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: isvc.Name,
+		Namespace: isvc.Namespace}})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+	g.Expect(result.RequeueAfter).To(gomega.BeNumerically("<=", constants.DefaultCanaryDrainGracePeriod))
+
+	draining := &knservingv1.Service{}
+	g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: predictorName, Namespace: isvc.Namespace},
+		draining)).To(gomega.Succeed())
+	g.Expect(draining.Spec.Traffic).To(gomega.ContainElement(gomega.HaveField("Tag", "prev")))
+	g.Expect(draining.Annotations).To(gomega.HaveKey(constants.CanaryDrainStartInternalAnnotationKey))
+
+	// The ingress is reconciled without waiting for the drain to finish
+	virtualService := &v1alpha3.VirtualService{}
+	g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: isvc.Name, Namespace: isvc.Namespace},
+		virtualService)).To(gomega.Succeed())
+	actual := &v1beta1api.InferenceService{}
+	g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: isvc.Name, Namespace: isvc.Namespace},
+		actual)).To(gomega.Succeed())
+	g.Expect(actual.Status.URL).NotTo(gomega.BeNil())
+}
+
+func TestMinRequeueAfter(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		a, b     time.Duration
+		expected time.Duration
+	}{
+		"BothZero":    {a: 0, b: 0, expected: 0},
+		"FirstZero":   {a: 0, b: time.Minute, expected: time.Minute},
+		"SecondZero":  {a: time.Minute, b: 0, expected: time.Minute},
+		"SecondLower": {a: time.Minute, b: time.Second, expected: time.Second},
+		"FirstLower":  {a: time.Second, b: time.Minute, expected: time.Second},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g.Expect(minRequeueAfter(scenario.a, scenario.b)).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
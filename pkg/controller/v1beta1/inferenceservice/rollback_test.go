@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+	"testing"
+
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClearRollbackAnnotation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(v1beta1api.AddToScheme(s)).To(gomega.Succeed())
+	isvc := &v1beta1api.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "sklearn",
+			Namespace:   "default",
+			Annotations: map[string]string{constants.RollbackAnnotationKey: "true"},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(isvc).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &InferenceServiceReconciler{
+		Client:   cli,
+		Scheme:   s,
+		Log:      ctrl.Log.WithName("V1beta1InferenceServiceController"),
+		Recorder: recorder,
+	}
+	isvc.Status.SetRollbackRevision(v1beta1api.PredictorComponent, "sklearn-predictor-default-00001")
+
+	g.Expect(r.clearRollbackAnnotation(isvc, constants.Serverless)).To(gomega.Succeed())
+	g.Expect(isvc.Annotations).NotTo(gomega.HaveKey(constants.RollbackAnnotationKey))
+	actual := &v1beta1api.InferenceService{}
+	g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: isvc.Name, Namespace: isvc.Namespace},
+		actual)).To(gomega.Succeed())
+	g.Expect(actual.Annotations).NotTo(gomega.HaveKey(constants.RollbackAnnotationKey))
+	g.Expect(recorder.Events).To(gomega.Receive(gomega.Equal("Normal " + RollbackReason +
+		" InferenceService [sklearn] predictor is rolled back to revision sklearn-predictor-default-00001," +
+		" the revision serves the traffic until the spec changes")))
+
+	// nothing is recorded once the annotation is cleared
+	g.Expect(r.clearRollbackAnnotation(isvc, constants.Serverless)).To(gomega.Succeed())
+	g.Expect(recorder.Events).NotTo(gomega.Receive())
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	v1beta1api "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+func TestReconcileTritonPredictor(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	s := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1alpha1.AddToScheme(s)).To(gomega.Succeed())
+	g.Expect(v1beta1api.AddToScheme(s)).To(gomega.Succeed())
+
+	data, err := os.ReadFile("../../../../config/runtimes/" + constants.TritonServer + ".yaml")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	servingRuntime := &v1alpha1.ClusterServingRuntime{}
+	g.Expect(yaml.Unmarshal(data, servingRuntime)).To(gomega.Succeed())
+	runtimeArgs := []string{"tritonserver", "--model-store=/mnt/models", "--grpc-port=9000", "--http-port=8080",
+		"--allow-grpc=true", "--allow-http=true"}
+
+	scenarios := map[string]struct {
+		predictorExtension v1beta1api.PredictorExtensionSpec
+		expectedArgs       []string
+		expectedResources  v1.ResourceRequirements
+	}{
+		"ModelRepository": {
+			predictorExtension: v1beta1api.PredictorExtensionSpec{
+				StorageURI: proto.String("gs://models/triton"),
+			},
+			expectedArgs: runtimeArgs,
+			expectedResources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("2Gi")},
+				Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("2Gi")},
+			},
+		},
+		"ExplicitModelControlWithoutStorage": {
+			expectedArgs: append(append([]string{}, runtimeArgs...), "--model-control-mode=explicit"),
+			expectedResources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("2Gi")},
+				Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("2Gi")},
+			},
+		},
+		"GPURequestMatchesLimit": {
+			predictorExtension: v1beta1api.PredictorExtensionSpec{
+				StorageURI: proto.String("gs://models/triton"),
+				Container: v1.Container{
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("1")},
+					},
+				},
+			},
+			expectedArgs: runtimeArgs,
+			expectedResources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:                  resource.MustParse("1"),
+					v1.ResourceMemory:               resource.MustParse("2Gi"),
+					constants.NvidiaGPUResourceType: resource.MustParse("1"),
+				},
+				Limits: v1.ResourceList{
+					v1.ResourceCPU:                  resource.MustParse("1"),
+					v1.ResourceMemory:               resource.MustParse("2Gi"),
+					constants.NvidiaGPUResourceType: resource.MustParse("1"),
+				},
+			},
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			configMap := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: constants.InferenceServiceConfigMapName, Namespace: constants.KServeNamespace},
+				Data: map[string]string{
+					"ingress": `{
+						"ingressGateway": "knative-serving/knative-ingress-gateway",
+						"ingressService": "test-destination",
+						"ingressDomain": "example.com"
+					}`,
+				},
+			}
+			isvc := &v1beta1api.InferenceService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "triton",
+					Namespace:   "default",
+					UID:         "isvc-uid",
+					Annotations: map[string]string{constants.DeploymentMode: string(constants.RawDeployment)},
+				},
+				Spec: v1beta1api.InferenceServiceSpec{
+					Predictor: v1beta1api.PredictorSpec{
+						Triton: &v1beta1api.TritonSpec{PredictorExtensionSpec: scenario.predictorExtension},
+					},
+				},
+			}
+			// the defaulting webhook moves the triton predictor to a model spec before the isvc is reconciled
+			isvc.DefaultInferenceService(&v1beta1api.InferenceServicesConfig{},
+				&v1beta1api.DeployConfig{DefaultDeploymentMode: string(constants.RawDeployment)})
+			cli := fake.NewClientBuilder().WithScheme(s).WithObjects(configMap, servingRuntime.DeepCopy(), isvc).Build()
+			r := &InferenceServiceReconciler{
+				Client:   cli,
+				Scheme:   s,
+				Log:      ctrl.Log.WithName("V1beta1InferenceServiceController"),
+				Recorder: record.NewFakeRecorder(10),
+				DryRun:   true,
+			}
+
+			_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: isvc.Name,
+				Namespace: isvc.Namespace}})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			g.Expect(cli.Get(context.TODO(), types.NamespacedName{Name: constants.DefaultPredictorServiceName(isvc.Name),
+				Namespace: isvc.Namespace}, deployment)).To(gomega.Succeed())
+			container := deployment.Spec.Template.Spec.Containers[0]
+			g.Expect(container.Args).To(gomega.Equal(scenario.expectedArgs))
+			g.Expect(container.Resources).To(gomega.Equal(scenario.expectedResources))
+			// the server only reports ready once the models of the repository are loaded
+			g.Expect(container.ReadinessProbe).To(gomega.Equal(&v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					HTTPGet: &v1.HTTPGetAction{Path: constants.V2HealthReadyPath, Port: intstr.FromInt(8080)},
+				},
+			}))
+		})
+	}
+}
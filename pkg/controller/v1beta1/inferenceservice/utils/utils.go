@@ -20,11 +20,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"html/template"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
@@ -36,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -186,7 +190,7 @@ func ReplacePlaceholders(container *v1.Container, meta metav1.ObjectMeta) error
 }
 
 // UpdateImageTag Update image tag if GPU is enabled or runtime version is provided
-func UpdateImageTag(container *v1.Container, runtimeVersion *string, servingRuntime *string) {
+func UpdateImageTag(container *v1.Container, runtimeVersion *string, servingRuntime *string, gpuResourceName v1.ResourceName) {
 	image := container.Image
 	if runtimeVersion != nil {
 		re := regexp.MustCompile(`(:([\w.\-_]*))$`)
@@ -196,7 +200,7 @@ func UpdateImageTag(container *v1.Container, runtimeVersion *string, servingRunt
 			container.Image = re.ReplaceAllString(image, ":"+*runtimeVersion)
 		}
 	} else {
-		if utils.IsGPUEnabled(container.Resources) && len(strings.Split(image, ":")) > 0 {
+		if utils.IsGPUEnabled(container.Resources, gpuResourceName) && len(strings.Split(image, ":")) > 0 {
 			re := regexp.MustCompile(`(:([\w.\-_]*))$`)
 			if len(re.FindString(image)) > 0 {
 				// For TFServing/TorchServe the GPU image is tagged with suffix "-gpu", when the version is found in the tag
@@ -229,3 +233,215 @@ func sortPodsByCreatedTimestampDesc(pods *v1.PodList) {
 		return pods.Items[j].ObjectMeta.CreationTimestamp.Before(&pods.Items[i].ObjectMeta.CreationTimestamp)
 	})
 }
+
+// GetUserPortName returns the port name Knative expects for the given protocol, "h2c" for gRPC and "http1" otherwise.
+func GetUserPortName(protocol constants.InferenceServiceProtocol) string {
+	if protocol == constants.ProtocolGRPCV1 || protocol == constants.ProtocolGRPCV2 {
+		return constants.KnativeH2CPortName
+	}
+	return constants.KnativeHTTP1PortName
+}
+
+// SetUserPortName names the user port of the container so that traffic is routed with the right HTTP version.
+// An unnamed port is named after the protocol, while the user port name annotation overrides the port name regardless.
+func SetUserPortName(container *v1.Container, protocol constants.InferenceServiceProtocol, annotations map[string]string) {
+	if len(container.Ports) == 0 {
+		return
+	}
+	if portName, ok := annotations[constants.UserPortNameAnnotationKey]; ok && portName != "" {
+		container.Ports[0].Name = portName
+	} else if container.Ports[0].Name == "" {
+		container.Ports[0].Name = GetUserPortName(protocol)
+	}
+}
+
+// SetV2ReadinessProbe probes the server readiness endpoint of the open inference protocol when a predictor serving the
+// V2 REST protocol sets no readiness probe, the health check port is probed when it is set and the user port otherwise.
+func SetV2ReadinessProbe(container *v1.Container, protocol constants.InferenceServiceProtocol, healthCheckPort *int32) {
+	if protocol != constants.ProtocolV2 || container.ReadinessProbe != nil {
+		return
+	}
+	container.ReadinessProbe = httpGetProbe(container, constants.V2HealthReadyPath, healthCheckPort)
+}
+
+// SetModelReadinessProbe probes the model readiness endpoint of the protocol when the serving runtime opts into the
+// model readiness probe and the container sets no readiness probe, so that a replica only receives traffic once the
+// model is loaded rather than as soon as the server listens.
+func SetModelReadinessProbe(container *v1.Container, protocol constants.InferenceServiceProtocol, modelName string,
+	annotations map[string]string, healthCheckPort *int32) {
+	if enabled, _ := strconv.ParseBool(annotations[constants.ModelReadinessProbeAnnotationKey]); !enabled ||
+		container.ReadinessProbe != nil {
+		return
+	}
+	path := constants.ModelReadyPath(modelName, protocol)
+	if path == "" {
+		return
+	}
+	container.ReadinessProbe = httpGetProbe(container, path, healthCheckPort)
+}
+
+// httpGetProbe returns a probe of the path on the health check port when it is set and on the user port otherwise
+func httpGetProbe(container *v1.Container, path string, healthCheckPort *int32) *v1.Probe {
+	port, _ := strconv.Atoi(constants.InferenceServiceDefaultHttpPort)
+	if healthCheckPort != nil {
+		port = int(*healthCheckPort)
+	} else if len(container.Ports) != 0 {
+		port = int(container.Ports[0].ContainerPort)
+	}
+	return &v1.Probe{
+		ProbeHandler: v1.ProbeHandler{
+			HTTPGet: &v1.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromInt(port),
+			},
+		},
+	}
+}
+
+// startupProbePeriodSeconds is the period of the startup probe generated from the model load timeout
+const startupProbePeriodSeconds = 10
+
+// SetStartupProbe gives the container the model load timeout to start before it is restarted, the startup probe checks
+// the readiness probe endpoint or the user port when the container sets no readiness probe.
+func SetStartupProbe(container *v1.Container, modelLoadTimeoutSeconds int64) {
+	if container.StartupProbe != nil {
+		return
+	}
+	handler := v1.ProbeHandler{}
+	if container.ReadinessProbe != nil {
+		handler = *container.ReadinessProbe.ProbeHandler.DeepCopy()
+	} else {
+		port, _ := strconv.Atoi(constants.InferenceServiceDefaultHttpPort)
+		if len(container.Ports) != 0 {
+			port = int(container.Ports[0].ContainerPort)
+		}
+		handler.TCPSocket = &v1.TCPSocketAction{Port: intstr.FromInt(port)}
+	}
+	container.StartupProbe = &v1.Probe{
+		ProbeHandler:     handler,
+		PeriodSeconds:    startupProbePeriodSeconds,
+		FailureThreshold: int32((modelLoadTimeoutSeconds + startupProbePeriodSeconds - 1) / startupProbePeriodSeconds),
+	}
+}
+
+// SetHealthCheckPort points the readiness and liveness probes of the container which do not specify a port to the
+// health check port, an error is returned when the health check port is not declared in the container ports.
+func SetHealthCheckPort(container *v1.Container, healthCheckPort *int32) error {
+	if healthCheckPort == nil {
+		return nil
+	}
+	declared := false
+	for _, port := range container.Ports {
+		if port.ContainerPort == *healthCheckPort {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return fmt.Errorf("health check port %d is not declared in the ports of container %s", *healthCheckPort, container.Name)
+	}
+	for _, probe := range []*v1.Probe{container.ReadinessProbe, container.LivenessProbe} {
+		if probe == nil {
+			continue
+		}
+		if probe.HTTPGet != nil && probe.HTTPGet.Port == (intstr.IntOrString{}) {
+			probe.HTTPGet.Port = intstr.FromInt(int(*healthCheckPort))
+		}
+		if probe.TCPSocket != nil && probe.TCPSocket.Port == (intstr.IntOrString{}) {
+			probe.TCPSocket.Port = intstr.FromInt(int(*healthCheckPort))
+		}
+	}
+	return nil
+}
+
+// SetModelMountPath rewrites the default model mount path referenced by the container args and env to the
+// configured model mount path.
+func SetModelMountPath(container *v1.Container, mountPath string) {
+	if mountPath == constants.DefaultModelLocalMountPath {
+		return
+	}
+	for i, arg := range container.Args {
+		container.Args[i] = strings.ReplaceAll(arg, constants.DefaultModelLocalMountPath, mountPath)
+	}
+	for i, env := range container.Env {
+		container.Env[i].Value = strings.ReplaceAll(env.Value, constants.DefaultModelLocalMountPath, mountPath)
+	}
+}
+
+// SetGPUResourceRequirements sets the request of the GPU resource to its limit, or the limit to the request when
+// only the request is set, since extended resources like GPUs cannot be overcommitted.
+func SetGPUResourceRequirements(podSpec *v1.PodSpec, gpuResourceName v1.ResourceName) {
+	for i := range podSpec.Containers {
+		resources := &podSpec.Containers[i].Resources
+		if limit, ok := resources.Limits[gpuResourceName]; ok {
+			if resources.Requests == nil {
+				resources.Requests = v1.ResourceList{}
+			}
+			resources.Requests[gpuResourceName] = limit
+		} else if request, ok := resources.Requests[gpuResourceName]; ok {
+			if resources.Limits == nil {
+				resources.Limits = v1.ResourceList{}
+			}
+			resources.Limits[gpuResourceName] = request
+		}
+	}
+}
+
+// SetGPUResourceNameAnnotation records the GPU resource name of the component on the pod annotations for the pod
+// mutator, the nvidia GPU resource name is assumed when it is not recorded
+func SetGPUResourceNameAnnotation(annotations map[string]string, gpuResourceName v1.ResourceName) {
+	if gpuResourceName != constants.NvidiaGPUResourceType {
+		annotations[constants.GPUResourceNameInternalAnnotationKey] = string(gpuResourceName)
+	}
+}
+
+// ApplyScalingSchedule returns a copy of the component extension with the replica bounds of the first scaling window
+// active at the given time, the component extension is returned as is when no window is active.
+func ApplyScalingSchedule(componentExt *v1beta1api.ComponentExtensionSpec, now time.Time) *v1beta1api.ComponentExtensionSpec {
+	for i := range componentExt.ScalingSchedule {
+		window := &componentExt.ScalingSchedule[i]
+		start, _, err := window.Occurrence(now)
+		if err != nil || start.After(now) {
+			continue
+		}
+		scheduled := componentExt.DeepCopy()
+		if window.MinReplicas != nil {
+			minReplicas := *window.MinReplicas
+			scheduled.MinReplicas = &minReplicas
+		}
+		if window.MaxReplicas != nil {
+			scheduled.MaxReplicas = *window.MaxReplicas
+		}
+		// The bounds overridden by the window take precedence over the bounds of the component
+		if scheduled.MinReplicas != nil && scheduled.MaxReplicas != 0 && *scheduled.MinReplicas > scheduled.MaxReplicas {
+			if window.MaxReplicas != nil {
+				minReplicas := scheduled.MaxReplicas
+				scheduled.MinReplicas = &minReplicas
+			} else {
+				scheduled.MaxReplicas = *scheduled.MinReplicas
+			}
+		}
+		return scheduled
+	}
+	return componentExt
+}
+
+// NextScalingScheduleTransition returns the duration until a scaling window of the component starts or ends,
+// it is 0 when the component has no scaling schedule.
+func NextScalingScheduleTransition(componentExt *v1beta1api.ComponentExtensionSpec, now time.Time) time.Duration {
+	var next time.Duration
+	for i := range componentExt.ScalingSchedule {
+		start, end, err := componentExt.ScalingSchedule[i].Occurrence(now)
+		if err != nil {
+			continue
+		}
+		transition := start.Sub(now)
+		if transition <= 0 {
+			transition = end.Sub(now)
+		}
+		if next == 0 || transition < next {
+			next = transition
+		}
+	}
+	return next
+}
@@ -19,6 +19,7 @@ package utils
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -1043,11 +1045,12 @@ func TestUpdateImageTag(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	scenarios := map[string]struct {
-		container      *v1.Container
-		runtimeVersion *string
-		servingRuntime string
-		isvcConfig     *v1beta1.InferenceServicesConfig
-		expected       string
+		container       *v1.Container
+		runtimeVersion  *string
+		servingRuntime  string
+		gpuResourceName v1.ResourceName
+		isvcConfig      *v1beta1.InferenceServicesConfig
+		expected        string
 	}{
 		"UpdateRuntimeVersion": {
 			container: &v1.Container{
@@ -1100,6 +1103,21 @@ func TestUpdateImageTag(t *testing.T) {
 			servingRuntime: constants.TFServing,
 			expected:       "tfserving:1.14.0-gpu",
 		},
+		"UpdateGPUImageTagWithCustomGPUResource": {
+			container: &v1.Container{
+				Name:  "kserve-container",
+				Image: "tfserving:1.14.0",
+				Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{
+						"example.com/gpu-fraction": resource.MustParse("50"),
+					},
+				},
+			},
+			runtimeVersion:  nil,
+			servingRuntime:  constants.TFServing,
+			gpuResourceName: "example.com/gpu-fraction",
+			expected:        "tfserving:1.14.0-gpu",
+		},
 		"UpdateGPUImageTagWithProxy": {
 			container: &v1.Container{
 				Name:  "kserve-container",
@@ -1182,7 +1200,11 @@ func TestUpdateImageTag(t *testing.T) {
 	}
 	for name, scenario := range scenarios {
 		t.Run(name, func(t *testing.T) {
-			UpdateImageTag(scenario.container, scenario.runtimeVersion, &scenario.servingRuntime)
+			gpuResourceName := scenario.gpuResourceName
+			if gpuResourceName == "" {
+				gpuResourceName = constants.NvidiaGPUResourceType
+			}
+			UpdateImageTag(scenario.container, scenario.runtimeVersion, &scenario.servingRuntime, gpuResourceName)
 			if !g.Expect(scenario.container.Image).To(gomega.Equal(scenario.expected)) {
 				t.Errorf("got %v, want %v", scenario.container.Image, scenario.expected)
 			}
@@ -1236,3 +1258,542 @@ func TestGetDeploymentMode(t *testing.T) {
 		})
 	}
 }
+
+func TestSetUserPortName(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		container   *v1.Container
+		protocol    constants.InferenceServiceProtocol
+		annotations map[string]string
+		expected    []v1.ContainerPort
+	}{
+		"HTTPProtocol": {
+			container: &v1.Container{
+				Ports: []v1.ContainerPort{{ContainerPort: 8080, Protocol: v1.ProtocolTCP}},
+			},
+			protocol:    constants.ProtocolV1,
+			annotations: map[string]string{},
+			expected:    []v1.ContainerPort{{Name: "http1", ContainerPort: 8080, Protocol: v1.ProtocolTCP}},
+		},
+		"V2Protocol": {
+			container: &v1.Container{
+				Ports: []v1.ContainerPort{{ContainerPort: 8080, Protocol: v1.ProtocolTCP}},
+			},
+			protocol:    constants.ProtocolV2,
+			annotations: map[string]string{},
+			expected:    []v1.ContainerPort{{Name: "http1", ContainerPort: 8080, Protocol: v1.ProtocolTCP}},
+		},
+		"GRPCProtocol": {
+			container: &v1.Container{
+				Ports: []v1.ContainerPort{{ContainerPort: 9000, Protocol: v1.ProtocolTCP}},
+			},
+			protocol:    constants.ProtocolGRPCV2,
+			annotations: map[string]string{},
+			expected:    []v1.ContainerPort{{Name: "h2c", ContainerPort: 9000, Protocol: v1.ProtocolTCP}},
+		},
+		"KeepUserPortName": {
+			container: &v1.Container{
+				Ports: []v1.ContainerPort{{Name: "h2c", ContainerPort: 9000, Protocol: v1.ProtocolTCP}},
+			},
+			protocol:    constants.ProtocolV1,
+			annotations: map[string]string{},
+			expected:    []v1.ContainerPort{{Name: "h2c", ContainerPort: 9000, Protocol: v1.ProtocolTCP}},
+		},
+		"OverridePortName": {
+			container: &v1.Container{
+				Ports: []v1.ContainerPort{{Name: "h2c", ContainerPort: 9000, Protocol: v1.ProtocolTCP}},
+			},
+			protocol: constants.ProtocolGRPCV1,
+			annotations: map[string]string{
+				constants.UserPortNameAnnotationKey: "http2",
+			},
+			expected: []v1.ContainerPort{{Name: "http2", ContainerPort: 9000, Protocol: v1.ProtocolTCP}},
+		},
+		"NoPorts": {
+			container:   &v1.Container{},
+			protocol:    constants.ProtocolGRPCV2,
+			annotations: map[string]string{},
+			expected:    nil,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			SetUserPortName(scenario.container, scenario.protocol, scenario.annotations)
+			g.Expect(scenario.container.Ports).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestSetV2ReadinessProbe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	v2Probe := func(port int) *v1.Probe {
+		return &v1.Probe{
+			ProbeHandler: v1.ProbeHandler{
+				HTTPGet: &v1.HTTPGetAction{Path: "/v2/health/ready", Port: intstr.FromInt(port)},
+			},
+		}
+	}
+	ports := []v1.ContainerPort{
+		{Name: "http1", ContainerPort: 8085, Protocol: v1.ProtocolTCP},
+		{Name: "management", ContainerPort: 8086, Protocol: v1.ProtocolTCP},
+	}
+	scenarios := map[string]struct {
+		container       *v1.Container
+		protocol        constants.InferenceServiceProtocol
+		healthCheckPort *int32
+		expected        *v1.Probe
+	}{
+		"V1Protocol": {
+			container: &v1.Container{Ports: ports},
+			protocol:  constants.ProtocolV1,
+			expected:  nil,
+		},
+		"GRPCV2Protocol": {
+			container: &v1.Container{Ports: ports},
+			protocol:  constants.ProtocolGRPCV2,
+			expected:  nil,
+		},
+		"UserPort": {
+			container: &v1.Container{Ports: ports},
+			protocol:  constants.ProtocolV2,
+			expected:  v2Probe(8085),
+		},
+		"DefaultPort": {
+			container: &v1.Container{},
+			protocol:  constants.ProtocolV2,
+			expected:  v2Probe(8080),
+		},
+		"HealthCheckPort": {
+			container:       &v1.Container{Ports: ports},
+			protocol:        constants.ProtocolV2,
+			healthCheckPort: proto.Int32(8086),
+			expected:        v2Probe(8086),
+		},
+		"KeepReadinessProbe": {
+			container: &v1.Container{
+				Ports:          ports,
+				ReadinessProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}}},
+			},
+			protocol: constants.ProtocolV2,
+			expected: &v1.Probe{ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}}},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			SetV2ReadinessProbe(scenario.container, scenario.protocol, scenario.healthCheckPort)
+			g.Expect(scenario.container.ReadinessProbe).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestSetModelReadinessProbe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	httpGetProbe := func(path string, port int) *v1.Probe {
+		return &v1.Probe{
+			ProbeHandler: v1.ProbeHandler{
+				HTTPGet: &v1.HTTPGetAction{Path: path, Port: intstr.FromInt(port)},
+			},
+		}
+	}
+	enabled := map[string]string{constants.ModelReadinessProbeAnnotationKey: "true"}
+	scenarios := map[string]struct {
+		container       *v1.Container
+		protocol        constants.InferenceServiceProtocol
+		annotations     map[string]string
+		healthCheckPort *int32
+		expected        *v1.Probe
+	}{
+		"NotEnabled": {
+			container:   &v1.Container{},
+			protocol:    constants.ProtocolV1,
+			annotations: map[string]string{},
+			expected:    nil,
+		},
+		"Disabled": {
+			container:   &v1.Container{},
+			protocol:    constants.ProtocolV1,
+			annotations: map[string]string{constants.ModelReadinessProbeAnnotationKey: "false"},
+			expected:    nil,
+		},
+		"V1Protocol": {
+			container:   &v1.Container{},
+			protocol:    constants.ProtocolV1,
+			annotations: enabled,
+			expected:    httpGetProbe("/v1/models/sklearn-iris", 8080),
+		},
+		"V2Protocol": {
+			container:   &v1.Container{Ports: []v1.ContainerPort{{ContainerPort: 8085}}},
+			protocol:    constants.ProtocolV2,
+			annotations: enabled,
+			expected:    httpGetProbe("/v2/models/sklearn-iris/ready", 8085),
+		},
+		"GRPCV2Protocol": {
+			container:   &v1.Container{},
+			protocol:    constants.ProtocolGRPCV2,
+			annotations: enabled,
+			expected:    nil,
+		},
+		"HealthCheckPort": {
+			container:       &v1.Container{Ports: []v1.ContainerPort{{ContainerPort: 8085}, {ContainerPort: 8086}}},
+			protocol:        constants.ProtocolV1,
+			annotations:     enabled,
+			healthCheckPort: proto.Int32(8086),
+			expected:        httpGetProbe("/v1/models/sklearn-iris", 8086),
+		},
+		"KeepReadinessProbe": {
+			container: &v1.Container{
+				ReadinessProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}}},
+			},
+			protocol:    constants.ProtocolV1,
+			annotations: enabled,
+			expected:    &v1.Probe{ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}}},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			SetModelReadinessProbe(scenario.container, scenario.protocol, "sklearn-iris", scenario.annotations,
+				scenario.healthCheckPort)
+			g.Expect(scenario.container.ReadinessProbe).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestSetHealthCheckPort(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ports := []v1.ContainerPort{
+		{Name: "http1", ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+		{Name: "management", ContainerPort: 8081, Protocol: v1.ProtocolTCP},
+	}
+	scenarios := map[string]struct {
+		container       *v1.Container
+		healthCheckPort *int32
+		expected        *v1.Container
+		expectErr       bool
+	}{
+		"NoHealthCheckPort": {
+			container: &v1.Container{
+				Ports: ports,
+				ReadinessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/ready"}},
+				},
+			},
+			expected: &v1.Container{
+				Ports: ports,
+				ReadinessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/ready"}},
+				},
+			},
+		},
+		"ProbesTargetHealthCheckPort": {
+			container: &v1.Container{
+				Ports: ports,
+				ReadinessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/ready"}},
+				},
+				LivenessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}},
+				},
+			},
+			healthCheckPort: proto.Int32(8081),
+			expected: &v1.Container{
+				Ports: ports,
+				ReadinessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/ready", Port: intstr.FromInt(8081)}},
+				},
+				LivenessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(8081)}},
+				},
+			},
+		},
+		"KeepExplicitProbePort": {
+			container: &v1.Container{
+				Ports: ports,
+				LivenessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/live", Port: intstr.FromInt(8080)}},
+				},
+			},
+			healthCheckPort: proto.Int32(8081),
+			expected: &v1.Container{
+				Ports: ports,
+				LivenessProbe: &v1.Probe{
+					ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/live", Port: intstr.FromInt(8080)}},
+				},
+			},
+		},
+		"UndeclaredHealthCheckPort": {
+			container: &v1.Container{
+				Ports: ports,
+			},
+			healthCheckPort: proto.Int32(9090),
+			expectErr:       true,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			err := SetHealthCheckPort(scenario.container, scenario.healthCheckPort)
+			if scenario.expectErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(scenario.container).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestSetModelMountPath(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		container *v1.Container
+		mountPath string
+		expected  *v1.Container
+	}{
+		"RewriteArgsAndEnv": {
+			container: &v1.Container{
+				Args: []string{"--model_name=sklearn", "--model_dir=/mnt/models"},
+				Env:  []v1.EnvVar{{Name: "MODELS_DIR", Value: "/mnt/models/v1"}, {Name: "PORT", Value: "8080"}},
+			},
+			mountPath: "/models",
+			expected: &v1.Container{
+				Args: []string{"--model_name=sklearn", "--model_dir=/models"},
+				Env:  []v1.EnvVar{{Name: "MODELS_DIR", Value: "/models/v1"}, {Name: "PORT", Value: "8080"}},
+			},
+		},
+		"DefaultMountPath": {
+			container: &v1.Container{
+				Args: []string{"--model_dir=/mnt/models"},
+			},
+			mountPath: constants.DefaultModelLocalMountPath,
+			expected: &v1.Container{
+				Args: []string{"--model_dir=/mnt/models"},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			SetModelMountPath(scenario.container, scenario.mountPath)
+			g.Expect(scenario.container).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestSetGPUResourceRequirements(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	gpuFraction := v1.ResourceName("example.com/gpu-fraction")
+	scenarios := map[string]struct {
+		resources v1.ResourceRequirements
+		expected  v1.ResourceRequirements
+	}{
+		"RequestFromLimit": {
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{gpuFraction: resource.MustParse("50")},
+			},
+			expected: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{gpuFraction: resource.MustParse("50")},
+				Requests: v1.ResourceList{gpuFraction: resource.MustParse("50")},
+			},
+		},
+		"LimitFromRequest": {
+			resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{gpuFraction: resource.MustParse("25")},
+			},
+			expected: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{gpuFraction: resource.MustParse("25")},
+				Requests: v1.ResourceList{gpuFraction: resource.MustParse("25")},
+			},
+		},
+		"RequestOverriddenByLimit": {
+			resources: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{gpuFraction: resource.MustParse("50")},
+				Requests: v1.ResourceList{gpuFraction: resource.MustParse("25"), v1.ResourceCPU: resource.MustParse("1")},
+			},
+			expected: v1.ResourceRequirements{
+				Limits:   v1.ResourceList{gpuFraction: resource.MustParse("50")},
+				Requests: v1.ResourceList{gpuFraction: resource.MustParse("50"), v1.ResourceCPU: resource.MustParse("1")},
+			},
+		},
+		"OtherGPUResourceUntouched": {
+			resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("1")},
+			},
+			expected: v1.ResourceRequirements{
+				Limits: v1.ResourceList{constants.NvidiaGPUResourceType: resource.MustParse("1")},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			podSpec := &v1.PodSpec{Containers: []v1.Container{{Resources: scenario.resources}}}
+			SetGPUResourceRequirements(podSpec, gpuFraction)
+			g.Expect(podSpec.Containers[0].Resources).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestSetGPUResourceNameAnnotation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	annotations := map[string]string{}
+	SetGPUResourceNameAnnotation(annotations, constants.NvidiaGPUResourceType)
+	g.Expect(annotations).To(gomega.BeEmpty())
+
+	// the pod mutator reads the resource name of the fractional GPU device plugin from the pod annotations
+	SetGPUResourceNameAnnotation(annotations, "example.com/gpu-fraction")
+	g.Expect(annotations).To(gomega.HaveKeyWithValue(constants.GPUResourceNameInternalAnnotationKey, "example.com/gpu-fraction"))
+}
+
+func TestApplyScalingSchedule(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentExt := &v1beta1.ComponentExtensionSpec{
+		MinReplicas: v1beta1.GetIntReference(2),
+		MaxReplicas: 10,
+		ScalingSchedule: []v1beta1.ScalingWindow{
+			{
+				Start:       "08:00",
+				End:         "18:00",
+				Days:        []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+				MinReplicas: v1beta1.GetIntReference(6),
+			},
+			{
+				Start:       "22:00",
+				End:         "06:00",
+				MaxReplicas: v1beta1.GetIntReference(1),
+			},
+		},
+	}
+
+	scenarios := map[string]struct {
+		now                  time.Time
+		expectedMinReplicas  int
+		expectedMaxReplicas  int
+		expectedRequeueAfter time.Duration
+	}{
+		"BusinessHours": {
+			now:                  time.Date(2023, 3, 6, 9, 0, 0, 0, time.UTC),
+			expectedMinReplicas:  6,
+			expectedMaxReplicas:  10,
+			expectedRequeueAfter: 9 * time.Hour,
+		},
+		"Overnight": {
+			now:                  time.Date(2023, 3, 6, 23, 0, 0, 0, time.UTC),
+			expectedMinReplicas:  1,
+			expectedMaxReplicas:  1,
+			expectedRequeueAfter: 7 * time.Hour,
+		},
+		"OvernightFromThePreviousDay": {
+			now:                  time.Date(2023, 3, 6, 2, 0, 0, 0, time.UTC),
+			expectedMinReplicas:  1,
+			expectedMaxReplicas:  1,
+			expectedRequeueAfter: 4 * time.Hour,
+		},
+		"NoActiveWindow": {
+			now:                  time.Date(2023, 3, 7, 7, 0, 0, 0, time.UTC),
+			expectedMinReplicas:  2,
+			expectedMaxReplicas:  10,
+			expectedRequeueAfter: time.Hour,
+		},
+		"Weekend": {
+			now:                  time.Date(2023, 3, 11, 9, 0, 0, 0, time.UTC),
+			expectedMinReplicas:  2,
+			expectedMaxReplicas:  10,
+			expectedRequeueAfter: 13 * time.Hour,
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			scheduled := ApplyScalingSchedule(componentExt, scenario.now)
+			g.Expect(*scheduled.MinReplicas).To(gomega.Equal(scenario.expectedMinReplicas))
+			g.Expect(scheduled.MaxReplicas).To(gomega.Equal(scenario.expectedMaxReplicas))
+			g.Expect(NextScalingScheduleTransition(componentExt, scenario.now)).To(gomega.Equal(scenario.expectedRequeueAfter))
+		})
+	}
+	// The component extension of the InferenceService is never modified
+	g.Expect(*componentExt.MinReplicas).To(gomega.Equal(2))
+	g.Expect(componentExt.MaxReplicas).To(gomega.Equal(10))
+	g.Expect(NextScalingScheduleTransition(&v1beta1.ComponentExtensionSpec{}, time.Now())).To(gomega.BeZero())
+}
+
+func TestApplyScalingScheduleTimeZone(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	componentExt := &v1beta1.ComponentExtensionSpec{
+		ScalingSchedule: []v1beta1.ScalingWindow{
+			{
+				Start:       "08:00",
+				End:         "09:00",
+				TimeZone:    "America/New_York",
+				MinReplicas: v1beta1.GetIntReference(4),
+			},
+		},
+	}
+	// 08:30 in New York
+	scheduled := ApplyScalingSchedule(componentExt, time.Date(2023, 3, 6, 13, 30, 0, 0, time.UTC))
+	g.Expect(scheduled.MinReplicas).To(gomega.Equal(v1beta1.GetIntReference(4)))
+	scheduled = ApplyScalingSchedule(componentExt, time.Date(2023, 3, 6, 8, 30, 0, 0, time.UTC))
+	g.Expect(scheduled.MinReplicas).To(gomega.BeNil())
+}
+
+func TestSetStartupProbe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	readinessProbe := &v1.Probe{
+		ProbeHandler: v1.ProbeHandler{
+			HTTPGet: &v1.HTTPGetAction{Path: "/v1/models/sklearn-iris", Port: intstr.FromInt(8080)},
+		},
+	}
+	scenarios := map[string]struct {
+		container               *v1.Container
+		modelLoadTimeoutSeconds int64
+		expected                *v1.Probe
+	}{
+		"ReadinessProbe": {
+			container:               &v1.Container{ReadinessProbe: readinessProbe},
+			modelLoadTimeoutSeconds: 1800,
+			expected: &v1.Probe{
+				ProbeHandler:     readinessProbe.ProbeHandler,
+				PeriodSeconds:    10,
+				FailureThreshold: 180,
+			},
+		},
+		"UserPort": {
+			container:               &v1.Container{Ports: []v1.ContainerPort{{ContainerPort: 8085}}},
+			modelLoadTimeoutSeconds: 45,
+			expected: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(8085)},
+				},
+				PeriodSeconds:    10,
+				FailureThreshold: 5,
+			},
+		},
+		"DefaultPort": {
+			container:               &v1.Container{},
+			modelLoadTimeoutSeconds: 5,
+			expected: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(8080)},
+				},
+				PeriodSeconds:    10,
+				FailureThreshold: 1,
+			},
+		},
+		"KeepStartupProbe": {
+			container: &v1.Container{
+				StartupProbe: &v1.Probe{ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}}},
+			},
+			modelLoadTimeoutSeconds: 1800,
+			expected:                &v1.Probe{ProbeHandler: v1.ProbeHandler{TCPSocket: &v1.TCPSocketAction{}}},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			SetStartupProbe(scenario.container, scenario.modelLoadTimeoutSeconds)
+			g.Expect(scenario.container.StartupProbe).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
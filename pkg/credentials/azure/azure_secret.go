@@ -26,6 +26,7 @@ const (
 	AzureClientId         = "AZ_CLIENT_ID"
 	AzureClientSecret     = "AZ_CLIENT_SECRET"
 	AzureStorageAccessKey = "AZURE_STORAGE_ACCESS_KEY"
+	AzureStorageSasToken  = "AZURE_STORAGE_SAS_TOKEN"
 )
 
 func BuildSecretEnvs(secret *v1.Secret) []v1.EnvVar {
@@ -96,3 +97,21 @@ func BuildStorageAccessKeySecretEnv(secret *v1.Secret) []v1.EnvVar {
 
 	return envs
 }
+
+func BuildSasTokenSecretEnv(secret *v1.Secret) []v1.EnvVar {
+	envs := []v1.EnvVar{
+		{
+			Name: AzureStorageSasToken,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: secret.Name,
+					},
+					Key: AzureStorageSasToken,
+				},
+			},
+		},
+	}
+
+	return envs
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package gcs
 
 import (
+	"github.com/kserve/kserve/pkg/constants"
 	"k8s.io/api/core/v1"
 )
 
@@ -27,6 +28,11 @@ const (
 	GCSCredentialEnvKey          = "GOOGLE_APPLICATION_CREDENTIALS"
 )
 
+var (
+	// GCSCredentialFileAnnotation names the key of the secret which holds the service account JSON
+	GCSCredentialFileAnnotation = constants.KServeAPIGroupName + "/" + "gcs-credential-file"
+)
+
 type GCSConfig struct {
 	GCSCredentialFileName string `json:"gcsCredentialFileName,omitempty"`
 }
@@ -47,3 +53,18 @@ func BuildSecretVolume(secret *v1.Secret) (v1.Volume, v1.VolumeMount) {
 	}
 	return volume, volumeMount
 }
+
+// GetCredentialFileName returns the key of the secret which holds the service account JSON. A key named by the
+// gcs-credential-file annotation takes precedence over the default file name, an empty name is returned when the
+// secret does not hold GCS credentials.
+func GetCredentialFileName(secret *v1.Secret, defaultFileName string) string {
+	if fileName, ok := secret.Annotations[GCSCredentialFileAnnotation]; ok {
+		if _, ok := secret.Data[fileName]; ok {
+			return fileName
+		}
+	}
+	if _, ok := secret.Data[defaultFileName]; ok {
+		return defaultFileName
+	}
+	return ""
+}
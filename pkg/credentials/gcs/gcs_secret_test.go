@@ -66,3 +66,59 @@ func TestGcsSecret(t *testing.T) {
 		}
 	}
 }
+
+func TestGetCredentialFileName(t *testing.T) {
+	scenarios := map[string]struct {
+		secret   *v1.Secret
+		expected string
+	}{
+		"DefaultFileName": {
+			secret: &v1.Secret{
+				Data: map[string][]byte{
+					GCSCredentialFileName: {},
+				},
+			},
+			expected: GCSCredentialFileName,
+		},
+		"AnnotatedFileName": {
+			secret: &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						GCSCredentialFileAnnotation: "key.json",
+					},
+				},
+				Data: map[string][]byte{
+					"key.json": {},
+				},
+			},
+			expected: "key.json",
+		},
+		"AnnotatedFileNameMissing": {
+			secret: &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						GCSCredentialFileAnnotation: "key.json",
+					},
+				},
+				Data: map[string][]byte{
+					GCSCredentialFileName: {},
+				},
+			},
+			expected: GCSCredentialFileName,
+		},
+		"NoGCSCredentials": {
+			secret: &v1.Secret{
+				Data: map[string][]byte{
+					"AWS_SECRET_ACCESS_KEY": {},
+				},
+			},
+			expected: "",
+		},
+	}
+
+	for name, scenario := range scenarios {
+		if fileName := GetCredentialFileName(scenario.secret, GCSCredentialFileName); fileName != scenario.expected {
+			t.Errorf("Test %q unexpected file name, want %q got %q", name, scenario.expected, fileName)
+		}
+	}
+}
@@ -25,6 +25,7 @@ const (
 	HdfsRootPath      = "HDFS_ROOTPATH"
 	KerberosPrincipal = "KERBEROS_PRINCIPAL"
 	KerberosKeytab    = "KERBEROS_KEYTAB"
+	DelegationToken   = "DELEGATION_TOKEN"
 	TlsCert           = "TLS_CERT"
 	TlsKey            = "TLS_KEY"
 	TlsCa             = "TLS_CA"
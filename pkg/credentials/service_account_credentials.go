@@ -217,7 +217,7 @@ func (c *CredentialBuilder) CreateSecretVolumeAndEnv(namespace string, serviceAc
 			envs := s3.BuildSecretEnvs(secret, &c.config.S3)
 			// Merge envs here to override values possibly present from IAM Role annotations with values from secret annotations
 			container.Env = utils.MergeEnvs(container.Env, envs)
-		} else if _, ok := secret.Data[gcsCredentialFileName]; ok {
+		} else if fileName := gcs.GetCredentialFileName(secret, gcsCredentialFileName); fileName != "" {
 			log.Info("Setting secret volume for gcs", "GCSSecret", secret.Name)
 			volume, volumeMount := gcs.BuildSecretVolume(secret)
 			*volumes = utils.AppendVolumeIfNotExists(*volumes, volume)
@@ -226,7 +226,7 @@ func (c *CredentialBuilder) CreateSecretVolumeAndEnv(namespace string, serviceAc
 			container.Env = append(container.Env,
 				v1.EnvVar{
 					Name:  gcs.GCSCredentialEnvKey,
-					Value: gcs.GCSCredentialVolumeMountPath + gcsCredentialFileName,
+					Value: gcs.GCSCredentialVolumeMountPath + fileName,
 				})
 		} else if _, ok := secret.Data[azure.AzureClientSecret]; ok {
 			log.Info("Setting secret envs for azure", "AzureSecret", secret.Name)
@@ -236,6 +236,10 @@ func (c *CredentialBuilder) CreateSecretVolumeAndEnv(namespace string, serviceAc
 			log.Info("Setting secret envs with azure storage access key for azure", "AzureSecret", secret.Name)
 			envs := azure.BuildStorageAccessKeySecretEnv(secret)
 			container.Env = append(container.Env, envs...)
+		} else if _, ok := secret.Data[azure.AzureStorageSasToken]; ok {
+			log.Info("Setting secret envs with azure sas token for azure", "AzureSecret", secret.Name)
+			envs := azure.BuildSasTokenSecretEnv(secret)
+			container.Env = append(container.Env, envs...)
 		} else if _, ok := secret.Data[https.HTTPSHost]; ok {
 			log.Info("Setting secret volume from uri", "HTTP(S)Secret", secret.Name)
 			envs := https.BuildSecretEnvs(secret)
@@ -19,8 +19,8 @@ package logger
 import (
 	"bytes"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
-	"net/http/httptest"
 	"net/url"
 
 	"github.com/go-logr/logr"
@@ -34,8 +34,11 @@ import (
 type LoggerHandler struct {
 	log              logr.Logger
 	logUrl           *url.URL
+	sink             Sink
 	sourceUri        *url.URL
 	logMode          v1beta1.LoggerType
+	samplingPercent  int
+	redactor         *Redactor
 	inferenceService string
 	namespace        string
 	component        string
@@ -43,14 +46,19 @@ type LoggerHandler struct {
 	next             http.Handler
 }
 
-func New(logUrl *url.URL, sourceUri *url.URL, logMode v1beta1.LoggerType,
-	inferenceService string, namespace string, endpoint string, component string, next http.Handler) http.Handler {
+// New logs the payloads as cloud events to the log URL, or to the sink when it is set. The payloads are redacted
+// before they are logged when the redactor is set.
+func New(logUrl *url.URL, sink Sink, sourceUri *url.URL, logMode v1beta1.LoggerType, samplingPercent int,
+	redactor *Redactor, inferenceService string, namespace string, endpoint string, component string, next http.Handler) http.Handler {
 	logf.SetLogger(zap.New())
 	return &LoggerHandler{
 		log:              logf.Log.WithName("Logger"),
 		logUrl:           logUrl,
+		sink:             sink,
 		sourceUri:        sourceUri,
 		logMode:          logMode,
+		samplingPercent:  samplingPercent,
+		redactor:         redactor,
 		inferenceService: inferenceService,
 		namespace:        namespace,
 		component:        component,
@@ -59,8 +67,13 @@ func New(logUrl *url.URL, sourceUri *url.URL, logMode v1beta1.LoggerType,
 	}
 }
 
+// getOrCreateID returns the id of the inference, the id of the request set by the gateway is used when the caller did not
+// set the cloud event id
 func getOrCreateID(r *http.Request) string {
 	id := r.Header.Get(CloudEventsIdHeader)
+	if id == "" {
+		id = r.Header.Get(RequestIdHeader)
+	}
 	if id == "" {
 		id = guuid.New().String()
 	}
@@ -75,6 +88,10 @@ func (eh *LoggerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	if eh.samplingPercent < 100 && rand.Intn(100) >= eh.samplingPercent {
+		eh.next.ServeHTTP(w, r)
+		return
+	}
 	// Read Payload
 	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -84,12 +101,18 @@ func (eh *LoggerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Get or Create an ID
 	id := getOrCreateID(r)
+	// The id is passed on to the model and returned to the caller to correlate the logged request and response
+	r.Header.Set(CloudEventsIdHeader, id)
+	w.Header().Set(CloudEventsIdHeader, id)
 	contentType := r.Header.Get("Content-Type")
 	// log Request
 	if eh.logMode == v1beta1.LogAll || eh.logMode == v1beta1.LogRequest {
-		if err := QueueLogRequest(LogRequest{
+		if logged, err := eh.redact(body); err != nil {
+			eh.log.Error(err, "Failed to redact request, the request is not logged")
+		} else if err := QueueLogRequest(LogRequest{
 			Url:              eh.logUrl,
-			Bytes:            &body,
+			Sink:             eh.sink,
+			Bytes:            &logged,
 			ContentType:      contentType,
 			ReqType:          InferenceRequest,
 			Id:               id,
@@ -105,19 +128,19 @@ func (eh *LoggerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	// Proxy Request
 	r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
-	rr := httptest.NewRecorder()
+	rr := &responseRecorder{ResponseWriter: w}
 	eh.next.ServeHTTP(rr, r)
-	responseBody := rr.Body.Bytes()
-	contentType = rr.Header().Get("Content-Type")
-	if contentType != "" {
-		w.Header().Set("Content-Type", contentType)
-	}
+	responseBody := rr.body.Bytes()
+	contentType = w.Header().Get("Content-Type")
 	// log response if OK
-	if rr.Code == http.StatusOK {
+	if rr.statusCode() == http.StatusOK {
 		if eh.logMode == v1beta1.LogAll || eh.logMode == v1beta1.LogResponse {
-			if err := QueueLogRequest(LogRequest{
+			if logged, err := eh.redact(responseBody); err != nil {
+				eh.log.Error(err, "Failed to redact response, the response is not logged")
+			} else if err := QueueLogRequest(LogRequest{
 				Url:              eh.logUrl,
-				Bytes:            &responseBody,
+				Sink:             eh.sink,
+				Bytes:            &logged,
 				ContentType:      contentType,
 				ReqType:          InferenceResponse,
 				Id:               id,
@@ -131,13 +154,51 @@ func (eh *LoggerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 	} else {
-		eh.log.Info("Failed to proxy request", "status code", rr.Code)
+		eh.log.Info("Failed to proxy request", "status code", rr.statusCode())
 	}
+}
 
-	w.WriteHeader(rr.Code)
-	_, err = w.Write(rr.Body.Bytes())
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+// redact returns the payload to log, the payloads are logged as they are without a redactor
+func (eh *LoggerHandler) redact(payload []byte) ([]byte, error) {
+	if eh.redactor == nil {
+		return payload, nil
+	}
+	return eh.redactor.Redact(payload)
+}
+
+// responseRecorder passes the response through to the client while recording it for the logger, the flushes are
+// passed through as well so that the streamed responses reach the client as they are written
+type responseRecorder struct {
+	http.ResponseWriter
+	code int
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// statusCode returns the status code of the response, the response is OK when the handler wrote nothing
+func (r *responseRecorder) statusCode() int {
+	if r.code == 0 {
+		return http.StatusOK
 	}
+	return r.code
 }
@@ -23,6 +23,7 @@ import (
 	"net/http/httptest"
 	"net/http/httputil"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
@@ -78,7 +79,7 @@ func TestLogger(t *testing.T) {
 
 	StartDispatcher(5, logger)
 	httpProxy := httputil.NewSingleHostReverseProxy(targetUri)
-	oh := New(logSvcUrl, sourceUri, v1beta1.LogAll, "mymodel", "default", "default", "default", httpProxy)
+	oh := New(logSvcUrl, nil, sourceUri, v1beta1.LogAll, 100, nil, "mymodel", "default", "default", "default", httpProxy)
 
 	oh.ServeHTTP(w, r)
 
@@ -121,9 +122,126 @@ func TestBadResponse(t *testing.T) {
 
 	StartDispatcher(1, logger)
 	httpProxy := httputil.NewSingleHostReverseProxy(targetUri)
-	oh := New(logSvcUrl, sourceUri, v1beta1.LogAll, "mymodel", "default", "default", "default", httpProxy)
+	oh := New(logSvcUrl, nil, sourceUri, v1beta1.LogAll, 100, nil, "mymodel", "default", "default", "default", httpProxy)
 
 	oh.ServeHTTP(w, r)
 	g.Expect(w.Code).To(gomega.Equal(400))
 	g.Expect(w.Body.String()).To(gomega.Equal(predictorResponse))
 }
+
+func TestLoggerStreaming(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	predictorRequest := []byte(`{"prompt":"hello"}`)
+	predictorResponse := "data: {\"token\":\"hel\"}\n\ndata: {\"token\":\"lo\"}\n\n"
+
+	responseChan := make(chan string, 2)
+	logSvc := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		b, err := ioutil.ReadAll(req.Body)
+		g.Expect(err).To(gomega.BeNil())
+		responseChan <- string(b)
+	}))
+	defer logSvc.Close()
+
+	// The predictor flushes every event as soon as it is generated
+	predictor := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		for _, event := range strings.SplitAfter(predictorResponse, "\n\n")[:2] {
+			_, err := rw.Write([]byte(event))
+			g.Expect(err).To(gomega.BeNil())
+			rw.(http.Flusher).Flush()
+		}
+	}))
+	defer predictor.Close()
+
+	r := httptest.NewRequest("POST", "http://a", bytes.NewReader(predictorRequest))
+	w := httptest.NewRecorder()
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	logSvcUrl, err := url.Parse(logSvc.URL)
+	g.Expect(err).To(gomega.BeNil())
+	sourceUri, err := url.Parse("http://localhost:9081/")
+	g.Expect(err).To(gomega.BeNil())
+	targetUri, err := url.Parse(predictor.URL)
+	g.Expect(err).To(gomega.BeNil())
+
+	StartDispatcher(1, logger)
+	oh := New(logSvcUrl, nil, sourceUri, v1beta1.LogResponse, 100, nil, "mymodel", "default", "default", "default",
+		httputil.NewSingleHostReverseProxy(targetUri))
+	oh.ServeHTTP(w, r)
+
+	g.Expect(w.Flushed).To(gomega.BeTrue())
+	g.Expect(w.Header().Get("Content-Type")).To(gomega.Equal("text/event-stream"))
+	g.Expect(w.Body.String()).To(gomega.Equal(predictorResponse))
+	// The whole stream is logged once it completes
+	g.Eventually(responseChan).Should(gomega.Receive(gomega.Equal(predictorResponse)))
+}
+
+func TestLoggerCorrelation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	predictorRequest := []byte(`{"instances":[[0,0,0]]}`)
+	predictorResponse := []byte(`{"predictions":[1]}`)
+
+	idChan := make(chan string, 2)
+	logSvc := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		idChan <- req.Header.Get(CloudEventsIdHeader)
+	}))
+	defer logSvc.Close()
+
+	predictor := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		g.Expect(req.Header.Get(CloudEventsIdHeader)).To(gomega.Equal("inference-1"))
+		_, err := rw.Write(predictorResponse)
+		g.Expect(err).To(gomega.BeNil())
+	}))
+	defer predictor.Close()
+
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	logSvcUrl, err := url.Parse(logSvc.URL)
+	g.Expect(err).To(gomega.BeNil())
+	sourceUri, err := url.Parse("http://localhost:9081/")
+	g.Expect(err).To(gomega.BeNil())
+	targetUri, err := url.Parse(predictor.URL)
+	g.Expect(err).To(gomega.BeNil())
+
+	StartDispatcher(1, logger)
+	oh := New(logSvcUrl, nil, sourceUri, v1beta1.LogAll, 100, nil, "mymodel", "default", "default", "default",
+		httputil.NewSingleHostReverseProxy(targetUri))
+	r := httptest.NewRequest("POST", "http://a", bytes.NewReader(predictorRequest))
+	r.Header.Set(RequestIdHeader, "inference-1")
+	w := httptest.NewRecorder()
+	oh.ServeHTTP(w, r)
+
+	g.Expect(w.Body.Bytes()).To(gomega.Equal(predictorResponse))
+	g.Expect(w.Header().Get(CloudEventsIdHeader)).To(gomega.Equal("inference-1"))
+	// The request and the response are logged with the id of the inference
+	g.Eventually(idChan).Should(gomega.Receive(gomega.Equal("inference-1")))
+	g.Eventually(idChan).Should(gomega.Receive(gomega.Equal("inference-1")))
+}
+
+func TestLoggerSampling(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	predictorResponse := []byte(`{"predictions":[1]}`)
+	predictor := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write(predictorResponse)
+		g.Expect(err).To(gomega.BeNil())
+	}))
+	defer predictor.Close()
+
+	logSvcUrl, err := url.Parse("http://loggersvc")
+	g.Expect(err).To(gomega.BeNil())
+	sourceUri, err := url.Parse("http://localhost:9081/")
+	g.Expect(err).To(gomega.BeNil())
+	targetUri, err := url.Parse(predictor.URL)
+	g.Expect(err).To(gomega.BeNil())
+
+	// The requests which are not sampled are passed through without an id
+	oh := New(logSvcUrl, nil, sourceUri, v1beta1.LogAll, 0, nil, "mymodel", "default", "default", "default",
+		httputil.NewSingleHostReverseProxy(targetUri))
+	for i := 0; i < 10; i++ {
+		w := httptest.NewRecorder()
+		oh.ServeHTTP(w, httptest.NewRequest("POST", "http://a", strings.NewReader(`{"instances":[[0,0,0]]}`)))
+		g.Expect(w.Body.Bytes()).To(gomega.Equal(predictorResponse))
+		g.Expect(w.Header().Get(CloudEventsIdHeader)).To(gomega.BeEmpty())
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// The keys of the secret of the Kafka sink, they are the keys of the Knative Kafka broker secrets
+const (
+	KafkaSecretProtocolKey      = "protocol"
+	KafkaSecretSaslMechanismKey = "sasl.mechanism"
+	KafkaSecretUserKey          = "user"
+	KafkaSecretPasswordKey      = "password"
+	KafkaSecretCACertKey        = "ca.crt"
+	KafkaSecretUserCertKey      = "user.crt"
+	KafkaSecretUserKeyKey       = "user.key"
+)
+
+// The security protocols of the Kafka sink
+const (
+	KafkaProtocolPlaintext     = "PLAINTEXT"
+	KafkaProtocolSSL           = "SSL"
+	KafkaProtocolSaslPlaintext = "SASL_PLAINTEXT"
+	KafkaProtocolSaslSSL       = "SASL_SSL"
+)
+
+// Sink sends the logged payloads somewhere else than to the cloud event URL of the request
+type Sink interface {
+	Send(logReq LogRequest) error
+}
+
+// KafkaSink produces the logged payloads to a Kafka topic as binary cloud events, the payload is the value of the
+// message and the cloud event attributes are its headers. The messages are keyed by the id of the inference so that
+// the request and the response of an inference are produced to the same partition.
+type KafkaSink struct {
+	Producer sarama.SyncProducer
+	Topic    string
+}
+
+// NewKafkaSink connects to the brokers with the TLS and SASL configuration of the files of the secret directory, no
+// secret directory connects in plaintext
+func NewKafkaSink(brokers []string, topic string, secretDir string) (*KafkaSink, error) {
+	config, err := KafkaConfig(secretDir)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("while creating kafka producer: %w", err)
+	}
+	return &KafkaSink{Producer: producer, Topic: topic}, nil
+}
+
+func (k *KafkaSink) Send(logReq LogRequest) error {
+	eventType := CEInferenceResponse
+	if logReq.ReqType == InferenceRequest {
+		eventType = CEInferenceRequest
+	}
+	headers := []sarama.RecordHeader{
+		{Key: []byte("ce_specversion"), Value: []byte("1.0")},
+		{Key: []byte("ce_id"), Value: []byte(logReq.Id)},
+		{Key: []byte("ce_type"), Value: []byte(eventType)},
+		{Key: []byte("ce_source"), Value: []byte(logReq.SourceUri.String())},
+		{Key: []byte("ce_" + InferenceServiceAttr), Value: []byte(logReq.InferenceService)},
+		{Key: []byte("ce_" + NamespaceAttr), Value: []byte(logReq.Namespace)},
+		{Key: []byte("ce_" + ComponentAttr), Value: []byte(logReq.Component)},
+		{Key: []byte("ce_" + EndpointAttr), Value: []byte(logReq.Endpoint)},
+	}
+	if logReq.ContentType != "" {
+		headers = append(headers, sarama.RecordHeader{Key: []byte("content-type"), Value: []byte(logReq.ContentType)})
+	}
+	_, _, err := k.Producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   k.Topic,
+		Key:     sarama.StringEncoder(logReq.Id),
+		Value:   sarama.ByteEncoder(*logReq.Bytes),
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("while producing to kafka topic %s: %w", k.Topic, err)
+	}
+	return nil
+}
+
+// KafkaConfig returns the producer configuration of the secret directory
+func KafkaConfig(secretDir string) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	// The cloud event attributes are message headers which need kafka 0.11
+	config.Version = sarama.V2_0_0_0
+	config.Producer.RequiredAcks = sarama.WaitForLocal
+	config.Producer.Return.Successes = true
+	if secretDir == "" {
+		return config, nil
+	}
+
+	protocol := strings.TrimSpace(readSecretKey(secretDir, KafkaSecretProtocolKey))
+	switch protocol {
+	case "", KafkaProtocolPlaintext:
+	case KafkaProtocolSSL, KafkaProtocolSaslPlaintext, KafkaProtocolSaslSSL:
+	default:
+		return nil, fmt.Errorf("unsupported kafka protocol %s", protocol)
+	}
+	if protocol == KafkaProtocolSSL || protocol == KafkaProtocolSaslSSL {
+		tlsConfig, err := kafkaTLSConfig(secretDir)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	if protocol == KafkaProtocolSaslPlaintext || protocol == KafkaProtocolSaslSSL {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = readSecretKey(secretDir, KafkaSecretUserKey)
+		config.Net.SASL.Password = readSecretKey(secretDir, KafkaSecretPasswordKey)
+		mechanism := strings.TrimSpace(readSecretKey(secretDir, KafkaSecretSaslMechanismKey))
+		switch mechanism {
+		case "", sarama.SASLTypePlaintext:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case sarama.SASLTypeSCRAMSHA256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGenerator: scram.HashGeneratorFcn(sha256.New)}
+			}
+		case sarama.SASLTypeSCRAMSHA512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGenerator: scram.HashGeneratorFcn(sha512.New)}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported kafka sasl mechanism %s", mechanism)
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid kafka configuration: %w", err)
+	}
+	return config, nil
+}
+
+func kafkaTLSConfig(secretDir string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caCert := readSecretKey(secretDir, KafkaSecretCACertKey); caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, fmt.Errorf("the %s of the kafka secret is not a PEM certificate", KafkaSecretCACertKey)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	userCert := readSecretKey(secretDir, KafkaSecretUserCertKey)
+	userKey := readSecretKey(secretDir, KafkaSecretUserKeyKey)
+	if userCert != "" || userKey != "" {
+		certificate, err := tls.X509KeyPair([]byte(userCert), []byte(userKey))
+		if err != nil {
+			return nil, fmt.Errorf("while loading the kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// readSecretKey returns the value of the key of the mounted secret, the keys which are not set are empty
+func readSecretKey(secretDir string, key string) string {
+	value, err := ioutil.ReadFile(filepath.Join(secretDir, key))
+	if err != nil {
+		return ""
+	}
+	return string(value)
+}
+
+// scramClient implements the SCRAM authentication of sarama
+type scramClient struct {
+	*scram.ClientConversation
+	hashGenerator scram.HashGeneratorFcn
+}
+
+func (s *scramClient) Begin(userName, password, authzID string) error {
+	client, err := s.hashGenerator.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	s.ClientConversation = client.NewConversation()
+	return nil
+}
+
+func (s *scramClient) Step(challenge string) (string, error) {
+	return s.ClientConversation.Step(challenge)
+}
+
+func (s *scramClient) Done() bool {
+	return s.ClientConversation.Done()
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/onsi/gomega"
+	pkglogging "knative.dev/pkg/logging"
+)
+
+type fakeProducer struct {
+	messages chan *sarama.ProducerMessage
+}
+
+func (p *fakeProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	p.messages <- msg
+	return 0, 0, nil
+}
+
+func (p *fakeProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	for _, msg := range msgs {
+		p.messages <- msg
+	}
+	return nil
+}
+
+func (p *fakeProducer) Close() error {
+	return nil
+}
+
+func messageHeaders(msg *sarama.ProducerMessage) map[string]string {
+	headers := map[string]string{}
+	for _, header := range msg.Headers {
+		headers[string(header.Key)] = string(header.Value)
+	}
+	return headers
+}
+
+func TestKafkaSink(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	predictorRequest := []byte(`{"instances":[[0,0,0]]}`)
+	predictorResponse := []byte(`{"predictions":[1]}`)
+	predictor := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := rw.Write(predictorResponse)
+		g.Expect(err).To(gomega.BeNil())
+	}))
+	defer predictor.Close()
+
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	sourceUri, err := url.Parse("http://localhost:9081/")
+	g.Expect(err).To(gomega.BeNil())
+	targetUri, err := url.Parse(predictor.URL)
+	g.Expect(err).To(gomega.BeNil())
+	producer := &fakeProducer{messages: make(chan *sarama.ProducerMessage, 2)}
+
+	StartDispatcher(1, logger)
+	oh := New(&url.URL{}, &KafkaSink{Producer: producer, Topic: "payloads"}, sourceUri, v1beta1.LogAll, 100,
+		nil, "mymodel", "default", "default", "predictor", httputil.NewSingleHostReverseProxy(targetUri))
+	r := httptest.NewRequest("POST", "http://a", bytes.NewReader(predictorRequest))
+	r.Header.Set(CloudEventsIdHeader, "inference-1")
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	oh.ServeHTTP(w, r)
+	g.Expect(w.Body.Bytes()).To(gomega.Equal(predictorResponse))
+
+	expected := map[string][]byte{
+		CEInferenceRequest:  predictorRequest,
+		CEInferenceResponse: predictorResponse,
+	}
+	for i := 0; i < 2; i++ {
+		var msg *sarama.ProducerMessage
+		g.Eventually(producer.messages).Should(gomega.Receive(&msg))
+		g.Expect(msg.Topic).To(gomega.Equal("payloads"))
+		g.Expect(msg.Key).To(gomega.Equal(sarama.StringEncoder("inference-1")))
+		headers := messageHeaders(msg)
+		g.Expect(headers).To(gomega.HaveKeyWithValue("ce_id", "inference-1"))
+		g.Expect(headers).To(gomega.HaveKeyWithValue("ce_source", "http://localhost:9081/"))
+		g.Expect(headers).To(gomega.HaveKeyWithValue("ce_"+InferenceServiceAttr, "mymodel"))
+		g.Expect(headers).To(gomega.HaveKeyWithValue("ce_"+ComponentAttr, "predictor"))
+		g.Expect(headers).To(gomega.HaveKeyWithValue("content-type", "application/json"))
+		g.Expect(expected).To(gomega.HaveKey(headers["ce_type"]))
+		g.Expect(msg.Value).To(gomega.Equal(sarama.ByteEncoder(expected[headers["ce_type"]])))
+		delete(expected, headers["ce_type"])
+	}
+}
+
+func TestKafkaConfig(t *testing.T) {
+	scenarios := map[string]struct {
+		secret      map[string]string
+		expectedErr bool
+		check       func(g *gomega.WithT, config *sarama.Config)
+	}{
+		"NoSecret": {
+			check: func(g *gomega.WithT, config *sarama.Config) {
+				g.Expect(config.Net.TLS.Enable).To(gomega.BeFalse())
+				g.Expect(config.Net.SASL.Enable).To(gomega.BeFalse())
+			},
+		},
+		"SaslScram": {
+			secret: map[string]string{
+				KafkaSecretProtocolKey:      KafkaProtocolSaslSSL,
+				KafkaSecretSaslMechanismKey: sarama.SASLTypeSCRAMSHA512,
+				KafkaSecretUserKey:          "user",
+				KafkaSecretPasswordKey:      "password",
+			},
+			check: func(g *gomega.WithT, config *sarama.Config) {
+				g.Expect(config.Net.TLS.Enable).To(gomega.BeTrue())
+				g.Expect(config.Net.SASL.Enable).To(gomega.BeTrue())
+				g.Expect(config.Net.SASL.User).To(gomega.Equal("user"))
+				g.Expect(string(config.Net.SASL.Mechanism)).To(gomega.Equal(sarama.SASLTypeSCRAMSHA512))
+				g.Expect(config.Net.SASL.SCRAMClientGeneratorFunc).NotTo(gomega.BeNil())
+			},
+		},
+		"SaslPlain": {
+			secret: map[string]string{
+				KafkaSecretProtocolKey: KafkaProtocolSaslPlaintext,
+				KafkaSecretUserKey:     "user",
+				KafkaSecretPasswordKey: "password",
+			},
+			check: func(g *gomega.WithT, config *sarama.Config) {
+				g.Expect(config.Net.TLS.Enable).To(gomega.BeFalse())
+				g.Expect(string(config.Net.SASL.Mechanism)).To(gomega.Equal(sarama.SASLTypePlaintext))
+			},
+		},
+		"InvalidCACert": {
+			secret: map[string]string{
+				KafkaSecretProtocolKey: KafkaProtocolSSL,
+				KafkaSecretCACertKey:   "not a certificate",
+			},
+			expectedErr: true,
+		},
+		"UnsupportedProtocol": {
+			secret:      map[string]string{KafkaSecretProtocolKey: "QUIC"},
+			expectedErr: true,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			secretDir := ""
+			if scenario.secret != nil {
+				secretDir = t.TempDir()
+				for key, value := range scenario.secret {
+					g.Expect(ioutil.WriteFile(filepath.Join(secretDir, key), []byte(value), os.ModePerm)).To(gomega.Succeed())
+				}
+			}
+			config, err := KafkaConfig(secretDir)
+			if scenario.expectedErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			scenario.check(g, config)
+		})
+	}
+}
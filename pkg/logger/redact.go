@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// RedactedValue replaces the values of the redacted fields
+const RedactedValue = "[REDACTED]"
+
+// wildcard matches every element of an array or every field of an object
+const wildcard = "*"
+
+var pathTokenRegex = regexp.MustCompile(`^(?:\.([^.\[\]]+)|\[(\*|\d+)\])`)
+
+// Redactor masks the fields of the logged JSON payloads which match any of its paths
+type Redactor struct {
+	paths [][]string
+}
+
+// NewRedactor parses the JSON paths of the fields to mask, e.g. $.instances[*].email or $.inputs[0].data. A path
+// consists of field names, array indexes and * wildcards.
+func NewRedactor(paths []string) (*Redactor, error) {
+	redactor := &Redactor{}
+	for _, path := range paths {
+		tokens, err := parsePath(path)
+		if err != nil {
+			return nil, err
+		}
+		redactor.paths = append(redactor.paths, tokens)
+	}
+	return redactor, nil
+}
+
+func parsePath(path string) ([]string, error) {
+	if len(path) < 2 || path[0] != '$' {
+		return nil, fmt.Errorf("invalid redaction path %q, paths start with $", path)
+	}
+	tokens := []string{}
+	rest := path[1:]
+	for rest != "" {
+		match := pathTokenRegex.FindStringSubmatch(rest)
+		if match == nil {
+			return nil, fmt.Errorf("invalid redaction path %q at %q", path, rest)
+		}
+		if match[1] != "" {
+			tokens = append(tokens, match[1])
+		} else {
+			tokens = append(tokens, match[2])
+		}
+		rest = rest[len(match[0]):]
+	}
+	return tokens, nil
+}
+
+// Redact returns the payload with the values of the matching fields replaced, the payloads which are not JSON cannot
+// be redacted and return an error so that they are not logged
+func (r *Redactor) Redact(payload []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	// The numbers of the fields which are not redacted are logged as they were sent
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("the payload cannot be redacted: %w", err)
+	}
+	for _, path := range r.paths {
+		value = redact(value, path)
+	}
+	return json.Marshal(value)
+}
+
+func redact(value interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return RedactedValue
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if path[0] == wildcard || path[0] == key {
+				v[key] = redact(field, path[1:])
+			}
+		}
+	case []interface{}:
+		if path[0] == wildcard {
+			for i := range v {
+				v[i] = redact(v[i], path[1:])
+			}
+		} else if i, err := strconv.Atoi(path[0]); err == nil && i < len(v) {
+			v[i] = redact(v[i], path[1:])
+		}
+	}
+	return value
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestRedact(t *testing.T) {
+	scenarios := map[string]struct {
+		paths    []string
+		payload  string
+		expected string
+	}{
+		"Field": {
+			paths:    []string{"$.email"},
+			payload:  `{"email": "jane@example.com", "age": 42}`,
+			expected: `{"age":42,"email":"[REDACTED]"}`,
+		},
+		"ArrayWildcard": {
+			paths:    []string{"$.instances[*].email"},
+			payload:  `{"instances": [{"email": "a@example.com", "score": 0.5}, {"email": "b@example.com", "score": 1e3}]}`,
+			expected: `{"instances":[{"email":"[REDACTED]","score":0.5},{"email":"[REDACTED]","score":1e3}]}`,
+		},
+		"ArrayIndex": {
+			paths:    []string{"$.instances[*][1]"},
+			payload:  `{"instances": [[1, 2, 3], [4, 5, 6]]}`,
+			expected: `{"instances":[[1,"[REDACTED]",3],[4,"[REDACTED]",6]]}`,
+		},
+		"FieldWildcard": {
+			paths:    []string{"$.inputs[0].*"},
+			payload:  `{"inputs": [{"name": "ssn", "data": ["123-45-6789"]}]}`,
+			expected: `{"inputs":[{"data":"[REDACTED]","name":"[REDACTED]"}]}`,
+		},
+		"MissingField": {
+			paths:    []string{"$.instances[*].email", "$.instances[7]"},
+			payload:  `{"instances": [[1, 2]]}`,
+			expected: `{"instances":[[1,2]]}`,
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			redactor, err := NewRedactor(scenario.paths)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			redacted, err := redactor.Redact([]byte(scenario.payload))
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(string(redacted)).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
+func TestRedactInvalid(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	for _, path := range []string{"email", "$", "$.instances[a]", "$..email"} {
+		_, err := NewRedactor([]string{path})
+		g.Expect(err).To(gomega.HaveOccurred(), path)
+	}
+	redactor, err := NewRedactor([]string{"$.email"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	// The payloads which are not JSON are not logged as they cannot be redacted
+	_, err = redactor.Redact([]byte("data: {\"email\": \"jane@example.com\"}\n\n"))
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	guuid "github.com/google/uuid"
+	"github.com/kserve/kserve/pkg/agent/storage"
+	"go.uber.org/zap"
+)
+
+// StorageSink writes the logged payloads to a bucket as JSON lines of structured cloud events. The events are buffered
+// and written in batches, one object per namespace, InferenceService and date partition, so that they can be queried
+// as a partitioned table.
+type StorageSink struct {
+	StorageUri string
+	BatchSize  int
+	Upload     func(ctx context.Context, uri string, body io.Reader) error
+
+	now     func() time.Time
+	mu      sync.Mutex
+	batches map[string]*bytes.Buffer
+	events  int
+}
+
+// storageEvent is the JSON format of the cloud events, the JSON payloads are embedded as is and the other payloads
+// are base64 encoded
+type storageEvent struct {
+	SpecVersion      string          `json:"specversion"`
+	Id               string          `json:"id"`
+	Type             string          `json:"type"`
+	Source           string          `json:"source"`
+	Time             string          `json:"time"`
+	DataContentType  string          `json:"datacontenttype,omitempty"`
+	InferenceService string          `json:"inferenceservicename"`
+	Namespace        string          `json:"namespace"`
+	Component        string          `json:"component"`
+	Endpoint         string          `json:"endpoint"`
+	Data             json.RawMessage `json:"data,omitempty"`
+	DataBase64       []byte          `json:"data_base64,omitempty"`
+}
+
+// NewStorageSink writes the batches to the s3:// or gs:// storage URI with the credentials of the environment
+func NewStorageSink(storageUri string, batchSize int) (*StorageSink, error) {
+	var protocol storage.Protocol
+	switch {
+	case strings.HasPrefix(storageUri, string(storage.S3)):
+		protocol = storage.S3
+	case strings.HasPrefix(storageUri, string(storage.GCS)):
+		protocol = storage.GCS
+	default:
+		return nil, fmt.Errorf("unsupported storage URI %s, supported protocols are [%s %s]", storageUri, storage.S3, storage.GCS)
+	}
+	// The provider is created upfront as the batches are uploaded concurrently
+	providers := map[storage.Protocol]storage.Provider{}
+	if _, err := storage.GetProvider(providers, protocol); err != nil {
+		return nil, fmt.Errorf("while creating storage client: %w", err)
+	}
+	return &StorageSink{
+		StorageUri: storageUri,
+		BatchSize:  batchSize,
+		Upload: func(ctx context.Context, uri string, body io.Reader) error {
+			return storage.Upload(ctx, providers, uri, body)
+		},
+	}, nil
+}
+
+func (s *StorageSink) Send(logReq LogRequest) error {
+	now := time.Now().UTC()
+	if s.now != nil {
+		now = s.now()
+	}
+	event := storageEvent{
+		SpecVersion:      "1.0",
+		Id:               logReq.Id,
+		Type:             CEInferenceResponse,
+		Source:           logReq.SourceUri.String(),
+		Time:             now.Format(time.RFC3339Nano),
+		DataContentType:  logReq.ContentType,
+		InferenceService: logReq.InferenceService,
+		Namespace:        logReq.Namespace,
+		Component:        logReq.Component,
+		Endpoint:         logReq.Endpoint,
+	}
+	if logReq.ReqType == InferenceRequest {
+		event.Type = CEInferenceRequest
+	}
+	if json.Valid(*logReq.Bytes) {
+		event.Data = *logReq.Bytes
+	} else {
+		event.DataBase64 = *logReq.Bytes
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("while encoding log event: %w", err)
+	}
+	partition := fmt.Sprintf("namespace=%s/inferenceservice=%s/date=%s", logReq.Namespace, logReq.InferenceService,
+		now.Format("2006-01-02"))
+
+	s.mu.Lock()
+	if s.batches == nil {
+		s.batches = map[string]*bytes.Buffer{}
+	}
+	batch, ok := s.batches[partition]
+	if !ok {
+		batch = &bytes.Buffer{}
+		s.batches[partition] = batch
+	}
+	batch.Write(line)
+	batch.WriteByte('\n')
+	s.events++
+	var full map[string]*bytes.Buffer
+	if s.events >= s.BatchSize {
+		full = s.takeBatches()
+	}
+	s.mu.Unlock()
+	return s.upload(context.Background(), full)
+}
+
+// Flush writes the buffered events
+func (s *StorageSink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	batches := s.takeBatches()
+	s.mu.Unlock()
+	return s.upload(ctx, batches)
+}
+
+// Run flushes the buffered events every interval until the context is done
+func (s *StorageSink) Run(ctx context.Context, interval time.Duration, logger *zap.SugaredLogger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Flush(ctx); err != nil {
+				logger.Errorw("Failed to flush log events to storage", zap.Error(err))
+			}
+		}
+	}
+}
+
+// takeBatches must be called with the lock held
+func (s *StorageSink) takeBatches() map[string]*bytes.Buffer {
+	batches := s.batches
+	s.batches = nil
+	s.events = 0
+	return batches
+}
+
+func (s *StorageSink) upload(ctx context.Context, batches map[string]*bytes.Buffer) error {
+	var errs []string
+	for partition, batch := range batches {
+		uri := fmt.Sprintf("%s/%s/part-%s.jsonl", strings.TrimSuffix(s.StorageUri, "/"), partition, guuid.New().String())
+		if err := s.Upload(ctx, uri, batch); err != nil {
+			errs = append(errs, fmt.Sprintf("while writing %s: %v", uri, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logger
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func (b *fakeBucket) upload(ctx context.Context, uri string, body io.Reader) error {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.objects[uri] = string(data)
+	return nil
+}
+
+// partitions returns the objects keyed by the URI without the object name
+func (b *fakeBucket) partitions() map[string]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	partitions := map[string]string{}
+	for uri, data := range b.objects {
+		partitions[uri[:strings.LastIndex(uri, "/")]] = data
+	}
+	return partitions
+}
+
+func TestStorageSink(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	bucket := &fakeBucket{objects: map[string]string{}}
+	sink := &StorageSink{
+		StorageUri: "s3://audit/payloads/",
+		BatchSize:  3,
+		Upload:     bucket.upload,
+		now: func() time.Time {
+			return time.Date(2022, 10, 17, 8, 30, 0, 0, time.UTC)
+		},
+	}
+	sourceUri, err := url.Parse("http://localhost:9081/")
+	g.Expect(err).To(gomega.BeNil())
+	send := func(isvc string, reqType LogRequestType, payload string) {
+		data := []byte(payload)
+		g.Expect(sink.Send(LogRequest{
+			Bytes:            &data,
+			ContentType:      "application/json",
+			ReqType:          reqType,
+			Id:               "inference-1",
+			SourceUri:        sourceUri,
+			InferenceService: isvc,
+			Namespace:        "default",
+			Component:        "predictor",
+			Endpoint:         "default",
+		})).To(gomega.Succeed())
+	}
+
+	// The JSON payloads are compacted to a single line
+	send("sklearn", InferenceRequest, "{\n  \"instances\": [[1, 2]]\n}")
+	send("sklearn", InferenceResponse, `{"predictions": [1]}`)
+	g.Expect(bucket.objects).To(gomega.BeEmpty())
+	// The batch is written once it holds batch size events, to an object per partition
+	send("xgboost", InferenceRequest, "not json")
+	g.Expect(bucket.partitions()).To(gomega.Equal(map[string]string{
+		"s3://audit/payloads/namespace=default/inferenceservice=sklearn/date=2022-10-17": `{"specversion":"1.0","id":"inference-1","type":"org.kubeflow.serving.inference.request","source":"http://localhost:9081/","time":"2022-10-17T08:30:00Z","datacontenttype":"application/json","inferenceservicename":"sklearn","namespace":"default","component":"predictor","endpoint":"default","data":{"instances":[[1,2]]}}` + "\n" +
+			`{"specversion":"1.0","id":"inference-1","type":"org.kubeflow.serving.inference.response","source":"http://localhost:9081/","time":"2022-10-17T08:30:00Z","datacontenttype":"application/json","inferenceservicename":"sklearn","namespace":"default","component":"predictor","endpoint":"default","data":{"predictions":[1]}}` + "\n",
+		"s3://audit/payloads/namespace=default/inferenceservice=xgboost/date=2022-10-17": `{"specversion":"1.0","id":"inference-1","type":"org.kubeflow.serving.inference.request","source":"http://localhost:9081/","time":"2022-10-17T08:30:00Z","datacontenttype":"application/json","inferenceservicename":"xgboost","namespace":"default","component":"predictor","endpoint":"default","data_base64":"bm90IGpzb24="}` + "\n",
+	}))
+
+	// The events buffered below the batch size are written on flush
+	bucket.objects = map[string]string{}
+	send("sklearn", InferenceRequest, `{"instances": [[3, 4]]}`)
+	g.Expect(sink.Flush(context.Background())).To(gomega.Succeed())
+	g.Expect(bucket.objects).To(gomega.HaveLen(1))
+	g.Expect(sink.Flush(context.Background())).To(gomega.Succeed())
+	g.Expect(bucket.objects).To(gomega.HaveLen(1))
+}
+
+func TestNewStorageSinkUnsupportedUri(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	_, err := NewStorageSink("https://audit/payloads", 10)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
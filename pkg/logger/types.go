@@ -29,6 +29,7 @@ const (
 
 type LogRequest struct {
 	Url              *url.URL
+	Sink             Sink
 	Bytes            *[]byte
 	ContentType      string
 	ReqType          LogRequestType
@@ -40,6 +40,7 @@ const (
 
 	LoggerWorkerQueueSize = 100
 	CloudEventsIdHeader   = "Ce-Id"
+	RequestIdHeader       = "X-Request-Id"
 )
 
 // A buffered channel that we can send work requests on.
@@ -135,7 +136,11 @@ func (w *Worker) Start() {
 				// Receive a work request.
 				w.Log.Infof("Received work request %d, url: %s, requestId: %s", w.ID, work.Url.String(), work.Id)
 
-				if err := w.sendCloudEvent(work); err != nil {
+				if work.Sink != nil {
+					if err := work.Sink.Send(work); err != nil {
+						w.Log.Error(err, "Failed to send log request to sink")
+					}
+				} else if err := w.sendCloudEvent(work); err != nil {
 					w.Log.Error(err, "Failed to send cloud event, url: %s", work.Url.String())
 				}
 
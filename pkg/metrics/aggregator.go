@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.uber.org/zap"
+)
+
+// scrapeTimeout bounds the scrape of the component so that the agent metrics are served when the component hangs
+const scrapeTimeout = 5 * time.Second
+
+// Aggregator serves the metrics of the agent together with the metrics scraped from the component container, so that
+// a single port of the pod is scraped when there is no queue-proxy to aggregate them, e.g. in RawDeployment mode.
+// The component metrics named as an agent metric, e.g. the process metrics, are dropped as they would conflict.
+type Aggregator struct {
+	log          *zap.SugaredLogger
+	gatherer     prometheus.Gatherer
+	componentUrl string
+	client       *http.Client
+}
+
+func NewAggregator(gatherer prometheus.Gatherer, componentUrl string, log *zap.SugaredLogger) *Aggregator {
+	return &Aggregator{
+		log:          log,
+		gatherer:     gatherer,
+		componentUrl: componentUrl,
+		client:       &http.Client{Timeout: scrapeTimeout},
+	}
+}
+
+func (a *Aggregator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	families, err := a.gatherer.Gather()
+	if err != nil {
+		a.log.Errorw("Failed to gather the agent metrics", zap.Error(err))
+	}
+	names := map[string]bool{}
+	for _, family := range families {
+		names[family.GetName()] = true
+	}
+	// The agent metrics are still served when the component is not ready to be scraped
+	componentFamilies, err := a.scrape(r.Context())
+	if err != nil {
+		a.log.Warnw("Failed to scrape the component metrics", zap.Error(err))
+	}
+	for name, family := range componentFamilies {
+		if !names[name] {
+			families = append(families, family)
+		}
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].GetName() < families[j].GetName()
+	})
+
+	// The exemplars are only served in the OpenMetrics format
+	format := expfmt.NegotiateIncludingOpenMetrics(r.Header)
+	w.Header().Set("Content-Type", string(format))
+	encoder := expfmt.NewEncoder(w, format)
+	for _, family := range families {
+		if err := encoder.Encode(family); err != nil {
+			a.log.Errorw("Failed to encode the metrics", zap.Error(err))
+			return
+		}
+	}
+	if closer, ok := encoder.(expfmt.Closer); ok {
+		if err := closer.Close(); err != nil {
+			a.log.Errorw("Failed to encode the metrics", zap.Error(err))
+		}
+	}
+}
+
+func (a *Aggregator) scrape(ctx context.Context) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.componentUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Only the text format is parsed
+	req.Header.Set("Accept", string(expfmt.FmtText))
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s responded with status %d", a.componentUrl, resp.StatusCode)
+	}
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
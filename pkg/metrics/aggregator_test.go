@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	pkglogging "knative.dev/pkg/logging"
+)
+
+func TestAggregator(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	logger, _ := pkglogging.NewLogger("", "INFO")
+
+	registry := prometheus.NewRegistry()
+	queueDepth := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "kserve_request_queue_depth",
+		Help: "The number of requests waiting for the model server",
+	})
+	queueDepth.Set(3)
+	registry.MustRegister(queueDepth)
+
+	componentUp := true
+	component := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !componentUp {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, "# HELP request_predict_seconds The predict latency\n"+
+			"# TYPE request_predict_seconds gauge\n"+
+			"request_predict_seconds{model_name=\"sklearn\"} 0.25\n"+
+			"# HELP kserve_request_queue_depth The component metric named as an agent metric\n"+
+			"# TYPE kserve_request_queue_depth gauge\n"+
+			"kserve_request_queue_depth 100\n")
+	}))
+	defer component.Close()
+
+	aggregator := NewAggregator(registry, component.URL+"/metrics", logger)
+	serve := func() string {
+		w := httptest.NewRecorder()
+		aggregator.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		g.Expect(w.Code).To(gomega.Equal(http.StatusOK))
+		return w.Body.String()
+	}
+
+	g.Expect(serve()).To(gomega.Equal("# HELP kserve_request_queue_depth The number of requests waiting for the model server\n" +
+		"# TYPE kserve_request_queue_depth gauge\n" +
+		"kserve_request_queue_depth 3\n" +
+		"# HELP request_predict_seconds The predict latency\n" +
+		"# TYPE request_predict_seconds gauge\n" +
+		"request_predict_seconds{model_name=\"sklearn\"} 0.25\n"))
+
+	// The agent metrics are served while the component cannot be scraped
+	componentUp = false
+	g.Expect(serve()).To(gomega.Equal("# HELP kserve_request_queue_depth The number of requests waiting for the model server\n" +
+		"# TYPE kserve_request_queue_depth gauge\n" +
+		"kserve_request_queue_depth 3\n"))
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The labels of the agent metrics, the dashboards select the metrics of a model by the same labels on all the
+// sidecars
+const (
+	ServiceLabel   = "service"
+	NamespaceLabel = "namespace"
+	ComponentLabel = "component"
+	RevisionLabel  = "revision"
+	ModelLabel     = "model"
+	ProtocolLabel  = "protocol"
+	CodeLabel      = "code"
+	// TraceIdLabel is the label of the exemplars linking the requests to their trace
+	TraceIdLabel = "trace_id"
+)
+
+// The protocols of the inference requests
+const (
+	ProtocolV1     = "v1"
+	ProtocolV2     = "v2"
+	ProtocolGRPC   = "grpc"
+	ProtocolOpenAI = "openai"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kserve_request_duration_seconds",
+		Help:    "The latency of the requests served by the agent",
+		Buckets: prometheus.DefBuckets,
+	}, []string{ModelLabel, ProtocolLabel})
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kserve_requests_total",
+		Help: "The number of requests served by the agent",
+	}, []string{ModelLabel, ProtocolLabel, CodeLabel})
+
+	v1PathRegex = regexp.MustCompile(`^/v1/models/([^/:]+)(:[a-z]+)?$`)
+	v2PathRegex = regexp.MustCompile(`^/v2/models/([^/]+)(/.*)?$`)
+)
+
+// ServiceLabels returns the labels of the InferenceService component served by the agent, the labels without a
+// value are left out
+func ServiceLabels(service string, namespace string, component string, revision string) prometheus.Labels {
+	labels := prometheus.Labels{}
+	for name, value := range map[string]string{
+		ServiceLabel:   service,
+		NamespaceLabel: namespace,
+		ComponentLabel: component,
+		RevisionLabel:  revision,
+	} {
+		if value != "" {
+			labels[name] = value
+		}
+	}
+	return labels
+}
+
+// RegisterRequestMetrics registers the latency and the throughput metrics, the registerer adds the service labels
+func RegisterRequestMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{requestDuration, requests} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestMetricsHandler records the latency and the status code of the requests by model and protocol. The
+// observations carry the trace id of the request as exemplar, so that a slow request is looked up in the traces.
+type RequestMetricsHandler struct {
+	next http.Handler
+}
+
+func NewRequestMetricsHandler(next http.Handler) *RequestMetricsHandler {
+	return &RequestMetricsHandler{next: next}
+}
+
+func (h *RequestMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	rr := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+	h.next.ServeHTTP(rr, r)
+	elapsed := time.Since(start).Seconds()
+
+	model, protocol := ParseRequest(r)
+	duration := requestDuration.WithLabelValues(model, protocol)
+	count := requests.WithLabelValues(model, protocol, strconv.Itoa(rr.code))
+	if traceId := TraceId(r.Header); traceId != "" {
+		exemplar := prometheus.Labels{TraceIdLabel: traceId}
+		duration.(prometheus.ExemplarObserver).ObserveWithExemplar(elapsed, exemplar)
+		count.(prometheus.ExemplarAdder).AddWithExemplar(1, exemplar)
+		return
+	}
+	duration.Observe(elapsed)
+	count.Inc()
+}
+
+// ParseRequest returns the model and the protocol of the inference request, they are empty when the request is not
+// an inference request, the model of the gRPC and OpenAI requests is in the payload and is left empty as well
+func ParseRequest(r *http.Request) (string, string) {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		return "", ProtocolGRPC
+	}
+	if match := v1PathRegex.FindStringSubmatch(r.URL.Path); match != nil {
+		return match[1], ProtocolV1
+	}
+	if match := v2PathRegex.FindStringSubmatch(r.URL.Path); match != nil {
+		return match[1], ProtocolV2
+	}
+	if strings.HasPrefix(r.URL.Path, "/openai/") {
+		return "", ProtocolOpenAI
+	}
+	return "", ""
+}
+
+// TraceId returns the trace id of the W3C trace context or of the B3 headers propagated by Istio
+func TraceId(header http.Header) string {
+	// The traceparent header is formatted as version-traceid-parentid-flags
+	if parts := strings.Split(header.Get("traceparent"), "-"); len(parts) == 4 && len(parts[1]) == 32 {
+		return parts[1]
+	}
+	return header.Get("X-B3-TraceId")
+}
+
+// statusRecorder records the status code of the response, the flushes are passed through for the streamed responses
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.code = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
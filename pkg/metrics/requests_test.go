@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestParseRequest(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		path        string
+		contentType string
+		model       string
+		protocol    string
+	}{
+		"V1Predict":   {path: "/v1/models/sklearn:predict", model: "sklearn", protocol: ProtocolV1},
+		"V1Metadata":  {path: "/v1/models/sklearn", model: "sklearn", protocol: ProtocolV1},
+		"V2Infer":     {path: "/v2/models/sklearn/versions/1/infer", model: "sklearn", protocol: ProtocolV2},
+		"GRPC":        {path: "/inference.GRPCInferenceService/ModelInfer", contentType: "application/grpc", protocol: ProtocolGRPC},
+		"OpenAI":      {path: "/openai/v1/completions", protocol: ProtocolOpenAI},
+		"NotInferred": {path: "/v2/health/ready"},
+	}
+	for name, scenario := range scenarios {
+		r := httptest.NewRequest(http.MethodPost, scenario.path, nil)
+		r.Header.Set("Content-Type", scenario.contentType)
+		model, protocol := ParseRequest(r)
+		g.Expect(model).To(gomega.Equal(scenario.model), name)
+		g.Expect(protocol).To(gomega.Equal(scenario.protocol), name)
+	}
+}
+
+func TestTraceId(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(TraceId(http.Header{"Traceparent": []string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}})).
+		To(gomega.Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+	g.Expect(TraceId(http.Header{"X-B3-Traceid": []string{"80f198ee56343ba864fe8b2a57d3eff7"}})).
+		To(gomega.Equal("80f198ee56343ba864fe8b2a57d3eff7"))
+	g.Expect(TraceId(http.Header{"Traceparent": []string{"malformed"}})).To(gomega.BeEmpty())
+}
+
+func TestRequestMetricsHandler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	registry := prometheus.NewRegistry()
+	registerer := prometheus.WrapRegistererWith(ServiceLabels("sklearn", "default", "predictor", ""), registry)
+	g.Expect(RegisterRequestMetrics(registerer)).To(gomega.Succeed())
+
+	handler := NewRequestMetricsHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/models/unknown:predict" {
+			http.Error(w, "model not found", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"predictions": [1]}`))
+	}))
+	r := httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/models/unknown:predict", nil))
+
+	g.Expect(testutil.ToFloat64(requests.WithLabelValues("sklearn", ProtocolV1, "200"))).To(gomega.Equal(1.0))
+	g.Expect(testutil.ToFloat64(requests.WithLabelValues("unknown", ProtocolV1, "404"))).To(gomega.Equal(1.0))
+
+	families, err := registry.Gather()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	for _, family := range families {
+		if family.GetName() != "kserve_request_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			g.Expect(labels).To(gomega.HaveKeyWithValue(ServiceLabel, "sklearn"))
+			g.Expect(labels).To(gomega.HaveKeyWithValue(NamespaceLabel, "default"))
+			g.Expect(labels).To(gomega.HaveKeyWithValue(ComponentLabel, "predictor"))
+			g.Expect(labels).NotTo(gomega.HaveKey(RevisionLabel))
+			if labels[ModelLabel] != "sklearn" {
+				continue
+			}
+			// The bucket of the observation holds the trace id of the request
+			var traceIds []string
+			for _, bucket := range metric.GetHistogram().GetBucket() {
+				for _, label := range bucket.GetExemplar().GetLabel() {
+					traceIds = append(traceIds, label.GetValue())
+				}
+			}
+			g.Expect(traceIds).To(gomega.Equal([]string{"4bf92f3577b34da6a3ce929d0e0e4736"}))
+		}
+	}
+}
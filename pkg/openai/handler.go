@@ -0,0 +1,390 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	guuid "github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Paths of the OpenAI APIs served by the handler
+const (
+	ChatCompletionsPath = "/v1/chat/completions"
+	CompletionsPath     = "/v1/completions"
+	EmbeddingsPath      = "/v1/embeddings"
+)
+
+// Tensor names of the open inference protocol requests, they follow the conventions of the Triton LLM backends
+const (
+	DefaultInputName           = "text_input"
+	DefaultTextOutputName      = "text_output"
+	DefaultEmbeddingOutputName = "embedding"
+)
+
+type samplingParameters struct {
+	MaxTokens   *int        `json:"max_tokens,omitempty"`
+	Temperature *float64    `json:"temperature,omitempty"`
+	TopP        *float64    `json:"top_p,omitempty"`
+	Stop        interface{} `json:"stop,omitempty"`
+}
+
+type completionRequest struct {
+	samplingParameters
+	Model  string      `json:"model"`
+	Prompt interface{} `json:"prompt"`
+	Stream bool        `json:"stream,omitempty"`
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	samplingParameters
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream,omitempty"`
+}
+
+type embeddingRequest struct {
+	Model string      `json:"model"`
+	Input interface{} `json:"input"`
+}
+
+type inferTensor struct {
+	Name     string        `json:"name"`
+	Shape    []int         `json:"shape"`
+	Datatype string        `json:"datatype"`
+	Data     []interface{} `json:"data"`
+}
+
+type inferRequest struct {
+	Inputs     []inferTensor          `json:"inputs"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+type inferResponse struct {
+	Outputs []inferTensor `json:"outputs"`
+}
+
+// OpenAIHandler serves the OpenAI completions, chat completions and embeddings APIs in front of a model server
+// serving the open inference protocol, the other requests are passed through unchanged
+type OpenAIHandler struct {
+	log        *zap.SugaredLogger
+	modelName  string
+	inputName  string
+	outputName string
+	next       http.Handler
+}
+
+// New returns the handler translating the OpenAI requests to inference requests of the model, the default tensor
+// names are used for the names which are empty
+func New(modelName string, inputName string, outputName string, next http.Handler, log *zap.SugaredLogger) http.Handler {
+	if inputName == "" {
+		inputName = DefaultInputName
+	}
+	return &OpenAIHandler{
+		log:        log,
+		modelName:  modelName,
+		inputName:  inputName,
+		outputName: outputName,
+		next:       next,
+	}
+}
+
+func (h *OpenAIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	switch r.URL.Path {
+	case CompletionsPath:
+		h.serveCompletions(w, r)
+	case ChatCompletionsPath:
+		h.serveChatCompletions(w, r)
+	case EmbeddingsPath:
+		h.serveEmbeddings(w, r)
+	default:
+		h.next.ServeHTTP(w, r)
+	}
+}
+
+func (h *OpenAIHandler) serveCompletions(w http.ResponseWriter, r *http.Request) {
+	request := &completionRequest{}
+	if err := decode(r, request); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	prompts, err := texts(request.Prompt, "prompt")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	outputs, status, err := h.generate(r, prompts, request.samplingParameters)
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+	choices := make([]map[string]interface{}, len(outputs))
+	for i, output := range outputs {
+		choices[i] = map[string]interface{}{"index": i, "text": output, "logprobs": nil, "finish_reason": "stop"}
+	}
+	h.writeCompletion(w, "cmpl-", "text_completion", "text_completion", request.Model, choices, request.Stream)
+}
+
+func (h *OpenAIHandler) serveChatCompletions(w http.ResponseWriter, r *http.Request) {
+	request := &chatCompletionRequest{}
+	if err := decode(r, request); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(request.Messages) == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("messages must not be empty"))
+		return
+	}
+	outputs, status, err := h.generate(r, []string{chatPrompt(request.Messages)}, request.samplingParameters)
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+	message := map[string]interface{}{"role": "assistant", "content": outputs[0]}
+	choice := map[string]interface{}{"index": 0, "finish_reason": "stop"}
+	if request.Stream {
+		choice["delta"] = message
+	} else {
+		choice["message"] = message
+	}
+	h.writeCompletion(w, "chatcmpl-", "chat.completion", "chat.completion.chunk", request.Model,
+		[]map[string]interface{}{choice}, request.Stream)
+}
+
+func (h *OpenAIHandler) serveEmbeddings(w http.ResponseWriter, r *http.Request) {
+	request := &embeddingRequest{}
+	if err := decode(r, request); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	inputs, err := texts(request.Input, "input")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	output, status, err := h.infer(r, inputs, nil, h.outputNameOr(DefaultEmbeddingOutputName))
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+	// The embeddings of the inputs are the rows of the output tensor
+	size := len(output.Data) / len(inputs)
+	if len(output.Shape) == 2 {
+		size = output.Shape[1]
+	}
+	if size*len(inputs) != len(output.Data) {
+		writeError(w, http.StatusBadGateway, fmt.Errorf("output %s of shape %v does not hold an embedding per input", output.Name, output.Shape))
+		return
+	}
+	data := make([]map[string]interface{}, len(inputs))
+	for i := range inputs {
+		data[i] = map[string]interface{}{"object": "embedding", "index": i, "embedding": output.Data[i*size : (i+1)*size]}
+	}
+	writeJSON(w, map[string]interface{}{"object": "list", "model": h.responseModel(request.Model), "data": data})
+}
+
+// generate returns the texts generated by the model for the prompts
+func (h *OpenAIHandler) generate(r *http.Request, prompts []string, sampling samplingParameters) ([]string, int, error) {
+	parameters := map[string]interface{}{}
+	raw, _ := json.Marshal(sampling)
+	if err := json.Unmarshal(raw, &parameters); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	output, status, err := h.infer(r, prompts, parameters, h.outputNameOr(DefaultTextOutputName))
+	if err != nil {
+		return nil, status, err
+	}
+	if len(output.Data) != len(prompts) {
+		return nil, http.StatusBadGateway, fmt.Errorf("output %s holds %d texts for %d prompts", output.Name, len(output.Data), len(prompts))
+	}
+	texts := make([]string, len(output.Data))
+	for i, value := range output.Data {
+		text, ok := value.(string)
+		if !ok {
+			return nil, http.StatusBadGateway, fmt.Errorf("output %s does not hold texts", output.Name)
+		}
+		texts[i] = text
+	}
+	return texts, http.StatusOK, nil
+}
+
+// infer sends the texts as an inference request to the model server and returns the named output tensor
+func (h *OpenAIHandler) infer(r *http.Request, texts []string, parameters map[string]interface{},
+	outputName string) (*inferTensor, int, error) {
+	data := make([]interface{}, len(texts))
+	for i, text := range texts {
+		data[i] = text
+	}
+	body, err := json.Marshal(inferRequest{
+		Inputs: []inferTensor{{
+			Name:     h.inputName,
+			Shape:    []int{len(texts)},
+			Datatype: "BYTES",
+			Data:     data,
+		}},
+		Parameters: parameters,
+	})
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost,
+		fmt.Sprintf("/v2/models/%s/infer", h.modelName), bytes.NewReader(body))
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	for key, values := range r.Header {
+		req.Header[key] = values
+	}
+	req.Header.Del("Content-Length")
+	req.Header.Set("Content-Type", "application/json")
+	req.Host = r.Host
+
+	rr := httptest.NewRecorder()
+	h.next.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		h.log.Infow("Inference request failed", "model", h.modelName, "status code", rr.Code)
+		return nil, rr.Code, fmt.Errorf("inference request failed: %s", strings.TrimSpace(rr.Body.String()))
+	}
+	response := &inferResponse{}
+	if err := json.Unmarshal(rr.Body.Bytes(), response); err != nil {
+		return nil, http.StatusBadGateway, fmt.Errorf("invalid inference response: %v", err)
+	}
+	for i := range response.Outputs {
+		if response.Outputs[i].Name == outputName {
+			return &response.Outputs[i], http.StatusOK, nil
+		}
+	}
+	return nil, http.StatusBadGateway, fmt.Errorf("inference response has no output %s", outputName)
+}
+
+func (h *OpenAIHandler) outputNameOr(defaultName string) string {
+	if h.outputName != "" {
+		return h.outputName
+	}
+	return defaultName
+}
+
+func (h *OpenAIHandler) responseModel(requestModel string) string {
+	if requestModel != "" {
+		return requestModel
+	}
+	return h.modelName
+}
+
+// writeCompletion writes the completion object, or a single chunk of server-sent events when streaming is requested
+// as the whole text is generated before the response
+func (h *OpenAIHandler) writeCompletion(w http.ResponseWriter, idPrefix string, object string, chunkObject string,
+	requestModel string, choices []map[string]interface{}, stream bool) {
+	completion := map[string]interface{}{
+		"id":      idPrefix + guuid.New().String(),
+		"object":  object,
+		"created": time.Now().Unix(),
+		"model":   h.responseModel(requestModel),
+		"choices": choices,
+	}
+	if !stream {
+		writeJSON(w, completion)
+		return
+	}
+	completion["object"] = chunkObject
+	chunk, err := json.Marshal(completion)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprintf(w, "data: %s\n\ndata: [DONE]\n\n", chunk)
+}
+
+// chatPrompt renders the messages as a plain transcript ending with the turn of the assistant, the chat template of
+// the model is applied by the model server when it has one
+func chatPrompt(messages []chatMessage) string {
+	var prompt strings.Builder
+	for _, message := range messages {
+		fmt.Fprintf(&prompt, "%s: %s\n", message.Role, message.Content)
+	}
+	prompt.WriteString("assistant:")
+	return prompt.String()
+}
+
+// texts returns the texts of a field which is either a string or an array of strings
+func texts(value interface{}, field string) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		texts := make([]string, len(v))
+		for i, item := range v {
+			text, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a string or an array of strings", field)
+			}
+			texts[i] = text
+		}
+		if len(texts) > 0 {
+			return texts, nil
+		}
+	}
+	return nil, fmt.Errorf("%s must be a string or an array of strings", field)
+}
+
+func decode(r *http.Request, v interface{}) error {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("invalid request body: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the error in the format of the OpenAI API errors
+func writeError(w http.ResponseWriter, status int, err error) {
+	errorType := "server_error"
+	if status >= 400 && status < 500 {
+		errorType = "invalid_request_error"
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{"message": err.Error(), "type": errorType},
+	})
+}
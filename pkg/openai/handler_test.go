@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openai
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+	pkglogging "knative.dev/pkg/logging"
+)
+
+// modelServer echoes the prompts back as the generated texts and returns an embedding of two values per input
+func modelServer(g *gomega.WithT, requests *[]inferRequest) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/models/llm/infer" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		g.Expect(err).To(gomega.BeNil())
+		request := inferRequest{}
+		g.Expect(json.Unmarshal(body, &request)).To(gomega.Succeed())
+		*requests = append(*requests, request)
+
+		input := request.Inputs[0]
+		embedding := []interface{}{}
+		for i := range input.Data {
+			embedding = append(embedding, float64(i), float64(i)+0.5)
+		}
+		json.NewEncoder(w).Encode(inferResponse{Outputs: []inferTensor{
+			{Name: DefaultTextOutputName, Shape: input.Shape, Datatype: "BYTES", Data: input.Data},
+			{Name: DefaultEmbeddingOutputName, Shape: []int{len(input.Data), 2}, Datatype: "FP32", Data: embedding},
+		}})
+	})
+}
+
+func post(handler http.Handler, path string, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return w
+}
+
+func TestCompletions(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	requests := []inferRequest{}
+	handler := New("llm", "", "", modelServer(g, &requests), logger)
+
+	w := post(handler, CompletionsPath, `{"model": "llm", "prompt": ["Hello", "Bye"], "max_tokens": 16}`)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK), w.Body.String())
+	g.Expect(requests[0].Inputs[0].Name).To(gomega.Equal(DefaultInputName))
+	g.Expect(requests[0].Inputs[0].Shape).To(gomega.Equal([]int{2}))
+	g.Expect(requests[0].Parameters).To(gomega.Equal(map[string]interface{}{"max_tokens": float64(16)}))
+
+	response := map[string]interface{}{}
+	g.Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(gomega.Succeed())
+	g.Expect(response["object"]).To(gomega.Equal("text_completion"))
+	g.Expect(response["model"]).To(gomega.Equal("llm"))
+	choices := response["choices"].([]interface{})
+	g.Expect(choices).To(gomega.HaveLen(2))
+	g.Expect(choices[1].(map[string]interface{})["text"]).To(gomega.Equal("Bye"))
+
+	w = post(handler, CompletionsPath, `{"prompt": 1}`)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusBadRequest))
+	g.Expect(w.Body.String()).To(gomega.ContainSubstring("invalid_request_error"))
+}
+
+func TestChatCompletions(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	requests := []inferRequest{}
+	handler := New("llm", "", "", modelServer(g, &requests), logger)
+
+	body := `{"messages": [{"role": "system", "content": "Be brief."}, {"role": "user", "content": "Hi"}]}`
+	w := post(handler, ChatCompletionsPath, body)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK), w.Body.String())
+	prompt := "system: Be brief.\nuser: Hi\nassistant:"
+	g.Expect(requests[0].Inputs[0].Data).To(gomega.Equal([]interface{}{prompt}))
+
+	response := map[string]interface{}{}
+	g.Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(gomega.Succeed())
+	g.Expect(response["object"]).To(gomega.Equal("chat.completion"))
+	g.Expect(response["model"]).To(gomega.Equal("llm"))
+	choice := response["choices"].([]interface{})[0].(map[string]interface{})
+	g.Expect(choice["message"]).To(gomega.Equal(map[string]interface{}{"role": "assistant", "content": prompt}))
+
+	// The streamed response is a single chunk followed by the end of the stream
+	w = post(handler, ChatCompletionsPath, strings.Replace(body, "{", `{"stream": true, `, 1))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK), w.Body.String())
+	g.Expect(w.Header().Get("Content-Type")).To(gomega.Equal("text/event-stream"))
+	events := strings.Split(strings.TrimSpace(w.Body.String()), "\n\n")
+	g.Expect(events).To(gomega.HaveLen(2))
+	g.Expect(events[0]).To(gomega.ContainSubstring(`"object":"chat.completion.chunk"`))
+	g.Expect(events[0]).To(gomega.ContainSubstring(`"delta"`))
+	g.Expect(events[1]).To(gomega.Equal("data: [DONE]"))
+}
+
+func TestEmbeddings(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	requests := []inferRequest{}
+	handler := New("llm", "", "", modelServer(g, &requests), logger)
+
+	w := post(handler, EmbeddingsPath, `{"model": "embedder", "input": ["a", "b"]}`)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK), w.Body.String())
+	response := map[string]interface{}{}
+	g.Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(gomega.Succeed())
+	g.Expect(response["model"]).To(gomega.Equal("embedder"))
+	data := response["data"].([]interface{})
+	g.Expect(data).To(gomega.HaveLen(2))
+	g.Expect(data[1].(map[string]interface{})["embedding"]).To(gomega.Equal([]interface{}{float64(1), 1.5}))
+
+	// The configured output tensor is missing in the response
+	handler = New("llm", "", "missing", modelServer(g, &requests), logger)
+	w = post(handler, EmbeddingsPath, `{"input": "a"}`)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusBadGateway))
+	g.Expect(w.Body.String()).To(gomega.ContainSubstring("server_error"))
+}
+
+func TestPassThrough(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	logger, _ := pkglogging.NewLogger("", "INFO")
+	requests := []inferRequest{}
+	handler := New("llm", "", "", modelServer(g, &requests), logger)
+
+	w := post(handler, "/v2/models/llm/infer", `{"inputs": [{"name": "text_input", "shape": [1], "datatype": "BYTES", "data": ["x"]}]}`)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK))
+	g.Expect(requests).To(gomega.HaveLen(1))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, CompletionsPath, nil))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusNotFound))
+
+	// The errors of the model server are reported with their status code
+	handler = New("other", "", "", modelServer(g, &requests), logger)
+	w = post(handler, CompletionsPath, `{"prompt": "Hello"}`)
+	g.Expect(w.Code).To(gomega.Equal(http.StatusNotFound))
+}
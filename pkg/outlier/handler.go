@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outlier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	guuid "github.com/google/uuid"
+	"github.com/kserve/kserve/pkg/logger"
+	"go.uber.org/zap"
+	"knative.dev/pkg/network"
+)
+
+const (
+	// OutlierScoreHeader is the response header carrying the score returned by the outlier detector
+	OutlierScoreHeader = "Outlier-Score"
+	DetectorTimeout    = 10 * time.Second
+)
+
+type OutlierHandler struct {
+	log         *zap.SugaredLogger
+	detectorUrl *url.URL
+	sourceUri   *url.URL
+	client      *http.Client
+	next        http.Handler
+}
+
+func New(detectorUrl *url.URL, sourceUri *url.URL, next http.Handler, log *zap.SugaredLogger) http.Handler {
+	return &OutlierHandler{
+		log:         log,
+		detectorUrl: detectorUrl,
+		sourceUri:   sourceUri,
+		client:      &http.Client{Timeout: DetectorTimeout},
+		next:        next,
+	}
+}
+
+// score posts the request payload to the outlier detector as a binary cloud event and returns the detector response
+func (h *OutlierHandler) score(id string, contentType string, body []byte) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, h.detectorUrl.String(), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Ce-Specversion", "1.0")
+	req.Header.Set(logger.CloudEventsIdHeader, id)
+	req.Header.Set("Ce-Type", logger.CEInferenceRequest)
+	req.Header.Set("Ce-Source", h.sourceUri.String())
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	score, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("outlier detector returned %s: %s", resp.Status, score)
+	}
+	// header values can not span multiple lines
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, score); err == nil {
+		return compacted.String(), nil
+	}
+	return strings.Join(strings.Fields(string(score)), " "), nil
+}
+
+// call svc and score the request with the outlier detector while it is being served, the score is attached to the
+// response as a header. A failure of the detector does not fail the request.
+func (h *OutlierHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if network.IsKubeletProbe(r) {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "can't read body", http.StatusBadRequest)
+		return
+	}
+
+	id := r.Header.Get(logger.CloudEventsIdHeader)
+	if id == "" {
+		id = guuid.New().String()
+	}
+	scores := make(chan string, 1)
+	go func() {
+		score, err := h.score(id, r.Header.Get("Content-Type"), body)
+		if err != nil {
+			h.log.Errorw("Failed to score request with the outlier detector", "id", id, "error", err)
+		}
+		scores <- score
+	}()
+
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+	sw := &scoredWriter{ResponseWriter: w, scores: scores}
+	h.next.ServeHTTP(sw, r)
+	if !sw.wroteHeader {
+		sw.WriteHeader(http.StatusOK)
+	}
+}
+
+// scoredWriter passes the response through to the client, the score is awaited before the response headers are
+// written and the flushes are passed through so that the streamed responses reach the client as they are written
+type scoredWriter struct {
+	http.ResponseWriter
+	scores      chan string
+	wroteHeader bool
+}
+
+func (w *scoredWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if score := <-w.scores; score != "" {
+		w.Header().Set(OutlierScoreHeader, score)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *scoredWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *scoredWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outlier
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"testing"
+
+	"github.com/kserve/kserve/pkg/logger"
+	"github.com/onsi/gomega"
+	pkglogging "knative.dev/pkg/logging"
+)
+
+func TestOutlierScore(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	predictorRequest := []byte(`{"instances":[[0,0,0]]}`)
+	predictorResponse := []byte(`{"predictions":[1]}`)
+
+	detector := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		b, err := ioutil.ReadAll(req.Body)
+		g.Expect(err).To(gomega.BeNil())
+		g.Expect(b).To(gomega.Equal(predictorRequest))
+		g.Expect(req.Header.Get("Ce-Type")).To(gomega.Equal(logger.CEInferenceRequest))
+		g.Expect(req.Header.Get(logger.CloudEventsIdHeader)).To(gomega.Equal("request-1"))
+		_, err = rw.Write([]byte("{\n  \"data\": {\"is_outlier\": [1]}\n}"))
+		g.Expect(err).To(gomega.BeNil())
+	}))
+	defer detector.Close()
+
+	predictor := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		b, err := ioutil.ReadAll(req.Body)
+		g.Expect(err).To(gomega.BeNil())
+		g.Expect(b).To(gomega.Equal(predictorRequest))
+		_, err = rw.Write(predictorResponse)
+		g.Expect(err).To(gomega.BeNil())
+	}))
+	defer predictor.Close()
+
+	r := httptest.NewRequest("POST", "http://a", bytes.NewReader(predictorRequest))
+	r.Header.Set(logger.CloudEventsIdHeader, "request-1")
+	w := httptest.NewRecorder()
+	log, _ := pkglogging.NewLogger("", "INFO")
+	detectorUrl, err := url.Parse(detector.URL)
+	g.Expect(err).To(gomega.BeNil())
+	sourceUri, err := url.Parse("http://localhost:9081/")
+	g.Expect(err).To(gomega.BeNil())
+	targetUri, err := url.Parse(predictor.URL)
+	g.Expect(err).To(gomega.BeNil())
+
+	oh := New(detectorUrl, sourceUri, httputil.NewSingleHostReverseProxy(targetUri), log)
+	oh.ServeHTTP(w, r)
+
+	b, _ := ioutil.ReadAll(w.Result().Body)
+	g.Expect(b).To(gomega.Equal(predictorResponse))
+	g.Expect(w.Result().Header.Get(OutlierScoreHeader)).To(gomega.Equal(`{"data":{"is_outlier":[1]}}`))
+}
+
+func TestOutlierDetectorUnavailable(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	predictorRequest := []byte(`{"instances":[[0,0,0]]}`)
+	predictorResponse := []byte(`{"predictions":[1]}`)
+
+	predictor := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write(predictorResponse)
+		g.Expect(err).To(gomega.BeNil())
+	}))
+	defer predictor.Close()
+
+	r := httptest.NewRequest("POST", "http://a", bytes.NewReader(predictorRequest))
+	w := httptest.NewRecorder()
+	log, _ := pkglogging.NewLogger("", "INFO")
+	detectorUrl, err := url.Parse("http://localhost:1")
+	g.Expect(err).To(gomega.BeNil())
+	sourceUri, err := url.Parse("http://localhost:9081/")
+	g.Expect(err).To(gomega.BeNil())
+	targetUri, err := url.Parse(predictor.URL)
+	g.Expect(err).To(gomega.BeNil())
+
+	oh := New(detectorUrl, sourceUri, httputil.NewSingleHostReverseProxy(targetUri), log)
+	oh.ServeHTTP(w, r)
+
+	b, _ := ioutil.ReadAll(w.Result().Body)
+	g.Expect(w.Result().StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(b).To(gomega.Equal(predictorResponse))
+	g.Expect(w.Result().Header.Get(OutlierScoreHeader)).To(gomega.BeEmpty())
+}
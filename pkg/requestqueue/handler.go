@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestqueue
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const DefaultRetryAfterSeconds = 1
+
+var (
+	queueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: constants.QueueDepthMetricName,
+		Help: "The number of requests waiting for the model server",
+	})
+	inFlightRequests = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "kserve_request_in_flight",
+		Help: "The number of requests being served by the model server",
+	})
+	rejectedRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "kserve_request_queue_rejected_total",
+		Help: "The number of requests rejected because the request queue is full",
+	})
+)
+
+// RegisterMetrics registers the request queue metrics, the registerer adds the service labels
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{queueDepth, inFlightRequests, rejectedRequests} {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QueueHandler forwards up to maxInFlight requests to the model server concurrently, the requests above are queued up
+// to maxQueueDepth and the requests above the queue depth are rejected with 429 Too Many Requests
+type QueueHandler struct {
+	log           *zap.SugaredLogger
+	slots         chan struct{}
+	maxQueueDepth int64
+	queued        int64
+	retryAfter    string
+	next          http.Handler
+}
+
+func New(maxInFlight int, maxQueueDepth int, retryAfterSeconds int, next http.Handler, log *zap.SugaredLogger) *QueueHandler {
+	return &QueueHandler{
+		log:           log,
+		slots:         make(chan struct{}, maxInFlight),
+		maxQueueDepth: int64(maxQueueDepth),
+		retryAfter:    strconv.Itoa(retryAfterSeconds),
+		next:          next,
+	}
+}
+
+// acquire waits for a free slot, it returns false when the queue is full or the request is cancelled while queued.
+// The blocked senders of the slots channel are served before the new requests, so the queue is served in order.
+func (h *QueueHandler) acquire(r *http.Request) bool {
+	select {
+	case h.slots <- struct{}{}:
+		return true
+	default:
+	}
+	if atomic.AddInt64(&h.queued, 1) > h.maxQueueDepth {
+		atomic.AddInt64(&h.queued, -1)
+		return false
+	}
+	queueDepth.Inc()
+	defer func() {
+		atomic.AddInt64(&h.queued, -1)
+		queueDepth.Dec()
+	}()
+	select {
+	case h.slots <- struct{}{}:
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
+
+func (h *QueueHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.acquire(r) {
+		if r.Context().Err() != nil {
+			return
+		}
+		rejectedRequests.Inc()
+		h.log.Debugf("Rejecting request %s, the request queue is full", r.URL.Path)
+		w.Header().Set("Retry-After", h.retryAfter)
+		http.Error(w, "the request queue is full", http.StatusTooManyRequests)
+		return
+	}
+	inFlightRequests.Inc()
+	defer func() {
+		<-h.slots
+		inFlightRequests.Dec()
+	}()
+	h.next.ServeHTTP(w, r)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestqueue
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	pkglogging "knative.dev/pkg/logging"
+)
+
+func TestQueueHandler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	logger, _ := pkglogging.NewLogger("", "INFO")
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 3)
+	predictor := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := New(1, 1, 5, predictor, logger)
+
+	serve := func(wg *sync.WaitGroup, code *int) {
+		defer wg.Done()
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/models/test:predict", nil))
+		*code = w.Code
+	}
+
+	// The first request is in flight and the second request is queued
+	var wg sync.WaitGroup
+	codes := make([]int, 2)
+	wg.Add(1)
+	go serve(&wg, &codes[0])
+	<-started
+	wg.Add(1)
+	go serve(&wg, &codes[1])
+	g.Eventually(func() float64 { return testutil.ToFloat64(queueDepth) }, time.Second).Should(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(inFlightRequests)).To(gomega.Equal(float64(1)))
+
+	// The third request is rejected as the queue is full
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/models/test:predict", nil))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusTooManyRequests))
+	g.Expect(w.Header().Get("Retry-After")).To(gomega.Equal("5"))
+	g.Expect(testutil.ToFloat64(rejectedRequests)).To(gomega.Equal(float64(1)))
+
+	// The queued request is served once the in-flight request completes
+	release <- struct{}{}
+	<-started
+	g.Expect(testutil.ToFloat64(queueDepth)).To(gomega.Equal(float64(0)))
+	release <- struct{}{}
+	wg.Wait()
+	g.Expect(codes).To(gomega.Equal([]int{http.StatusOK, http.StatusOK}))
+	g.Expect(testutil.ToFloat64(inFlightRequests)).To(gomega.Equal(float64(0)))
+}
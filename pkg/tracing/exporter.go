@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// DefaultExportInterval is the interval the agent exports the batched spans at
+	DefaultExportInterval = 5 * time.Second
+	// MaxQueueSize bounds the spans buffered between two exports, the spans ended while the queue is full are dropped
+	MaxQueueSize = 2048
+	// tracesPath is the OTLP/HTTP path of the traces on the collector
+	tracesPath = "/v1/traces"
+	// scopeName is the instrumentation scope of the agent spans
+	scopeName = "kserve-agent"
+	// exportTimeout bounds a single export so that a slow collector does not hold the spans of the next exports
+	exportTimeout = 10 * time.Second
+)
+
+// NewTracerProvider creates the tracer provider of the agent. The spans are batched and exported to the OTLP/HTTP
+// endpoint of the collector, e.g. http://otel-collector:4318, the resource attributes are set on all the spans. The
+// traces started by the agent are sampled at the sampling percent, the sampling decision of the caller is followed
+// for the traces continued by the agent.
+func NewTracerProvider(endpoint string, samplingPercent int, resourceAttributes map[string]string) (*sdktrace.TracerProvider, error) {
+	exporter, err := newExporter(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(resourceAttributes))
+	for key := range resourceAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attributes := make([]attribute.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		attributes = append(attributes, attribute.String(key, resourceAttributes[key]))
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithBatchTimeout(DefaultExportInterval),
+			sdktrace.WithMaxQueueSize(MaxQueueSize),
+			sdktrace.WithExportTimeout(exportTimeout)),
+		sdktrace.WithResource(resource.NewSchemaless(attributes...)),
+		sdktrace.WithSampler(newSampler(samplingPercent)),
+	), nil
+}
+
+// newSampler samples the traces started by the agent from their trace id, so that the decision is the same for a
+// trace id on all the agents
+func newSampler(samplingPercent int) sdktrace.Sampler {
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(float64(samplingPercent) / 100))
+}
+
+// newExporter creates the OTLP/HTTP exporter of the collector endpoint, the collector is called without TLS for the
+// http endpoints
+func newExporter(endpoint string) (*otlptrace.Exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing the collector endpoint %s: %w", endpoint, err)
+	}
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(u.Host),
+		otlptracehttp.WithURLPath(strings.TrimSuffix(u.Path, "/") + tracesPath),
+		otlptracehttp.WithTimeout(exportTimeout),
+	}
+	if u.Scheme == "http" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	return otlptracehttp.New(context.Background(), options...)
+}
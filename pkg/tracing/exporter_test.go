@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/onsi/gomega"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTracerProvider(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	var mu sync.Mutex
+	var paths []string
+	var requests []*collectortrace.ExportTraceServiceRequest
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		request := &collectortrace.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(body, request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		paths = append(paths, r.URL.Path)
+		requests = append(requests, request)
+	}))
+	defer collector.Close()
+
+	provider, err := NewTracerProvider(collector.URL+"/", 100, map[string]string{
+		"service.name":       "sklearn-predictor",
+		"k8s.namespace.name": "default",
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	defer provider.Shutdown(context.Background())
+
+	// Nothing is exported without spans
+	g.Expect(provider.ForceFlush(context.Background())).To(gomega.Succeed())
+	g.Expect(paths).To(gomega.BeEmpty())
+
+	handler := New(provider, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model server failed", http.StatusInternalServerError)
+	}))
+	r := httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	g.Expect(provider.ForceFlush(context.Background())).To(gomega.Succeed())
+
+	mu.Lock()
+	defer mu.Unlock()
+	g.Expect(paths).To(gomega.Equal([]string{"/v1/traces"}))
+	g.Expect(requests[0].ResourceSpans).To(gomega.HaveLen(1))
+	resourceSpans := requests[0].ResourceSpans[0]
+	resourceAttributes := map[string]string{}
+	for _, attribute := range resourceSpans.Resource.Attributes {
+		resourceAttributes[attribute.Key] = attribute.Value.GetStringValue()
+	}
+	g.Expect(resourceAttributes).To(gomega.Equal(map[string]string{
+		"service.name":       "sklearn-predictor",
+		"k8s.namespace.name": "default",
+	}))
+	g.Expect(resourceSpans.ScopeSpans).To(gomega.HaveLen(1))
+	g.Expect(resourceSpans.ScopeSpans[0].Scope.Name).To(gomega.Equal("kserve-agent"))
+	g.Expect(resourceSpans.ScopeSpans[0].Spans).To(gomega.HaveLen(1))
+	span := resourceSpans.ScopeSpans[0].Spans[0]
+	g.Expect(hex.EncodeToString(span.TraceId)).To(gomega.Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+	g.Expect(hex.EncodeToString(span.ParentSpanId)).To(gomega.Equal("00f067aa0ba902b7"))
+	g.Expect(span.Name).To(gomega.Equal("POST /v1/models/sklearn:predict"))
+	g.Expect(span.Kind).To(gomega.Equal(tracepb.Span_SPAN_KIND_SERVER))
+	g.Expect(span.Status.Code).To(gomega.Equal(tracepb.Status_STATUS_CODE_ERROR))
+	spanAttributes := map[string]*commonpb.AnyValue{}
+	for _, attribute := range span.Attributes {
+		spanAttributes[attribute.Key] = attribute.Value
+	}
+	g.Expect(spanAttributes["http.method"].GetStringValue()).To(gomega.Equal("POST"))
+	g.Expect(spanAttributes["http.status_code"].GetIntValue()).To(gomega.Equal(int64(http.StatusInternalServerError)))
+	g.Expect(spanAttributes["kserve.model"].GetStringValue()).To(gomega.Equal("sklearn"))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/kserve/kserve/pkg/metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingHandler records a server span of each request served by the agent. The W3C trace context of the caller,
+// e.g. the agent of the transformer, is continued and the span of the agent is the parent of the component spans, so
+// that the hops of a request through the components of an InferenceService are linked in a single trace.
+type TracingHandler struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	next       http.Handler
+}
+
+func New(provider trace.TracerProvider, next http.Handler) http.Handler {
+	return &TracingHandler{
+		tracer:     provider.Tracer(scopeName),
+		propagator: propagation.TraceContext{},
+		next:       next,
+	}
+}
+
+func (h *TracingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := h.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	ctx, span := h.tracer.Start(ctx, r.Method+" "+r.URL.Path,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("http.method", r.Method),
+			attribute.String("http.target", r.URL.Path),
+		))
+	defer span.End()
+	// The component and the inner handlers see the span of the agent as the parent span
+	h.propagator.Inject(ctx, propagation.HeaderCarrier(r.Header))
+	if !span.IsRecording() {
+		h.next.ServeHTTP(w, r.WithContext(ctx))
+		return
+	}
+
+	rr := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+	h.next.ServeHTTP(rr, r.WithContext(ctx))
+
+	span.SetAttributes(attribute.Int("http.status_code", rr.code))
+	model, protocol := metrics.ParseRequest(r)
+	if model != "" {
+		span.SetAttributes(attribute.String("kserve.model", model))
+	}
+	if protocol != "" {
+		span.SetAttributes(attribute.String("kserve.protocol", protocol))
+	}
+	if rr.code >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(rr.code))
+	}
+}
+
+// statusRecorder records the status code of the response, the flushes are passed through for the streamed responses
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.code = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
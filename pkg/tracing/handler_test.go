@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// forwardedSpanContext parses the trace context the handler forwarded to the component
+func forwardedSpanContext(header string) trace.SpanContext {
+	carrier := propagation.HeaderCarrier(http.Header{})
+	carrier.Set("traceparent", header)
+	return trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+}
+
+func TestTracingHandler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	var forwarded string
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder), sdktrace.WithSampler(newSampler(100)))
+	handler := New(provider, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = r.Header.Get("traceparent")
+		if r.URL.Path == "/v1/models/unknown:predict" {
+			http.Error(w, "model server failed", http.StatusInternalServerError)
+		}
+	}))
+
+	// The span of the agent continues the trace of the caller and is the parent of the component span
+	r := httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	g.Expect(recorder.Ended()).To(gomega.HaveLen(1))
+	span := recorder.Ended()[0]
+	g.Expect(span.SpanContext().TraceID().String()).To(gomega.Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+	g.Expect(span.Parent().SpanID().String()).To(gomega.Equal("00f067aa0ba902b7"))
+	g.Expect(forwardedSpanContext(forwarded).SpanID()).To(gomega.Equal(span.SpanContext().SpanID()))
+	g.Expect(span.Name()).To(gomega.Equal("POST /v1/models/sklearn:predict"))
+	g.Expect(span.SpanKind()).To(gomega.Equal(trace.SpanKindServer))
+	g.Expect(span.Attributes()).To(gomega.ContainElement(attribute.String("kserve.model", "sklearn")))
+	g.Expect(span.Attributes()).To(gomega.ContainElement(attribute.Int("http.status_code", http.StatusOK)))
+	g.Expect(span.Status().Code).To(gomega.Equal(codes.Unset))
+
+	// A trace is started for the requests without trace context
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/models/unknown:predict", nil))
+	g.Expect(recorder.Ended()).To(gomega.HaveLen(2))
+	span = recorder.Ended()[1]
+	g.Expect(span.SpanContext().TraceID().String()).NotTo(gomega.Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+	g.Expect(span.Parent().IsValid()).To(gomega.BeFalse())
+	g.Expect(span.Status().Code).To(gomega.Equal(codes.Error))
+
+	// The sampling decision of the caller is followed, the trace context is still propagated
+	r = httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	g.Expect(recorder.Ended()).To(gomega.HaveLen(2))
+	sc := forwardedSpanContext(forwarded)
+	g.Expect(sc.IsValid()).To(gomega.BeTrue())
+	g.Expect(sc.TraceID().String()).To(gomega.Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+	g.Expect(sc.IsSampled()).To(gomega.BeFalse())
+
+	// No trace started by the agent is sampled at 0 percent
+	recorder = tracetest.NewSpanRecorder()
+	provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder), sdktrace.WithSampler(newSampler(0)))
+	handler = New(provider, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for i := 0; i < 10; i++ {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/models/sklearn:predict", nil))
+	}
+	g.Expect(recorder.Ended()).To(gomega.BeEmpty())
+}
@@ -76,11 +76,21 @@ func AppendVolumeIfNotExists(slice []v1.Volume, volume v1.Volume) []v1.Volume {
 	return append(slice, volume)
 }
 
-func IsGPUEnabled(requirements v1.ResourceRequirements) bool {
-	_, ok := requirements.Limits[constants.NvidiaGPUResourceType]
+// IsGPUEnabled checks if the limits of the resource requirements include the given GPU resource
+func IsGPUEnabled(requirements v1.ResourceRequirements, gpuResourceName v1.ResourceName) bool {
+	_, ok := requirements.Limits[gpuResourceName]
 	return ok
 }
 
+// GetGPUResourceName returns the GPU resource name of the component recorded on the pod annotations, e.g. the resource
+// of a fractional GPU device plugin, the nvidia GPU resource name is returned if the annotation is not set.
+func GetGPUResourceName(annotations map[string]string) v1.ResourceName {
+	if resourceName, ok := annotations[constants.GPUResourceNameInternalAnnotationKey]; ok && resourceName != "" {
+		return v1.ResourceName(resourceName)
+	}
+	return constants.NvidiaGPUResourceType
+}
+
 // FirstNonNilError returns the first non nil interface in the slice
 func FirstNonNilError(objects []error) error {
 	for _, object := range objects {
@@ -422,8 +422,9 @@ func TestIncludesArg(t *testing.T) {
 func TestIsGpuEnabled(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	scenarios := map[string]struct {
-		resource v1.ResourceRequirements
-		expected bool
+		resource        v1.ResourceRequirements
+		gpuResourceName v1.ResourceName
+		expected        bool
 	}{
 		"GpuEnabled": {
 			resource: v1.ResourceRequirements{
@@ -457,15 +458,61 @@ func TestIsGpuEnabled(t *testing.T) {
 			},
 			expected: false,
 		},
+		"CustomGPUEnabled": {
+			resource: v1.ResourceRequirements{
+				Limits: v1.ResourceList{
+					"example.com/gpu-fraction": resource.MustParse("50"),
+				},
+			},
+			gpuResourceName: "example.com/gpu-fraction",
+			expected:        true,
+		},
+		"OtherGPUResource": {
+			resource: v1.ResourceRequirements{
+				Limits: v1.ResourceList{
+					constants.NvidiaGPUResourceType: resource.MustParse("1"),
+				},
+			},
+			gpuResourceName: "example.com/gpu-fraction",
+			expected:        false,
+		},
 	}
 	for name, scenario := range scenarios {
 		t.Run(name, func(t *testing.T) {
-			res := IsGPUEnabled(scenario.resource)
+			gpuResourceName := scenario.gpuResourceName
+			if gpuResourceName == "" {
+				gpuResourceName = constants.NvidiaGPUResourceType
+			}
+			res := IsGPUEnabled(scenario.resource, gpuResourceName)
 			g.Expect(res).To(gomega.Equal(scenario.expected))
 		})
 	}
 }
 
+func TestGetGPUResourceName(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scenarios := map[string]struct {
+		annotations map[string]string
+		expected    v1.ResourceName
+	}{
+		"DefaultGPUResourceName": {
+			annotations: map[string]string{},
+			expected:    constants.NvidiaGPUResourceType,
+		},
+		"CustomGPUResourceName": {
+			annotations: map[string]string{
+				constants.GPUResourceNameInternalAnnotationKey: "example.com/gpu-fraction",
+			},
+			expected: "example.com/gpu-fraction",
+		},
+	}
+	for name, scenario := range scenarios {
+		t.Run(name, func(t *testing.T) {
+			g.Expect(GetGPUResourceName(scenario.annotations)).To(gomega.Equal(scenario.expected))
+		})
+	}
+}
+
 func TestFirstNonNilError(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	scenarios := map[string]struct {
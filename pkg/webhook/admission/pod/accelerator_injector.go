@@ -30,8 +30,9 @@ const (
 
 func InjectGKEAcceleratorSelector(pod *v1.Pod) error {
 	gpuEnabled := false
+	gpuResourceName := utils.GetGPUResourceName(pod.Annotations)
 	for _, container := range pod.Spec.Containers {
-		if _, ok := container.Resources.Limits[constants.NvidiaGPUResourceType]; ok {
+		if _, ok := container.Resources.Limits[gpuResourceName]; ok {
 			gpuEnabled = true
 		}
 	}
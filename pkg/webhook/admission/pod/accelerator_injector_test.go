@@ -65,6 +65,43 @@ func TestAcceleratorInjector(t *testing.T) {
 				},
 			},
 		},
+		"AddGPUSelectorForCustomGPUResource": {
+			original: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "deployment",
+					Annotations: map[string]string{
+						constants.InferenceServiceGKEAcceleratorAnnotationKey: "nvidia-tesla-v100",
+						constants.GPUResourceNameInternalAnnotationKey:        "example.com/gpu-fraction",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Resources: v1.ResourceRequirements{
+							Limits: v1.ResourceList{"example.com/gpu-fraction": resource.MustParse("50")},
+						},
+					}},
+				},
+			},
+			expected: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "deployment",
+					Annotations: map[string]string{
+						constants.InferenceServiceGKEAcceleratorAnnotationKey: "nvidia-tesla-v100",
+						constants.GPUResourceNameInternalAnnotationKey:        "example.com/gpu-fraction",
+					},
+				},
+				Spec: v1.PodSpec{
+					NodeSelector: map[string]string{
+						GkeAcceleratorNodeSelector: "nvidia-tesla-v100",
+					},
+					Containers: []v1.Container{{
+						Resources: v1.ResourceRequirements{
+							Limits: v1.ResourceList{"example.com/gpu-fraction": resource.MustParse("50")},
+						},
+					}},
+				},
+			},
+		},
 		"DoNotAddGPUSelector": {
 			original: &v1.Pod{
 				ObjectMeta: metav1.ObjectMeta{
@@ -31,14 +31,44 @@ import (
 )
 
 const (
-	LoggerConfigMapKeyName         = "logger"
-	LoggerArgumentLogUrl           = "--log-url"
-	LoggerArgumentSourceUri        = "--source-uri"
-	LoggerArgumentMode             = "--log-mode"
-	LoggerArgumentInferenceService = "--inference-service"
-	LoggerArgumentNamespace        = "--namespace"
-	LoggerArgumentEndpoint         = "--endpoint"
-	LoggerArgumentComponent        = "--component"
+	LoggerConfigMapKeyName          = "logger"
+	LoggerArgumentLogUrl            = "--log-url"
+	LoggerArgumentSourceUri         = "--source-uri"
+	LoggerArgumentMode              = "--log-mode"
+	LoggerArgumentInferenceService  = "--inference-service"
+	LoggerArgumentNamespace         = "--namespace"
+	LoggerArgumentEndpoint          = "--endpoint"
+	LoggerArgumentComponent         = "--component"
+	LoggerArgumentSamplingPercent   = "--log-sampling-percent"
+	LoggerArgumentRedact            = "--log-redact"
+	LoggerArgumentKafkaBrokers      = "--log-kafka-brokers"
+	LoggerArgumentKafkaTopic        = "--log-kafka-topic"
+	LoggerArgumentKafkaSecretDir    = "--log-kafka-secret-dir"
+	LoggerArgumentStorageUri        = "--log-storage-uri"
+	LoggerArgumentStorageBatchSize  = "--log-storage-batch-size"
+	LoggerArgumentStorageFlush      = "--log-storage-flush-interval"
+	OutlierDetectorArgumentUrl      = "--outlier-detector-url"
+	RequestQueueEnableFlag          = "--enable-request-queue"
+	RequestQueueArgumentInFlight    = "--max-in-flight"
+	RequestQueueArgumentMaxDepth    = "--max-queue-depth"
+	RequestQueueArgumentRetryAfter  = "--retry-after"
+	OpenAIEnableFlag                = "--enable-openai"
+	OpenAIArgumentModelName         = "--openai-model-name"
+	OpenAIArgumentInputName         = "--openai-input-name"
+	OpenAIArgumentOutputName        = "--openai-output-name"
+	ResponseCacheEnableFlag         = "--enable-response-cache"
+	ResponseCacheArgumentTTL        = "--response-cache-ttl"
+	ResponseCacheArgumentEntries    = "--response-cache-max-entries"
+	ResponseCacheArgumentRedis      = "--response-cache-redis-address"
+	ResponseCacheArgumentKeyPrefix  = "--response-cache-key-prefix"
+	MetricAggregationEnableFlag     = "--enable-metric-aggregation"
+	MetricAggregationArgumentPort   = "--component-metrics-port"
+	MetricAggregationArgumentPath   = "--component-metrics-path"
+	MetricsArgumentRevision         = "--revision"
+	TracingArgumentEndpoint         = "--tracing-endpoint"
+	TracingArgumentSamplingPercent  = "--tracing-sampling-percent"
+	AuthenticationArgumentAudiences = "--authentication-audiences"
+	AuthenticationArgumentIssuer    = "--authentication-issuer"
 )
 
 type AgentConfig struct {
@@ -120,8 +150,15 @@ func (ag *AgentInjector) InjectAgent(pod *v1.Pod) error {
 	_, injectLogger := pod.ObjectMeta.Annotations[constants.LoggerInternalAnnotationKey]
 	_, injectPuller := pod.ObjectMeta.Annotations[constants.AgentShouldInjectAnnotationKey]
 	_, injectBatcher := pod.ObjectMeta.Annotations[constants.BatcherInternalAnnotationKey]
-
-	if !injectLogger && !injectPuller && !injectBatcher {
+	outlierDetectorUrl, injectOutlierDetector := pod.ObjectMeta.Annotations[constants.OutlierDetectorUrlInternalAnnotationKey]
+	_, injectRequestQueue := pod.ObjectMeta.Annotations[constants.RequestQueueInternalAnnotationKey]
+	_, injectOpenAI := pod.ObjectMeta.Annotations[constants.OpenAIInternalAnnotationKey]
+	_, injectResponseCache := pod.ObjectMeta.Annotations[constants.ResponseCacheInternalAnnotationKey]
+	tracingEndpoint, injectTracing := pod.ObjectMeta.Annotations[constants.TracingEndpointInternalAnnotationKey]
+	audiences, injectAuthentication := pod.ObjectMeta.Annotations[constants.AuthenticationAudiencesInternalAnnotationKey]
+
+	if !injectLogger && !injectPuller && !injectBatcher && !injectOutlierDetector && !injectRequestQueue && !injectOpenAI &&
+		!injectResponseCache && !injectTracing && !injectAuthentication {
 		return nil
 	}
 
@@ -195,8 +232,127 @@ func (ag *AgentInjector) InjectAgent(pod *v1.Pod) error {
 			LoggerArgumentComponent,
 			component,
 		}
+		if samplingPercent, ok := pod.ObjectMeta.Annotations[constants.LoggerSamplingPercentInternalAnnotationKey]; ok {
+			loggerArgs = append(loggerArgs, LoggerArgumentSamplingPercent, samplingPercent)
+		}
+		if redact, ok := pod.ObjectMeta.Annotations[constants.LoggerRedactInternalAnnotationKey]; ok {
+			loggerArgs = append(loggerArgs, LoggerArgumentRedact, redact)
+		}
+		if kafkaBrokers, ok := pod.ObjectMeta.Annotations[constants.LoggerKafkaBrokersInternalAnnotationKey]; ok {
+			loggerArgs = append(loggerArgs, LoggerArgumentKafkaBrokers, kafkaBrokers, LoggerArgumentKafkaTopic,
+				pod.ObjectMeta.Annotations[constants.LoggerKafkaTopicInternalAnnotationKey])
+			if _, ok := pod.ObjectMeta.Annotations[constants.LoggerKafkaSecretInternalAnnotationKey]; ok {
+				loggerArgs = append(loggerArgs, LoggerArgumentKafkaSecretDir, constants.LoggerKafkaSecretDir)
+			}
+		}
+		if storageUri, ok := pod.ObjectMeta.Annotations[constants.LoggerStorageUriInternalAnnotationKey]; ok {
+			loggerArgs = append(loggerArgs, LoggerArgumentStorageUri, storageUri)
+			if batchSize, ok := pod.ObjectMeta.Annotations[constants.LoggerStorageBatchSizeInternalAnnotationKey]; ok {
+				loggerArgs = append(loggerArgs, LoggerArgumentStorageBatchSize, batchSize)
+			}
+			if flushInterval, ok := pod.ObjectMeta.Annotations[constants.LoggerStorageFlushIntervalInternalAnnotationKey]; ok {
+				loggerArgs = append(loggerArgs, LoggerArgumentStorageFlush, flushInterval+"s")
+			}
+		}
 		args = append(args, loggerArgs...)
 	}
+	// Only inject if the inline outlier detector annotation is set
+	if injectOutlierDetector {
+		args = append(args, OutlierDetectorArgumentUrl)
+		args = append(args, outlierDetectorUrl)
+	}
+	// Only inject if the request queue annotations are set
+	if injectRequestQueue {
+		args = append(args, RequestQueueEnableFlag)
+		maxInFlight, ok := pod.ObjectMeta.Annotations[constants.RequestQueueMaxInFlightInternalAnnotationKey]
+		if ok {
+			args = append(args, RequestQueueArgumentInFlight)
+			args = append(args, maxInFlight)
+		}
+
+		maxQueueDepth, ok := pod.ObjectMeta.Annotations[constants.RequestQueueMaxDepthInternalAnnotationKey]
+		if ok {
+			args = append(args, RequestQueueArgumentMaxDepth)
+			args = append(args, maxQueueDepth)
+		}
+
+		retryAfter, ok := pod.ObjectMeta.Annotations[constants.RequestQueueRetryAfterInternalAnnotationKey]
+		if ok {
+			args = append(args, RequestQueueArgumentRetryAfter)
+			args = append(args, retryAfter)
+		}
+	}
+	// Only inject if the OpenAI annotation is set, the model server serves the model under the InferenceService name
+	if injectOpenAI {
+		args = append(args, OpenAIEnableFlag)
+		args = append(args, OpenAIArgumentModelName)
+		args = append(args, pod.ObjectMeta.Labels[constants.InferenceServiceLabel])
+		inputName, ok := pod.ObjectMeta.Annotations[constants.OpenAIInputNameInternalAnnotationKey]
+		if ok {
+			args = append(args, OpenAIArgumentInputName)
+			args = append(args, inputName)
+		}
+
+		outputName, ok := pod.ObjectMeta.Annotations[constants.OpenAIOutputNameInternalAnnotationKey]
+		if ok {
+			args = append(args, OpenAIArgumentOutputName)
+			args = append(args, outputName)
+		}
+	}
+	// Only inject if the response cache annotation is set, the cache keys of the InferenceServices sharing a Redis
+	// server are prefixed with the namespace and the name of the InferenceService
+	if injectResponseCache {
+		args = append(args, ResponseCacheEnableFlag)
+		args = append(args, ResponseCacheArgumentKeyPrefix)
+		args = append(args, pod.ObjectMeta.Namespace+"/"+pod.ObjectMeta.Labels[constants.InferenceServiceLabel])
+		ttl, ok := pod.ObjectMeta.Annotations[constants.ResponseCacheTTLInternalAnnotationKey]
+		if ok {
+			args = append(args, ResponseCacheArgumentTTL)
+			args = append(args, ttl)
+		}
+
+		maxEntries, ok := pod.ObjectMeta.Annotations[constants.ResponseCacheMaxEntriesInternalAnnotationKey]
+		if ok {
+			args = append(args, ResponseCacheArgumentEntries)
+			args = append(args, maxEntries)
+		}
+
+		redisAddress, ok := pod.ObjectMeta.Annotations[constants.ResponseCacheRedisAddressInternalAnnotationKey]
+		if ok {
+			args = append(args, ResponseCacheArgumentRedis)
+			args = append(args, redisAddress)
+		}
+	}
+	// Only inject if the tracing annotations are set
+	if injectTracing {
+		args = append(args, TracingArgumentEndpoint)
+		args = append(args, tracingEndpoint)
+		samplingPercent, ok := pod.ObjectMeta.Annotations[constants.TracingSamplingPercentInternalAnnotationKey]
+		if ok {
+			args = append(args, TracingArgumentSamplingPercent)
+			args = append(args, samplingPercent)
+		}
+	}
+	// Only inject if the authentication annotations are set, the service account tokens are verified without an issuer
+	if injectAuthentication {
+		args = append(args, AuthenticationArgumentAudiences)
+		args = append(args, audiences)
+		issuer, ok := pod.ObjectMeta.Annotations[constants.AuthenticationIssuerInternalAnnotationKey]
+		if ok {
+			args = append(args, AuthenticationArgumentIssuer)
+			args = append(args, issuer)
+		}
+	}
+
+	// The agent metrics are labelled with the InferenceService component, the logger passes the same arguments
+	if inferenceServiceName, ok := pod.ObjectMeta.Labels[constants.InferenceServiceLabel]; ok && !injectLogger {
+		args = append(args, LoggerArgumentInferenceService, inferenceServiceName)
+		args = append(args, LoggerArgumentNamespace, pod.ObjectMeta.Namespace)
+		args = append(args, LoggerArgumentComponent, pod.ObjectMeta.Labels[constants.KServiceComponentLabel])
+	}
+	if revision, ok := pod.ObjectMeta.Labels[constants.RevisionLabel]; ok {
+		args = append(args, MetricsArgumentRevision, revision)
+	}
 
 	var queueProxyEnvs []v1.EnvVar
 	var agentEnvs []v1.EnvVar
@@ -220,6 +376,27 @@ func (ag *AgentInjector) InjectAgent(pod *v1.Pod) error {
 		}
 	}
 
+	// Without a queue-proxy to aggregate the metrics, e.g. in RawDeployment mode, the agent serves the kserve-container
+	// metrics together with its own on the agent metrics port
+	aggregateMetrics := !queueProxyAvailable && pod.ObjectMeta.Annotations[constants.EnableMetricAggregation] == "true"
+	if aggregateMetrics {
+		kserveContainerPromPort := defaultPrometheusPort
+		if port, ok := pod.ObjectMeta.Annotations[constants.KserveContainerPrometheusPortKey]; ok {
+			kserveContainerPromPort = port
+		}
+		kserveContainerPromPath := constants.DefaultPrometheusPath
+		if path, ok := pod.ObjectMeta.Annotations[constants.KServeContainerPrometheusPathKey]; ok {
+			kserveContainerPromPath = path
+		}
+		args = append(args, MetricAggregationEnableFlag)
+		args = append(args, MetricAggregationArgumentPort, kserveContainerPromPort)
+		args = append(args, MetricAggregationArgumentPath, kserveContainerPromPath)
+		if pod.ObjectMeta.Annotations[constants.SetPrometheusAggregateAnnotation] == "true" {
+			pod.ObjectMeta.Annotations[constants.PrometheusPortAnnotationKey] = fmt.Sprint(constants.InferenceServiceAgentMetricsPort)
+			pod.ObjectMeta.Annotations[constants.PrometheusPathAnnotationKey] = constants.DefaultPrometheusPath
+		}
+	}
+
 	if !queueProxyAvailable {
 		readinessProbeJson, err := json.Marshal(pod.Spec.Containers[0].ReadinessProbe)
 		if err != nil {
@@ -235,6 +412,18 @@ func (ag *AgentInjector) InjectAgent(pod *v1.Pod) error {
 		}
 	}
 
+	if redisSecret, ok := pod.ObjectMeta.Annotations[constants.ResponseCacheRedisSecretInternalAnnotationKey]; ok && injectResponseCache {
+		agentEnvs = append(agentEnvs, v1.EnvVar{
+			Name: constants.ResponseCacheRedisPasswordEnvVar,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: redisSecret},
+					Key:                  constants.ResponseCacheRedisPasswordSecretKey,
+				},
+			},
+		})
+	}
+
 	// Make sure securityContext is initialized and valid
 	securityContext := pod.Spec.Containers[0].SecurityContext.DeepCopy()
 
@@ -278,6 +467,15 @@ func (ag *AgentInjector) InjectAgent(pod *v1.Pod) error {
 		},
 	}
 
+	// The agent metrics are served on their own port as the agent port proxies all paths to the model server
+	if injectRequestQueue || aggregateMetrics {
+		agentContainer.Ports = append(agentContainer.Ports, v1.ContainerPort{
+			Name:          "agent-metrics",
+			ContainerPort: constants.InferenceServiceAgentMetricsPort,
+			Protocol:      "TCP",
+		})
+	}
+
 	// Inject credentials
 	if err := ag.credentialBuilder.CreateSecretVolumeAndEnv(
 		pod.Namespace,
@@ -291,6 +489,16 @@ func (ag *AgentInjector) InjectAgent(pod *v1.Pod) error {
 	// Add container to the spec
 	pod.Spec.Containers = append(pod.Spec.Containers, *agentContainer)
 
+	// Mount the secret of the Kafka sink of the logger to the model agent container
+	if kafkaSecret, ok := pod.ObjectMeta.Annotations[constants.LoggerKafkaSecretInternalAnnotationKey]; ok && injectLogger {
+		mountVolumeToContainer(constants.AgentContainerName, pod, v1.Volume{
+			Name: constants.LoggerKafkaSecretVolumeName,
+			VolumeSource: v1.VolumeSource{
+				Secret: &v1.SecretVolumeSource{SecretName: kafkaSecret},
+			},
+		}, constants.LoggerKafkaSecretDir)
+	}
+
 	if _, ok := pod.ObjectMeta.Annotations[constants.AgentShouldInjectAnnotationKey]; ok {
 		// Mount the modelDir volume to the pod and model agent container
 		err := mountModelDir(pod)
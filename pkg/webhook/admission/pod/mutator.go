@@ -23,6 +23,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	k8types "k8s.io/apimachinery/pkg/types"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/credentials"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -62,6 +63,16 @@ func (mutator *Mutator) Handle(ctx context.Context, req admission.Request) admis
 	// For some reason pod namespace is always empty when coming to pod mutator, need to set from admission request
 	pod.Namespace = req.AdmissionRequest.Namespace
 
+	policies, err := v1alpha1.GetServingPolicies(mutator.Client, pod.Namespace)
+	if err != nil {
+		log.Error(err, "Failed to list serving policies", "name", pod.Labels[constants.InferenceServicePodLabelKey])
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if err := validateServingPolicies(pod, policies); err != nil {
+		log.Info("Pod violates a serving policy", "name", pod.Labels[constants.InferenceServicePodLabelKey], "error", err)
+		return admission.Denied(err.Error())
+	}
+
 	if err := mutator.mutate(pod, configMap); err != nil {
 		log.Error(err, "Failed to mutate pod", "name", pod.Labels[constants.InferenceServicePodLabelKey])
 		return admission.Errored(http.StatusInternalServerError, err)
@@ -87,6 +98,7 @@ func (mutator *Mutator) mutate(pod *v1.Pod, configMap *v1.ConfigMap) error {
 	storageInitializer := &StorageInitializerInjector{
 		credentialBuilder: credentialBuilder,
 		config:            storageInitializerConfig,
+		client:            mutator.Client,
 	}
 
 	loggerConfig, err := getLoggerConfigs(configMap)
@@ -127,6 +139,20 @@ func (mutator *Mutator) mutate(pod *v1.Pod, configMap *v1.ConfigMap) error {
 	return nil
 }
 
+// validateServingPolicies checks the images of the pod containers, which include the serving runtime images the
+// validation webhook cannot see
+func validateServingPolicies(pod *v1.Pod, policies []v1alpha1.ClusterServingPolicy) error {
+	containers := append(append([]v1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for i := range policies {
+		for _, container := range containers {
+			if err := policies[i].ValidateImage(container.Image); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func needMutate(pod *v1.Pod) bool {
 	// Skip webhook if pod not managed by kserve
 	_, ok := pod.Labels[constants.InferenceServicePodLabelKey]
@@ -3,8 +3,10 @@ package pod
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/onsi/gomega"
 	gomegaTypes "github.com/onsi/gomega/types"
@@ -295,3 +297,23 @@ func TestMutator_Handle(t *testing.T) {
 	}
 
 }
+
+func TestValidateServingPolicies(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	policies := []v1alpha1.ClusterServingPolicy{{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec:       v1alpha1.ServingPolicySpec{AllowedImageRegistries: []string{"gcr.io/ml"}},
+	}}
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: constants.InferenceServiceContainerName, Image: "gcr.io/ml/sklearnserver:v0.9.0"}},
+		},
+	}
+	g.Expect(validateServingPolicies(pod, nil)).To(gomega.Succeed())
+	g.Expect(validateServingPolicies(pod, policies)).To(gomega.Succeed())
+
+	// The images of the serving runtimes are checked as well
+	pod.Spec.InitContainers = []v1.Container{{Name: "model-puller", Image: "busybox"}}
+	g.Expect(validateServingPolicies(pod, policies)).To(gomega.MatchError(
+		fmt.Sprintf(v1alpha1.ServingPolicyImageRegistryError, "busybox", "production", "gcr.io/ml")))
+}
@@ -19,12 +19,19 @@ package pod
 import (
 	"encoding/json"
 	"fmt"
+	"path"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/credentials"
+	"github.com/kserve/kserve/pkg/utils"
 
 	v1 "k8s.io/api/core/v1"
 )
@@ -38,6 +45,22 @@ const (
 	PvcURIPrefix                            = "pvc://"
 	PvcSourceMountName                      = "kserve-pvc-source"
 	PvcSourceMountPath                      = "/mnt/pvc"
+	OciURIPrefix                            = "oci://"
+	ModelcarContainerName                   = "modelcar"
+	ModelcarInitContainerName               = "modelcar-init"
+	// ModelcarImageModelPath is where the model has to be placed in a modelcar image
+	ModelcarImageModelPath = "/models"
+	ModelCacheVolumeName   = "kserve-model-cache"
+	ModelCacheMountPath    = "/mnt/cache"
+	ModelCacheDirEnvVarKey = "STORAGE_CACHE_DIR"
+	// ModelCacheSizeLimitEnvVarKey is the size of the cache in bytes, least recently used models are evicted above it
+	ModelCacheSizeLimitEnvVarKey = "STORAGE_CACHE_SIZE_LIMIT"
+	// DownloadConcurrencyEnvVarKey is the number of files or parts of a file the storage initializer downloads at once
+	DownloadConcurrencyEnvVarKey = "STORAGE_DOWNLOAD_CONCURRENCY"
+	// DownloadChunkSizeEnvVarKey is the part size in bytes above which a file is downloaded with ranged requests
+	DownloadChunkSizeEnvVarKey = "STORAGE_DOWNLOAD_CHUNK_SIZE"
+	// DownloadRetriesEnvVarKey is how often the storage initializer retries a download after a transient error
+	DownloadRetriesEnvVarKey = "STORAGE_DOWNLOAD_RETRIES"
 )
 
 type StorageInitializerConfig struct {
@@ -47,11 +70,30 @@ type StorageInitializerConfig struct {
 	MemoryRequest         string `json:"memoryRequest"`
 	MemoryLimit           string `json:"memoryLimit"`
 	StorageSpecSecretName string `json:"storageSpecSecretName"`
+	// EnableDirectPvcVolumeMount mounts the path of pvc:// storage URIs read-only at the model mount path instead of
+	// linking the model from an init container, the path must then be a directory
+	EnableDirectPvcVolumeMount bool `json:"enableDirectPvcVolumeMount"`
+	// EnableModelcar serves oci:// storage URIs from a sidecar running the model image, so the model is pulled and
+	// cached by the container runtime like any other image instead of being downloaded on every pod start
+	EnableModelcar bool `json:"enableModelcar"`
+	// CacheHostPath is a directory on the node where downloaded models are cached keyed by storage URI and version, so
+	// replicas and restarts on the same node skip the download. Caching is disabled when it is empty.
+	CacheHostPath string `json:"cacheHostPath,omitempty"`
+	// CacheSizeLimit bounds the size of the node cache, e.g. 100Gi
+	CacheSizeLimit string `json:"cacheSizeLimit,omitempty"`
+	// DownloadConcurrency is the number of files, or parts of large files, which are downloaded in parallel
+	DownloadConcurrency int `json:"downloadConcurrency,omitempty"`
+	// DownloadChunkSize is the size of the parts large files are split into, e.g. 64Mi
+	DownloadChunkSize string `json:"downloadChunkSize,omitempty"`
+	// DownloadRetries is how often a download is retried with backoff after a transient storage error, it is resumed
+	// from the bytes already downloaded where the storage supports it
+	DownloadRetries *int `json:"downloadRetries,omitempty"`
 }
 
 type StorageInitializerInjector struct {
 	credentialBuilder *credentials.CredentialBuilder
 	config            *StorageInitializerConfig
+	client            client.Client
 }
 
 func getStorageInitializerConfigs(configMap *v1.ConfigMap) (*StorageInitializerConfig, error) {
@@ -73,6 +115,16 @@ func getStorageInitializerConfigs(configMap *v1.ConfigMap) (*StorageInitializerC
 			return storageInitializerConfig, fmt.Errorf("Failed to parse resource configuration for %q: %q", StorageInitializerConfigMapKeyName, err.Error())
 		}
 	}
+	if storageInitializerConfig.CacheSizeLimit != "" {
+		if _, err := resource.ParseQuantity(storageInitializerConfig.CacheSizeLimit); err != nil {
+			return storageInitializerConfig, fmt.Errorf("Failed to parse cache size limit for %q: %q", StorageInitializerConfigMapKeyName, err.Error())
+		}
+	}
+	if storageInitializerConfig.DownloadChunkSize != "" {
+		if _, err := resource.ParseQuantity(storageInitializerConfig.DownloadChunkSize); err != nil {
+			return storageInitializerConfig, fmt.Errorf("Failed to parse download chunk size for %q: %q", StorageInitializerConfigMapKeyName, err.Error())
+		}
+	}
 
 	return storageInitializerConfig, nil
 }
@@ -113,6 +165,31 @@ func (mi *StorageInitializerInjector) InjectStorageInitializer(pod *v1.Pod) erro
 		return fmt.Errorf("Invalid configuration: cannot find container: %s", constants.InferenceServiceContainerName)
 	}
 
+	// Use the model mount path configured for the model format if present
+	modelMountPath := constants.DefaultModelLocalMountPath
+	if mountPath, ok := pod.ObjectMeta.Annotations[constants.ModelMountPathInternalAnnotationKey]; ok && mountPath != "" {
+		modelMountPath = mountPath
+	}
+
+	if strings.HasPrefix(srcURI, PvcURIPrefix) && mi.config != nil && mi.config.EnableDirectPvcVolumeMount {
+		return mountPvcDirectly(pod, userContainer, srcURI, modelMountPath)
+	}
+
+	if strings.HasPrefix(srcURI, OciURIPrefix) {
+		if mi.config == nil || !mi.config.EnableModelcar {
+			return fmt.Errorf("Invalid configuration: %s storage URIs require enableModelcar in the %s config", OciURIPrefix, StorageInitializerConfigMapKeyName)
+		}
+		return mi.injectModelcar(pod, userContainer, srcURI, modelMountPath)
+	}
+
+	storageContainer, err := mi.getStorageContainer(srcURI)
+	if err != nil {
+		return err
+	}
+	if storageContainer == nil && !isBuiltInStorageURI(srcURI) {
+		return fmt.Errorf("Invalid configuration: no ClusterStorageContainer supports the storage URI %s", srcURI)
+	}
+
 	podVolumes := []v1.Volume{}
 	storageInitializerMounts := []v1.VolumeMount{}
 
@@ -162,7 +239,7 @@ func (mi *StorageInitializerInjector) InjectStorageInitializer(pod *v1.Pod) erro
 	// Create a write mount into the shared volume
 	sharedVolumeWriteMount := v1.VolumeMount{
 		Name:      StorageInitializerVolumeName,
-		MountPath: constants.DefaultModelLocalMountPath,
+		MountPath: modelMountPath,
 		ReadOnly:  false,
 	}
 	storageInitializerMounts = append(storageInitializerMounts, sharedVolumeWriteMount)
@@ -179,7 +256,7 @@ func (mi *StorageInitializerInjector) InjectStorageInitializer(pod *v1.Pod) erro
 		Image: storageInitializerImage,
 		Args: []string{
 			srcURI,
-			constants.DefaultModelLocalMountPath,
+			modelMountPath,
 		},
 		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 		VolumeMounts:             storageInitializerMounts,
@@ -195,18 +272,42 @@ func (mi *StorageInitializerInjector) InjectStorageInitializer(pod *v1.Pod) erro
 		},
 		SecurityContext: securityContext,
 	}
+	if storageContainer != nil {
+		initContainer = customStorageInitializer(storageContainer, initContainer)
+	} else if mi.config != nil {
+		if mi.config.CacheHostPath != "" && !strings.HasPrefix(srcURI, PvcSourceMountPath) {
+			podVolumes = append(podVolumes, mi.addModelCache(initContainer))
+		}
+		initContainer.Env = append(initContainer.Env, DownloadEnvs(mi.config)...)
+	}
+	if sha256, ok := pod.ObjectMeta.Annotations[constants.StorageInitializerSha256InternalAnnotationKey]; ok {
+		initContainer.Env = append(initContainer.Env, v1.EnvVar{
+			Name:  constants.StorageSha256EnvVarKey,
+			Value: sha256,
+		})
+	}
+	if verification, ok := pod.ObjectMeta.Annotations[constants.StorageVerificationInternalAnnotationKey]; ok {
+		// Only the built-in storage initializer verifies signatures, a custom container would skip the check
+		if storageContainer != nil {
+			return fmt.Errorf("Invalid configuration: model verification is not supported by the ClusterStorageContainer for the storage URI %s", srcURI)
+		}
+		initContainer.Env = append(initContainer.Env, v1.EnvVar{
+			Name:  constants.StorageVerificationEnvVarKey,
+			Value: verification,
+		})
+	}
 
 	// Add a mount the shared volume on the kserve-container, update the PodSpec
 	sharedVolumeReadMount := v1.VolumeMount{
 		Name:      StorageInitializerVolumeName,
-		MountPath: constants.DefaultModelLocalMountPath,
+		MountPath: modelMountPath,
 		ReadOnly:  true,
 	}
 	userContainer.VolumeMounts = append(userContainer.VolumeMounts, sharedVolumeReadMount)
 	// Change the CustomSpecStorageUri env variable value to the default model path if present
 	for index, envVar := range userContainer.Env {
 		if envVar.Name == constants.CustomSpecStorageUriEnvVarKey && envVar.Value != "" {
-			userContainer.Env[index].Value = constants.DefaultModelLocalMountPath
+			userContainer.Env[index].Value = modelMountPath
 		}
 	}
 
@@ -255,6 +356,194 @@ func (mi *StorageInitializerInjector) InjectStorageInitializer(pod *v1.Pod) erro
 	return nil
 }
 
+// mountPvcDirectly mounts the path on the PVC read-only at the model mount path of the serving container, so the model
+// is served straight from the PVC without running the storage initializer
+func mountPvcDirectly(pod *v1.Pod, userContainer *v1.Container, srcURI string, modelMountPath string) error {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == PvcSourceMountName {
+			return nil
+		}
+	}
+	pvcName, pvcPath, err := parsePvcURI(srcURI)
+	if err != nil {
+		return err
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: PvcSourceMountName,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvcName,
+				ReadOnly:  true,
+			},
+		},
+	})
+	userContainer.VolumeMounts = append(userContainer.VolumeMounts, v1.VolumeMount{
+		Name:      PvcSourceMountName,
+		MountPath: modelMountPath,
+		SubPath:   pvcPath,
+		ReadOnly:  true,
+	})
+	return nil
+}
+
+// addModelCache mounts the node cache into the storage initializer and returns the host path volume backing it
+func (mi *StorageInitializerInjector) addModelCache(initContainer *v1.Container) v1.Volume {
+	hostPathType := v1.HostPathDirectoryOrCreate
+	initContainer.VolumeMounts = append(initContainer.VolumeMounts, v1.VolumeMount{
+		Name:      ModelCacheVolumeName,
+		MountPath: ModelCacheMountPath,
+	})
+	initContainer.Env = append(initContainer.Env, v1.EnvVar{
+		Name:  ModelCacheDirEnvVarKey,
+		Value: ModelCacheMountPath,
+	})
+	if mi.config.CacheSizeLimit != "" {
+		sizeLimit := resource.MustParse(mi.config.CacheSizeLimit)
+		initContainer.Env = append(initContainer.Env, v1.EnvVar{
+			Name:  ModelCacheSizeLimitEnvVarKey,
+			Value: strconv.FormatInt(sizeLimit.Value(), 10),
+		})
+	}
+	return v1.Volume{
+		Name: ModelCacheVolumeName,
+		VolumeSource: v1.VolumeSource{
+			HostPath: &v1.HostPathVolumeSource{
+				Path: mi.config.CacheHostPath,
+				Type: &hostPathType,
+			},
+		},
+	}
+}
+
+// getStorageContainer returns the container of the ClusterStorageContainer which supports the storage URI, or nil
+// when there is none
+func (mi *StorageInitializerInjector) getStorageContainer(storageURI string) (*v1.Container, error) {
+	if mi.client == nil {
+		return nil, nil
+	}
+	return v1alpha1.GetStorageContainer(mi.client, storageURI)
+}
+
+// isBuiltInStorageURI returns true for local paths and the storage URIs the storage initializer downloads from
+func isBuiltInStorageURI(storageURI string) bool {
+	return !regexp.MustCompile(v1beta1.StorageURISchemeRegEx).MatchString(storageURI) ||
+		strings.HasPrefix(storageURI, v1beta1.AzureURIPrefix) ||
+		strings.Contains(storageURI, v1beta1.AzureBlobURL) ||
+		utils.IsPrefixSupported(storageURI, v1beta1.SupportedStorageURIPrefixList)
+}
+
+// customStorageInitializer runs the container of a ClusterStorageContainer in place of the storage initializer, it
+// keeps the name, arguments and model mount of the storage initializer and its resources unless the container sets them
+func customStorageInitializer(storageContainer *v1.Container, initContainer *v1.Container) *v1.Container {
+	storageContainer.Name = initContainer.Name
+	storageContainer.Args = initContainer.Args
+	storageContainer.Env = append(storageContainer.Env, initContainer.Env...)
+	storageContainer.VolumeMounts = append(storageContainer.VolumeMounts, initContainer.VolumeMounts...)
+	storageContainer.TerminationMessagePolicy = initContainer.TerminationMessagePolicy
+	if len(storageContainer.Resources.Limits) == 0 && len(storageContainer.Resources.Requests) == 0 {
+		storageContainer.Resources = initContainer.Resources
+	}
+	if storageContainer.SecurityContext == nil {
+		storageContainer.SecurityContext = initContainer.SecurityContext
+	}
+	return storageContainer
+}
+
+// DownloadEnvs returns the env variables which configure the parallel downloads and retries of the storage initializer
+func DownloadEnvs(config *StorageInitializerConfig) []v1.EnvVar {
+	var envs []v1.EnvVar
+	if config.DownloadConcurrency > 0 {
+		envs = append(envs, v1.EnvVar{
+			Name:  DownloadConcurrencyEnvVarKey,
+			Value: strconv.Itoa(config.DownloadConcurrency),
+		})
+	}
+	if config.DownloadChunkSize != "" {
+		chunkSize := resource.MustParse(config.DownloadChunkSize)
+		envs = append(envs, v1.EnvVar{
+			Name:  DownloadChunkSizeEnvVarKey,
+			Value: strconv.FormatInt(chunkSize.Value(), 10),
+		})
+	}
+	if config.DownloadRetries != nil {
+		envs = append(envs, v1.EnvVar{
+			Name:  DownloadRetriesEnvVarKey,
+			Value: strconv.Itoa(*config.DownloadRetries),
+		})
+	}
+	return envs
+}
+
+// injectModelcar runs the model image as a sidecar of the serving container. The containers share the process namespace
+// and a volume at the parent of the model mount path, the sidecar links its model directory into the volume through
+// /proc so the serving container reads the model straight from the image filesystem. An init container runs the same
+// image first, which makes sure the image is pulled before the serving container starts.
+func (mi *StorageInitializerInjector) injectModelcar(pod *v1.Pod, userContainer *v1.Container, srcURI string, modelMountPath string) error {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == ModelcarContainerName {
+			return nil
+		}
+	}
+	image := strings.TrimPrefix(srcURI, OciURIPrefix)
+	if image == "" {
+		return fmt.Errorf("Invalid URI must be %s<image>: %s", OciURIPrefix, srcURI)
+	}
+	volumeMountPath := path.Dir(modelMountPath)
+	if volumeMountPath == "/" {
+		return fmt.Errorf("Invalid configuration: model mount path %s must not be a top level directory for modelcars", modelMountPath)
+	}
+
+	volumeMount := v1.VolumeMount{
+		Name:      StorageInitializerVolumeName,
+		MountPath: volumeMountPath,
+	}
+	resources := v1.ResourceRequirements{
+		Limits: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU:    resource.MustParse(mi.config.CpuLimit),
+			v1.ResourceMemory: resource.MustParse(mi.config.MemoryLimit),
+		},
+		Requests: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU:    resource.MustParse(mi.config.CpuRequest),
+			v1.ResourceMemory: resource.MustParse(mi.config.MemoryRequest),
+		},
+	}
+	// Mount the volume before adding the sidecar, appending to the containers may move the serving container
+	userContainer.VolumeMounts = append(userContainer.VolumeMounts, v1.VolumeMount{
+		Name:      StorageInitializerVolumeName,
+		MountPath: volumeMountPath,
+		ReadOnly:  true,
+	})
+	securityContext := userContainer.SecurityContext
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, v1.Container{
+		Name:                     ModelcarInitContainerName,
+		Image:                    image,
+		Command:                  []string{"sh", "-c", "echo Prefetched model image"},
+		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+		Resources:                resources,
+		SecurityContext:          securityContext.DeepCopy(),
+	})
+	// The sidecar runs as the same user as the serving container, otherwise the link through /proc is not readable
+	pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{
+		Name:  ModelcarContainerName,
+		Image: image,
+		Command: []string{"sh", "-c",
+			fmt.Sprintf("ln -sfn /proc/$$/root%s %s && sleep infinity", ModelcarImageModelPath, modelMountPath)},
+		TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+		VolumeMounts:             []v1.VolumeMount{volumeMount},
+		Resources:                resources,
+		SecurityContext:          securityContext.DeepCopy(),
+	})
+	pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+		Name: StorageInitializerVolumeName,
+		VolumeSource: v1.VolumeSource{
+			EmptyDir: &v1.EmptyDirVolumeSource{},
+		},
+	})
+	shareProcessNamespace := true
+	pod.Spec.ShareProcessNamespace = &shareProcessNamespace
+	return nil
+}
+
 func parsePvcURI(srcURI string) (pvcName string, pvcPath string, err error) {
 	parts := strings.Split(strings.TrimPrefix(srcURI, PvcURIPrefix), "/")
 	if len(parts) > 1 {
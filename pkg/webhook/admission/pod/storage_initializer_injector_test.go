@@ -21,8 +21,11 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/pkg/kmp"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	"github.com/kserve/kserve/pkg/constants"
 	"github.com/kserve/kserve/pkg/credentials"
 	"github.com/kserve/kserve/pkg/credentials/gcs"
@@ -193,6 +196,68 @@ func TestStorageInitializerInjector(t *testing.T) {
 				},
 			},
 		},
+		"StorageInitializerInjectedWithModelMountPath": {
+			original: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.StorageInitializerSourceUriInternalAnnotationKey: "gs://foo",
+						constants.ModelMountPathInternalAnnotationKey:              "/models",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: constants.InferenceServiceContainerName,
+						},
+					},
+				},
+			},
+			expected: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.StorageInitializerSourceUriInternalAnnotationKey: "gs://foo",
+						constants.ModelMountPathInternalAnnotationKey:              "/models",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: constants.InferenceServiceContainerName,
+							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      "kserve-provision-location",
+									MountPath: "/models",
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+					InitContainers: []v1.Container{
+						{
+							Name:                     "storage-initializer",
+							Image:                    StorageInitializerContainerImage + ":" + StorageInitializerContainerImageVersion,
+							Args:                     []string{"gs://foo", "/models"},
+							Resources:                resourceRequirement,
+							TerminationMessagePolicy: "FallbackToLogsOnError",
+							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      "kserve-provision-location",
+									MountPath: "/models",
+								},
+							},
+						},
+					},
+					Volumes: []v1.Volume{
+						{
+							Name: "kserve-provision-location",
+							VolumeSource: v1.VolumeSource{
+								EmptyDir: &v1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+				},
+			},
+		},
 		"StorageInitializerInjectedAndMountsPvc": {
 			original: &v1.Pod{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1044,6 +1109,421 @@ func TestStorageInitializerConfigmap(t *testing.T) {
 	}
 }
 
+func TestDirectPvcVolumeMount(t *testing.T) {
+	scenarios := map[string]struct {
+		original *v1.Pod
+		expected *v1.Pod
+	}{
+		"MountsPvcSubPath": {
+			original: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.StorageInitializerSourceUriInternalAnnotationKey: "pvc://mypvcname/some/path/on/pvc",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: constants.InferenceServiceContainerName,
+						},
+					},
+				},
+			},
+			expected: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: constants.InferenceServiceContainerName,
+							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      "kserve-pvc-source",
+									MountPath: constants.DefaultModelLocalMountPath,
+									SubPath:   "some/path/on/pvc",
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+					Volumes: []v1.Volume{
+						{
+							Name: "kserve-pvc-source",
+							VolumeSource: v1.VolumeSource{
+								PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "mypvcname",
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"MountsPvcRootAtModelMountPath": {
+			original: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						constants.StorageInitializerSourceUriInternalAnnotationKey: "pvc://mypvcname",
+						constants.ModelMountPathInternalAnnotationKey:              "/models",
+					},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: constants.InferenceServiceContainerName,
+						},
+					},
+				},
+			},
+			expected: &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{
+							Name: constants.InferenceServiceContainerName,
+							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      "kserve-pvc-source",
+									MountPath: "/models",
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+					Volumes: []v1.Volume{
+						{
+							Name: "kserve-pvc-source",
+							VolumeSource: v1.VolumeSource{
+								PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "mypvcname",
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, scenario := range scenarios {
+		injector := &StorageInitializerInjector{
+			config: &StorageInitializerConfig{
+				EnableDirectPvcVolumeMount: true,
+			},
+		}
+		if err := injector.InjectStorageInitializer(scenario.original); err != nil {
+			t.Errorf("Test %q unexpected result: %s", name, err)
+		}
+		// injecting again does not mount the PVC twice
+		if err := injector.InjectStorageInitializer(scenario.original); err != nil {
+			t.Errorf("Test %q unexpected result: %s", name, err)
+		}
+		if diff, _ := kmp.SafeDiff(scenario.expected.Spec, scenario.original.Spec); diff != "" {
+			t.Errorf("Test %q unexpected result (-want +got): %v", name, diff)
+		}
+	}
+}
+
+func TestModelcar(t *testing.T) {
+	resources := v1.ResourceRequirements{
+		Limits: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU:    resource.MustParse(StorageInitializerDefaultCPULimit),
+			v1.ResourceMemory: resource.MustParse(StorageInitializerDefaultMemoryLimit),
+		},
+		Requests: map[v1.ResourceName]resource.Quantity{
+			v1.ResourceCPU:    resource.MustParse(StorageInitializerDefaultCPURequest),
+			v1.ResourceMemory: resource.MustParse(StorageInitializerDefaultMemoryRequest),
+		},
+	}
+	shareProcessNamespace := true
+	original := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.StorageInitializerSourceUriInternalAnnotationKey: "oci://registry.example.com/models/sklearn-iris:v1",
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: constants.InferenceServiceContainerName,
+				},
+			},
+		},
+	}
+	expected := v1.PodSpec{
+		InitContainers: []v1.Container{
+			{
+				Name:                     ModelcarInitContainerName,
+				Image:                    "registry.example.com/models/sklearn-iris:v1",
+				Command:                  []string{"sh", "-c", "echo Prefetched model image"},
+				TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+				Resources:                resources,
+			},
+		},
+		Containers: []v1.Container{
+			{
+				Name: constants.InferenceServiceContainerName,
+				VolumeMounts: []v1.VolumeMount{
+					{
+						Name:      StorageInitializerVolumeName,
+						MountPath: "/mnt",
+						ReadOnly:  true,
+					},
+				},
+			},
+			{
+				Name:                     ModelcarContainerName,
+				Image:                    "registry.example.com/models/sklearn-iris:v1",
+				Command:                  []string{"sh", "-c", "ln -sfn /proc/$$/root/models /mnt/models && sleep infinity"},
+				TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+				VolumeMounts: []v1.VolumeMount{
+					{
+						Name:      StorageInitializerVolumeName,
+						MountPath: "/mnt",
+					},
+				},
+				Resources: resources,
+			},
+		},
+		Volumes: []v1.Volume{
+			{
+				Name: StorageInitializerVolumeName,
+				VolumeSource: v1.VolumeSource{
+					EmptyDir: &v1.EmptyDirVolumeSource{},
+				},
+			},
+		},
+		ShareProcessNamespace: &shareProcessNamespace,
+	}
+
+	config := *storageInitializerConfig
+	config.EnableModelcar = true
+	injector := &StorageInitializerInjector{config: &config}
+	if err := injector.InjectStorageInitializer(original); err != nil {
+		t.Errorf("Test %q unexpected result: %s", "Modelcar", err)
+	}
+	// injecting again does not add a second sidecar
+	if err := injector.InjectStorageInitializer(original); err != nil {
+		t.Errorf("Test %q unexpected result: %s", "Modelcar", err)
+	}
+	if diff, _ := kmp.SafeDiff(expected, original.Spec); diff != "" {
+		t.Errorf("Test %q unexpected result (-want +got): %v", "Modelcar", diff)
+	}
+
+	// oci:// URIs are rejected when modelcars are not enabled
+	disabled := &StorageInitializerInjector{config: storageInitializerConfig}
+	if err := disabled.InjectStorageInitializer(&v1.Pod{
+		ObjectMeta: original.ObjectMeta,
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: constants.InferenceServiceContainerName}},
+		},
+	}); err == nil {
+		t.Errorf("Test %q expected an error when modelcars are disabled", "ModelcarDisabled")
+	}
+}
+
+func TestClusterStorageContainer(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(s); err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	if err := v1.AddToScheme(s); err != nil {
+		t.Errorf("unable to add scheme : %v", err)
+	}
+	disabled := true
+	storageContainers := &v1alpha1.ClusterStorageContainerList{
+		Items: []v1alpha1.ClusterStorageContainer{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ftp-legacy"},
+				Spec: v1alpha1.StorageContainerSpec{
+					Container:           v1.Container{Name: "ftp", Image: "example.com/ftp-downloader:v0"},
+					SupportedUriFormats: []v1alpha1.SupportedUriFormat{{Prefix: "ftp://"}},
+				},
+				Disabled: &disabled,
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ftp"},
+				Spec: v1alpha1.StorageContainerSpec{
+					Container: v1.Container{
+						Name:  "ftp",
+						Image: "example.com/ftp-downloader:v1",
+						Env:   []v1.EnvVar{{Name: "FTP_PASSIVE", Value: "true"}},
+					},
+					SupportedUriFormats: []v1alpha1.SupportedUriFormat{{Regex: "^ftp://.+"}},
+				},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(s).WithLists(storageContainers).Build()
+	injector := &StorageInitializerInjector{
+		credentialBuilder: credentials.NewCredentialBulder(c, &v1.ConfigMap{}),
+		config:            storageInitializerConfig,
+		client:            c,
+	}
+	original := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.StorageInitializerSourceUriInternalAnnotationKey: "ftp://models.example.com/iris",
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: constants.InferenceServiceContainerName}},
+		},
+	}
+	expected := []v1.Container{
+		{
+			Name:  StorageInitializerContainerName,
+			Image: "example.com/ftp-downloader:v1",
+			Args:  []string{"ftp://models.example.com/iris", constants.DefaultModelLocalMountPath},
+			Env:   []v1.EnvVar{{Name: "FTP_PASSIVE", Value: "true"}},
+			Resources: v1.ResourceRequirements{
+				Limits: map[v1.ResourceName]resource.Quantity{
+					v1.ResourceCPU:    resource.MustParse(StorageInitializerDefaultCPULimit),
+					v1.ResourceMemory: resource.MustParse(StorageInitializerDefaultMemoryLimit),
+				},
+				Requests: map[v1.ResourceName]resource.Quantity{
+					v1.ResourceCPU:    resource.MustParse(StorageInitializerDefaultCPURequest),
+					v1.ResourceMemory: resource.MustParse(StorageInitializerDefaultMemoryRequest),
+				},
+			},
+			TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
+			VolumeMounts: []v1.VolumeMount{
+				{
+					Name:      StorageInitializerVolumeName,
+					MountPath: constants.DefaultModelLocalMountPath,
+				},
+			},
+		},
+	}
+	if err := injector.InjectStorageInitializer(original); err != nil {
+		t.Errorf("Test %q unexpected result: %s", "ClusterStorageContainer", err)
+	}
+	if diff, _ := kmp.SafeDiff(expected, original.Spec.InitContainers); diff != "" {
+		t.Errorf("Test %q unexpected result (-want +got): %v", "ClusterStorageContainer", diff)
+	}
+
+	// storage URIs which neither the storage initializer nor a storage container supports are rejected
+	if err := injector.InjectStorageInitializer(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.StorageInitializerSourceUriInternalAnnotationKey: "sftp://models.example.com/iris",
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: constants.InferenceServiceContainerName}},
+		},
+	}); err == nil {
+		t.Errorf("Test %q expected an error for an unsupported storage URI", "ClusterStorageContainer")
+	}
+
+	// storage containers do not verify model signatures, so verified models are rejected
+	if err := injector.InjectStorageInitializer(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.StorageInitializerSourceUriInternalAnnotationKey: "ftp://models.example.com/iris",
+				constants.StorageVerificationInternalAnnotationKey:         `{"type":"cosign","publicKey":"key"}`,
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: constants.InferenceServiceContainerName}},
+		},
+	}); err == nil {
+		t.Errorf("Test %q expected an error for model verification", "ClusterStorageContainer")
+	}
+}
+
+func TestModelVerification(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	injector := &StorageInitializerInjector{
+		credentialBuilder: credentials.NewCredentialBulder(c, &v1.ConfigMap{}),
+		config:            storageInitializerConfig,
+	}
+	verification := `{"type":"gpg","publicKey":"key","signatureUri":"https://models.example.com/iris.tar.gz.asc"}`
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				constants.StorageInitializerSourceUriInternalAnnotationKey: "https://models.example.com/iris.tar.gz",
+				constants.StorageVerificationInternalAnnotationKey:         verification,
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: constants.InferenceServiceContainerName}},
+		},
+	}
+	g.Expect(injector.InjectStorageInitializer(pod)).To(gomega.Succeed())
+	g.Expect(pod.Spec.InitContainers).To(gomega.HaveLen(1))
+	g.Expect(pod.Spec.InitContainers[0].Env).To(gomega.ContainElement(
+		v1.EnvVar{Name: constants.StorageVerificationEnvVarKey, Value: verification}))
+}
+
+func TestAddModelCache(t *testing.T) {
+	config := *storageInitializerConfig
+	config.CacheHostPath = "/var/cache/kserve"
+	config.CacheSizeLimit = "10Gi"
+	injector := &StorageInitializerInjector{config: &config}
+	initContainer := &v1.Container{Name: StorageInitializerContainerName}
+
+	volume := injector.addModelCache(initContainer)
+
+	hostPathType := v1.HostPathDirectoryOrCreate
+	expectedVolume := v1.Volume{
+		Name: ModelCacheVolumeName,
+		VolumeSource: v1.VolumeSource{
+			HostPath: &v1.HostPathVolumeSource{
+				Path: "/var/cache/kserve",
+				Type: &hostPathType,
+			},
+		},
+	}
+	expectedContainer := &v1.Container{
+		Name: StorageInitializerContainerName,
+		Env: []v1.EnvVar{
+			{Name: ModelCacheDirEnvVarKey, Value: ModelCacheMountPath},
+			{Name: ModelCacheSizeLimitEnvVarKey, Value: "10737418240"},
+		},
+		VolumeMounts: []v1.VolumeMount{
+			{Name: ModelCacheVolumeName, MountPath: ModelCacheMountPath},
+		},
+	}
+	if diff, _ := kmp.SafeDiff(expectedVolume, volume); diff != "" {
+		t.Errorf("Test %q unexpected volume (-want +got): %v", "ModelCache", diff)
+	}
+	if diff, _ := kmp.SafeDiff(expectedContainer, initContainer); diff != "" {
+		t.Errorf("Test %q unexpected container (-want +got): %v", "ModelCache", diff)
+	}
+}
+
+func TestDownloadEnvs(t *testing.T) {
+	noRetries := 0
+	scenarios := map[string]struct {
+		config   StorageInitializerConfig
+		expected []v1.EnvVar
+	}{
+		"Defaults": {
+			config:   StorageInitializerConfig{},
+			expected: nil,
+		},
+		"ParallelDownloads": {
+			config: StorageInitializerConfig{DownloadConcurrency: 8, DownloadChunkSize: "64Mi"},
+			expected: []v1.EnvVar{
+				{Name: DownloadConcurrencyEnvVarKey, Value: "8"},
+				{Name: DownloadChunkSizeEnvVarKey, Value: "67108864"},
+			},
+		},
+		"RetriesDisabled": {
+			config: StorageInitializerConfig{DownloadRetries: &noRetries},
+			expected: []v1.EnvVar{
+				{Name: DownloadRetriesEnvVarKey, Value: "0"},
+			},
+		},
+	}
+	for name, scenario := range scenarios {
+		envs := DownloadEnvs(&scenario.config)
+		if diff, _ := kmp.SafeDiff(scenario.expected, envs); diff != "" {
+			t.Errorf("Test %q unexpected envs (-want +got): %v", name, diff)
+		}
+	}
+}
+
 func TestGetStorageInitializerConfigs(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	cases := []struct {
@@ -20,6 +20,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	pkgtest "github.com/kserve/kserve/pkg/testing"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -33,6 +34,9 @@ var c client.Client
 func TestMain(m *testing.M) {
 	t := pkgtest.SetupEnvTest()
 	var err error
+	if err = v1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		klog.Error(err, "Failed to add v1alpha1 to scheme")
+	}
 	if cfg, err = t.Start(); err != nil {
 		klog.Error(err, "Failed to start testing panel")
 	}
@@ -0,0 +1,335 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kserve/kserve/pkg/controller/v1beta1/inferenceservice"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("inferenceservice-dry-run")
+
+const (
+	// Path the handler is registered at on the webhook server
+	Path = "/dry-run-inferenceservice"
+	// Namespace of the manifests which do not set one
+	defaultNamespace = "default"
+	// maxManifestBytes bounds the size of the posted manifests
+	maxManifestBytes = 1 << 20
+	bearerPrefix     = "Bearer "
+)
+
+// Response is the result of a dry run
+type Response struct {
+	// InferenceService with the defaults of the mutating webhook applied
+	InferenceService *v1beta1.InferenceService `json:"inferenceService,omitempty"`
+	// Resources the controller creates for the InferenceService, in creation order
+	Resources []runtime.Object `json:"resources,omitempty"`
+	// Error rejecting the manifest, either by the validation webhook or by the controller
+	Error string `json:"error,omitempty"`
+}
+
+// Handler validates the InferenceService manifests posted as YAML or JSON and returns the defaulted object with the
+// child resources the controller would create for it. The controller reconciles against an in-memory client seeded
+// with the inferenceservice configmap, the serving runtimes and the serving policies of the cluster, so nothing in the
+// cluster is changed. The callers authenticate with a bearer token and must be allowed to create the InferenceService in
+// its namespace.
+type Handler struct {
+	// Client reading the configmap and the serving runtimes, and reviewing the tokens of the callers
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	user, err := h.authenticate(r)
+	if err != nil {
+		log.Error(err, "Failed to review the token of the dry run request")
+		http.Error(w, "failed to review the bearer token", http.StatusInternalServerError)
+		return
+	}
+	if user == nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "a valid bearer token is required", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxManifestBytes+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxManifestBytes {
+		http.Error(w, fmt.Sprintf("the manifest exceeds %d bytes", maxManifestBytes), http.StatusRequestEntityTooLarge)
+		return
+	}
+	posted := &v1beta1.InferenceService{}
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(body), 4096).Decode(posted); err != nil {
+		log.Error(err, "Failed to decode InferenceService")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Only the fields a user may set on creation are kept, the status in particular would drive the rollout logic of
+	// the controller
+	isvc := &v1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        posted.Name,
+			Namespace:   posted.Namespace,
+			Labels:      posted.Labels,
+			Annotations: posted.Annotations,
+		},
+		Spec: posted.Spec,
+	}
+	if isvc.Namespace == "" {
+		isvc.Namespace = defaultNamespace
+	}
+
+	allowed, err := h.authorize(r.Context(), user, isvc)
+	if err != nil {
+		log.Error(err, "Failed to review the access of the dry run request", "user", user.Username)
+		http.Error(w, "failed to review the access of the caller", http.StatusInternalServerError)
+		return
+	}
+	if !allowed {
+		http.Error(w, fmt.Sprintf("%s is not allowed to create InferenceServices in the namespace %s",
+			user.Username, isvc.Namespace), http.StatusForbidden)
+		return
+	}
+
+	status := http.StatusOK
+	response, err := h.DryRun(r.Context(), isvc)
+	if err != nil {
+		log.Error(err, "Failed to dry run InferenceService", "name", isvc.Name)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if response.Error != "" {
+		status = http.StatusUnprocessableEntity
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Error(err, "Failed to encode dry run response", "name", isvc.Name)
+	}
+}
+
+// DryRun defaults, validates and reconciles the InferenceService, the rejections are reported in the response and the
+// returned error is set only when the cluster state cannot be read
+func (h *Handler) DryRun(ctx context.Context, isvc *v1beta1.InferenceService) (*Response, error) {
+	if isvc.Namespace == "" {
+		isvc.Namespace = defaultNamespace
+	}
+	if isvc.Annotations == nil {
+		isvc.Annotations = map[string]string{}
+	}
+
+	isvcConfig, err := v1beta1.NewInferenceServicesConfig(h.Client)
+	if err != nil {
+		return nil, err
+	}
+	deployConfig, err := v1beta1.NewDeployConfig(h.Client)
+	if err != nil {
+		return nil, err
+	}
+	isvc.DefaultInferenceService(isvcConfig, deployConfig)
+	response := &Response{InferenceService: isvc.DeepCopy()}
+	if err := isvc.ValidateCreate(); err != nil {
+		response.Error = err.Error()
+		return response, nil
+	}
+
+	objects, err := h.clusterObjects(ctx, isvc.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	// The in-memory client holds none of the child resources, so the controller only takes its creation paths and
+	// never analyzes canaries or calls the analysis webhooks
+	recorder := &recordingClient{
+		Client: fake.NewClientBuilder().WithScheme(h.Scheme).WithObjects(append(objects, isvc)...).Build(),
+	}
+	reconciler := &inferenceservice.InferenceServiceReconciler{
+		Client:   recorder,
+		Log:      log,
+		Scheme:   h.Scheme,
+		Recorder: &record.FakeRecorder{},
+		DryRun:   true,
+	}
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{
+		Name:      isvc.Name,
+		Namespace: isvc.Namespace,
+	}}); err != nil {
+		response.Error = err.Error()
+	}
+
+	for _, created := range recorder.created {
+		// The resources of other namespaces, e.g. the certificates of the ingress gateway, are not shown to the
+		// callers which may only access the namespace of the InferenceService
+		if created.GetNamespace() != isvc.Namespace {
+			continue
+		}
+		if err := recorder.Get(ctx, client.ObjectKeyFromObject(created), created); err != nil {
+			return nil, err
+		}
+		gvk, err := apiutil.GVKForObject(created, h.Scheme)
+		if err != nil {
+			return nil, err
+		}
+		created.GetObjectKind().SetGroupVersionKind(gvk)
+		response.Resources = append(response.Resources, created)
+	}
+	return response, nil
+}
+
+// authenticate returns the user of the bearer token of the request, or nil when the token is missing or invalid
+func (h *Handler) authenticate(r *http.Request) (*authenticationv1.UserInfo, error) {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return nil, nil
+	}
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: authorization[len(bearerPrefix):]},
+	}
+	if err := h.Client.Create(r.Context(), review); err != nil {
+		return nil, err
+	}
+	if !review.Status.Authenticated {
+		return nil, nil
+	}
+	return &review.Status.User, nil
+}
+
+// authorize checks that the user may create the InferenceService, the dry run reveals the serving runtimes and the
+// configuration of the namespace
+func (h *Handler) authorize(ctx context.Context, user *authenticationv1.UserInfo, isvc *v1beta1.InferenceService) (bool, error) {
+	extra := map[string]authorizationv1.ExtraValue{}
+	for key, values := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(values)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: isvc.Namespace,
+				Verb:      "create",
+				Group:     v1beta1.SchemeGroupVersion.Group,
+				Resource:  "inferenceservices",
+				Name:      isvc.Name,
+			},
+		},
+	}
+	if err := h.Client.Create(ctx, review); err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+// clusterObjects returns the objects of the cluster the controller reads while reconciling
+func (h *Handler) clusterObjects(ctx context.Context, namespace string) ([]client.Object, error) {
+	configMap := &v1.ConfigMap{}
+	if err := h.Client.Get(ctx, types.NamespacedName{Name: constants.InferenceServiceConfigMapName,
+		Namespace: constants.KServeNamespace}, configMap); err != nil {
+		return nil, err
+	}
+	objects := []client.Object{configMap}
+
+	runtimes := &v1alpha1.ServingRuntimeList{}
+	if err := h.Client.List(ctx, runtimes, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for i := range runtimes.Items {
+		objects = append(objects, &runtimes.Items[i])
+	}
+	clusterRuntimes := &v1alpha1.ClusterServingRuntimeList{}
+	if err := h.Client.List(ctx, clusterRuntimes); err != nil {
+		return nil, err
+	}
+	for i := range clusterRuntimes.Items {
+		objects = append(objects, &clusterRuntimes.Items[i])
+	}
+	storageContainers := &v1alpha1.ClusterStorageContainerList{}
+	if err := h.Client.List(ctx, storageContainers); err != nil {
+		return nil, err
+	}
+	for i := range storageContainers.Items {
+		objects = append(objects, &storageContainers.Items[i])
+	}
+
+	policies := &v1alpha1.ClusterServingPolicyList{}
+	if err := h.Client.List(ctx, policies); err != nil && !meta.IsNoMatchError(err) {
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return objects, nil
+	}
+	for i := range policies.Items {
+		objects = append(objects, &policies.Items[i])
+	}
+	// The policies select the namespaces by their labels, a namespace which does not exist yet has none
+	ns := &v1.Namespace{}
+	if err := h.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if !apierr.IsNotFound(err) {
+			return nil, err
+		}
+		ns.Name = namespace
+	}
+	return append(objects, ns), nil
+}
+
+// recordingClient records the objects created through it
+type recordingClient struct {
+	client.Client
+	created []client.Object
+}
+
+func (c *recordingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.created = append(c.created, obj.DeepCopyObject().(client.Object))
+	return nil
+}
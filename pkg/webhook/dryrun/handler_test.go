@@ -0,0 +1,225 @@
+/*
+Copyright 2022 The KServe Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	validToken  = "valid"
+	deniedToken = "denied"
+)
+
+const manifest = `
+apiVersion: serving.kserve.io/v1beta1
+kind: InferenceService
+metadata:
+  name: sklearn-iris
+  annotations:
+    serving.kserve.io/deploymentMode: RawDeployment
+spec:
+  predictor:
+    sklearn:
+      storageUri: gs://kfserving-examples/models/sklearn/1.0/model
+`
+
+type responseKinds struct {
+	InferenceService *v1beta1.InferenceService `json:"inferenceService"`
+	Resources        []metav1.TypeMeta         `json:"resources"`
+	Error            string                    `json:"error"`
+}
+
+// reviewClient answers the token and access reviews of the API server, the valid token may create InferenceServices in
+// the default namespace and the denied one may not
+type reviewClient struct {
+	client.Client
+}
+
+func (c *reviewClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	switch review := obj.(type) {
+	case *authenticationv1.TokenReview:
+		if review.Spec.Token == validToken || review.Spec.Token == deniedToken {
+			review.Status.Authenticated = true
+			review.Status.User.Username = review.Spec.Token
+		}
+		return nil
+	case *authorizationv1.SubjectAccessReview:
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == validToken && attributes.Namespace == "default" &&
+			attributes.Verb == "create" && attributes.Group == "serving.kserve.io" && attributes.Resource == "inferenceservices"
+		return nil
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func dryRunRequest(token string, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req
+}
+
+func newHandler(g *gomega.WithT) *Handler {
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(v1beta1.AddToScheme(scheme)).To(gomega.Succeed())
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.InferenceServiceConfigMapName,
+			Namespace: constants.KServeNamespace,
+		},
+		Data: map[string]string{
+			"ingress": `{
+				"ingressGateway": "knative-serving/knative-ingress-gateway",
+				"ingressService": "test-destination",
+				"localGateway": "knative-serving/knative-local-gateway",
+				"localGatewayService": "knative-local-gateway.istio-system.svc.cluster.local",
+				"ingressDomain": "example.com"
+			}`,
+		},
+	}
+	servingRuntime := &v1alpha1.ClusterServingRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "kserve-sklearnserver"},
+		Spec: v1alpha1.ServingRuntimeSpec{
+			SupportedModelFormats: []v1alpha1.SupportedModelFormat{
+				{Name: "sklearn", Version: proto.String("1"), AutoSelect: proto.Bool(true)},
+			},
+			ServingRuntimePodSpec: v1alpha1.ServingRuntimePodSpec{
+				Containers: []v1.Container{
+					{Name: constants.InferenceServiceContainerName, Image: "kserve/sklearnserver:latest"},
+				},
+			},
+		},
+	}
+	return &Handler{
+		Client: &reviewClient{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap, servingRuntime).Build()},
+		Scheme: scheme,
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	handler := newHandler(g)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, dryRunRequest(validToken, manifest))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK), w.Body.String())
+
+	response := &responseKinds{}
+	g.Expect(json.Unmarshal(w.Body.Bytes(), response)).To(gomega.Succeed())
+	g.Expect(response.Error).To(gomega.BeEmpty())
+	g.Expect(response.InferenceService.Namespace).To(gomega.Equal("default"))
+	g.Expect(response.InferenceService.Spec.Predictor.SKLearn).To(gomega.BeNil())
+	g.Expect(response.InferenceService.Spec.Predictor.Model.ModelFormat.Name).To(gomega.Equal("sklearn"))
+	kinds := []string{}
+	for _, resource := range response.Resources {
+		kinds = append(kinds, resource.Kind)
+	}
+	g.Expect(kinds).To(gomega.ContainElements("Deployment", "Service", "HorizontalPodAutoscaler"))
+
+	// Nothing is created in the cluster
+	isvcs := &v1beta1.InferenceServiceList{}
+	g.Expect(handler.Client.List(context.TODO(), isvcs)).To(gomega.Succeed())
+	g.Expect(isvcs.Items).To(gomega.BeEmpty())
+}
+
+func TestDryRunInvalid(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	handler := newHandler(g)
+
+	w := httptest.NewRecorder()
+	invalid := strings.Replace(manifest, "sklearn-iris", "1-sklearn-iris", 1)
+	handler.ServeHTTP(w, dryRunRequest(validToken, invalid))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusUnprocessableEntity))
+	response := &responseKinds{}
+	g.Expect(json.Unmarshal(w.Body.Bytes(), response)).To(gomega.Succeed())
+	g.Expect(response.Error).To(gomega.ContainSubstring("is invalid"))
+	g.Expect(response.Resources).To(gomega.BeEmpty())
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, dryRunRequest(validToken, "spec: ["))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusBadRequest))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, Path, nil))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusMethodNotAllowed))
+}
+
+func TestDryRunStatusIgnored(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	handler := newHandler(g)
+
+	withStatus := manifest + `
+status:
+  url: http://attacker.example.com
+  components:
+    predictor:
+      latestReadyRevision: sklearn-iris-predictor-00002
+`
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, dryRunRequest(validToken, withStatus))
+	g.Expect(w.Code).To(gomega.Equal(http.StatusOK), w.Body.String())
+	response := &responseKinds{}
+	g.Expect(json.Unmarshal(w.Body.Bytes(), response)).To(gomega.Succeed())
+	g.Expect(response.InferenceService.Status.URL).To(gomega.BeNil())
+	g.Expect(response.InferenceService.Status.Components).To(gomega.BeEmpty())
+}
+
+func TestDryRunAccess(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	handler := newHandler(g)
+
+	scenarios := map[string]struct {
+		token  string
+		body   string
+		status int
+	}{
+		"Anonymous":      {body: manifest, status: http.StatusUnauthorized},
+		"InvalidToken":   {token: "invalid", body: manifest, status: http.StatusUnauthorized},
+		"Denied":         {token: deniedToken, body: manifest, status: http.StatusForbidden},
+		"OtherNamespace": {token: validToken, body: strings.Replace(manifest, "name: sklearn-iris", "name: sklearn-iris\n  namespace: other", 1), status: http.StatusForbidden},
+		"TooLarge":       {token: validToken, body: manifest + "#" + strings.Repeat("x", maxManifestBytes), status: http.StatusRequestEntityTooLarge},
+	}
+	for name, scenario := range scenarios {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, dryRunRequest(scenario.token, scenario.body))
+		g.Expect(w.Code).To(gomega.Equal(scenario.status), name)
+	}
+}
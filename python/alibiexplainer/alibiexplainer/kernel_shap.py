@@ -0,0 +1,44 @@
+# Copyright 2021 The KServe Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+import kserve
+import logging
+import numpy as np
+import alibi
+from alibi.api.interfaces import Explanation
+from alibiexplainer.explainer_wrapper import ExplainerWrapper
+from typing import Callable, List, Optional, Dict
+
+logging.basicConfig(level=kserve.constants.KSERVE_LOGLEVEL)
+
+
+class KernelShap(ExplainerWrapper):
+    def __init__(
+        self,
+        predict_fn: Callable,
+        explainer: Optional[alibi.explainers.KernelShap],
+        **kwargs
+    ):
+        if explainer is None:
+            raise Exception("Kernel SHAP requires a built explainer fitted on the background data")
+        self.predict_fn = predict_fn
+        self.kernel_shap = explainer
+        self.kwargs = kwargs
+
+    def explain(self, inputs: List, headers: Dict[str, str] = None) -> Explanation:
+        arr = np.array(inputs)
+        # the explainer is fitted offline, point it at the predictor served alongside it
+        self.kernel_shap.reset_predictor(self.predict_fn)
+        logging.info("Calling Kernel SHAP explain on input of shape %s", (arr.shape,))
+        shap_exp = self.kernel_shap.explain(arr, **self.kwargs)
+        return shap_exp
@@ -214,6 +214,23 @@ def parse_args(sys_args):
     )
     addCommonParserArgs(parser_anchor_images)
 
+    # Kernel SHAP Arguments
+    parser_kernel_shap = subparsers.add_parser(str(ExplainerMethod.kernel_shap))
+    parser_kernel_shap.add_argument(
+        "--nsamples",
+        type=int,
+        action=GroupedAction,
+        dest="explainer.nsamples",
+        default=argparse.SUPPRESS,
+    )
+    parser_kernel_shap.add_argument(
+        "--l1_reg",
+        type=str,
+        action=GroupedAction,
+        dest="explainer.l1_reg",
+        default=argparse.SUPPRESS,
+    )
+
     args, _ = parser.parse_known_args(sys_args)
 
     argdDict = vars(args).copy()
@@ -134,3 +134,22 @@ def test_anchor_images_parser():
     parser, _ = parse_args(args)
     assert parser.predictor_host == PREDICTOR_HOST
     assert parser.explainer.p_sample == P_SAMPLE
+
+
+NSAMPLES = 200
+L1_REG = "num_features(10)"
+
+
+def test_kernel_shap_parser():
+    args = [
+        "--predictor_host",
+        PREDICTOR_HOST,
+        "KernelShap",
+        "--nsamples",
+        str(NSAMPLES),
+        "--l1_reg",
+        L1_REG,
+    ]
+    parser, extra = parse_args(args)
+    assert parser.predictor_host == PREDICTOR_HOST
+    assert extra == {"nsamples": NSAMPLES, "l1_reg": L1_REG}
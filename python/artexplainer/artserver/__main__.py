@@ -22,6 +22,8 @@ DEFAULT_ADVERSARY_TYPE = "SquareAttack"
 
 DEFAULT_MAX_ITER = "1000"
 DEFAULT_NB_CLASSES = "10"
+DEFAULT_BIT_DEPTH = "8"
+DEFAULT_CLIP_MAX = "1.0"
 
 parser = argparse.ArgumentParser(parents=[kserve.model_server.parser])
 parser.add_argument('--model_name', default=DEFAULT_MODEL_NAME,
@@ -32,12 +34,17 @@ parser.add_argument('--max_iter', default=DEFAULT_MAX_ITER,
                     help='The max number of iterations to run.')
 parser.add_argument('--nb_classes', default=DEFAULT_NB_CLASSES,
                     help='The number of different classification types.')
+parser.add_argument('--bit_depth', default=DEFAULT_BIT_DEPTH,
+                    help='The bit depth the inputs are squeezed to by the feature squeezing detector.')
+parser.add_argument('--clip_max', default=DEFAULT_CLIP_MAX,
+                    help='The maximum value of the input features.')
 
 parser.add_argument('--predictor_host', help='The host for the predictor', required=True)
 args, _ = parser.parse_known_args()
 
 if __name__ == "__main__":
     model = ARTModel(args.model_name, args.predictor_host, adversary_type=args.adversary_type,
-                     nb_classes=args.nb_classes, max_iter=args.max_iter)
+                     nb_classes=args.nb_classes, max_iter=args.max_iter,
+                     bit_depth=args.bit_depth, clip_max=args.clip_max)
     model.load()
     kserve.ModelServer().start([model], nest_asyncio=True)
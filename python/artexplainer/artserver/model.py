@@ -17,22 +17,28 @@ from typing import Dict
 
 import numpy as np
 from art.attacks.evasion.square_attack import SquareAttack
+from art.defences.preprocessor import FeatureSqueezing
 from art.estimators.classification import BlackBoxClassifierNeuralNetwork
 
 import kserve
 
 
+ADVERSARY_TYPES = ["squareattack", "featuresqueezing"]
+
+
 class ARTModel(kserve.Model):  # pylint:disable=c-extension-no-member
     def __init__(self, name: str, predictor_host: str, adversary_type: str,
-                 nb_classes: str, max_iter: str):
+                 nb_classes: str, max_iter: str, bit_depth: str = "8", clip_max: str = "1.0"):
         super().__init__(name)
         self.name = name
         self.predictor_host = predictor_host
-        if str.lower(adversary_type) != "squareattack":
+        if str.lower(adversary_type) not in ADVERSARY_TYPES:
             raise Exception("Invalid adversary type: %s" % adversary_type)
         self.adversary_type = adversary_type
         self.nb_classes = int(nb_classes)
         self.max_iter = int(max_iter)
+        self.bit_depth = int(bit_depth)
+        self.clip_max = float(clip_max)
         self.ready = False
         self.count = 0
 
@@ -50,6 +56,27 @@ class ARTModel(kserve.Model):  # pylint:disable=c-extension-no-member
         prediction = np.array(resp["predictions"])
         return [1 if x == prediction else 0 for x in range(0, self.nb_classes)]
 
+    def detect_adversarial(self, payload: Dict, headers: Dict[str, str] = None) -> Dict:
+        """
+        Scores the inputs with feature squeezing, an input whose prediction changes once its
+        color depth is reduced is likely to carry an adversarial perturbation.
+        """
+        if str.lower(self.adversary_type) != "featuresqueezing":
+            raise NotImplementedError
+        try:
+            inputs = np.array(payload["instances"])
+        except Exception as e:
+            raise Exception(
+                "Failed to initialize NumPy array from inputs: %s, %s" % (e, payload["instances"]))
+        squeeze = FeatureSqueezing(clip_values=(0.0, self.clip_max), bit_depth=self.bit_depth)
+        squeezed, _ = squeeze(inputs)
+        scores = []
+        for x, x_squeezed in zip(inputs, squeezed):
+            prediction = np.array(self._predict(np.expand_dims(x, 0)))
+            squeezed_prediction = np.array(self._predict(np.expand_dims(x_squeezed, 0)))
+            scores.append(float(np.abs(prediction - squeezed_prediction).sum()))
+        return {"adversarial": [score > 0 for score in scores], "scores": scores}
+
     def explain(self, payload: Dict, headers: Dict[str, str] = None) -> Dict:
         image = payload["instances"][0]
         label = payload["instances"][1]
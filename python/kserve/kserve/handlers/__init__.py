@@ -15,5 +15,5 @@
 from .base import NotFoundHandler  # noqa # pylint: disable=unused-import
 from .health import LivenessHandler, HealthHandler  # noqa # pylint: disable=unused-import
 from .model_management import LoadHandler, UnloadHandler, ListHandler  # noqa # pylint: disable=unused-import
-from .explain import ExplainHandler  # noqa # pylint: disable=unused-import
+from .explain import ExplainHandler, AdversarialHandler  # noqa # pylint: disable=unused-import
 from .predict import PredictHandler  # noqa # pylint: disable=unused-import
@@ -39,3 +39,22 @@ class ExplainHandler(HTTPHandler):
             model_handle = model
             response = await model_handle.remote(body, model_type=ModelType.EXPLAINER, headers=self.request.headers)
         self.write(response)
+
+
+class AdversarialHandler(HTTPHandler):
+    async def post(self, name: str):
+        try:
+            body = json.loads(self.request.body)
+        except json.decoder.JSONDecodeError as e:
+            raise tornado.web.HTTPError(
+                status_code=HTTPStatus.BAD_REQUEST,
+                reason="Unrecognized request format: %s" % e
+            )
+        model = self.get_model(name)
+        if not isinstance(model, RayServeHandle):
+            response = await model(body, model_type=ModelType.ADVERSARIAL_DETECTOR, headers=self.request.headers)
+        else:
+            model_handle = model
+            response = await model_handle.remote(body, model_type=ModelType.ADVERSARIAL_DETECTOR,
+                                                 headers=self.request.headers)
+        self.write(response)
@@ -37,16 +37,22 @@ PREDICTOR_URL_FORMAT = "http://{0}/v1/models/{1}:predict"
 EXPLAINER_URL_FORMAT = "http://{0}/v1/models/{1}:explain"
 PREDICTOR_V2_URL_FORMAT = "http://{0}/v2/models/{1}/infer"
 EXPLAINER_V2_URL_FORMAT = "http://{0}/v2/models/{1}/explain"
+# The W3C trace context headers forwarded to the predictor and the explainer
+TRACE_CONTEXT_HEADERS = ["Traceparent", "Tracestate"]
+# The credentials of the caller, forwarded so that the predictor and the explainer can authenticate the request
+AUTHORIZATION_HEADER = "Authorization"
 
 PRE_HIST_TIME = Histogram('request_preprocessing_seconds', 'pre-processing request latency')
 POST_HIST_TIME = Histogram('request_postprocessing_seconds', 'post-processing request latency')
 PREDICT_HIST_TIME = Histogram('request_predict_processing_seconds', 'prediction request latency')
 EXPLAIN_HIST_TIME = Histogram('request_explain_processing_seconds', 'explain request latency')
+ADVERSARIAL_HIST_TIME = Histogram('request_adversarial_processing_seconds', 'adversarial detection request latency')
 
 
 class ModelType(Enum):
     EXPLAINER = 1
     PREDICTOR = 2
+    ADVERSARIAL_DETECTOR = 3
 
 
 class PredictorProtocol(Enum):
@@ -118,6 +124,13 @@ class Model:
                 response = (await self.predict(payload, headers)) if inspect.iscoroutinefunction(self.predict) \
                     else self.predict(payload, headers)
                 predict_ms = get_latency_ms(start, time.time())
+        elif model_type == ModelType.ADVERSARIAL_DETECTOR:
+            with ADVERSARIAL_HIST_TIME.time():
+                start = time.time()
+                response = (await self.detect_adversarial(payload, headers)) \
+                    if inspect.iscoroutinefunction(self.detect_adversarial) \
+                    else self.detect_adversarial(payload, headers)
+                explain_ms = get_latency_ms(start, time.time())
         else:
             raise NotImplementedError
 
@@ -231,6 +244,12 @@ class Model:
                 predict_headers['X-Request-Id'] = headers['X-Request-Id']
             if 'X-B3-Traceid' in headers:
                 predict_headers['X-B3-Traceid'] = headers['X-B3-Traceid']
+            # Propagate the W3C trace context so that the predictor spans are children of the caller span
+            for trace_header in TRACE_CONTEXT_HEADERS:
+                if trace_header in headers:
+                    predict_headers[trace_header] = headers[trace_header]
+            if AUTHORIZATION_HEADER in headers:
+                predict_headers[AUTHORIZATION_HEADER] = headers[AUTHORIZATION_HEADER]
 
         response = await self._http_client.fetch(
             predict_url,
@@ -246,7 +265,11 @@ class Model:
         return json.loads(response.body)
 
     async def _grpc_predict(self, payload: ModelInferRequest, headers: Dict[str, str] = None) -> ModelInferResponse:
-        async_result = await self._grpc_client.ModelInfer(request=payload, timeout=self.timeout, headers=headers)
+        metadata = None
+        if headers is not None and AUTHORIZATION_HEADER in headers:
+            # gRPC metadata keys are lower case
+            metadata = [(AUTHORIZATION_HEADER.lower(), headers[AUTHORIZATION_HEADER])]
+        async_result = await self._grpc_client.ModelInfer(request=payload, timeout=self.timeout, metadata=metadata)
         return async_result
 
     async def predict(self, payload: Union[Dict, ModelInferRequest],
@@ -265,6 +288,15 @@ class Model:
         else:
             return await self._http_predict(payload, headers)
 
+    async def detect_adversarial(self, payload: Dict, headers: Dict[str, str] = None) -> Dict:
+        """
+        The adversarial handler can be overridden by explainers which score the inputs for adversarial perturbation.
+        :param payload: Dict passed from preprocess handler
+        :param headers: Dict
+        :return: Dict
+        """
+        raise NotImplementedError
+
     async def explain(self, payload: Dict, headers: Dict[str, str] = None) -> Dict:
         """
         The explain handler can be overridden to implement the model explanation.
@@ -278,10 +310,18 @@ class Model:
         explain_url = EXPLAINER_URL_FORMAT.format(self.explainer_host, self.name)
         if self.protocol == PredictorProtocol.REST_V2.value:
             explain_url = EXPLAINER_V2_URL_FORMAT.format(self.explainer_host, self.name)
+        explain_headers = {}
+        if headers is not None:
+            for trace_header in TRACE_CONTEXT_HEADERS:
+                if trace_header in headers:
+                    explain_headers[trace_header] = headers[trace_header]
+            if AUTHORIZATION_HEADER in headers:
+                explain_headers[AUTHORIZATION_HEADER] = headers[AUTHORIZATION_HEADER]
         response = await self._http_client.fetch(
             url=explain_url,
             method='POST',
             request_timeout=self.timeout,
+            headers=explain_headers,
             body=json.dumps(payload)
         )
         if response.code != 200:
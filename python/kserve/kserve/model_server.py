@@ -97,6 +97,8 @@ class ModelServer:
              handlers.HealthHandler, dict(models=self.registered_models)),
             (r"/v2/models/([a-zA-Z0-9_-]+)/status",
              handlers.HealthHandler, dict(models=self.registered_models)),
+            (r"/v2/models/([a-zA-Z0-9_-]+)/ready",
+             handlers.HealthHandler, dict(models=self.registered_models)),
             (r"/v1/models/([a-zA-Z0-9_-]+):predict",
              handlers.PredictHandler, dict(models=self.registered_models)),
             (r"/v2/models/([a-zA-Z0-9_-]+)/infer",
@@ -105,6 +107,8 @@ class ModelServer:
              handlers.ExplainHandler, dict(models=self.registered_models)),
             (r"/v2/models/([a-zA-Z0-9_-]+)/explain",
              handlers.ExplainHandler, dict(models=self.registered_models)),
+            (r"/v1/models/([a-zA-Z0-9_-]+):adversarial",
+             handlers.AdversarialHandler, dict(models=self.registered_models)),
             (r"/v2/repository/models/([a-zA-Z0-9_-]+)/load",
              handlers.LoadHandler, dict(models=self.registered_models)),
             (r"/v2/repository/models/([a-zA-Z0-9_-]+)/unload",
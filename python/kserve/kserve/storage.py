@@ -13,17 +13,22 @@
 # limitations under the License.
 
 import base64
+import concurrent.futures
 import glob
 import gzip
+import hashlib
 import logging
 import mimetypes
 import os
+import random
 import re
 import json
 import shutil
+import subprocess
 import tarfile
 import tempfile
-from typing import Dict
+import time
+from typing import Dict, Optional, Tuple
 import zipfile
 from urllib.parse import urlparse
 import requests
@@ -34,7 +39,10 @@ from azure.storage.fileshare import ShareServiceClient
 
 from botocore.client import Config
 from botocore import UNSIGNED
+import botocore.exceptions
 import boto3
+from boto3.s3.transfer import TransferConfig
+from google.api_core import exceptions as api_exceptions
 from google.auth import exceptions
 from google.cloud import storage
 
@@ -46,11 +54,32 @@ _HDFS_PREFIX = "hdfs://"
 _WEBHDFS_PREFIX = "webhdfs://"
 _AZURE_BLOB_RE = "https://(.+?).blob.core.windows.net/(.+)"
 _AZURE_FILE_RE = "https://(.+?).file.core.windows.net/(.+)"
+_AZURE_PREFIX = "azure://"
+_AZURE_BLOB_HOST = "blob.core.windows.net"
 _LOCAL_PREFIX = "file://"
 _URI_RE = "https?://(.+)/(.+)"
 _HTTP_PREFIX = "http(s)://"
 _HEADERS_SUFFIX = "-headers"
 _PVC_PREFIX = "/mnt/pvc"
+_STORAGE_SHA256_ENV = "STORAGE_SHA256"
+_STORAGE_VERIFICATION_ENV = "STORAGE_VERIFICATION"
+_SIGNATURE_SUFFIX = ".sig"
+# Node local model cache, mounted by the storage initializer injector
+_STORAGE_CACHE_DIR_ENV = "STORAGE_CACHE_DIR"
+_STORAGE_CACHE_SIZE_LIMIT_ENV = "STORAGE_CACHE_SIZE_LIMIT"
+_CACHE_STAGING_PREFIX = ".staging-"
+# Number of files or parts of a large file which are downloaded in parallel
+_DOWNLOAD_CONCURRENCY_ENV = "STORAGE_DOWNLOAD_CONCURRENCY"
+# Files larger than the chunk size are downloaded in parts with ranged requests
+_DOWNLOAD_CHUNK_SIZE_ENV = "STORAGE_DOWNLOAD_CHUNK_SIZE"
+_DEFAULT_DOWNLOAD_CHUNK_SIZE = 64 * 1024 * 1024
+# Transient storage errors are retried with a jittered exponential backoff
+_DOWNLOAD_RETRIES_ENV = "STORAGE_DOWNLOAD_RETRIES"
+_DEFAULT_DOWNLOAD_RETRIES = 5
+_RETRY_BACKOFF_SECONDS = 1
+_MAX_RETRY_BACKOFF_SECONDS = 60
+# Files are downloaded under this suffix until they are complete, so that an interrupted download can be resumed
+_PARTIAL_SUFFIX = ".part"
 
 _HDFS_SECRET_DIRECTORY = "/var/secrets/kserve-hdfscreds"
 _HDFS_FILE_SECRETS = ["KERBEROS_KEYTAB", "TLS_CERT", "TLS_KEY", "TLS_CA"]
@@ -77,13 +106,37 @@ class Storage(object):  # pylint: disable=too-few-public-methods
         elif not os.path.exists(out_dir):
             os.mkdir(out_dir)
 
+        cache_entry = None if is_local else Storage._get_cache_entry(uri)
+        if cache_entry:
+            return Storage._download_cached(uri, out_dir, cache_entry)
+        return Storage._download_to(uri, out_dir, is_local)
+
+    @staticmethod
+    def resolve_metadata(out_dir: str) -> Dict:
+        """Summarizes the downloaded model artifacts so that what is deployed can be compared to the registry: the
+        total size, a checksum over the relative paths and content of the files and the framework version recorded
+        in the artifacts when it can be detected."""
+        checksum = hashlib.sha256()
+        size = 0
+        for path in sorted(p for p in Path(out_dir).rglob("*") if p.is_file()):
+            relative_path = path.relative_to(out_dir).as_posix()
+            checksum.update(f"{relative_path}\0{_file_sha256(str(path))}\n".encode())
+            size += path.stat().st_size
+        metadata = {"artifactSize": size, "checksum": "sha256:" + checksum.hexdigest()}
+        framework = _detect_framework(out_dir)
+        if framework:
+            metadata["framework"], metadata["frameworkVersion"] = framework
+        return metadata
+
+    @staticmethod
+    def _download_to(uri: str, out_dir: str, is_local: bool) -> str:
         if uri.startswith(_GCS_PREFIX):
             Storage._download_gcs(uri, out_dir)
         elif uri.startswith(_S3_PREFIX):
             Storage._download_s3(uri, out_dir)
         elif uri.startswith(_HDFS_PREFIX) or uri.startswith(_WEBHDFS_PREFIX):
             Storage._download_hdfs(uri, out_dir)
-        elif re.search(_AZURE_BLOB_RE, uri):
+        elif re.search(_AZURE_BLOB_RE, uri) or uri.startswith(_AZURE_PREFIX):
             Storage._download_azure_blob(uri, out_dir)
         elif re.search(_AZURE_FILE_RE, uri):
             Storage._download_azure_file_share(uri, out_dir)
@@ -97,12 +150,108 @@ class Storage(object):  # pylint: disable=too-few-public-methods
             return out_dir
         else:
             raise Exception("Cannot recognize storage type for " + uri +
-                            "\n'%s', '%s', '%s', and '%s' are the current available storage type." %
-                            (_GCS_PREFIX, _S3_PREFIX, _LOCAL_PREFIX, _HTTP_PREFIX))
+                            "\n'%s', '%s', '%s', '%s' and '%s' are the current available storage type." %
+                            (_GCS_PREFIX, _S3_PREFIX, _AZURE_PREFIX, _LOCAL_PREFIX, _HTTP_PREFIX))
+
+        logging.info("Successfully copied %s to %s", uri, out_dir)
+        return out_dir
+
+    @staticmethod
+    def _get_cache_entry(uri: str) -> Optional[str]:
+        cache_dir = os.getenv(_STORAGE_CACHE_DIR_ENV)
+        if not cache_dir:
+            return None
+        try:
+            version = Storage._get_version(uri)
+        except Exception as e:  # pylint: disable=broad-except
+            logging.warning("Not caching %s, failed to get its version: %s", uri, e)
+            return None
+        if not version:
+            return None
+        # A changed checksum or signing key has to be verified again so they are part of the key
+        key = hashlib.sha256(f"{uri}\n{version}\n{os.getenv(_STORAGE_SHA256_ENV, '')}\n"
+                             f"{os.getenv(_STORAGE_VERIFICATION_ENV, '')}".encode()).hexdigest()
+        return os.path.join(cache_dir, key)
+
+    @staticmethod
+    def _get_version(uri: str) -> Optional[str]:
+        """Returns a fingerprint of the current content of the uri, which is None when it cannot be determined."""
+        if uri.startswith(_GCS_PREFIX):
+            try:
+                storage_client = storage.Client()
+            except exceptions.DefaultCredentialsError:
+                storage_client = storage.Client.create_anonymous_client()
+            bucket_name, _, bucket_path = uri[len(_GCS_PREFIX):].partition("/")
+            blobs = storage_client.bucket(bucket_name).list_blobs(prefix=bucket_path)
+            return ",".join(sorted(f"{blob.name}:{blob.etag}" for blob in blobs)) or None
+        if uri.startswith(_S3_PREFIX):
+            kwargs = {"config": Storage.get_S3_config()}
+            endpoint_url = os.getenv("AWS_ENDPOINT_URL")
+            if endpoint_url:
+                kwargs.update({"endpoint_url": endpoint_url})
+            parsed = urlparse(uri, scheme='s3')
+            bucket = boto3.resource("s3", **kwargs).Bucket(parsed.netloc)
+            objects = bucket.objects.filter(Prefix=parsed.path.lstrip('/'))
+            return ",".join(sorted(f"{obj.key}:{obj.e_tag}" for obj in objects)) or None
+        if re.search(_URI_RE, uri) and not re.search(_AZURE_BLOB_RE, uri) and not re.search(_AZURE_FILE_RE, uri):
+            url = urlparse(uri)
+            headers = json.loads(os.getenv(url.hostname + _HEADERS_SUFFIX, "{}"))
+            response = requests.head(uri, headers=headers, allow_redirects=True)
+            if response.status_code != 200:
+                return None
+            return response.headers.get("ETag") or response.headers.get("Last-Modified")
+        return None
 
+    @staticmethod
+    def _download_cached(uri: str, out_dir: str, cache_entry: str) -> str:
+        if os.path.isdir(cache_entry):
+            logging.info("Using cached copy of %s", uri)
+            # Touch the entry so that it is evicted last
+            os.utime(cache_entry)
+        else:
+            cache_dir = os.path.dirname(cache_entry)
+            staging_dir = tempfile.mkdtemp(dir=cache_dir, prefix=_CACHE_STAGING_PREFIX)
+            try:
+                Storage._download_to(uri, staging_dir, False)
+                os.rename(staging_dir, cache_entry)
+            except OSError:
+                # Another pod on the node cached the same model first
+                if not os.path.isdir(cache_entry):
+                    raise
+            finally:
+                shutil.rmtree(staging_dir, ignore_errors=True)
+            Storage._evict_cache(cache_dir, cache_entry)
+        for root, _, files in os.walk(cache_entry):
+            target_dir = os.path.join(out_dir, os.path.relpath(root, cache_entry))
+            os.makedirs(target_dir, exist_ok=True)
+            for f in files:
+                _link_or_copy(os.path.join(root, f), os.path.join(target_dir, f))
         logging.info("Successfully copied %s to %s", uri, out_dir)
         return out_dir
 
+    @staticmethod
+    def _evict_cache(cache_dir: str, keep: str):
+        size_limit = os.getenv(_STORAGE_CACHE_SIZE_LIMIT_ENV)
+        if not size_limit:
+            return
+        entries = []
+        for name in os.listdir(cache_dir):
+            entry = os.path.join(cache_dir, name)
+            if name.startswith(_CACHE_STAGING_PREFIX) or not os.path.isdir(entry):
+                continue
+            size = sum(os.path.getsize(os.path.join(root, f)) for root, _, files in os.walk(entry) for f in files)
+            entries.append((os.path.getmtime(entry), entry, size))
+        total = sum(size for _, _, size in entries)
+        # Evict the least recently used models first, the model which is being served is always kept
+        for _, entry, size in sorted(entries):
+            if total <= int(size_limit):
+                break
+            if entry == keep:
+                continue
+            logging.info("Evicting %s from the model cache", entry)
+            shutil.rmtree(entry, ignore_errors=True)
+            total -= size
+
     @staticmethod
     def _update_with_storage_spec():
         storage_secret_json = json.loads(os.environ.get("STORAGE_CONFIG", "{}"))
@@ -162,6 +311,10 @@ class Storage(object):  # pylint: disable=too-few-public-methods
         bucket_name = parsed.netloc
         bucket_path = parsed.path.lstrip('/')
 
+        chunk_size = Storage._download_chunk_size()
+        transfer_config = TransferConfig(max_concurrency=Storage._download_concurrency(),
+                                         multipart_threshold=chunk_size, multipart_chunksize=chunk_size)
+        downloads = []
         count = 0
         bucket = s3.Bucket(bucket_name)
         for obj in bucket.objects.filter(Prefix=bucket_path):
@@ -190,13 +343,23 @@ class Storage(object):  # pylint: disable=too-few-public-methods
             target = f"{temp_dir}/{target_key}"
             if not os.path.exists(os.path.dirname(target)):
                 os.makedirs(os.path.dirname(target), exist_ok=True)
-            bucket.download_file(obj.key, target)
-            logging.info('Downloaded object %s to %s' % (obj.key, target))
+            # boto3 only moves the object to the target once it is complete, so it was downloaded by an earlier attempt
+            if os.path.exists(target) and os.path.getsize(target) == obj.size:
+                logging.info('Object %s is already downloaded to %s' % (obj.key, target))
+            else:
+                downloads.append((obj.key, target))
             count = count + 1
         if count == 0:
             raise RuntimeError(
                 "Failed to fetch model. No model found in %s." % bucket_path)
 
+        # Large objects are split into ranged requests by the transfer config, the objects are downloaded in parallel
+        def download_object(download):
+            key, target = download
+            Storage._with_retries(bucket.download_file, key, target, Config=transfer_config)
+            logging.info('Downloaded object %s to %s' % (key, target))
+        Storage._run_concurrently(download_object, downloads)
+
         # Unpack compressed file, supports .tgz, tar.gz and zip file formats.
         if count == 1:
             mimetype, _ = mimetypes.guess_type(target)
@@ -217,6 +380,9 @@ class Storage(object):  # pylint: disable=too-few-public-methods
         if not prefix.endswith("/"):
             prefix = prefix + "/"
         blobs = bucket.list_blobs(prefix=prefix)
+        chunk_size = Storage._download_chunk_size()
+        parts = []
+        partial_paths = []
         count = 0
         for blob in blobs:
             # Replace any prefix from the object key with temp_dir
@@ -229,9 +395,25 @@ class Storage(object):  # pylint: disable=too-few-public-methods
                     os.makedirs(local_object_dir, exist_ok=True)
             if subdir_object_key.strip() != "" and not subdir_object_key.endswith("/"):
                 dest_path = os.path.join(temp_dir, subdir_object_key)
-                logging.info("Downloading: %s", dest_path)
-                blob.download_to_filename(dest_path)
+                if os.path.exists(dest_path) and os.path.getsize(dest_path) == blob.size:
+                    logging.info("Already downloaded: %s", dest_path)
+                elif blob.size and blob.size > chunk_size:
+                    logging.info("Downloading: %s", dest_path)
+                    # Allocate the file so that the parts can be written at their offsets, it is only moved to the
+                    # destination once all parts are written because its size does not tell whether it is complete
+                    partial_path = dest_path + _PARTIAL_SUFFIX
+                    with open(partial_path, "wb") as f:
+                        f.truncate(blob.size)
+                    parts += [(blob, partial_path, start, min(start + chunk_size, blob.size) - 1)
+                              for start in range(0, blob.size, chunk_size)]
+                    partial_paths.append(partial_path)
+                else:
+                    logging.info("Downloading: %s", dest_path)
+                    parts.append((blob, dest_path, None, None))
             count = count + 1
+        Storage._run_concurrently(lambda part: Storage._with_retries(Storage._download_gcs_part, *part), parts)
+        for partial_path in partial_paths:
+            os.rename(partial_path, partial_path[:-len(_PARTIAL_SUFFIX)])
         if count == 0:
             raise RuntimeError(
                 "Failed to fetch model. No model found in %s." % uri)
@@ -242,6 +424,53 @@ class Storage(object):  # pylint: disable=too-few-public-methods
             if mimetype in ["application/x-tar", "application/zip"]:
                 Storage._unpack_archive_file(dest_path, mimetype, temp_dir)
 
+    @staticmethod
+    def _download_gcs_part(blob, dest_path: str, start: Optional[int], end: Optional[int]):
+        if start is None:
+            blob.download_to_filename(dest_path)
+            return
+        with open(dest_path, "r+b") as f:
+            f.seek(start)
+            blob.download_to_file(f, start=start, end=end)
+
+    @staticmethod
+    def _download_concurrency() -> int:
+        return max(int(os.getenv(_DOWNLOAD_CONCURRENCY_ENV, "1")), 1)
+
+    @staticmethod
+    def _download_chunk_size() -> int:
+        return int(os.getenv(_DOWNLOAD_CHUNK_SIZE_ENV, _DEFAULT_DOWNLOAD_CHUNK_SIZE))
+
+    @staticmethod
+    def _download_retries() -> int:
+        return max(int(os.getenv(_DOWNLOAD_RETRIES_ENV, _DEFAULT_DOWNLOAD_RETRIES)), 0)
+
+    @staticmethod
+    def _with_retries(fn, *args, **kwargs):
+        """Calls fn and retries transient storage errors with a jittered exponential backoff."""
+        retries = Storage._download_retries()
+        for attempt in range(retries + 1):
+            try:
+                return fn(*args, **kwargs)
+            except Exception as e:  # pylint: disable=broad-except
+                if attempt == retries or not _is_transient_error(e):
+                    raise
+                backoff = random.uniform(0, min(_MAX_RETRY_BACKOFF_SECONDS, _RETRY_BACKOFF_SECONDS * 2 ** attempt))
+                logging.warning("Download failed with %s, retrying in %.1f seconds", e, backoff)
+                time.sleep(backoff)
+
+    @staticmethod
+    def _run_concurrently(fn, items):
+        """Calls fn for all items with up to the configured download concurrency and raises the first failure."""
+        concurrency = Storage._download_concurrency()
+        if concurrency == 1 or len(items) <= 1:
+            for item in items:
+                fn(item)
+            return
+        with concurrent.futures.ThreadPoolExecutor(max_workers=concurrency) as executor:
+            for future in [executor.submit(fn, item) for item in items]:
+                future.result()
+
     @staticmethod
     def _load_hdfs_configuration() -> Dict:
         config = {
@@ -256,6 +485,7 @@ class Storage(object):  # pylint: disable=too-few-public-methods
             "N_THREADS": "2",
             "KERBEROS_KEYTAB": None,
             "KERBEROS_PRINCIPAL": None,
+            "DELEGATION_TOKEN": None,
         }
 
         secret_dir = _HDFS_SECRET_DIRECTORY
@@ -281,6 +511,7 @@ class Storage(object):  # pylint: disable=too-few-public-methods
     def _download_hdfs(uri, out_dir: str):
         from krbcontext.context import krbContext
         from hdfs.ext.kerberos import Client, KerberosClient
+        from hdfs.client import TokenClient
 
         config = Storage._load_hdfs_configuration()
 
@@ -323,6 +554,14 @@ class Storage(object):  # pylint: disable=too-few-public-methods
                 root=config["HDFS_ROOTPATH"],
                 session=s
             )
+        elif config["DELEGATION_TOKEN"]:
+            client = TokenClient(
+                config["HDFS_NAMENODE"],
+                config["DELEGATION_TOKEN"].strip(),
+                proxy=config["USER_PROXY"],
+                root=config["HDFS_ROOTPATH"],
+                session=s
+            )
         else:
             client = Client(
                 config["HDFS_NAMENODE"],
@@ -349,7 +588,8 @@ class Storage(object):  # pylint: disable=too-few-public-methods
                      account_name,
                      container_name,
                      prefix)
-        token = Storage._get_azure_storage_token() or Storage._get_azure_storage_access_key()
+        token = Storage._get_azure_storage_token() or Storage._get_azure_storage_sas_token() or \
+            Storage._get_azure_storage_access_key()
         if token is None:
             logging.warning("Azure credentials or shared access signature token not found, retrying anonymous access")
 
@@ -441,8 +681,10 @@ class Storage(object):  # pylint: disable=too-few-public-methods
     def _parse_azure_uri(uri):  # pylint: disable=too-many-locals
         parsed = urlparse(uri)
         account_name = parsed.netloc.split('.')[0]
-        account_url = 'https://{}{}'.format(parsed.netloc, '?' + parsed.query if parsed.query else '')
-        object_name, prefix = parsed.path.lstrip('/').split("/", 1)
+        # azure://{account}/{container}/{path} is a short form of the blob URL
+        host = '{}.{}'.format(account_name, _AZURE_BLOB_HOST) if uri.startswith(_AZURE_PREFIX) else parsed.netloc
+        account_url = 'https://{}{}'.format(host, '?' + parsed.query if parsed.query else '')
+        object_name, _, prefix = parsed.path.lstrip('/').partition("/")
         prefix = prefix.strip('/')
         return account_name, account_url, object_name, prefix
 
@@ -469,6 +711,10 @@ class Storage(object):  # pylint: disable=too-few-public-methods
     def _get_azure_storage_access_key():
         return os.getenv("AZURE_STORAGE_ACCESS_KEY")
 
+    @staticmethod
+    def _get_azure_storage_sas_token():
+        return os.getenv("AZURE_STORAGE_SAS_TOKEN")
+
     @staticmethod
     def _download_local(uri, out_dir=None):
         local_path = uri.replace(_LOCAL_PREFIX, "", 1)
@@ -518,8 +764,52 @@ class Storage(object):  # pylint: disable=too-few-public-methods
         headers_json = os.getenv(host_uri + _HEADERS_SUFFIX, "{}")
         headers = json.loads(headers_json)
 
+        # The artifact is downloaded as published, interrupted downloads are resumed from the partial file
+        partial_path = local_path + _PARTIAL_SUFFIX
+        Storage._with_retries(Storage._download_partial_from_uri, uri, headers, mimetype, partial_path)
+
+        # The checksum covers the artifact as it was published, so it is computed before decompressing
+        expected_sha256 = os.getenv(_STORAGE_SHA256_ENV)
+        if expected_sha256:
+            sha256 = _file_sha256(partial_path)
+            if sha256 != expected_sha256.lower():
+                os.remove(partial_path)
+                raise RuntimeError("URI: %s has sha256 %s, expected %s" % (uri, sha256, expected_sha256))
+
+        verification = os.getenv(_STORAGE_VERIFICATION_ENV)
+        if verification:
+            try:
+                Storage._verify_signature(uri, partial_path, json.loads(verification))
+            except Exception:
+                os.remove(partial_path)
+                raise
+
+        if encoding == 'gzip':
+            local_path = os.path.join(out_dir, f'{filename}.tar')
+            with gzip.open(partial_path, 'rb') as stream, open(local_path, 'wb') as out:
+                shutil.copyfileobj(stream, out)
+            os.remove(partial_path)
+        else:
+            os.rename(partial_path, local_path)
+
+        if mimetype in ["application/x-tar", "application/zip"]:
+            Storage._unpack_archive_file(local_path, mimetype, out_dir)
+
+        return out_dir
+
+    @staticmethod
+    def _download_partial_from_uri(uri, headers: Dict, mimetype: Optional[str], partial_path: str):
+        offset = os.path.getsize(partial_path) if os.path.exists(partial_path) else 0
+        if offset > 0:
+            headers = dict(headers, Range="bytes=%d-" % offset)
         with requests.get(uri, stream=True, headers=headers) as response:
-            if response.status_code != 200:
+            if response.status_code == 416 and offset > 0:
+                # The artifact changed since the partial file was written, it has to be downloaded from the start
+                os.remove(partial_path)
+                raise _TransientDownloadError("URI: %s can not be resumed at byte %s." % (uri, offset))
+            if response.status_code >= 500 or response.status_code == 429:
+                raise _TransientDownloadError("URI: %s returned a %s response code." % (uri, response.status_code))
+            if response.status_code not in (200, 206):
                 raise RuntimeError("URI: %s returned a %s response code." % (uri, response.status_code))
             zip_content_types = ('application/x-zip-compressed', 'application/zip', 'application/zip-compressed')
             if mimetype == 'application/zip' and not response.headers.get('Content-Type', '')\
@@ -536,18 +826,58 @@ class Storage(object):  # pylint: disable=too-few-public-methods
                 raise RuntimeError("URI: %s did not respond with \'Content-Type\': \'application/octet-stream\'"
                                    % uri)
 
-            if encoding == 'gzip':
-                stream = gzip.GzipFile(fileobj=response.raw)
-                local_path = os.path.join(out_dir, f'{filename}.tar')
-            else:
-                stream = response.raw
-            with open(local_path, 'wb') as out:
-                shutil.copyfileobj(stream, out)
+            # The server sends the whole artifact when it does not support ranges
+            if response.status_code == 200:
+                offset = 0
+            with open(partial_path, 'ab' if offset > 0 else 'wb') as out:
+                shutil.copyfileobj(response.raw, out)
+                received = out.tell() - offset
+            content_length = response.headers.get('Content-Length')
+            if content_length is not None and received < int(content_length):
+                raise _TransientDownloadError("URI: %s was interrupted after %s of %s bytes."
+                                              % (uri, received, content_length))
 
-        if mimetype in ["application/x-tar", "application/zip"]:
-            Storage._unpack_archive_file(local_path, mimetype, out_dir)
+    @staticmethod
+    def _verify_signature(uri, path: str, verification: Dict):
+        """Verifies the detached cosign or GPG signature of the artifact before it is unpacked."""
+        signature_type = verification.get("type")
+        signature_uri = verification.get("signatureUri") or uri + _SIGNATURE_SUFFIX
+        with tempfile.TemporaryDirectory() as work_dir:
+            key_path = os.path.join(work_dir, "key.pub")
+            with open(key_path, "w") as f:
+                f.write(verification.get("publicKey", ""))
+            signature_path = os.path.join(work_dir, "signature")
+            Storage._with_retries(Storage._download_signature, signature_uri, signature_path)
+
+            if signature_type == "cosign":
+                commands = [["cosign", "verify-blob", "--key", key_path, "--signature", signature_path, path]]
+            elif signature_type == "gpg":
+                # The key is imported into a keyring of its own, so only signatures by this key are trusted
+                gpg = ["gpg", "--batch", "--no-tty", "--homedir", work_dir]
+                commands = [gpg + ["--import", key_path], gpg + ["--verify", signature_path, path]]
+            else:
+                raise RuntimeError("Signature type %s is not supported, must be one of: cosign, gpg" % signature_type)
+            for command in commands:
+                try:
+                    result = subprocess.run(command, stdout=subprocess.PIPE, stderr=subprocess.STDOUT)
+                except OSError as e:
+                    raise RuntimeError("Failed to run %s to verify URI: %s: %s" % (command[0], uri, e))
+                if result.returncode != 0:
+                    raise RuntimeError("URI: %s failed the %s signature verification: %s"
+                                       % (uri, signature_type, result.stdout.decode(errors="replace").strip()))
+        logging.info("Verified the %s signature %s of %s", signature_type, signature_uri, uri)
 
-        return out_dir
+    @staticmethod
+    def _download_signature(signature_uri: str, signature_path: str):
+        headers = json.loads(os.getenv(urlparse(signature_uri).hostname + _HEADERS_SUFFIX, "{}"))
+        with requests.get(signature_uri, headers=headers) as response:
+            if response.status_code >= 500 or response.status_code == 429:
+                raise _TransientDownloadError("URI: %s returned a %s response code." % (signature_uri,
+                                                                                        response.status_code))
+            if response.status_code != 200:
+                raise RuntimeError("URI: %s returned a %s response code." % (signature_uri, response.status_code))
+            with open(signature_path, "wb") as f:
+                f.write(response.content)
 
     @staticmethod
     def _unpack_archive_file(file_path, mimetype, target_dir=None):
@@ -566,3 +896,66 @@ class Storage(object):  # pylint: disable=too-few-public-methods
             raise RuntimeError("Failed to unpack archive file. \
 The file format is not valid.")
         os.remove(file_path)
+
+
+class _TransientDownloadError(RuntimeError):
+    """Raised for download failures which are expected to succeed when the download is retried."""
+
+
+def _is_transient_error(e: Exception) -> bool:
+    if isinstance(e, (_TransientDownloadError, requests.exceptions.ConnectionError, requests.exceptions.Timeout,
+                      requests.exceptions.ChunkedEncodingError, botocore.exceptions.ConnectionError,
+                      botocore.exceptions.HTTPClientError, api_exceptions.ServerError,
+                      api_exceptions.TooManyRequests, ConnectionError, TimeoutError)):
+        return True
+    if isinstance(e, botocore.exceptions.ClientError):
+        status_code = e.response.get("ResponseMetadata", {}).get("HTTPStatusCode", 0)
+        return status_code >= 500 or status_code == 429 or \
+            e.response.get("Error", {}).get("Code") in ("SlowDown", "Throttling", "RequestTimeout")
+    return False
+
+
+def _file_sha256(path: str) -> str:
+    sha256 = hashlib.sha256()
+    with open(path, "rb") as f:
+        for block in iter(lambda: f.read(1024 * 1024), b""):
+            sha256.update(block)
+    return sha256.hexdigest()
+
+
+def _detect_framework(out_dir: str) -> Optional[Tuple[str, str]]:
+    """Returns the framework and its version from the MLflow MLmodel file or the XGBoost JSON model."""
+    mlmodel = os.path.join(out_dir, "MLmodel")
+    if os.path.isfile(mlmodel):
+        # The flavors are indented under the top level flavors key, e.g. "  sklearn:" and "    sklearn_version: 1.0.2"
+        in_flavors, flavor = False, None
+        with open(mlmodel) as f:
+            for line in f:
+                if not line.startswith(" "):
+                    in_flavors = line.rstrip() == "flavors:"
+                    continue
+                if not in_flavors:
+                    continue
+                indent = len(line) - len(line.lstrip(" "))
+                key, _, value = line.strip().partition(":")
+                if indent == 2:
+                    flavor = key
+                elif flavor and flavor != "python_function" and key.endswith("_version") and value.strip():
+                    return flavor, value.strip().strip("'\"")
+    for path in Path(out_dir).glob("*.json"):
+        try:
+            with open(path) as f:
+                model = json.load(f)
+        except (ValueError, UnicodeDecodeError):
+            continue
+        if isinstance(model, dict) and "learner" in model and isinstance(model.get("version"), list):
+            return "xgboost", ".".join(str(v) for v in model["version"])
+    return None
+
+
+def _link_or_copy(src, dst):
+    """Hard links a cached file when the cache and the model directory are on the same filesystem."""
+    try:
+        os.link(src, dst)
+    except OSError:
+        shutil.copy2(src, dst)
@@ -124,6 +124,34 @@ def test_secure_blob(mock_storage, mock_get_token, mock_makedirs):  # pylint: di
     assert arg_list == [{'credential': 'some_token'}]
 
 
+@mock.patch('kserve.storage.os.makedirs')
+@mock.patch('kserve.storage.Storage._get_azure_storage_sas_token')
+@mock.patch('kserve.storage.BlobServiceClient')
+def test_azure_scheme_blob(mock_storage, mock_get_sas_token, mock_makedirs):  # pylint: disable=unused-argument
+
+    # given
+    blob_path = 'azure://kfserving/triton/simple_string/'
+    paths = ['simple_string/1/model.graphdef', 'simple_string/config.pbtxt']
+    mock_get_sas_token.return_value = "sv=2021-06-08&sig=some_signature"
+    mock_blob, mock_container = create_mock_blob(mock_storage, paths)
+
+    # when
+    kserve.Storage.download(blob_path, "dest_path")
+
+    # then
+    mock_storage.assert_called_with('https://kfserving.blob.core.windows.net',
+                                    credential="sv=2021-06-08&sig=some_signature")
+    mock_blob.return_value.get_container_client.assert_called_with('triton')
+    arg_list = get_call_args(mock_container.download_blob.call_args_list)
+    assert set(arg_list) == set([('simple_string/1/model.graphdef',),
+                                 ('simple_string/config.pbtxt',)])
+
+
+def test_parse_azure_scheme_uri():
+    assert kserve.Storage._parse_azure_uri('azure://kfserving/triton') == \
+        ('kfserving', 'https://kfserving.blob.core.windows.net', 'triton', '')
+
+
 @mock.patch('kserve.storage.os.makedirs')
 @mock.patch('kserve.storage.BlobServiceClient')
 def test_deep_blob(mock_storage, mock_makedirs):  # pylint: disable=unused-argument
@@ -79,6 +79,9 @@ class DummyModel(Model):
     async def explain(self, request, headers=None):
         return {"predictions": request["instances"]}
 
+    async def detect_adversarial(self, request, headers=None):
+        return {"adversarial": [False for _ in request["instances"]]}
+
 
 @serve.deployment
 class DummyServeModel(Model):
@@ -224,6 +227,14 @@ class TestTFHttpServer:
         assert resp.body == b'{"predictions": [[1, 2]]}'
         assert resp.headers['content-type'] == "application/json; charset=UTF-8"
 
+    async def test_adversarial(self, http_server_client):
+        resp = await http_server_client.fetch('/v1/models/TestModel:adversarial',
+                                              method="POST",
+                                              body=b'{"instances":[[1,2]]}')
+        assert resp.code == 200
+        assert resp.body == b'{"adversarial": [false]}'
+        assert resp.headers['content-type'] == "application/json; charset=UTF-8"
+
     async def test_list(self, http_server_client):
         resp = await http_server_client.fetch('/v1/models')
         assert resp.code == 200
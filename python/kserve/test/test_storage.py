@@ -12,8 +12,11 @@
 # See the License for the specific language governing permissions and
 # limitations under the License.
 
+import hashlib
 import io
+import json
 import os
+import subprocess
 import tempfile
 import binascii
 import unittest.mock as mock
@@ -69,6 +72,7 @@ class MockHttpResponse(object):
     ):
         self.status_code = status_code
         self.raw = io.BytesIO(raw)
+        self.content = raw
         self.headers = {'Content-Type': content_type}
 
     def __enter__(self):
@@ -134,15 +138,142 @@ def test_http_uri_paths(uri, response, expected_error):
     mock.patch('requests.get', return_value=response)(test)()
 
 
+def test_http_uri_sha256():
+    uri = HTTPS_URI_TARGZ
+    sha256 = hashlib.sha256(FILE_TAR_GZ_RAW).hexdigest()
+    with tempfile.TemporaryDirectory() as out_dir:
+        with mock.patch('requests.get', return_value=MockHttpResponse(200, FILE_TAR_GZ_RAW, 'application/x-tar')), \
+                mock.patch.dict(os.environ, {'STORAGE_SHA256': sha256.upper()}):
+            assert kserve.Storage.download(uri, out_dir=out_dir) == out_dir
+        assert os.path.exists(os.path.join(out_dir, 'model.pth'))
+
+    with tempfile.TemporaryDirectory() as out_dir:
+        with mock.patch('requests.get', return_value=MockHttpResponse(200, FILE_TAR_GZ_RAW, 'application/x-tar')), \
+                mock.patch.dict(os.environ, {'STORAGE_SHA256': '0' * 64}):
+            with pytest.raises(RuntimeError):
+                kserve.Storage.download(uri, out_dir=out_dir)
+        assert os.listdir(out_dir) == []
+
+
+def test_http_uri_signature():
+    uri = HTTPS_URI_TARGZ
+    verification = json.dumps({'type': 'cosign', 'publicKey': 'cosign-public-key'})
+
+    def get(url, **_):
+        if url == uri + '.sig':
+            return MockHttpResponse(200, b'signature')
+        return MockHttpResponse(200, FILE_TAR_GZ_RAW, 'application/x-tar')
+
+    with tempfile.TemporaryDirectory() as out_dir:
+        with mock.patch('requests.get', side_effect=get), \
+                mock.patch.dict(os.environ, {'STORAGE_VERIFICATION': verification}), \
+                mock.patch(STORAGE_MODULE + '.subprocess.run',
+                           return_value=subprocess.CompletedProcess([], 0, b'Verified OK')) as run:
+            assert kserve.Storage.download(uri, out_dir=out_dir) == out_dir
+        command = run.call_args[0][0]
+        assert command[:2] == ['cosign', 'verify-blob']
+        assert command[-1] == os.path.join(out_dir, 'model.tar.gz.part')
+        assert os.path.exists(os.path.join(out_dir, 'model.pth'))
+
+    verification = json.dumps({'type': 'gpg', 'publicKey': 'gpg-public-key',
+                               'signatureUri': 'https://foo.bar/model.tar.gz.sig'})
+    with tempfile.TemporaryDirectory() as out_dir:
+        with mock.patch('requests.get', side_effect=get), \
+                mock.patch.dict(os.environ, {'STORAGE_VERIFICATION': verification}), \
+                mock.patch(STORAGE_MODULE + '.subprocess.run',
+                           return_value=subprocess.CompletedProcess([], 1, b'BAD signature')) as run:
+            with pytest.raises(RuntimeError, match='BAD signature'):
+                kserve.Storage.download(uri, out_dir=out_dir)
+        assert run.call_args[0][0][0] == 'gpg'
+        assert os.listdir(out_dir) == []
+
+
+def test_http_uri_resume():
+    uri = 'https://foo.bar/model.joblib'
+    content = b'x' * 60 + b'y' * 40
+    interrupted = MockHttpResponse(200, content[:60], 'application/octet-stream')
+    interrupted.headers['Content-Length'] = str(len(content))
+    resumed = MockHttpResponse(206, content[60:], 'application/octet-stream')
+    with tempfile.TemporaryDirectory() as out_dir, \
+            mock.patch('requests.get', side_effect=[interrupted, resumed]) as mock_get, \
+            mock.patch('time.sleep') as mock_sleep:
+        assert kserve.Storage.download(uri, out_dir=out_dir) == out_dir
+        with open(os.path.join(out_dir, 'model.joblib'), 'rb') as f:
+            assert f.read() == content
+        assert os.listdir(out_dir) == ['model.joblib']
+    assert mock_get.call_args_list[1][1]['headers'] == {'Range': 'bytes=60-'}
+    mock_sleep.assert_called_once()
+
+
+def test_http_uri_retry():
+    uri = 'https://foo.bar/model.joblib'
+    responses = [MockHttpResponse(503), MockHttpResponse(429), MockHttpResponse(200, b'x', 'application/octet-stream')]
+    with tempfile.TemporaryDirectory() as out_dir, \
+            mock.patch('requests.get', side_effect=responses) as mock_get, \
+            mock.patch('time.sleep'):
+        assert kserve.Storage.download(uri, out_dir=out_dir) == out_dir
+    assert mock_get.call_count == 3
+
+    # client errors are not retried
+    with mock.patch('requests.get', return_value=MockHttpResponse(404)) as mock_get, mock.patch('time.sleep'):
+        with pytest.raises(RuntimeError):
+            kserve.Storage.download(uri)
+    assert mock_get.call_count == 1
+
+    with mock.patch.dict(os.environ, {'STORAGE_DOWNLOAD_RETRIES': '2'}), \
+            mock.patch('requests.get', side_effect=lambda *args, **kwargs: MockHttpResponse(503)) as mock_get, \
+            mock.patch('time.sleep'):
+        with pytest.raises(RuntimeError):
+            kserve.Storage.download(uri)
+    assert mock_get.call_count == 3
+
+
+def test_http_uri_cache():
+    uri = 'https://foo.bar/model.joblib'
+    with tempfile.TemporaryDirectory() as cache_dir, \
+            mock.patch.dict(os.environ, {'STORAGE_CACHE_DIR': cache_dir, 'STORAGE_CACHE_SIZE_LIMIT': '150'}), \
+            mock.patch('requests.get', side_effect=lambda *args, **kwargs: MockHttpResponse(
+                200, b'x' * 100, 'application/octet-stream')) as mock_get:
+        for etag in ['v1', 'v1', 'v2']:
+            with tempfile.TemporaryDirectory() as out_dir, \
+                    mock.patch('requests.head', return_value=MockHttpResponse(200)) as mock_head:
+                mock_head.return_value.headers['ETag'] = etag
+                assert kserve.Storage.download(uri, out_dir=out_dir) == out_dir
+                with open(os.path.join(out_dir, 'model.joblib'), 'rb') as f:
+                    assert f.read() == b'x' * 100
+        # the second download is served from the cache and the first version is evicted by the size limit
+        assert mock_get.call_count == 2
+        assert len(os.listdir(cache_dir)) == 1
+
+
 @mock.patch(STORAGE_MODULE + '.storage')
 def test_mock_gcs(mock_storage):
     gcs_path = 'gs://foo/bar'
     mock_obj = mock.MagicMock()
     mock_obj.name = 'mock.object'
+    mock_obj.size = 0
     mock_storage.Client().bucket().list_blobs().__iter__.return_value = [mock_obj]
     assert kserve.Storage.download(gcs_path)
 
 
+@mock.patch(STORAGE_MODULE + '.storage')
+def test_mock_gcs_ranged_download(mock_storage):
+    gcs_path = 'gs://foo/bar'
+    content = bytes(range(256)) * 4
+    mock_obj = mock.MagicMock()
+    mock_obj.name = 'bar/model.bin'
+    mock_obj.size = len(content)
+    mock_obj.download_to_file.side_effect = lambda f, start, end: f.write(content[start:end + 1])
+    mock_storage.Client().bucket().list_blobs().__iter__.return_value = [mock_obj]
+    with tempfile.TemporaryDirectory() as out_dir, \
+            mock.patch.dict(os.environ, {'STORAGE_DOWNLOAD_CONCURRENCY': '4', 'STORAGE_DOWNLOAD_CHUNK_SIZE': '100'}):
+        kserve.Storage.download(gcs_path, out_dir=out_dir)
+        with open(os.path.join(out_dir, 'model.bin'), 'rb') as f:
+            assert f.read() == content
+    assert mock_obj.download_to_file.call_count == 11
+    mock_obj.download_to_filename.assert_not_called()
+
+
 def test_storage_blob_exception():
     blob_path = 'https://accountname.blob.core.windows.net/container/some/blob/'
     with pytest.raises(Exception):
@@ -197,3 +328,30 @@ def test_unpack_zip_file():
     kserve.Storage._unpack_archive_file(tar_file, mimetype, out_dir)
     assert os.path.exists(os.path.join(out_dir, 'model.pth'))
     os.remove(os.path.join(out_dir, 'model.pth'))
+
+
+def test_resolve_metadata():
+    with tempfile.TemporaryDirectory() as out_dir:
+        os.makedirs(os.path.join(out_dir, "model"))
+        Path(out_dir, "model", "model.pkl").write_bytes(b"model")
+        Path(out_dir, "MLmodel").write_text("artifact_path: model\n"
+                                            "flavors:\n"
+                                            "  python_function:\n"
+                                            "    python_version: 3.8.10\n"
+                                            "  sklearn:\n"
+                                            "    pickled_model: model.pkl\n"
+                                            "    sklearn_version: 1.0.2\n")
+        metadata = kserve.Storage.resolve_metadata(out_dir)
+        assert metadata["artifactSize"] == len(b"model") + os.path.getsize(os.path.join(out_dir, "MLmodel"))
+        assert metadata["checksum"].startswith("sha256:")
+        assert metadata["framework"] == "sklearn"
+        assert metadata["frameworkVersion"] == "1.0.2"
+
+        # The checksum changes with the content of the files
+        Path(out_dir, "model", "model.pkl").write_bytes(b"retrained")
+        assert kserve.Storage.resolve_metadata(out_dir)["checksum"] != metadata["checksum"]
+
+    with tempfile.TemporaryDirectory() as out_dir:
+        Path(out_dir, "model.json").write_text(json.dumps({"learner": {}, "version": [1, 7, 3]}))
+        metadata = kserve.Storage.resolve_metadata(out_dir)
+        assert (metadata["framework"], metadata["frameworkVersion"]) == ("xgboost", "1.7.3")